@@ -0,0 +1,18 @@
+package vent
+
+// ActionResult is what a schema action reports when it succeeds on an entity.
+type ActionResult struct {
+	// Message is shown as a toast; empty shows "<Label>: done.".
+	Message string
+	// Redirect, when set, navigates there instead of re-rendering the page
+	// the action was run from. Bulk runs ignore it.
+	Redirect string
+}
+
+// ToastMessage is the toast shown after an action labelled label succeeds.
+func (r ActionResult) ToastMessage(label string) string {
+	if r.Message != "" {
+		return r.Message
+	}
+	return label + ": done."
+}
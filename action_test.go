@@ -0,0 +1,12 @@
+package vent
+
+import "testing"
+
+func TestActionResultToastMessage(t *testing.T) {
+	if got := (ActionResult{}).ToastMessage("Publish"); got != "Publish: done." {
+		t.Fatalf("default message = %q", got)
+	}
+	if got := (ActionResult{Message: "Published Dune."}).ToastMessage("Publish"); got != "Published Dune." {
+		t.Fatalf("message = %q", got)
+	}
+}
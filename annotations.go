@@ -3,6 +3,8 @@ package vent
 import (
 	"encoding/json"
 	"errors"
+	"reflect"
+	"strings"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
@@ -11,6 +13,24 @@ import (
 type VentConfigAnnotation struct {
 	VentExtensionConfig
 	Configs []NodeRenderConfig
+	// AuditLog is the schema using AuditLogMixin; it is zero when the audit
+	// log is disabled.
+	AuditLog AuditLogConfig
+	// Revisions is the schema using RevisionMixin; it is zero when revisions
+	// are disabled.
+	Revisions RevisionConfig
+}
+
+// AuditLogConfig names the audit log schema and its admin route.
+type AuditLogConfig struct {
+	SchemaName string
+	RouteName  string
+}
+
+// RevisionConfig names the revision schema and its ID type.
+type RevisionConfig struct {
+	SchemaName string
+	IDType     string
 }
 
 func (VentConfigAnnotation) Name() string {
@@ -53,30 +73,199 @@ func (a *VentAuthMixinAnnotation) parse(node *gen.Type) error {
 	return json.Unmarshal(jsonBytes, a)
 }
 
+// VentAuditLogAnnotation marks the schema that uses AuditLogMixin.
+type VentAuditLogAnnotation struct{}
+
+func (VentAuditLogAnnotation) Name() string {
+	return "VentAuditLog"
+}
+
+func isAuditLogNode(node *gen.Type) bool {
+	_, ok := node.Annotations[VentAuditLogAnnotation{}.Name()]
+	return ok
+}
+
+// VentRevisionAnnotation marks the schema that uses RevisionMixin.
+type VentRevisionAnnotation struct{}
+
+func (VentRevisionAnnotation) Name() string {
+	return "VentRevision"
+}
+
+func isRevisionNode(node *gen.Type) bool {
+	_, ok := node.Annotations[VentRevisionAnnotation{}.Name()]
+	return ok
+}
+
+// VentSoftDeleteAnnotation marks schemas that use SoftDeleteMixin.
+type VentSoftDeleteAnnotation struct{}
+
+func (VentSoftDeleteAnnotation) Name() string {
+	return "VentSoftDelete"
+}
+
+func isSoftDeleteNode(node *gen.Type) bool {
+	_, ok := node.Annotations[VentSoftDeleteAnnotation{}.Name()]
+	return ok
+}
+
+// VentSchemaAnnotation configures a schema's admin. Schemas and mixins can
+// each declare one, and Ent merges them in order (see Merge). The bool
+// fields are ORed when merged, so a schema cannot turn an inherited true off
+// by setting false; it has to name the field in Clear.
 type VentSchemaAnnotation struct {
 	DisableAdmin        bool
 	ReadOnly            bool
 	DisableCreate       bool
 	DisableDelete       bool
 	ReadOnlyFields      []string
+	FileFields          []string
+	ImageFields         []string
 	RouteName           string
 	SingularDisplayName string
 	PluralDisplayName   string
 	CustomFields        []Field
 	FieldSets           []FieldSet
+	FieldSetLayout      FieldSetLayout
 	TableColumns        []string
 	FilterableColumns   []string
+	SearchFields        []string
+	DefaultOrdering     []string
 	PageSize            int
-	Permissions         []Permission
+	// VersionField names an int or time field (e.g. "version" or
+	// "updated_at") the change form carries; a save is refused when someone
+	// else has changed it since the form loaded.
+	VersionField string
+	// PrepopulatedFields fills string fields on the add form from other
+	// fields as the user types, e.g. {"slug": {"title"}} keeps a slug of
+	// the title in "slug" until the user edits it.
+	PrepopulatedFields map[string][]string
+	Permissions        []Permission
+	// Clear names inherited annotation fields (e.g. "FilterableColumns") to
+	// reset before this annotation is merged over mixin defaults. Code
+	// generation fails on a name that is not a field of this struct.
+	Clear []string
 }
 
 func (VentSchemaAnnotation) Name() string {
 	return "VentSchema"
 }
 
-// Merge allows schema-level VentSchema annotations to override mixin defaults.
-func (VentSchemaAnnotation) Merge(annotation schema.Annotation) schema.Annotation {
-	return annotation
+// Merge folds a later VentSchema annotation (the schema's own, or a later
+// mixin's) over this one. Fields listed in Clear are reset first; then bools
+// are ORed, set scalars override, non-empty lists replace, and Permissions and
+// CustomFields append, with later entries replacing earlier ones of the same
+// name. Unknown Clear names are kept in the merged Clear so code generation
+// reports them.
+func (a VentSchemaAnnotation) Merge(other schema.Annotation) schema.Annotation {
+	var b VentSchemaAnnotation
+	switch other := other.(type) {
+	case VentSchemaAnnotation:
+		b = other
+	case *VentSchemaAnnotation:
+		if other == nil {
+			return a
+		}
+		b = *other
+	default:
+		return a
+	}
+
+	merged := a
+	target := reflect.ValueOf(&merged).Elem()
+	for _, name := range b.Clear {
+		if isClearableAnnotationField(name) {
+			field := target.FieldByName(name)
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+	merged.Clear = mergeNamed(merged.Clear, b.Clear, func(name string) string { return name })
+
+	merged.DisableAdmin = merged.DisableAdmin || b.DisableAdmin
+	merged.ReadOnly = merged.ReadOnly || b.ReadOnly
+	merged.DisableCreate = merged.DisableCreate || b.DisableCreate
+	merged.DisableDelete = merged.DisableDelete || b.DisableDelete
+	if b.RouteName != "" {
+		merged.RouteName = b.RouteName
+	}
+	if b.SingularDisplayName != "" {
+		merged.SingularDisplayName = b.SingularDisplayName
+	}
+	if b.PluralDisplayName != "" {
+		merged.PluralDisplayName = b.PluralDisplayName
+	}
+	if b.FieldSetLayout != "" {
+		merged.FieldSetLayout = b.FieldSetLayout
+	}
+	if b.PageSize != 0 {
+		merged.PageSize = b.PageSize
+	}
+	if b.VersionField != "" {
+		merged.VersionField = b.VersionField
+	}
+	if len(b.ReadOnlyFields) > 0 {
+		merged.ReadOnlyFields = b.ReadOnlyFields
+	}
+	if len(b.FileFields) > 0 {
+		merged.FileFields = b.FileFields
+	}
+	if len(b.ImageFields) > 0 {
+		merged.ImageFields = b.ImageFields
+	}
+	if len(b.FieldSets) > 0 {
+		merged.FieldSets = b.FieldSets
+	}
+	if len(b.TableColumns) > 0 {
+		merged.TableColumns = b.TableColumns
+	}
+	if len(b.FilterableColumns) > 0 {
+		merged.FilterableColumns = b.FilterableColumns
+	}
+	if len(b.SearchFields) > 0 {
+		merged.SearchFields = b.SearchFields
+	}
+	if len(b.DefaultOrdering) > 0 {
+		merged.DefaultOrdering = b.DefaultOrdering
+	}
+	if len(b.PrepopulatedFields) > 0 {
+		merged.PrepopulatedFields = b.PrepopulatedFields
+	}
+	merged.Permissions = mergeNamed(merged.Permissions, b.Permissions, func(p Permission) string { return p.Name })
+	merged.CustomFields = mergeNamed(merged.CustomFields, b.CustomFields, func(f Field) string { return strings.ToLower(f.Name) })
+	return merged
+}
+
+// mergeNamed appends next to prev; an entry whose key already exists replaces
+// the earlier one in place.
+func mergeNamed[T any](prev, next []T, key func(T) string) []T {
+	if len(next) == 0 {
+		return prev
+	}
+	merged := append([]T(nil), prev...)
+	for _, item := range next {
+		replaced := false
+		for i := range merged {
+			if key(merged[i]) == key(item) {
+				merged[i] = item
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+// isClearableAnnotationField reports whether name is a VentSchemaAnnotation
+// field that Clear can reset.
+func isClearableAnnotationField(name string) bool {
+	if name == "Clear" {
+		return false
+	}
+	field, ok := reflect.TypeOf(VentSchemaAnnotation{}).FieldByName(name)
+	return ok && field.IsExported()
 }
 
 func (a *VentSchemaAnnotation) parse(node *gen.Type) error {
@@ -93,6 +282,51 @@ func (a *VentSchemaAnnotation) parse(node *gen.Type) error {
 	return json.Unmarshal(jsonBytes, a)
 }
 
+// VentFieldAnnotation customizes how a single Ent field or edge is presented
+// in the admin. Attach it with field.X(...).Annotations(...).
+type VentFieldAnnotation struct {
+	// Label replaces the form and filter label derived from the field name.
+	Label string
+	// HelpText is shown beneath the form input.
+	HelpText string
+	// Placeholder is shown in empty text and number inputs.
+	Placeholder string
+	// Widget overrides the default form input for string and time fields.
+	Widget FieldWidget
+	// ColumnHeader replaces the list-view column header; defaults to Label.
+	ColumnHeader string
+}
+
+func (VentFieldAnnotation) Name() string {
+	return "VentField"
+}
+
+func (a *VentFieldAnnotation) parse(annotations map[string]any) (bool, error) {
+	annotation, ok := annotations[a.Name()]
+	if !ok {
+		return false, nil
+	}
+
+	jsonBytes, err := json.Marshal(annotation)
+	if err != nil {
+		return false, err
+	}
+
+	return true, json.Unmarshal(jsonBytes, a)
+}
+
+// FieldWidget selects an alternative form input for a field.
+type FieldWidget string
+
+const (
+	WidgetTextarea FieldWidget = "textarea"
+	WidgetEmail    FieldWidget = "email"
+	WidgetURL      FieldWidget = "url"
+	WidgetColor    FieldWidget = "color"
+	// WidgetDate edits a time field as a date without a time of day.
+	WidgetDate FieldWidget = "date"
+)
+
 type Field struct {
 	Name      string
 	Type      string
@@ -100,7 +334,23 @@ type Field struct {
 	Sensitive bool
 }
 
+// FieldSet groups form fields under a titled section (or tab).
 type FieldSet struct {
-	Label  string
-	Fields []string
+	Label       string
+	Description string
+	Fields      []string
+	// Collapsible renders the section as a disclosure the user can fold away.
+	Collapsible bool
+	// Collapsed starts a collapsible section folded; it implies Collapsible.
+	Collapsed bool
 }
+
+// FieldSetLayout controls how multiple field sets are arranged on forms.
+type FieldSetLayout string
+
+const (
+	// FieldSetLayoutSections stacks field sets as titled sections (the default).
+	FieldSetLayoutSections FieldSetLayout = "sections"
+	// FieldSetLayoutTabs shows one field set at a time behind a tab bar.
+	FieldSetLayoutTabs FieldSetLayout = "tabs"
+)
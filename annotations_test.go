@@ -0,0 +1,89 @@
+package vent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVentSchemaAnnotationMergeKeepsMixinDefaults(t *testing.T) {
+	mixin := VentSchemaAnnotation{
+		TableColumns:       []string{"email", "is_staff"},
+		FilterableColumns:  []string{"email"},
+		FieldSets:          []FieldSet{{Fields: []string{"email", "groups"}}},
+		Permissions:        []Permission{{Name: "impersonate", Desc: "Act as another user"}},
+		PrepopulatedFields: map[string][]string{"username": {"email"}},
+	}
+	merged := mixin.Merge(VentSchemaAnnotation{
+		RouteName:    "members",
+		TableColumns: []string{"email"},
+		PageSize:     25,
+		Permissions: []Permission{
+			{Name: "impersonate", Desc: "Sign in as another member"},
+			{Name: "export", Desc: "Export members"},
+		},
+	}).(VentSchemaAnnotation)
+
+	if merged.RouteName != "members" || merged.PageSize != 25 {
+		t.Fatalf("scalars = %q/%d, want members/25", merged.RouteName, merged.PageSize)
+	}
+	if !reflect.DeepEqual(merged.TableColumns, []string{"email"}) {
+		t.Fatalf("TableColumns = %v, want schema override", merged.TableColumns)
+	}
+	if !reflect.DeepEqual(merged.FilterableColumns, []string{"email"}) {
+		t.Fatalf("FilterableColumns = %v, want inherited", merged.FilterableColumns)
+	}
+	if len(merged.FieldSets) != 1 {
+		t.Fatalf("FieldSets = %v, want inherited", merged.FieldSets)
+	}
+	if !reflect.DeepEqual(merged.PrepopulatedFields, mixin.PrepopulatedFields) {
+		t.Fatalf("PrepopulatedFields = %v, want inherited", merged.PrepopulatedFields)
+	}
+	want := []Permission{
+		{Name: "impersonate", Desc: "Sign in as another member"},
+		{Name: "export", Desc: "Export members"},
+	}
+	if !reflect.DeepEqual(merged.Permissions, want) {
+		t.Fatalf("Permissions = %v, want %v", merged.Permissions, want)
+	}
+	if len(mixin.Permissions) != 1 || mixin.Permissions[0].Desc != "Act as another user" {
+		t.Fatalf("mixin Permissions mutated: %v", mixin.Permissions)
+	}
+}
+
+func TestVentSchemaAnnotationMergeClear(t *testing.T) {
+	mixin := VentSchemaAnnotation{
+		DisableCreate:     true,
+		FilterableColumns: []string{"name"},
+		ReadOnlyFields:    []string{"name"},
+		CustomFields:      []Field{{Name: "Notes", Type: "string"}},
+	}
+	merged := mixin.Merge(&VentSchemaAnnotation{
+		Clear:        []string{"DisableCreate", "FilterableColumns", "CustomFields"},
+		CustomFields: []Field{{Name: "summary", Type: "string"}},
+	}).(VentSchemaAnnotation)
+
+	if merged.DisableCreate {
+		t.Fatal("DisableCreate = true, want cleared")
+	}
+	if merged.FilterableColumns != nil {
+		t.Fatalf("FilterableColumns = %v, want cleared", merged.FilterableColumns)
+	}
+	if !reflect.DeepEqual(merged.ReadOnlyFields, []string{"name"}) {
+		t.Fatalf("ReadOnlyFields = %v, want inherited", merged.ReadOnlyFields)
+	}
+	if len(merged.CustomFields) != 1 || merged.CustomFields[0].Name != "summary" {
+		t.Fatalf("CustomFields = %v, want only schema entry", merged.CustomFields)
+	}
+}
+
+func TestVentSchemaAnnotationMergeDeduplicatesCustomFields(t *testing.T) {
+	merged := VentSchemaAnnotation{
+		CustomFields: []Field{{Name: "Notes", Type: "string"}},
+	}.Merge(VentSchemaAnnotation{
+		CustomFields: []Field{{Name: "notes", Type: "string", InputType: "json"}},
+	}).(VentSchemaAnnotation)
+
+	if len(merged.CustomFields) != 1 || merged.CustomFields[0].InputType != "json" {
+		t.Fatalf("CustomFields = %v, want schema entry to replace mixin entry", merged.CustomFields)
+	}
+}
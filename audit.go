@@ -0,0 +1,65 @@
+package vent
+
+// AuditAction is the kind of admin mutation an audit log entry records.
+type AuditAction string
+
+const (
+	AuditActionCreate   AuditAction = "create"
+	AuditActionUpdate   AuditAction = "update"
+	AuditActionDelete   AuditAction = "delete"
+	AuditActionPassword AuditAction = "password"
+	// AuditActionRestore and AuditActionPurge record an entity of a
+	// SoftDeleteMixin schema being taken out of the trash or deleted for good.
+	AuditActionRestore AuditAction = "restore"
+	AuditActionPurge   AuditAction = "purge"
+)
+
+// HistoryLimit is how many audit log entries an entity's History tab shows,
+// newest first.
+const HistoryLimit = 100
+
+// AuditChange is one field's value before and after an audited mutation, in
+// list cell form. Before is empty for creates and After for deletes.
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// AuditValue is one field's list cell value in a snapshot of an entity.
+type AuditValue struct {
+	Field string
+	Value string
+}
+
+// AuditDiff returns the fields whose values differ between two snapshots of
+// the same entity, in snapshot order. A nil before records a create and a nil
+// after a delete; those keep only the non-empty values.
+func AuditDiff(before, after []AuditValue) []AuditChange {
+	var changes []AuditChange
+	switch {
+	case before == nil:
+		for _, value := range after {
+			if value.Value != "" {
+				changes = append(changes, AuditChange{Field: value.Field, After: value.Value})
+			}
+		}
+	case after == nil:
+		for _, value := range before {
+			if value.Value != "" {
+				changes = append(changes, AuditChange{Field: value.Field, Before: value.Value})
+			}
+		}
+	default:
+		previous := make(map[string]string, len(before))
+		for _, value := range before {
+			previous[value.Field] = value.Value
+		}
+		for _, value := range after {
+			if old := previous[value.Field]; old != value.Value {
+				changes = append(changes, AuditChange{Field: value.Field, Before: old, After: value.Value})
+			}
+		}
+	}
+	return changes
+}
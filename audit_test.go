@@ -0,0 +1,39 @@
+package vent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before := []AuditValue{{"title", "Dune"}, {"pages", "412"}, {"tags", ""}}
+	after := []AuditValue{{"title", "Dune Messiah"}, {"pages", "412"}, {"tags", "sf"}}
+
+	tests := []struct {
+		name          string
+		before, after []AuditValue
+		want          []AuditChange
+	}{
+		{"update", before, after, []AuditChange{
+			{Field: "title", Before: "Dune", After: "Dune Messiah"},
+			{Field: "tags", After: "sf"},
+		}},
+		{"create", nil, before, []AuditChange{
+			{Field: "title", After: "Dune"},
+			{Field: "pages", After: "412"},
+		}},
+		{"delete", after, nil, []AuditChange{
+			{Field: "title", Before: "Dune Messiah"},
+			{Field: "pages", Before: "412"},
+			{Field: "tags", Before: "sf"},
+		}},
+		{"unchanged", before, before, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AuditDiff(tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("AuditDiff() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
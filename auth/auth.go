@@ -2,7 +2,6 @@ package auth
 
 import (
 	"crypto/rand"
-	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,12 +11,12 @@ type VentClaims struct {
 	jwt.RegisteredClaims
 }
 
-func NewClaims(userID int) *VentClaims {
+func NewClaims(subject string) *VentClaims {
 	now := time.Now()
 	return &VentClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        rand.Text(),
-			Subject:   strconv.Itoa(userID),
+			Subject:   subject,
 			ExpiresAt: jwt.NewNumericDate(now.Add(24 * time.Hour)),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
@@ -4,12 +4,20 @@ import (
 	"crypto/rand"
 	"crypto/subtle"
 	"encoding/hex"
+	"mime"
 	"net/http"
 )
 
 const (
 	CSRFTokenCookieName = "vent-csrf-token"
 	CSRFTokenHeaderName = "X-CSRF-Token"
+	// CSRFTokenFormField carries the token in multipart form submissions that
+	// cannot set the header.
+	CSRFTokenFormField = "csrf_token"
+	// MaxMultipartBytes caps multipart request bodies (form fields plus uploads).
+	MaxMultipartBytes = 32 << 20
+	// multipartMemoryBytes is kept in memory while parsing; larger files spill to disk.
+	multipartMemoryBytes = 8 << 20
 	// CSRFTokenMaxAge matches the auth session lifetime.
 	CSRFTokenMaxAge = 24 * 60 * 60
 )
@@ -41,9 +49,30 @@ func ValidateCSRFToken(r *http.Request) bool {
 	}
 
 	headerToken := r.Header.Get(CSRFTokenHeaderName)
+	if headerToken == "" && IsMultipartRequest(r) {
+		if err := ParseMultipartForm(r); err != nil {
+			return false
+		}
+		headerToken = r.PostFormValue(CSRFTokenFormField)
+	}
 	if headerToken == "" {
 		return false
 	}
 
 	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(headerToken)) == 1
 }
+
+// IsMultipartRequest reports whether r carries a multipart/form-data body.
+func IsMultipartRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// ParseMultipartForm parses r's multipart body once, capped at MaxMultipartBytes.
+func ParseMultipartForm(r *http.Request) error {
+	if r.MultipartForm != nil {
+		return nil
+	}
+	r.Body = http.MaxBytesReader(nil, r.Body, MaxMultipartBytes)
+	return r.ParseMultipartForm(multipartMemoryBytes)
+}
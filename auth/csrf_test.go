@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"bytes"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -70,6 +72,20 @@ func TestValidateCSRFToken(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "multipart form field",
+			request: func() *http.Request {
+				return newMultipartCSRFRequest(t, token, token)
+			},
+			want: true,
+		},
+		{
+			name: "multipart mismatched form field",
+			request: func() *http.Request {
+				return newMultipartCSRFRequest(t, token, "different")
+			},
+			want: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,3 +132,19 @@ func TestSetCSRFTokenCookie(t *testing.T) {
 		t.Fatalf("MaxAge = %d, want %d", cookie.MaxAge, CSRFTokenMaxAge)
 	}
 }
+
+func newMultipartCSRFRequest(t *testing.T, cookie, field string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField(CSRFTokenFormField, field); err != nil {
+		t.Fatalf("WriteField() error = %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/admin/books/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	r.AddCookie(&http.Cookie{Name: CSRFTokenCookieName, Value: cookie})
+	return r
+}
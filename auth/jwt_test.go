@@ -16,7 +16,7 @@ func TestJwtUsesSecretProviderOnEachCall(t *testing.T) {
 	gen := NewJwtTokenGenerator(provider)
 	authn := NewJwtTokenAuthenticator(provider)
 
-	token, err := gen.Generate(NewClaims(42))
+	token, err := gen.Generate(NewClaims("42"))
 	if err != nil {
 		t.Fatalf("generate: %v", err)
 	}
@@ -36,7 +36,7 @@ func TestJwtUsesSecretProviderOnEachCall(t *testing.T) {
 		t.Fatal("expected token signed with old secret to fail after rotation")
 	}
 
-	rotated, err := gen.Generate(NewClaims(7))
+	rotated, err := gen.Generate(NewClaims("7"))
 	if err != nil {
 		t.Fatalf("generate after rotation: %v", err)
 	}
@@ -0,0 +1,50 @@
+package vent
+
+// MaxBulkActionRows caps how many rows one bulk action may run on, including
+// selections of every row matching the list filters.
+const MaxBulkActionRows = 10000
+
+// BulkActionDelete names the built-in bulk delete. Schema actions may not
+// reuse it.
+const BulkActionDelete = "delete"
+
+// BulkSelection is the list selection a bulk action runs on, read from the
+// list page's bulk signals.
+type BulkSelection struct {
+	Action string   `json:"action"`
+	IDs    []string `json:"ids"`
+	// All selects every row matching the list's filters and search rather
+	// than the listed IDs.
+	All bool `json:"all"`
+}
+
+// BulkResult summarizes a bulk action run. Rows are independent: a failed
+// row does not undo the rows that succeeded.
+type BulkResult struct {
+	// Label is the action's menu label.
+	Label     string
+	Succeeded int
+	Failures  []BulkFailure
+}
+
+// BulkFailure is one row a bulk action could not be applied to.
+type BulkFailure struct {
+	ID      string
+	Name    string
+	Message string
+}
+
+// Record adds the outcome of one row to the result. message is the row's
+// error, or "" when it succeeded.
+func (r *BulkResult) Record(id, name, message string) {
+	if message == "" {
+		r.Succeeded++
+		return
+	}
+	r.Failures = append(r.Failures, BulkFailure{ID: id, Name: name, Message: message})
+}
+
+// Total is how many rows the action ran on.
+func (r BulkResult) Total() int {
+	return r.Succeeded + len(r.Failures)
+}
@@ -0,0 +1,21 @@
+package vent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBulkResultRecord(t *testing.T) {
+	result := BulkResult{Label: "Delete"}
+	result.Record("1", "Dune", "")
+	result.Record("2", "Emma", "forbidden")
+	result.Record("3", "Ulysses", "")
+
+	if result.Succeeded != 2 || result.Total() != 3 {
+		t.Fatalf("Succeeded = %d, Total = %d, want 2 and 3", result.Succeeded, result.Total())
+	}
+	want := []BulkFailure{{ID: "2", Name: "Emma", Message: "forbidden"}}
+	if !reflect.DeepEqual(result.Failures, want) {
+		t.Fatalf("Failures = %+v, want %+v", result.Failures, want)
+	}
+}
@@ -0,0 +1,69 @@
+package vent
+
+import "encoding/json"
+
+// EditConflictField is one field of a change form that lost a concurrent
+// edit, with its values formatted by FormatRevisionValue.
+type EditConflictField struct {
+	Field string
+	// Loaded is the value when the form loaded, Saved the value saved since
+	// and Submitted the value the form posted.
+	Loaded    string
+	Saved     string
+	Submitted string
+	// Changed reports that someone else saved a new value after the form
+	// loaded; Pending that the user changed it on the form. A field with
+	// both set is a real conflict.
+	Changed bool
+	Pending bool
+}
+
+// EncodeEditOriginal encodes a change form's values as it loaded them. The
+// form posts them back so EditConflictDiff can tell the fields someone else
+// changed from the ones the user did.
+func EncodeEditOriginal(loaded RevisionSnapshot) (string, error) {
+	data, err := json.Marshal(loaded)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// EditConflictDiff compares a change form that lost a concurrent edit with
+// the values it loaded with, given as original by EncodeEditOriginal. saved
+// is the entity as saved now and input the schema's update input; fields it
+// left unset are never pending. It returns the fields that were changed or
+// are pending, in the order of fields. Without original the form is taken to
+// have loaded the saved values, so only pending fields are returned.
+func EditConflictDiff(fields []string, original string, saved RevisionSnapshot, input any) ([]EditConflictField, error) {
+	loaded := saved
+	if original != "" {
+		loaded = RevisionSnapshot{}
+		if err := json.Unmarshal([]byte(original), &loaded); err != nil {
+			return nil, err
+		}
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	var submitted RevisionSnapshot
+	if err := json.Unmarshal(data, &submitted); err != nil {
+		return nil, err
+	}
+	var diff []EditConflictField
+	for _, field := range fields {
+		f := EditConflictField{
+			Field:     field,
+			Loaded:    FormatRevisionValue(loaded[field]),
+			Saved:     FormatRevisionValue(saved[field]),
+			Submitted: FormatRevisionValue(submitted[field]),
+		}
+		f.Changed = f.Saved != f.Loaded
+		f.Pending = submitted[field] != nil && f.Submitted != f.Loaded
+		if f.Changed || f.Pending {
+			diff = append(diff, f)
+		}
+	}
+	return diff, nil
+}
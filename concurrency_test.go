@@ -0,0 +1,55 @@
+package vent
+
+import (
+	"reflect"
+	"testing"
+)
+
+type conflictInput struct {
+	Title     *string  `json:"title"`
+	Pages     *int     `json:"pages"`
+	Author    *string  `json:"author"`
+	Reviewers []string `json:"reviewers"`
+}
+
+func TestEditConflictDiff(t *testing.T) {
+	title, pages := "Dune", 412
+	input := conflictInput{Title: &title, Pages: &pages, Reviewers: []string{"1", "2"}}
+	saved := RevisionSnapshot{"title": "Dune Messiah", "pages": 412, "author": "3", "reviewers": []string{"1"}}
+
+	got, err := EditConflictDiff([]string{"title", "pages", "author", "reviewers"}, "", saved, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []EditConflictField{
+		{Field: "title", Loaded: "Dune Messiah", Saved: "Dune Messiah", Submitted: "Dune", Pending: true},
+		{Field: "reviewers", Loaded: "1", Saved: "1", Submitted: "1, 2", Pending: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("EditConflictDiff() = %+v, want %+v", got, want)
+	}
+}
+
+func TestEditConflictDiffSeparatesBothUsersEdits(t *testing.T) {
+	original, err := EncodeEditOriginal(RevisionSnapshot{"title": "Dune", "pages": 412, "reviewers": []string{"1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Someone else retitled the book; this user only changed its pages and
+	// resubmitted the title and reviewers as loaded.
+	saved := RevisionSnapshot{"title": "Dune Messiah", "pages": 412, "reviewers": []string{"1"}}
+	title, pages := "Dune", 500
+	input := conflictInput{Title: &title, Pages: &pages, Reviewers: []string{"1"}}
+
+	got, err := EditConflictDiff([]string{"title", "pages", "reviewers"}, original, saved, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []EditConflictField{
+		{Field: "title", Loaded: "Dune", Saved: "Dune Messiah", Submitted: "Dune", Changed: true},
+		{Field: "pages", Loaded: "412", Saved: "412", Submitted: "500", Pending: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("EditConflictDiff() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,15 @@
+package vent
+
+// DeleteEffect is what deleting an entity does to the entities related to it
+// through one edge, following the ON DELETE action of the edge's foreign key.
+type DeleteEffect string
+
+const (
+	// DeleteCascade deletes the related entities too.
+	DeleteCascade DeleteEffect = "cascade"
+	// DeleteUnlink keeps the related entities but removes their link to the
+	// deleted entity.
+	DeleteUnlink DeleteEffect = "unlink"
+	// DeleteBlock refuses the delete while related entities exist.
+	DeleteBlock DeleteEffect = "block"
+)
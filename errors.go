@@ -10,10 +10,13 @@ import (
 // HttpError is a client-safe error with an HTTP status code.
 // Message is safe to show to clients and in form UI.
 // Cause holds internal details for server-side logs only.
+// Field names the form field a validation error belongs to, so forms can show
+// it next to that input.
 type HttpError struct {
 	Status  int
 	Message string
 	Cause   error
+	Field   string
 }
 
 func (e *HttpError) Error() string {
@@ -68,6 +71,11 @@ func BadRequest(message string) *HttpError {
 	return NewHttpError(http.StatusBadRequest, message)
 }
 
+// InvalidField returns a 400 error for one form field's value.
+func InvalidField(field, message string) *HttpError {
+	return &HttpError{Status: http.StatusBadRequest, Message: message, Field: field}
+}
+
 func Unauthorized(message string) *HttpError {
 	return NewHttpError(http.StatusUnauthorized, message)
 }
@@ -60,6 +60,16 @@ func TestAsHttpError(t *testing.T) {
 	}
 }
 
+func TestInvalidFieldKeepsFieldThroughWrapping(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("save: %w", InvalidField("metadata", "invalid metadata").WithCause(errors.New("unexpected EOF")))
+	he, ok := AsHttpError(err)
+	if !ok || he.Status != http.StatusBadRequest || he.Field != "metadata" || he.PublicMessage() != "invalid metadata" {
+		t.Fatalf("AsHttpError() = %+v, %v", he, ok)
+	}
+}
+
 func TestHttpErrorUnwrap(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,713 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/troygilman/vent"
+	"github.com/troygilman/vent/auth"
+	"github.com/troygilman/vent/examples/basic/ent"
+	"github.com/troygilman/vent/examples/basic/ent/admin"
+	"github.com/troygilman/vent/examples/basic/ent/auditlog"
+	"github.com/troygilman/vent/examples/basic/ent/book"
+	"github.com/troygilman/vent/examples/basic/ent/hook"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
+	"github.com/troygilman/vent/examples/basic/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testCSRFToken = "test-csrf-token"
+
+// testAdmin is the example admin over a fresh SQLite database, with a
+// signed-in superuser.
+type testAdmin struct {
+	client  *ent.Client
+	handler http.Handler
+	token   string
+}
+
+// newTestAdmin builds the example admin; configure can swap schema admins
+// before the handler is created.
+func newTestAdmin(t *testing.T, configure ...func(*admin.AdminConfig)) *testAdmin {
+	t.Helper()
+	ctx := context.Background()
+	client, err := ent.Open("sqlite3", "file:"+filepath.Join(t.TempDir(), "test.db")+"?_fk=1&_busy_timeout=5000")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	superuser, err := client.User.Create().
+		SetEmail("admin@vent.com").
+		SetPasswordHash("unused").
+		SetIsStaff(true).
+		SetIsSuperuser(true).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create superuser: %v", err)
+	}
+	secret := auth.SecretProviderFunc(func() []byte { return []byte("secret") })
+	token, err := auth.NewJwtTokenGenerator(secret).Generate(auth.NewClaims(vent.FormatID(superuser.ID)))
+	if err != nil {
+		t.Fatalf("generate token: %v", err)
+	}
+
+	config := admin.AdminConfig{
+		Client:                  client,
+		SecretProvider:          secret,
+		CredentialGenerator:     auth.NewBCryptCredentialGenerator(),
+		CredentialAuthenticator: auth.NewBCryptCredentialAuthenticator(),
+		FileStorage:             vent.NewLocalFileStorage(t.TempDir()),
+		Schemas:                 newSchemaAdmins(client),
+	}
+	for _, fn := range configure {
+		fn(&config)
+	}
+	handler, err := admin.NewAdminHandler(config)
+	if err != nil {
+		t.Fatalf("NewAdminHandler() error = %v", err)
+	}
+	return &testAdmin{client: client, handler: handler, token: token}
+}
+
+// signInAs switches a to a new staff user whose only group holds permissions.
+func (a *testAdmin) signInAs(t *testing.T, email string, permissions ...string) {
+	t.Helper()
+	ctx := context.Background()
+	group := a.client.PermissionGroup.Create().SetName(email)
+	for _, name := range permissions {
+		group.AddPermissions(a.client.Permission.Create().SetName(name).SaveX(ctx))
+	}
+	staff := a.client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("unused").
+		SetIsStaff(true).
+		SetIsActive(true).
+		AddGroups(group.SaveX(ctx)).
+		SaveX(ctx)
+	secret := auth.SecretProviderFunc(func() []byte { return []byte("secret") })
+	token, err := auth.NewJwtTokenGenerator(secret).Generate(auth.NewClaims(vent.FormatID(staff.ID)))
+	if err != nil {
+		t.Fatalf("generate token: %v", err)
+	}
+	a.token = token
+}
+
+// do sends a signed-in request carrying a CSRF token.
+func (a *testAdmin) do(t *testing.T, method, path, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.AddCookie(&http.Cookie{Name: "vent-auth-token", Value: a.token})
+	req.AddCookie(&http.Cookie{Name: auth.CSRFTokenCookieName, Value: testCSRFToken})
+	req.Header.Set(auth.CSRFTokenHeaderName, testCSRFToken)
+	rec := httptest.NewRecorder()
+	a.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// importFile posts a CSV import of content to the import route at path.
+func (a *testAdmin) importFile(t *testing.T, path, content string, fields map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	file, err := form.CreateFormFile("file", "import.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.WriteString(file, content); err != nil {
+		t.Fatal(err)
+	}
+	if err := form.Close(); err != nil {
+		t.Fatal(err)
+	}
+	rec := a.do(t, http.MethodPost, path, form.FormDataContentType(), &body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST %s status = %d, body = %s", path, rec.Code, rec.Body.String())
+	}
+	return rec
+}
+
+// createBook saves a book with a new author, outside the admin.
+func (a *testAdmin) createBook(t *testing.T, title string) *ent.Book {
+	t.Helper()
+	ctx := context.Background()
+	owner, err := a.client.User.Create().SetEmail(strings.ToLower(strings.ReplaceAll(title, " ", ".")) + "@vent.com").SetPasswordHash("unused").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	author, err := a.client.Author.Create().SetUser(owner).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.client.Book.Create().SetTitle(title).SetAuthor(author).SaveX(ctx)
+}
+
+// patchBook submits the change form of e with entity as its signals.
+func (a *testAdmin) patchBook(t *testing.T, e *ent.Book, entity string) *httptest.ResponseRecorder {
+	t.Helper()
+	body := fmt.Sprintf(`{"entity":%s,"entityVersion":"%d"}`, entity, e.Version)
+	return a.do(t, http.MethodPatch, "/admin/books/"+vent.FormatIDPath(e.ID)+"/", "application/json", strings.NewReader(body))
+}
+
+// editOriginal loads the change page of e and returns the form values it
+// posts back with a save.
+func (a *testAdmin) editOriginal(t *testing.T, e *ent.Book) string {
+	t.Helper()
+	rec := a.do(t, http.MethodGet, "/admin/books/"+vent.FormatIDPath(e.ID)+"/", "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET change page status = %d", rec.Code)
+	}
+	match := regexp.MustCompile(`data-signals__ifmissing="\{entityVersion: [^,]*, entityOriginal: ([^"]*)\}"`).FindStringSubmatch(rec.Body.String())
+	if match == nil {
+		t.Fatalf("change page has no loaded values:\n%s", rec.Body.String())
+	}
+	original, err := strconv.Unquote(html.UnescapeString(match[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return original
+}
+
+func TestRunActionOverHTTP(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	draft := a.createBook(t, "Draft")
+
+	path := "/admin/books/" + vent.FormatIDPath(draft.ID) + "/actions/publish/"
+	rec := a.do(t, http.MethodPost, path, "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST %s status = %d, body = %s", path, rec.Code, rec.Body.String())
+	}
+	if !a.client.Book.GetX(ctx, draft.ID).Published {
+		t.Fatal("publish action did not run")
+	}
+}
+
+func TestEditConflictSeparatesBothUsersEdits(t *testing.T) {
+	a := newTestAdmin(t)
+	opened := a.createBook(t, "Dune")
+	original := a.editOriginal(t, opened)
+
+	if rec := a.patchBook(t, opened, `{"title":"Dune Messiah"}`); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	body := fmt.Sprintf(`{"entity":{"title":"Dune","pages":500},"entityVersion":"%d","entityOriginal":%q}`, opened.Version, original)
+	rec := a.do(t, http.MethodPatch, "/admin/books/"+vent.FormatIDPath(opened.ID)+"/", "application/json", strings.NewReader(body))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("stale PATCH status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	for _, want := range []string{
+		`<th scope="row">title</th><td>Dune</td><td>Dune Messiah</td><td><span class="detail-empty">unchanged</span></td>`,
+		`<th scope="row">pages</th><td>0</td><td><span class="detail-empty">unchanged</span></td><td class="edit-conflict-pending">500</td>`,
+	} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Fatalf("conflict missing %q:\n%s", want, rec.Body.String())
+		}
+	}
+	if got := a.client.Book.GetX(context.Background(), opened.ID).Title; got != "Dune Messiah" {
+		t.Fatalf("title after stale save = %q, want Dune Messiah", got)
+	}
+}
+
+func TestRestoreRevisionOverHTTP(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	edited := a.createBook(t, "First Title")
+
+	if rec := a.patchBook(t, edited, `{"title":"Second Title"}`); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if got := a.client.Book.GetX(ctx, edited.ID).Title; got != "Second Title" {
+		t.Fatalf("title after edit = %q", got)
+	}
+	rev := a.client.Revision.Query().
+		Where(revision.SchemaEQ("Book"), revision.EntityIDEQ(vent.FormatID(edited.ID))).
+		OnlyX(ctx)
+
+	path := fmt.Sprintf("/admin/books/%s/revisions/%s/restore/", vent.FormatIDPath(edited.ID), vent.FormatIDPath(rev.ID))
+	rec := a.do(t, http.MethodPost, path, "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST %s status = %d, body = %s", path, rec.Code, rec.Body.String())
+	}
+	if got := a.client.Book.GetX(ctx, edited.ID).Title; got != "First Title" {
+		t.Fatalf("title after restore = %q, want First Title", got)
+	}
+}
+
+func TestAuditFailureRollsBackTheChange(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	kept := a.createBook(t, "Kept Title")
+	a.client.AuditLog.Use(func(ent.Mutator) ent.Mutator {
+		return hook.AuditLogFunc(func(context.Context, *ent.AuditLogMutation) (ent.Value, error) {
+			return nil, errors.New("audit log unavailable")
+		})
+	})
+
+	a.patchBook(t, kept, `{"title":"Lost Title"}`)
+	if got := a.client.Book.GetX(ctx, kept.ID).Title; got != "Kept Title" {
+		t.Fatalf("title = %q, want the edit rolled back with its audit entry", got)
+	}
+	if rec := a.do(t, http.MethodDelete, "/admin/books/"+vent.FormatIDPath(kept.ID)+"/", "", nil); rec.Code != http.StatusOK {
+		t.Fatalf("DELETE status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if !a.client.Book.Query().Where(book.IDEQ(kept.ID)).ExistX(ctx) {
+		t.Fatal("book was deleted without its audit entry")
+	}
+}
+
+func TestRevisionFailureRollsBackTheChange(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	kept := a.createBook(t, "Kept Title")
+	a.client.Revision.Use(func(ent.Mutator) ent.Mutator {
+		return hook.RevisionFunc(func(context.Context, *ent.RevisionMutation) (ent.Value, error) {
+			return nil, errors.New("revisions unavailable")
+		})
+	})
+
+	a.patchBook(t, kept, `{"title":"Lost Title"}`)
+	if got := a.client.Book.GetX(ctx, kept.ID).Title; got != "Kept Title" {
+		t.Fatalf("title = %q, want the edit rolled back with its revision", got)
+	}
+	if n := a.client.AuditLog.Query().CountX(ctx); n != 0 {
+		t.Fatalf("audit log entries = %d, want the rolled back edit's entry gone", n)
+	}
+}
+
+// txNotesField is the example's notes field, refusing to save outside the
+// admin's transaction.
+type txNotesField struct {
+	BookNotesField
+}
+
+func (f txNotesField) ApplyCreate(ctx context.Context, builder *ent.BookCreate, input admin.BookCreateInput) error {
+	if ent.TxFromContext(ctx) == nil {
+		return errors.New("notes saved outside a transaction")
+	}
+	return f.BookNotesField.ApplyCreate(ctx, builder, input)
+}
+
+func (f txNotesField) ApplyUpdate(ctx context.Context, builder *ent.BookUpdateOne, input admin.BookUpdateInput) error {
+	if ent.TxFromContext(ctx) == nil {
+		return errors.New("notes saved outside a transaction")
+	}
+	return f.BookNotesField.ApplyUpdate(ctx, builder, input)
+}
+
+type txNotesBookAdmin struct {
+	BookAdmin
+}
+
+func (txNotesBookAdmin) FieldNotes() admin.BookField {
+	return txNotesField{}
+}
+
+func TestImportAndRestoreRunFieldsInATransaction(t *testing.T) {
+	a := newTestAdmin(t, func(config *admin.AdminConfig) {
+		config.Schemas.Book = txNotesBookAdmin{BookAdmin{DefaultBookAdmin: admin.NewDefaultBookAdmin(config.Client)}}
+	})
+	ctx := context.Background()
+	existing := a.createBook(t, "Existing")
+
+	rec := a.importFile(t, "/admin/books/import/", fmt.Sprintf("title,author,notes\nImported,%s,first draft\n", vent.FormatID(existing.QueryAuthor().OnlyIDX(ctx))), nil)
+	imported, err := a.client.Book.Query().Where(book.TitleEQ("Imported")).Only(ctx)
+	if err != nil {
+		t.Fatalf("imported book: %v; result = %s", err, rec.Body.String())
+	}
+
+	if rec := a.patchBook(t, imported, `{"notes":"second draft"}`); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	rev := a.client.Revision.Query().
+		Where(revision.SchemaEQ("Book"), revision.EntityIDEQ(vent.FormatID(imported.ID))).
+		OnlyX(ctx)
+	path := fmt.Sprintf("/admin/books/%s/revisions/%s/restore/", vent.FormatIDPath(imported.ID), vent.FormatIDPath(rev.ID))
+	rec = a.do(t, http.MethodPost, path, "", nil)
+	if bytes.Contains(rec.Body.Bytes(), []byte("outside a transaction")) {
+		t.Fatalf("restore ran the field outside a transaction: %s", rec.Body.String())
+	}
+	if got := a.client.Book.GetX(ctx, imported.ID).Version; got != imported.Version+2 {
+		t.Fatalf("version after edit and restore = %d, want %d", got, imported.Version+2)
+	}
+}
+
+func TestImportUpsertSavesMatchedRowsThroughUpdate(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	reader, err := a.client.User.Create().SetEmail("reader@vent.com").SetPasswordHash("unused").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.importFile(t, "/admin/users/import/", "email,is_staff\nreader@vent.com,true\nnew@vent.com,false\n", map[string]string{"upsert": "email"})
+
+	reader, err = a.client.User.Get(ctx, reader.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reader.IsStaff {
+		t.Fatal("matched user was not updated")
+	}
+	if n := a.client.User.Query().Where(user.EmailEQ("new@vent.com")).CountX(ctx); n != 1 {
+		t.Fatalf("created users = %d, want 1", n)
+	}
+	updates := a.client.AuditLog.Query().
+		Where(auditlog.ActionEQ(auditlog.ActionUpdate), auditlog.EntityIDEQ(vent.FormatID(reader.ID))).
+		CountX(ctx)
+	if updates != 1 {
+		t.Fatalf("update audit entries = %d, want 1", updates)
+	}
+}
+
+func TestImportRollsBackEveryRowWhenOneFails(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+
+	rec := a.importFile(t, "/admin/users/import/", "email\ntwin@vent.com\ntwin@vent.com\nthird@vent.com\n", nil)
+
+	if n := a.client.User.Query().CountX(ctx); n != 1 {
+		t.Fatalf("users after failed import = %d, want only the superuser", n)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte("third@vent.com")) {
+		t.Fatalf("rows after the failed one are missing from the result: %s", rec.Body.String())
+	}
+}
+
+func TestTrashNeedsDeletePermission(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := vent.SkipSoftDelete(context.Background())
+	trashed := a.client.Publisher.Create().SetName("Gone").SetDeletedAt(time.Now()).SaveX(ctx)
+	a.signInAs(t, "restorer@vent.com", "read_publisher", "restore_publisher", "purge_publisher")
+
+	for _, tc := range []struct{ method, path string }{
+		{http.MethodGet, "/admin/publishers/trash/"},
+		{http.MethodPost, "/admin/publishers/" + vent.FormatIDPath(trashed.ID) + "/restore/"},
+		{http.MethodPost, "/admin/publishers/" + vent.FormatIDPath(trashed.ID) + "/purge/"},
+	} {
+		if rec := a.do(t, tc.method, tc.path, "", nil); rec.Code != http.StatusForbidden {
+			t.Errorf("%s %s status = %d, want %d", tc.method, tc.path, rec.Code, http.StatusForbidden)
+		}
+	}
+	if e := a.client.Publisher.GetX(ctx, trashed.ID); e.DeletedAt == nil {
+		t.Fatal("publisher left the trash without delete permission")
+	}
+}
+
+func TestSoftDeleteLeavesRelationsToThePurgeConfirmation(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	acme := a.client.Publisher.Create().SetName("Acme").SaveX(ctx)
+	a.client.Book.UpdateOne(a.createBook(t, "Dune")).SetPublisher(acme).ExecX(ctx)
+	entityPath := "/admin/publishers/" + vent.FormatIDPath(acme.ID) + "/"
+
+	rec := a.do(t, http.MethodGet, entityPath+"delete/", "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET delete page status = %d", rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "Related objects are kept.") || strings.Contains(body, "no longer linked") {
+		t.Fatalf("soft delete page should keep related objects:\n%s", body)
+	}
+	if rec := a.do(t, http.MethodDelete, entityPath, "", nil); rec.Code != http.StatusOK {
+		t.Fatalf("DELETE status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	rec = a.do(t, http.MethodGet, entityPath+"purge/", "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET purge page status = %d", rec.Code)
+	}
+	for _, want := range []string{
+		`<h1 class="page-title">Purge Acme</h1>`,
+		`<h2 class="entity-form-section-title">Kept, but no longer linked</h2>`,
+		`<h3 class="entity-form-section-title">Books (1)</h3>`,
+	} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Fatalf("purge page missing %q:\n%s", want, rec.Body.String())
+		}
+	}
+}
+
+// changeFormNotesField fails whenever the change form is built.
+type changeFormNotesField struct {
+	BookNotesField
+}
+
+func (changeFormNotesField) UpdateHTML(context.Context, *ent.Book) (string, error) {
+	return "", errors.New("change form built")
+}
+
+type changeFormBookAdmin struct {
+	BookAdmin
+}
+
+func (changeFormBookAdmin) FieldNotes() admin.BookField {
+	return changeFormNotesField{}
+}
+
+func TestReadOnlyUserGetsDetailPageWithoutBuildingTheChangeForm(t *testing.T) {
+	a := newTestAdmin(t, func(config *admin.AdminConfig) {
+		config.Schemas.Book = changeFormBookAdmin{BookAdmin{DefaultBookAdmin: admin.NewDefaultBookAdmin(config.Client)}}
+	})
+	read := a.createBook(t, "Read Only")
+	a.signInAs(t, "reader@vent.com", "read_book")
+
+	rec := a.do(t, http.MethodGet, "/admin/books/"+vent.FormatIDPath(read.ID)+"/", "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), "Read Only") {
+		t.Fatalf("detail page does not show the book: %s", rec.Body.String())
+	}
+}
+
+func TestFilesNeedReadPermissionOnTheirEntity(t *testing.T) {
+	storage := vent.NewLocalFileStorage(t.TempDir())
+	a := newTestAdmin(t, func(config *admin.AdminConfig) {
+		config.FileStorage = storage
+	})
+	ctx := context.Background()
+	const key = "book/cover/front.png"
+	if err := storage.Save(ctx, key, strings.NewReader("png")); err != nil {
+		t.Fatal(err)
+	}
+	covered := a.createBook(t, "Covered")
+	a.client.Book.UpdateOne(covered).SetCover(key).ExecX(ctx)
+	if err := storage.Save(ctx, "book/cover/stray.png", strings.NewReader("png")); err != nil {
+		t.Fatal(err)
+	}
+
+	a.signInAs(t, "reader@vent.com", "read_book")
+	for path, want := range map[string]int{
+		"/admin/files/" + key:                 http.StatusOK,
+		"/admin/files/book/cover/stray.png":   http.StatusNotFound,
+		"/admin/files/author/photo/front.png": http.StatusNotFound,
+	} {
+		if rec := a.do(t, http.MethodGet, path, "", nil); rec.Code != want {
+			t.Fatalf("reader GET %s status = %d, want %d", path, rec.Code, want)
+		}
+	}
+
+	a.signInAs(t, "author@vent.com", "read_author")
+	if rec := a.do(t, http.MethodGet, "/admin/files/"+key, "", nil); rec.Code != http.StatusForbidden {
+		t.Fatalf("GET without read_book status = %d, want 403", rec.Code)
+	}
+}
+
+func TestReplacedClearedAndDeletedFilesAreRemoved(t *testing.T) {
+	storage := vent.NewLocalFileStorage(t.TempDir())
+	a := newTestAdmin(t, func(config *admin.AdminConfig) {
+		config.FileStorage = storage
+	})
+	ctx := context.Background()
+	stored := func(key string) bool {
+		file, err := storage.Open(ctx, key)
+		if err != nil {
+			return false
+		}
+		file.Close()
+		return true
+	}
+	save := func(key string) {
+		if err := storage.Save(ctx, key, strings.NewReader("png")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	save("book/cover/old.png")
+	e := a.createBook(t, "Covered")
+	e = a.client.Book.UpdateOne(e).SetCover("book/cover/old.png").SaveX(ctx)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if err := form.WriteField("datastar", fmt.Sprintf(`{"entity":{},"entityVersion":"%d"}`, e.Version)); err != nil {
+		t.Fatal(err)
+	}
+	file, err := form.CreateFormFile("cover", "new.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.WriteString(file, "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"); err != nil {
+		t.Fatal(err)
+	}
+	if err := form.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if rec := a.do(t, http.MethodPatch, "/admin/books/"+vent.FormatIDPath(e.ID)+"/", form.FormDataContentType(), &body); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH with a new cover status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	e = a.client.Book.GetX(ctx, e.ID)
+	if stored("book/cover/old.png") || !stored(e.Cover) {
+		t.Fatalf("after replacing, old file kept = %v, new file %q kept = %v", stored("book/cover/old.png"), e.Cover, stored(e.Cover))
+	}
+
+	replaced := e.Cover
+	if rec := a.patchBook(t, e, `{"cover":""}`); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH clearing the cover status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if stored(replaced) {
+		t.Fatal("cleared cover file is still stored")
+	}
+
+	save("book/cover/shared.png")
+	save("book/cover/own.png")
+	a.client.Book.UpdateOneID(e.ID).SetCover("book/cover/shared.png").ExecX(ctx)
+	a.client.Book.UpdateOne(a.createBook(t, "Sharing")).SetCover("book/cover/shared.png").ExecX(ctx)
+	other := a.client.Book.UpdateOne(a.createBook(t, "Other")).SetCover("book/cover/own.png").SaveX(ctx)
+	for _, id := range []int{e.ID, other.ID} {
+		if rec := a.do(t, http.MethodDelete, "/admin/books/"+vent.FormatIDPath(id)+"/", "", nil); rec.Code != http.StatusOK {
+			t.Fatalf("DELETE book %d status = %d, body = %s", id, rec.Code, rec.Body.String())
+		}
+	}
+	if stored("book/cover/own.png") {
+		t.Fatal("deleted book's cover file is still stored")
+	}
+	if !stored("book/cover/shared.png") {
+		t.Fatal("cover file another book still holds was deleted")
+	}
+}
+
+func TestSavingABookKeepsItsTrashedPublisher(t *testing.T) {
+	a := newTestAdmin(t)
+	ctx := context.Background()
+	e := a.createBook(t, "Published")
+	publisher := a.client.Publisher.Create().SetName("Press").AddBooks(e).SaveX(ctx)
+	path := "/admin/publishers/" + vent.FormatIDPath(publisher.ID) + "/"
+	if rec := a.do(t, http.MethodDelete, path, "", nil); rec.Code != http.StatusOK {
+		t.Fatalf("DELETE publisher status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	e = a.client.Book.GetX(ctx, e.ID)
+	if rec := a.do(t, http.MethodGet, "/admin/books/"+vent.FormatIDPath(e.ID)+"/", "", nil); !strings.Contains(rec.Body.String(), "Press (in trash)") {
+		t.Fatalf("change form does not keep the trashed publisher selected:\n%s", rec.Body.String())
+	}
+	if rec := a.patchBook(t, e, a.editOriginal(t, e)); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH book status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if rec := a.do(t, http.MethodPost, path+"restore/", "", nil); rec.Code != http.StatusOK {
+		t.Fatalf("POST restore publisher status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if got, err := e.QueryPublisher().OnlyID(ctx); err != nil || got != publisher.ID {
+		t.Fatalf("book publisher = %v, %v, want %v", got, err, publisher.ID)
+	}
+}
+
+// hookBookAdmin is the example's book admin recording the hooks it runs. Its
+// BeforeUpdate refuses to retitle a book "Refused", after writing a
+// publisher in the save's transaction.
+type hookBookAdmin struct {
+	BookAdmin
+	client *ent.Client
+	calls  *[]string
+}
+
+func (hookBookAdmin) BeforeUpdate(ctx context.Context, _ *ent.Book, builder *ent.BookUpdateOne) error {
+	if title, _ := builder.Mutation().Title(); title == "Refused" {
+		ent.TxFromContext(ctx).Publisher.Create().SetName("Before hook").ExecX(ctx)
+		return vent.BadRequest("refused")
+	}
+	return nil
+}
+
+func (a hookBookAdmin) AfterCreate(ctx context.Context, e *ent.Book) error {
+	*a.calls = append(*a.calls, fmt.Sprintf("create %s committed=%t", e.Title, a.committed(ctx, e)))
+	return nil
+}
+
+func (a hookBookAdmin) AfterUpdate(ctx context.Context, prev, e *ent.Book) error {
+	*a.calls = append(*a.calls, fmt.Sprintf("update %s->%s committed=%t", prev.Title, e.Title, a.committed(ctx, e)))
+	return nil
+}
+
+// committed reports whether e is saved as the hook sees it, outside any
+// transaction.
+func (a hookBookAdmin) committed(ctx context.Context, e *ent.Book) bool {
+	saved, err := a.client.Book.Get(context.Background(), e.ID)
+	return ent.TxFromContext(ctx) == nil && err == nil && saved.Title == e.Title
+}
+
+func newHookTestAdmin(t *testing.T) (*testAdmin, *[]string) {
+	calls := new([]string)
+	a := newTestAdmin(t, func(config *admin.AdminConfig) {
+		config.Schemas.Book = hookBookAdmin{
+			BookAdmin: BookAdmin{DefaultBookAdmin: admin.NewDefaultBookAdmin(config.Client)},
+			client:    config.Client,
+			calls:     calls,
+		}
+	})
+	return a, calls
+}
+
+func TestBeforeUpdateErrorRollsBackTheSave(t *testing.T) {
+	a, calls := newHookTestAdmin(t)
+	ctx := context.Background()
+	e := a.createBook(t, "Draft")
+
+	rec := a.patchBook(t, e, `{"title":"Refused"}`)
+	if !strings.Contains(rec.Body.String(), "refused") {
+		t.Fatalf("PATCH does not report the hook's error: %s", rec.Body.String())
+	}
+	if got := a.client.Book.GetX(ctx, e.ID).Title; got != "Draft" {
+		t.Fatalf("title = %q, want the refused save rolled back", got)
+	}
+	if n := a.client.Publisher.Query().CountX(vent.SkipSoftDelete(ctx)); n != 0 {
+		t.Fatalf("publishers = %d, want the hook's write rolled back", n)
+	}
+	if len(*calls) != 0 {
+		t.Fatalf("hooks after a refused save = %q, want none", *calls)
+	}
+}
+
+func TestAfterUpdateGetsPreviousAndSavedBookAfterCommit(t *testing.T) {
+	a, calls := newHookTestAdmin(t)
+	e := a.createBook(t, "Draft")
+
+	if rec := a.patchBook(t, e, `{"title":"Final"}`); rec.Code != http.StatusOK {
+		t.Fatalf("PATCH status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if want := []string{"update Draft->Final committed=true"}; !slices.Equal(*calls, want) {
+		t.Fatalf("hooks = %q, want %q", *calls, want)
+	}
+}
+
+func TestAfterCreateRunsOnlyOnceAnImportCommits(t *testing.T) {
+	a, calls := newHookTestAdmin(t)
+	ctx := context.Background()
+	author := vent.FormatID(a.createBook(t, "Existing").QueryAuthor().OnlyIDX(ctx))
+
+	a.importFile(t, "/admin/books/import/?dry_run=1", fmt.Sprintf("title,author\nPreviewed,%s\n", author), nil)
+	a.importFile(t, "/admin/books/import/", fmt.Sprintf("title,author\nFailed,%s\nBroken,nobody\n", author), nil)
+	if len(*calls) != 0 {
+		t.Fatalf("hooks after a dry run and a failed import = %q, want none", *calls)
+	}
+
+	a.importFile(t, "/admin/books/import/", fmt.Sprintf("title,author\nImported,%s\n", author), nil)
+	if want := []string{"create Imported committed=true"}; !slices.Equal(*calls, want) {
+		t.Fatalf("hooks = %q, want %q", *calls, want)
+	}
+}
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"context"
+	"time"
+
+	"github.com/troygilman/vent"
 	ent "github.com/troygilman/vent/examples/basic/ent"
 	"github.com/troygilman/vent/examples/basic/ent/admin"
 )
@@ -25,3 +29,26 @@ func (a BookAdmin) EagerLoadQuery(q *ent.BookQuery) *ent.BookQuery {
 func (a BookAdmin) FieldNotes() admin.BookField {
 	return BookNotesField{}
 }
+
+// Actions adds a "Mark published" action, enforcing the schema's extra
+// publish permission.
+func (a BookAdmin) Actions() []admin.BookAction {
+	return []admin.BookAction{
+		{
+			Name:       "publish",
+			Label:      "Mark published",
+			Permission: "publish",
+			Confirm:    "Mark as published?",
+			Run: func(ctx context.Context, e *ent.Book) (vent.ActionResult, error) {
+				update := a.Client.Book.UpdateOne(e).SetPublished(true)
+				if e.PublishedAt == nil {
+					update.SetPublishedAt(time.Now())
+				}
+				if err := update.Exec(ctx); err != nil {
+					return vent.ActionResult{}, err
+				}
+				return vent.ActionResult{Message: "Published " + e.Title + "."}, nil
+			},
+		},
+	}
+}
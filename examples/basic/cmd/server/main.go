@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/troygilman/vent"
 	"github.com/troygilman/vent/auth"
 	"github.com/troygilman/vent/examples/basic/ent"
 	"github.com/troygilman/vent/examples/basic/ent/admin"
@@ -36,20 +37,8 @@ func main() {
 		}),
 		CredentialGenerator:     credentialGenerator,
 		CredentialAuthenticator: auth.NewBCryptCredentialAuthenticator(),
-		Schemas: admin.SchemaAdmins{
-			User: UserAdmin{
-				DefaultUserAdmin: admin.NewDefaultUserAdmin(client),
-			},
-			Author: AuthorAdmin{
-				DefaultAuthorAdmin: admin.NewDefaultAuthorAdmin(client),
-			},
-			Book: BookAdmin{
-				DefaultBookAdmin: admin.NewDefaultBookAdmin(client),
-			},
-			Review: ReviewAdmin{
-				DefaultReviewAdmin: admin.NewDefaultReviewAdmin(client),
-			},
-		},
+		FileStorage:             vent.NewLocalFileStorage("tmp/uploads"),
+		Schemas:                 newSchemaAdmins(client),
 	})
 	if err != nil {
 		log.Fatalf("failed creating admin handler: %v", err)
@@ -62,3 +51,21 @@ func main() {
 		panic(err)
 	}
 }
+
+// newSchemaAdmins returns the example's schema admin overrides.
+func newSchemaAdmins(client *ent.Client) admin.SchemaAdmins {
+	return admin.SchemaAdmins{
+		User: UserAdmin{
+			DefaultUserAdmin: admin.NewDefaultUserAdmin(client),
+		},
+		Author: AuthorAdmin{
+			DefaultAuthorAdmin: admin.NewDefaultAuthorAdmin(client),
+		},
+		Book: BookAdmin{
+			DefaultBookAdmin: admin.NewDefaultBookAdmin(client),
+		},
+		Review: ReviewAdmin{
+			DefaultReviewAdmin: admin.NewDefaultReviewAdmin(client),
+		},
+	}
+}
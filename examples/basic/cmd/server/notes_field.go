@@ -41,6 +41,13 @@ func (BookNotesField) UpdateHTML(ctx context.Context, e *ent.Book) (string, erro
 	})
 }
 
+func (f BookNotesField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Notes",
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (BookNotesField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input admin.BookCreateInput) error {
 	if input.Notes != "" {
 		builder.SetInternalNotes(input.Notes)
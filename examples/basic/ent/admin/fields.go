@@ -10,10 +10,12 @@ import (
 
 	"github.com/troygilman/vent"
 	ent "github.com/troygilman/vent/examples/basic/ent"
+	"github.com/troygilman/vent/examples/basic/ent/auditlog"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
 	"github.com/troygilman/vent/examples/basic/ent/permission"
 	"github.com/troygilman/vent/examples/basic/ent/permissiongroup"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
 	"github.com/troygilman/vent/examples/basic/ent/user"
 	"github.com/troygilman/vent/requestctx"
@@ -24,30 +26,533 @@ import (
 var (
 	_ = sort.Strings
 	_ = strings.Builder{}
+	_ = auditlog.Label
 	_ = author.Label
 	_ = book.Label
 	_ = permission.Label
 	_ = permissiongroup.Label
+	_ = publisher.Label
 	_ = review.Label
 	_ = user.Label
 )
 
+// AuditLogField is the typed admin field contract for AuditLog.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// AuditLogDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
+type AuditLogField interface {
+	ListCell(ctx context.Context, e *ent.AuditLog) string
+	CreateHTML(ctx context.Context) (string, error)
+	UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error)
+	DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error)
+	ApplyCreate(ctx context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error
+	ApplyUpdate(ctx context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error
+}
+
+type auditlogDuplicateSourceKey struct{}
+
+// withAuditLogDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withAuditLogDuplicateSource(ctx context.Context, e *ent.AuditLog) context.Context {
+	return context.WithValue(ctx, auditlogDuplicateSourceKey{}, e)
+}
+
+// AuditLogDuplicateSource returns the AuditLog the add form being rendered
+// duplicates, or nil for a blank add form.
+func AuditLogDuplicateSource(ctx context.Context) *ent.AuditLog {
+	e, _ := ctx.Value(auditlogDuplicateSourceKey{}).(*ent.AuditLog)
+	return e
+}
+
+// AuditLogFields holds the resolved admin field implementations for AuditLog.
+type AuditLogFields struct {
+	listColumns         []AuditLogField
+	createFormFieldSets []AuditLogFieldSet
+	updateFormFieldSets []AuditLogFieldSet
+	createBindFields    []AuditLogField
+	updateBindFields    []AuditLogField
+	// auditFields are the fields audit log entries diff.
+	auditFields []auditlogAuditField
+}
+
+// auditlogAuditField is a AuditLog field with the name audit log diffs use.
+type auditlogAuditField struct {
+	name  string
+	field AuditLogField
+}
+
+// AuditLogFieldSet is one form section: its heading props and the fields it renders.
+type AuditLogFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []AuditLogField
+}
+
+func newAuditLogFields(schemaAdmin AuditLogAdmin) (AuditLogFields, error) {
+	f := AuditLogFields{}
+	CreatedAtField := schemaAdmin.FieldCreatedAt()
+	if CreatedAtField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldCreatedAt() returned nil")
+	}
+	ActorField := schemaAdmin.FieldActor()
+	if ActorField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldActor() returned nil")
+	}
+	ActionField := schemaAdmin.FieldAction()
+	if ActionField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldAction() returned nil")
+	}
+	SchemaField := schemaAdmin.FieldSchema()
+	if SchemaField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldSchema() returned nil")
+	}
+	EntityIdField := schemaAdmin.FieldEntityId()
+	if EntityIdField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldEntityId() returned nil")
+	}
+	EntityNameField := schemaAdmin.FieldEntityName()
+	if EntityNameField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldEntityName() returned nil")
+	}
+	ChangesField := schemaAdmin.FieldChanges()
+	if ChangesField == nil {
+		return AuditLogFields{}, fmt.Errorf("AuditLogAdmin.FieldChanges() returned nil")
+	}
+	f.listColumns = []AuditLogField{
+		CreatedAtField,
+		ActorField,
+		ActionField,
+		SchemaField,
+		EntityNameField,
+	}
+	f.createFormFieldSets = []AuditLogFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []AuditLogField{
+				CreatedAtField,
+				ActorField,
+				ActionField,
+				SchemaField,
+				EntityIdField,
+				EntityNameField,
+				ChangesField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []AuditLogFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []AuditLogField{
+				CreatedAtField,
+				ActorField,
+				ActionField,
+				SchemaField,
+				EntityIdField,
+				EntityNameField,
+				ChangesField,
+			},
+		},
+	}
+	f.createBindFields = []AuditLogField{}
+	f.updateBindFields = []AuditLogField{}
+	f.auditFields = []auditlogAuditField{
+		{name: "created_at", field: CreatedAtField},
+		{name: "actor", field: ActorField},
+		{name: "action", field: ActionField},
+		{name: "schema", field: SchemaField},
+		{name: "entity_id", field: EntityIdField},
+		{name: "entity_name", field: EntityNameField},
+		{name: "changes", field: ChangesField},
+	}
+	return f, nil
+}
+
+type AuditLogCreatedAtField struct {
+	client *ent.Client
+}
+
+// NewAuditLogCreatedAtField returns the generated default implementation for created_at.
+func NewAuditLogCreatedAtField(client *ent.Client) AuditLogCreatedAtField {
+	return AuditLogCreatedAtField{client: client}
+}
+
+func (f AuditLogCreatedAtField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatFormValue(e.CreatedAt)
+}
+
+func (f AuditLogCreatedAtField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
+		Name:     "created_at",
+		Label:    "CreatedAt",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f AuditLogCreatedAtField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
+		Name:     "created_at",
+		Label:    "CreatedAt",
+		Value:    vent.FormatFormValue(e.CreatedAt),
+		Editable: false,
+	})
+}
+
+func (f AuditLogCreatedAtField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "CreatedAt",
+		Kind:  vent.FieldKind("time"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogCreatedAtField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogCreatedAtField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
+type AuditLogActorField struct {
+	client *ent.Client
+}
+
+// NewAuditLogActorField returns the generated default implementation for actor.
+func NewAuditLogActorField(client *ent.Client) AuditLogActorField {
+	return AuditLogActorField{client: client}
+}
+
+func (f AuditLogActorField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatFormValue(e.Actor)
+}
+
+func (f AuditLogActorField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "actor",
+		Label:    "Actor",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f AuditLogActorField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "actor",
+		Label:    "Actor",
+		Value:    vent.FormatFormValue(e.Actor),
+		Editable: false,
+	})
+}
+
+func (f AuditLogActorField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Actor",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogActorField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogActorField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
+type AuditLogActionField struct {
+	client *ent.Client
+}
+
+// NewAuditLogActionField returns the generated default implementation for action.
+func NewAuditLogActionField(client *ent.Client) AuditLogActionField {
+	return AuditLogActionField{client: client}
+}
+
+func (f AuditLogActionField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatFormValue(e.Action)
+}
+
+func (f AuditLogActionField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderEnumFieldHTML(ctx, gui.SchemaEntityEnumFieldProps{
+		Name:     "action",
+		Label:    "Action",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  []string{"create", "update", "delete", "password", "restore", "purge"},
+	})
+}
+
+func (f AuditLogActionField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderEnumFieldHTML(ctx, gui.SchemaEntityEnumFieldProps{
+		Name:     "action",
+		Label:    "Action",
+		Value:    vent.FormatFormValue(e.Action),
+		Editable: false,
+		Options:  []string{"create", "update", "delete", "password", "restore", "purge"},
+	})
+}
+
+func (f AuditLogActionField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Action",
+		Kind:  vent.FieldKind("enum"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogActionField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogActionField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
+type AuditLogSchemaField struct {
+	client *ent.Client
+}
+
+// NewAuditLogSchemaField returns the generated default implementation for schema.
+func NewAuditLogSchemaField(client *ent.Client) AuditLogSchemaField {
+	return AuditLogSchemaField{client: client}
+}
+
+func (f AuditLogSchemaField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatFormValue(e.Schema)
+}
+
+func (f AuditLogSchemaField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "schema",
+		Label:    "Schema",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f AuditLogSchemaField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "schema",
+		Label:    "Schema",
+		Value:    vent.FormatFormValue(e.Schema),
+		Editable: false,
+	})
+}
+
+func (f AuditLogSchemaField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Schema",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogSchemaField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogSchemaField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
+type AuditLogEntityIdField struct {
+	client *ent.Client
+}
+
+// NewAuditLogEntityIdField returns the generated default implementation for entity_id.
+func NewAuditLogEntityIdField(client *ent.Client) AuditLogEntityIdField {
+	return AuditLogEntityIdField{client: client}
+}
+
+func (f AuditLogEntityIdField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatFormValue(e.EntityID)
+}
+
+func (f AuditLogEntityIdField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "entity_id",
+		Label:    "EntityId",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f AuditLogEntityIdField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "entity_id",
+		Label:    "EntityId",
+		Value:    vent.FormatFormValue(e.EntityID),
+		Editable: false,
+	})
+}
+
+func (f AuditLogEntityIdField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "EntityId",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogEntityIdField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogEntityIdField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
+type AuditLogEntityNameField struct {
+	client *ent.Client
+}
+
+// NewAuditLogEntityNameField returns the generated default implementation for entity_name.
+func NewAuditLogEntityNameField(client *ent.Client) AuditLogEntityNameField {
+	return AuditLogEntityNameField{client: client}
+}
+
+func (f AuditLogEntityNameField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatFormValue(e.EntityName)
+}
+
+func (f AuditLogEntityNameField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "entity_name",
+		Label:    "EntityName",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f AuditLogEntityNameField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "entity_name",
+		Label:    "EntityName",
+		Value:    vent.FormatFormValue(e.EntityName),
+		Editable: false,
+	})
+}
+
+func (f AuditLogEntityNameField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "EntityName",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogEntityNameField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogEntityNameField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
+type AuditLogChangesField struct {
+	client *ent.Client
+}
+
+// NewAuditLogChangesField returns the generated default implementation for changes.
+func NewAuditLogChangesField(client *ent.Client) AuditLogChangesField {
+	return AuditLogChangesField{client: client}
+}
+
+func (f AuditLogChangesField) ListCell(ctx context.Context, e *ent.AuditLog) string {
+	return vent.FormatJSONValue(e.Changes)
+}
+
+func (f AuditLogChangesField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderJSONFieldHTML(ctx, gui.SchemaEntityJSONFieldProps{
+		Name:     "changes",
+		Label:    "Changes",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f AuditLogChangesField) UpdateHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderJSONFieldHTML(ctx, gui.SchemaEntityJSONFieldProps{
+		Name:     "changes",
+		Label:    "Changes",
+		Value:    vent.FormatJSONFormValue(e.Changes),
+		Editable: false,
+	})
+}
+
+func (f AuditLogChangesField) DetailHTML(ctx context.Context, e *ent.AuditLog) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Changes",
+		Kind:  vent.FieldKind("json"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f AuditLogChangesField) ApplyCreate(_ context.Context, builder *ent.AuditLogCreate, input AuditLogCreateInput) error {
+	return nil
+}
+
+func (f AuditLogChangesField) ApplyUpdate(_ context.Context, builder *ent.AuditLogUpdateOne, input AuditLogUpdateInput) error {
+	return nil
+}
+
 // AuthorField is the typed admin field contract for Author.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// AuthorDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
 type AuthorField interface {
 	ListCell(ctx context.Context, e *ent.Author) string
 	CreateHTML(ctx context.Context) (string, error)
 	UpdateHTML(ctx context.Context, e *ent.Author) (string, error)
+	DetailHTML(ctx context.Context, e *ent.Author) (string, error)
 	ApplyCreate(ctx context.Context, builder *ent.AuthorCreate, input AuthorCreateInput) error
 	ApplyUpdate(ctx context.Context, builder *ent.AuthorUpdateOne, input AuthorUpdateInput) error
 }
 
+type authorDuplicateSourceKey struct{}
+
+// withAuthorDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withAuthorDuplicateSource(ctx context.Context, e *ent.Author) context.Context {
+	return context.WithValue(ctx, authorDuplicateSourceKey{}, e)
+}
+
+// AuthorDuplicateSource returns the Author the add form being rendered
+// duplicates, or nil for a blank add form.
+func AuthorDuplicateSource(ctx context.Context) *ent.Author {
+	e, _ := ctx.Value(authorDuplicateSourceKey{}).(*ent.Author)
+	return e
+}
+
 // AuthorFields holds the resolved admin field implementations for Author.
 type AuthorFields struct {
-	listColumns      []AuthorField
-	createFormFields []AuthorField
-	updateFormFields []AuthorField
-	createBindFields []AuthorField
-	updateBindFields []AuthorField
+	listColumns         []AuthorField
+	createFormFieldSets []AuthorFieldSet
+	updateFormFieldSets []AuthorFieldSet
+	createBindFields    []AuthorField
+	updateBindFields    []AuthorField
+	// auditFields are the fields audit log entries diff.
+	auditFields []authorAuditField
+}
+
+// authorAuditField is a Author field with the name audit log diffs use.
+type authorAuditField struct {
+	name  string
+	field AuthorField
+}
+
+// AuthorFieldSet is one form section: its heading props and the fields it renders.
+type AuthorFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []AuthorField
 }
 
 func newAuthorFields(schemaAdmin AuthorAdmin) (AuthorFields, error) {
@@ -64,13 +569,33 @@ func newAuthorFields(schemaAdmin AuthorAdmin) (AuthorFields, error) {
 		UserField,
 		ActiveField,
 	}
-	f.createFormFields = []AuthorField{
-		UserField,
-		ActiveField,
-	}
-	f.updateFormFields = []AuthorField{
-		UserField,
-		ActiveField,
+	f.createFormFieldSets = []AuthorFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []AuthorField{
+				UserField,
+				ActiveField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []AuthorFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []AuthorField{
+				UserField,
+				ActiveField,
+			},
+		},
 	}
 	f.createBindFields = []AuthorField{
 		UserField,
@@ -80,6 +605,10 @@ func newAuthorFields(schemaAdmin AuthorAdmin) (AuthorFields, error) {
 		UserField,
 		ActiveField,
 	}
+	f.auditFields = []authorAuditField{
+		{name: "user", field: UserField},
+		{name: "active", field: ActiveField},
+	}
 	return f, nil
 }
 
@@ -104,6 +633,9 @@ func (f AuthorUserField) CreateHTML(ctx context.Context) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "user"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyUniqueFieldHTML(ctx, gui.SchemaEntityForeignKeyUniqueFieldProps{
 		Name:     "user",
 		Label:    "User",
@@ -125,13 +657,34 @@ func (f AuthorUserField) UpdateHTML(ctx context.Context, e *ent.Author) (string,
 	})
 }
 
+func (f AuthorUserField) DetailHTML(ctx context.Context, e *ent.Author) (string, error) {
+	related, err := MustAdmin(ctx).User().EagerLoadQuery(e.QueryUser()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_user")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).User().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "User",
+		Links: links,
+	})
+}
+
 func (f AuthorUserField) ApplyCreate(_ context.Context, builder *ent.AuthorCreate, input AuthorCreateInput) error {
 	if input.User != "" {
-		id, err := parseID(input.User, "user")
-		if err != nil {
+		if err := setID(builder.SetUserID, input.User, "user"); err != nil {
 			return err
 		}
-		builder.SetUserID(id)
 	}
 	return nil
 }
@@ -139,11 +692,9 @@ func (f AuthorUserField) ApplyCreate(_ context.Context, builder *ent.AuthorCreat
 func (f AuthorUserField) ApplyUpdate(_ context.Context, builder *ent.AuthorUpdateOne, input AuthorUpdateInput) error {
 	if input.User != nil {
 		if *input.User != "" {
-			id, err := parseID(*input.User, "user")
-			if err != nil {
+			if err := setID(builder.SetUserID, *input.User, "user"); err != nil {
 				return err
 			}
-			builder.SetUserID(id)
 		} else {
 			builder.ClearUser()
 		}
@@ -158,7 +709,7 @@ func (f AuthorUserField) loadUserOptions(ctx context.Context) ([]gui.SelectOptio
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
+			Value: vent.FormatID(entity.ID),
 			Label: MustAdmin(ctx).User().Name(entity),
 		}
 	}
@@ -174,7 +725,7 @@ func (f AuthorUserField) loadUserOptionsWithSelection(ctx context.Context, e *en
 		return nil, err
 	}
 	for i := range options {
-		options[i].Selected = e.Edges.User != nil && e.Edges.User.ID == options[i].Value
+		options[i].Selected = e.Edges.User != nil && vent.FormatID(e.Edges.User.ID) == options[i].Value
 	}
 	return options, nil
 }
@@ -193,10 +744,17 @@ func (f AuthorActiveField) ListCell(ctx context.Context, e *ent.Author) string {
 }
 
 func (f AuthorActiveField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(author.DefaultActive)
+	if source := AuthorDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Active)
+	}
+	if initial, ok := vent.InitialValue(ctx, "active"); ok {
+		value = initial
+	}
 	return gui.RenderBoolFieldHTML(ctx, gui.SchemaEntityBoolFieldProps{
 		Name:     "active",
 		Label:    "Active",
-		Value:    vent.FormatFormValue(author.DefaultActive),
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -210,6 +768,14 @@ func (f AuthorActiveField) UpdateHTML(ctx context.Context, e *ent.Author) (strin
 	})
 }
 
+func (f AuthorActiveField) DetailHTML(ctx context.Context, e *ent.Author) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Active",
+		Kind:  vent.FieldKind("bool"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f AuthorActiveField) ApplyCreate(_ context.Context, builder *ent.AuthorCreate, input AuthorCreateInput) error {
 	if input.Active != nil {
 		builder.SetActive(*input.Active)
@@ -225,21 +791,56 @@ func (f AuthorActiveField) ApplyUpdate(_ context.Context, builder *ent.AuthorUpd
 }
 
 // BookField is the typed admin field contract for Book.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// BookDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
 type BookField interface {
 	ListCell(ctx context.Context, e *ent.Book) string
 	CreateHTML(ctx context.Context) (string, error)
 	UpdateHTML(ctx context.Context, e *ent.Book) (string, error)
+	DetailHTML(ctx context.Context, e *ent.Book) (string, error)
 	ApplyCreate(ctx context.Context, builder *ent.BookCreate, input BookCreateInput) error
 	ApplyUpdate(ctx context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error
 }
 
+type bookDuplicateSourceKey struct{}
+
+// withBookDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withBookDuplicateSource(ctx context.Context, e *ent.Book) context.Context {
+	return context.WithValue(ctx, bookDuplicateSourceKey{}, e)
+}
+
+// BookDuplicateSource returns the Book the add form being rendered
+// duplicates, or nil for a blank add form.
+func BookDuplicateSource(ctx context.Context) *ent.Book {
+	e, _ := ctx.Value(bookDuplicateSourceKey{}).(*ent.Book)
+	return e
+}
+
 // BookFields holds the resolved admin field implementations for Book.
 type BookFields struct {
-	listColumns      []BookField
-	createFormFields []BookField
-	updateFormFields []BookField
-	createBindFields []BookField
-	updateBindFields []BookField
+	listColumns         []BookField
+	createFormFieldSets []BookFieldSet
+	updateFormFieldSets []BookFieldSet
+	createBindFields    []BookField
+	updateBindFields    []BookField
+	// auditFields are the fields audit log entries diff.
+	auditFields []bookAuditField
+}
+
+// bookAuditField is a Book field with the name audit log diffs use.
+type bookAuditField struct {
+	name  string
+	field BookField
+}
+
+// BookFieldSet is one form section: its heading props and the fields it renders.
+type BookFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []BookField
 }
 
 func newBookFields(schemaAdmin BookAdmin) (BookFields, error) {
@@ -248,14 +849,30 @@ func newBookFields(schemaAdmin BookAdmin) (BookFields, error) {
 	if TitleField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldTitle() returned nil")
 	}
+	SlugField := schemaAdmin.FieldSlug()
+	if SlugField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldSlug() returned nil")
+	}
+	CoverField := schemaAdmin.FieldCover()
+	if CoverField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldCover() returned nil")
+	}
 	AuthorField := schemaAdmin.FieldAuthor()
 	if AuthorField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldAuthor() returned nil")
 	}
+	PublisherField := schemaAdmin.FieldPublisher()
+	if PublisherField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldPublisher() returned nil")
+	}
 	PagesField := schemaAdmin.FieldPages()
 	if PagesField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldPages() returned nil")
 	}
+	FormatField := schemaAdmin.FieldFormat()
+	if FormatField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldFormat() returned nil")
+	}
 	PublishedField := schemaAdmin.FieldPublished()
 	if PublishedField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldPublished() returned nil")
@@ -264,54 +881,179 @@ func newBookFields(schemaAdmin BookAdmin) (BookFields, error) {
 	if PublishedAtField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldPublishedAt() returned nil")
 	}
+	TagsField := schemaAdmin.FieldTags()
+	if TagsField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldTags() returned nil")
+	}
+	EditionsField := schemaAdmin.FieldEditions()
+	if EditionsField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldEditions() returned nil")
+	}
+	MetadataField := schemaAdmin.FieldMetadata()
+	if MetadataField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldMetadata() returned nil")
+	}
 	CreatedAtField := schemaAdmin.FieldCreatedAt()
 	if CreatedAtField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldCreatedAt() returned nil")
 	}
+	VersionField := schemaAdmin.FieldVersion()
+	if VersionField == nil {
+		return BookFields{}, fmt.Errorf("BookAdmin.FieldVersion() returned nil")
+	}
 	NotesField := schemaAdmin.FieldNotes()
 	if NotesField == nil {
 		return BookFields{}, fmt.Errorf("BookAdmin.FieldNotes() is required")
 	}
 	f.listColumns = []BookField{
+		CoverField,
 		TitleField,
 		AuthorField,
+		FormatField,
 		PublishedField,
 		PagesField,
 	}
-	f.createFormFields = []BookField{
-		TitleField,
-		AuthorField,
-		PagesField,
-		PublishedField,
-		PublishedAtField,
-		CreatedAtField,
-		NotesField,
-	}
-	f.updateFormFields = []BookField{
-		TitleField,
-		AuthorField,
-		PagesField,
-		PublishedField,
-		PublishedAtField,
-		CreatedAtField,
-		NotesField,
+	f.createFormFieldSets = []BookFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Book",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []BookField{
+				TitleField,
+				SlugField,
+				CoverField,
+				AuthorField,
+				PublisherField,
+				PagesField,
+				FormatField,
+			},
+		},
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Publishing",
+				Description: "Release status and catalog tags.",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []BookField{
+				PublishedField,
+				PublishedAtField,
+				TagsField,
+				EditionsField,
+			},
+		},
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Advanced",
+				Description: "Free-form metadata and internal notes.",
+				Collapsible: true,
+				Collapsed:   true,
+			},
+			fields: []BookField{
+				MetadataField,
+				CreatedAtField,
+				VersionField,
+				NotesField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []BookFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Book",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []BookField{
+				TitleField,
+				SlugField,
+				CoverField,
+				AuthorField,
+				PublisherField,
+				PagesField,
+				FormatField,
+			},
+		},
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Publishing",
+				Description: "Release status and catalog tags.",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []BookField{
+				PublishedField,
+				PublishedAtField,
+				TagsField,
+				EditionsField,
+			},
+		},
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Advanced",
+				Description: "Free-form metadata and internal notes.",
+				Collapsible: true,
+				Collapsed:   true,
+			},
+			fields: []BookField{
+				MetadataField,
+				CreatedAtField,
+				VersionField,
+				NotesField,
+			},
+		},
 	}
 	f.createBindFields = []BookField{
 		TitleField,
+		SlugField,
+		CoverField,
 		AuthorField,
+		PublisherField,
 		PagesField,
+		FormatField,
 		PublishedField,
 		PublishedAtField,
+		TagsField,
+		EditionsField,
+		MetadataField,
 		NotesField,
 	}
 	f.updateBindFields = []BookField{
 		TitleField,
+		SlugField,
+		CoverField,
 		AuthorField,
+		PublisherField,
 		PagesField,
+		FormatField,
 		PublishedField,
 		PublishedAtField,
+		TagsField,
+		EditionsField,
+		MetadataField,
 		NotesField,
 	}
+	f.auditFields = []bookAuditField{
+		{name: "title", field: TitleField},
+		{name: "slug", field: SlugField},
+		{name: "cover", field: CoverField},
+		{name: "author", field: AuthorField},
+		{name: "publisher", field: PublisherField},
+		{name: "pages", field: PagesField},
+		{name: "format", field: FormatField},
+		{name: "published", field: PublishedField},
+		{name: "published_at", field: PublishedAtField},
+		{name: "tags", field: TagsField},
+		{name: "editions", field: EditionsField},
+		{name: "metadata", field: MetadataField},
+		{name: "created_at", field: CreatedAtField},
+		{name: "version", field: VersionField},
+		{name: "notes", field: NotesField},
+	}
 	return f, nil
 }
 
@@ -329,19 +1071,37 @@ func (f BookTitleField) ListCell(ctx context.Context, e *ent.Book) string {
 }
 
 func (f BookTitleField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Title)
+	}
+	if initial, ok := vent.InitialValue(ctx, "title"); ok {
+		value = initial
+	}
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
-		Name:     "title",
-		Label:    "Title",
-		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Name:        "title",
+		Label:       "Title",
+		Placeholder: "e.g. The Left Hand of Darkness",
+		Value:       value,
+		Editable:    gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
 
 func (f BookTitleField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
-		Name:     "title",
-		Label:    "Title",
-		Value:    vent.FormatFormValue(e.Title),
-		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Name:        "title",
+		Label:       "Title",
+		Placeholder: "e.g. The Left Hand of Darkness",
+		Value:       vent.FormatFormValue(e.Title),
+		Editable:    gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookTitleField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Title",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
 	})
 }
 
@@ -357,6 +1117,137 @@ func (f BookTitleField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOn
 	return nil
 }
 
+type BookSlugField struct {
+	client *ent.Client
+}
+
+// NewBookSlugField returns the generated default implementation for slug.
+func NewBookSlugField(client *ent.Client) BookSlugField {
+	return BookSlugField{client: client}
+}
+
+func (f BookSlugField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.Slug)
+}
+
+func (f BookSlugField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Slug)
+	}
+	if initial, ok := vent.InitialValue(ctx, "slug"); ok {
+		value = initial
+	}
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:        "slug",
+		Label:       "Slug",
+		Desc:        "Filled in from the title on the add form.",
+		Value:       value,
+		Editable:    gui.MustRenderContext(ctx).CanUpdate,
+		Prepopulate: []string{"title"},
+	})
+}
+
+func (f BookSlugField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "slug",
+		Label:    "Slug",
+		Desc:     "Filled in from the title on the add form.",
+		Value:    vent.FormatFormValue(e.Slug),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookSlugField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Slug",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookSlugField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	if input.Slug != nil {
+		builder.SetSlug(*input.Slug)
+	}
+	return nil
+}
+
+func (f BookSlugField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	if input.Slug != nil {
+		builder.SetSlug(*input.Slug)
+	}
+	return nil
+}
+
+type BookCoverField struct {
+	client *ent.Client
+}
+
+// NewBookCoverField returns the generated default implementation for cover.
+func NewBookCoverField(client *ent.Client) BookCoverField {
+	return BookCoverField{client: client}
+}
+
+func (f BookCoverField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.Cover)
+}
+
+func (f BookCoverField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderFileFieldHTML(ctx, gui.SchemaEntityFileFieldProps{
+		Name:      "cover",
+		Label:     "Cover",
+		Editable:  gui.MustRenderContext(ctx).CanUpdate,
+		Image:     true,
+		Clearable: true,
+	})
+}
+
+func (f BookCoverField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderFileFieldHTML(ctx, gui.SchemaEntityFileFieldProps{
+		Name:      "cover",
+		Label:     "Cover",
+		Value:     vent.FormatFormValue(e.Cover),
+		Editable:  gui.MustRenderContext(ctx).CanUpdate,
+		Image:     true,
+		Clearable: true,
+	})
+}
+
+func (f BookCoverField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Cover",
+		Kind:  vent.FieldKind("image"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookCoverField) ApplyCreate(ctx context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	key, ok, err := vent.SaveImageUpload(ctx, "cover", "book/cover")
+	if err != nil {
+		return err
+	}
+	if ok {
+		builder.SetCover(key)
+	}
+	return nil
+}
+
+func (f BookCoverField) ApplyUpdate(ctx context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	key, ok, err := vent.SaveImageUpload(ctx, "cover", "book/cover")
+	if err != nil {
+		return err
+	}
+	if ok {
+		builder.SetCover(key)
+		return nil
+	}
+	if input.Cover != nil && *input.Cover == "" {
+		builder.ClearCover()
+	}
+	return nil
+}
+
 type BookAuthorField struct {
 	client *ent.Client
 }
@@ -374,10 +1265,19 @@ func (f BookAuthorField) ListCell(ctx context.Context, e *ent.Book) string {
 }
 
 func (f BookAuthorField) CreateHTML(ctx context.Context) (string, error) {
-	options, err := f.loadAuthorOptions(ctx)
+	var options []gui.SelectOption
+	var err error
+	if source := BookDuplicateSource(ctx); source != nil {
+		options, err = f.loadAuthorOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadAuthorOptions(ctx)
+	}
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "author"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyUniqueFieldHTML(ctx, gui.SchemaEntityForeignKeyUniqueFieldProps{
 		Name:     "author",
 		Label:    "Author",
@@ -399,13 +1299,34 @@ func (f BookAuthorField) UpdateHTML(ctx context.Context, e *ent.Book) (string, e
 	})
 }
 
+func (f BookAuthorField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	related, err := MustAdmin(ctx).Author().EagerLoadQuery(e.QueryAuthor()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_author")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).Author().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%sauthors/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Author",
+		Links: links,
+	})
+}
+
 func (f BookAuthorField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
 	if input.Author != "" {
-		id, err := parseID(input.Author, "author")
-		if err != nil {
+		if err := setID(builder.SetAuthorID, input.Author, "author"); err != nil {
 			return err
 		}
-		builder.SetAuthorID(id)
 	}
 	return nil
 }
@@ -413,11 +1334,9 @@ func (f BookAuthorField) ApplyCreate(_ context.Context, builder *ent.BookCreate,
 func (f BookAuthorField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
 	if input.Author != nil {
 		if *input.Author != "" {
-			id, err := parseID(*input.Author, "author")
-			if err != nil {
+			if err := setID(builder.SetAuthorID, *input.Author, "author"); err != nil {
 				return err
 			}
-			builder.SetAuthorID(id)
 		} else {
 			builder.ClearAuthor()
 		}
@@ -432,7 +1351,7 @@ func (f BookAuthorField) loadAuthorOptions(ctx context.Context) ([]gui.SelectOpt
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
+			Value: vent.FormatID(entity.ID),
 			Label: MustAdmin(ctx).Author().Name(entity),
 		}
 	}
@@ -448,7 +1367,146 @@ func (f BookAuthorField) loadAuthorOptionsWithSelection(ctx context.Context, e *
 		return nil, err
 	}
 	for i := range options {
-		options[i].Selected = e.Edges.Author != nil && e.Edges.Author.ID == options[i].Value
+		options[i].Selected = e.Edges.Author != nil && vent.FormatID(e.Edges.Author.ID) == options[i].Value
+	}
+	return options, nil
+}
+
+type BookPublisherField struct {
+	client *ent.Client
+}
+
+// NewBookPublisherField returns the generated default implementation for publisher.
+func NewBookPublisherField(client *ent.Client) BookPublisherField {
+	return BookPublisherField{client: client}
+}
+
+func (f BookPublisherField) ListCell(ctx context.Context, e *ent.Book) string {
+	if e.Edges.Publisher != nil {
+		return MustAdmin(ctx).Publisher().Name(e.Edges.Publisher)
+	}
+	return ""
+}
+
+func (f BookPublisherField) CreateHTML(ctx context.Context) (string, error) {
+	var options []gui.SelectOption
+	var err error
+	if source := BookDuplicateSource(ctx); source != nil {
+		options, err = f.loadPublisherOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadPublisherOptions(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+	if initial, ok := vent.InitialValue(ctx, "publisher"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
+	return gui.RenderForeignKeyUniqueFieldHTML(ctx, gui.SchemaEntityForeignKeyUniqueFieldProps{
+		Name:     "publisher",
+		Label:    "Publisher",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  options,
+	})
+}
+
+func (f BookPublisherField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	options, err := f.loadPublisherOptionsWithSelection(ctx, e)
+	if err != nil {
+		return "", err
+	}
+	return gui.RenderForeignKeyUniqueFieldHTML(ctx, gui.SchemaEntityForeignKeyUniqueFieldProps{
+		Name:     "publisher",
+		Label:    "Publisher",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  options,
+	})
+}
+
+func (f BookPublisherField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	related, err := MustAdmin(ctx).Publisher().EagerLoadQuery(e.QueryPublisher()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_publisher")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).Publisher().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%spublishers/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Publisher",
+		Links: links,
+	})
+}
+
+func (f BookPublisherField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	if input.Publisher != "" {
+		if err := setID(builder.SetPublisherID, input.Publisher, "publisher"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f BookPublisherField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	if input.Publisher != nil {
+		if *input.Publisher != "" {
+			if err := setID(builder.SetPublisherID, *input.Publisher, "publisher"); err != nil {
+				return err
+			}
+		} else {
+			builder.ClearPublisher()
+		}
+	}
+	return nil
+}
+func (f BookPublisherField) loadPublisherOptions(ctx context.Context) ([]gui.SelectOption, error) {
+	entities, err := MustAdmin(ctx).Publisher().EagerLoadQuery(f.client.Publisher.Query()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]gui.SelectOption, len(entities))
+	for i, entity := range entities {
+		options[i] = gui.SelectOption{
+			Value: vent.FormatID(entity.ID),
+			Label: MustAdmin(ctx).Publisher().Name(entity),
+		}
+	}
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Label < options[j].Label
+	})
+	return options, nil
+}
+
+func (f BookPublisherField) loadPublisherOptionsWithSelection(ctx context.Context, e *ent.Book) ([]gui.SelectOption, error) {
+	options, err := f.loadPublisherOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range options {
+		options[i].Selected = e.Edges.Publisher != nil && vent.FormatID(e.Edges.Publisher.ID) == options[i].Value
+	}
+	// Trashed Publishers are not options, but e stays linked to them until they
+	// are purged, so they are listed as selected for a save to keep.
+	related, err := e.QueryPublisher().All(vent.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range related {
+		if r.DeletedAt != nil {
+			options = append(options, gui.SelectOption{
+				Value:    vent.FormatID(r.ID),
+				Label:    MustAdmin(ctx).Publisher().Name(r) + " (in trash)",
+				Selected: true,
+			})
+		}
 	}
 	return options, nil
 }
@@ -467,10 +1525,17 @@ func (f BookPagesField) ListCell(ctx context.Context, e *ent.Book) string {
 }
 
 func (f BookPagesField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(book.DefaultPages)
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Pages)
+	}
+	if initial, ok := vent.InitialValue(ctx, "pages"); ok {
+		value = initial
+	}
 	return gui.RenderIntFieldHTML(ctx, gui.SchemaEntityIntFieldProps{
 		Name:     "pages",
 		Label:    "Pages",
-		Value:    vent.FormatFormValue(book.DefaultPages),
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -484,6 +1549,14 @@ func (f BookPagesField) UpdateHTML(ctx context.Context, e *ent.Book) (string, er
 	})
 }
 
+func (f BookPagesField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Pages",
+		Kind:  vent.FieldKind("int"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f BookPagesField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
 	if input.Pages != nil {
 		builder.SetPages(*input.Pages)
@@ -498,6 +1571,69 @@ func (f BookPagesField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOn
 	return nil
 }
 
+type BookFormatField struct {
+	client *ent.Client
+}
+
+// NewBookFormatField returns the generated default implementation for format.
+func NewBookFormatField(client *ent.Client) BookFormatField {
+	return BookFormatField{client: client}
+}
+
+func (f BookFormatField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.Format)
+}
+
+func (f BookFormatField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(book.DefaultFormat)
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Format)
+	}
+	if initial, ok := vent.InitialValue(ctx, "format"); ok {
+		value = initial
+	}
+	return gui.RenderEnumFieldHTML(ctx, gui.SchemaEntityEnumFieldProps{
+		Name:     "format",
+		Label:    "Format",
+		Value:    value,
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  []string{"hardcover", "paperback", "ebook", "audiobook"},
+	})
+}
+
+func (f BookFormatField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderEnumFieldHTML(ctx, gui.SchemaEntityEnumFieldProps{
+		Name:     "format",
+		Label:    "Format",
+		Value:    vent.FormatFormValue(e.Format),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  []string{"hardcover", "paperback", "ebook", "audiobook"},
+	})
+}
+
+func (f BookFormatField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Format",
+		Kind:  vent.FieldKind("enum"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookFormatField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	if input.Format != nil && *input.Format != "" {
+		value := book.Format(*input.Format)
+		builder.SetFormat(value)
+	}
+	return nil
+}
+
+func (f BookFormatField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	if input.Format != nil {
+		builder.SetFormat(book.Format(*input.Format))
+	}
+	return nil
+}
+
 type BookPublishedField struct {
 	client *ent.Client
 }
@@ -512,10 +1648,17 @@ func (f BookPublishedField) ListCell(ctx context.Context, e *ent.Book) string {
 }
 
 func (f BookPublishedField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(book.DefaultPublished)
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Published)
+	}
+	if initial, ok := vent.InitialValue(ctx, "published"); ok {
+		value = initial
+	}
 	return gui.RenderBoolFieldHTML(ctx, gui.SchemaEntityBoolFieldProps{
 		Name:     "published",
 		Label:    "Published",
-		Value:    vent.FormatFormValue(book.DefaultPublished),
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -529,6 +1672,14 @@ func (f BookPublishedField) UpdateHTML(ctx context.Context, e *ent.Book) (string
 	})
 }
 
+func (f BookPublishedField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Published",
+		Kind:  vent.FieldKind("bool"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f BookPublishedField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
 	if input.Published != nil {
 		builder.SetPublished(*input.Published)
@@ -560,9 +1711,22 @@ func (f BookPublishedAtField) ListCell(ctx context.Context, e *ent.Book) string
 }
 
 func (f BookPublishedAtField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = ""
+		if source.PublishedAt != nil {
+			value = vent.FormatFormValue(*source.PublishedAt)
+		}
+	}
+	if initial, ok := vent.InitialValue(ctx, "published_at"); ok {
+		value = initial
+	}
 	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
 		Name:     "published_at",
-		Label:    "PublishedAt",
+		Label:    "Publication date",
+		Desc:     "Leave empty for unpublished books.",
+		DateOnly: true,
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -574,12 +1738,23 @@ func (f BookPublishedAtField) UpdateHTML(ctx context.Context, e *ent.Book) (stri
 	}
 	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
 		Name:     "published_at",
-		Label:    "PublishedAt",
+		Label:    "Publication date",
+		Desc:     "Leave empty for unpublished books.",
+		DateOnly: true,
 		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
 
+func (f BookPublishedAtField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label:    "Publication date",
+		Kind:     vent.FieldKind("time"),
+		Value:    f.ListCell(ctx, e),
+		DateOnly: true,
+	})
+}
+
 func (f BookPublishedAtField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
 	if input.PublishedAt != nil {
 		if *input.PublishedAt != "" {
@@ -608,33 +1783,247 @@ func (f BookPublishedAtField) ApplyUpdate(_ context.Context, builder *ent.BookUp
 	return nil
 }
 
-type BookCreatedAtField struct {
+type BookTagsField struct {
 	client *ent.Client
 }
 
-// NewBookCreatedAtField returns the generated default implementation for created_at.
-func NewBookCreatedAtField(client *ent.Client) BookCreatedAtField {
-	return BookCreatedAtField{client: client}
+// NewBookTagsField returns the generated default implementation for tags.
+func NewBookTagsField(client *ent.Client) BookTagsField {
+	return BookTagsField{client: client}
 }
 
-func (f BookCreatedAtField) ListCell(ctx context.Context, e *ent.Book) string {
-	return vent.FormatFormValue(e.CreatedAt)
+func (f BookTagsField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.Tags)
 }
 
-func (f BookCreatedAtField) CreateHTML(ctx context.Context) (string, error) {
-	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
-		Name:     "created_at",
-		Label:    "CreatedAt",
+func (f BookTagsField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatListFormValue(source.Tags)
+	}
+	if initial, ok := vent.InitialValue(ctx, "tags"); ok {
+		value = initial
+	}
+	return gui.RenderTagsFieldHTML(ctx, gui.SchemaEntityTagsFieldProps{
+		Name:     "tags",
+		Label:    "Tags",
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
 
-func (f BookCreatedAtField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
-	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
-		Name:     "created_at",
-		Label:    "CreatedAt",
-		Value:    vent.FormatFormValue(e.CreatedAt),
-		Editable: false,
+func (f BookTagsField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderTagsFieldHTML(ctx, gui.SchemaEntityTagsFieldProps{
+		Name:     "tags",
+		Label:    "Tags",
+		Value:    vent.FormatListFormValue(e.Tags),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookTagsField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Tags",
+		Kind:  vent.FieldKind("strings"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookTagsField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	if input.Tags != nil && *input.Tags != "" {
+		builder.SetTags(vent.ParseStringList(*input.Tags))
+	}
+	return nil
+}
+
+func (f BookTagsField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	if input.Tags != nil {
+		if *input.Tags == "" {
+			builder.ClearTags()
+			return nil
+		}
+		builder.SetTags(vent.ParseStringList(*input.Tags))
+	}
+	return nil
+}
+
+type BookEditionsField struct {
+	client *ent.Client
+}
+
+// NewBookEditionsField returns the generated default implementation for editions.
+func NewBookEditionsField(client *ent.Client) BookEditionsField {
+	return BookEditionsField{client: client}
+}
+
+func (f BookEditionsField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.Editions)
+}
+
+func (f BookEditionsField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatListFormValue(source.Editions)
+	}
+	if initial, ok := vent.InitialValue(ctx, "editions"); ok {
+		value = initial
+	}
+	return gui.RenderTagsFieldHTML(ctx, gui.SchemaEntityTagsFieldProps{
+		Name:     "editions",
+		Label:    "Editions",
+		Value:    value,
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Numeric:  true,
+	})
+}
+
+func (f BookEditionsField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderTagsFieldHTML(ctx, gui.SchemaEntityTagsFieldProps{
+		Name:     "editions",
+		Label:    "Editions",
+		Value:    vent.FormatListFormValue(e.Editions),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Numeric:  true,
+	})
+}
+
+func (f BookEditionsField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Editions",
+		Kind:  vent.FieldKind("ints"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookEditionsField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	if input.Editions != nil && *input.Editions != "" {
+		values, err := vent.ParseIntList(*input.Editions)
+		if err != nil {
+			return vent.InvalidField("editions", fmt.Sprintf("invalid editions: %v", err)).WithCause(err)
+		}
+		builder.SetEditions(values)
+	}
+	return nil
+}
+
+func (f BookEditionsField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	if input.Editions != nil {
+		if *input.Editions == "" {
+			builder.ClearEditions()
+			return nil
+		}
+		values, err := vent.ParseIntList(*input.Editions)
+		if err != nil {
+			return vent.InvalidField("editions", fmt.Sprintf("invalid editions: %v", err)).WithCause(err)
+		}
+		builder.SetEditions(values)
+	}
+	return nil
+}
+
+type BookMetadataField struct {
+	client *ent.Client
+}
+
+// NewBookMetadataField returns the generated default implementation for metadata.
+func NewBookMetadataField(client *ent.Client) BookMetadataField {
+	return BookMetadataField{client: client}
+}
+
+func (f BookMetadataField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatJSONValue(e.Metadata)
+}
+
+func (f BookMetadataField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := BookDuplicateSource(ctx); source != nil {
+		value = vent.FormatJSONFormValue(source.Metadata)
+	}
+	if initial, ok := vent.InitialValue(ctx, "metadata"); ok {
+		value = initial
+	}
+	return gui.RenderJSONFieldHTML(ctx, gui.SchemaEntityJSONFieldProps{
+		Name:     "metadata",
+		Label:    "Metadata",
+		Value:    value,
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookMetadataField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderJSONFieldHTML(ctx, gui.SchemaEntityJSONFieldProps{
+		Name:     "metadata",
+		Label:    "Metadata",
+		Value:    vent.FormatJSONFormValue(e.Metadata),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookMetadataField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Metadata",
+		Kind:  vent.FieldKind("json"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookMetadataField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	if input.Metadata != nil && *input.Metadata != "" {
+		if err := vent.SetJSONValue(builder.SetMetadata, *input.Metadata); err != nil {
+			return vent.InvalidField("metadata", fmt.Sprintf("invalid metadata: %v", err)).WithCause(err)
+		}
+	}
+	return nil
+}
+
+func (f BookMetadataField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	if input.Metadata != nil {
+		if *input.Metadata == "" {
+			builder.ClearMetadata()
+			return nil
+		}
+		if err := vent.SetJSONValue(builder.SetMetadata, *input.Metadata); err != nil {
+			return vent.InvalidField("metadata", fmt.Sprintf("invalid metadata: %v", err)).WithCause(err)
+		}
+	}
+	return nil
+}
+
+type BookCreatedAtField struct {
+	client *ent.Client
+}
+
+// NewBookCreatedAtField returns the generated default implementation for created_at.
+func NewBookCreatedAtField(client *ent.Client) BookCreatedAtField {
+	return BookCreatedAtField{client: client}
+}
+
+func (f BookCreatedAtField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.CreatedAt)
+}
+
+func (f BookCreatedAtField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
+		Name:     "created_at",
+		Label:    "CreatedAt",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookCreatedAtField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
+		Name:     "created_at",
+		Label:    "CreatedAt",
+		Value:    vent.FormatFormValue(e.CreatedAt),
+		Editable: false,
+	})
+}
+
+func (f BookCreatedAtField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "CreatedAt",
+		Kind:  vent.FieldKind("time"),
+		Value: f.ListCell(ctx, e),
 	})
 }
 
@@ -646,22 +2035,104 @@ func (f BookCreatedAtField) ApplyUpdate(_ context.Context, builder *ent.BookUpda
 	return nil
 }
 
+type BookVersionField struct {
+	client *ent.Client
+}
+
+// NewBookVersionField returns the generated default implementation for version.
+func NewBookVersionField(client *ent.Client) BookVersionField {
+	return BookVersionField{client: client}
+}
+
+func (f BookVersionField) ListCell(ctx context.Context, e *ent.Book) string {
+	return vent.FormatFormValue(e.Version)
+}
+
+func (f BookVersionField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderIntFieldHTML(ctx, gui.SchemaEntityIntFieldProps{
+		Name:     "version",
+		Label:    "Version",
+		Value:    vent.FormatFormValue(book.DefaultVersion),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f BookVersionField) UpdateHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderIntFieldHTML(ctx, gui.SchemaEntityIntFieldProps{
+		Name:     "version",
+		Label:    "Version",
+		Value:    vent.FormatFormValue(e.Version),
+		Editable: false,
+	})
+}
+
+func (f BookVersionField) DetailHTML(ctx context.Context, e *ent.Book) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Version",
+		Kind:  vent.FieldKind("int"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f BookVersionField) ApplyCreate(_ context.Context, builder *ent.BookCreate, input BookCreateInput) error {
+	return nil
+}
+
+func (f BookVersionField) ApplyUpdate(_ context.Context, builder *ent.BookUpdateOne, input BookUpdateInput) error {
+	return nil
+}
+
 // PermissionField is the typed admin field contract for Permission.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// PermissionDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
 type PermissionField interface {
 	ListCell(ctx context.Context, e *ent.Permission) string
 	CreateHTML(ctx context.Context) (string, error)
 	UpdateHTML(ctx context.Context, e *ent.Permission) (string, error)
+	DetailHTML(ctx context.Context, e *ent.Permission) (string, error)
 	ApplyCreate(ctx context.Context, builder *ent.PermissionCreate, input PermissionCreateInput) error
 	ApplyUpdate(ctx context.Context, builder *ent.PermissionUpdateOne, input PermissionUpdateInput) error
 }
 
+type permissionDuplicateSourceKey struct{}
+
+// withPermissionDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withPermissionDuplicateSource(ctx context.Context, e *ent.Permission) context.Context {
+	return context.WithValue(ctx, permissionDuplicateSourceKey{}, e)
+}
+
+// PermissionDuplicateSource returns the Permission the add form being rendered
+// duplicates, or nil for a blank add form.
+func PermissionDuplicateSource(ctx context.Context) *ent.Permission {
+	e, _ := ctx.Value(permissionDuplicateSourceKey{}).(*ent.Permission)
+	return e
+}
+
 // PermissionFields holds the resolved admin field implementations for Permission.
 type PermissionFields struct {
-	listColumns      []PermissionField
-	createFormFields []PermissionField
-	updateFormFields []PermissionField
-	createBindFields []PermissionField
-	updateBindFields []PermissionField
+	listColumns         []PermissionField
+	createFormFieldSets []PermissionFieldSet
+	updateFormFieldSets []PermissionFieldSet
+	createBindFields    []PermissionField
+	updateBindFields    []PermissionField
+	// auditFields are the fields audit log entries diff.
+	auditFields []permissionAuditField
+}
+
+// permissionAuditField is a Permission field with the name audit log diffs use.
+type permissionAuditField struct {
+	name  string
+	field PermissionField
+}
+
+// PermissionFieldSet is one form section: its heading props and the fields it renders.
+type PermissionFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []PermissionField
 }
 
 func newPermissionFields(schemaAdmin PermissionAdmin) (PermissionFields, error) {
@@ -678,13 +2149,33 @@ func newPermissionFields(schemaAdmin PermissionAdmin) (PermissionFields, error)
 		NameField,
 		GroupsField,
 	}
-	f.createFormFields = []PermissionField{
-		NameField,
-		GroupsField,
-	}
-	f.updateFormFields = []PermissionField{
-		NameField,
-		GroupsField,
+	f.createFormFieldSets = []PermissionFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []PermissionField{
+				NameField,
+				GroupsField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []PermissionFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []PermissionField{
+				NameField,
+				GroupsField,
+			},
+		},
 	}
 	f.createBindFields = []PermissionField{
 		GroupsField,
@@ -692,6 +2183,10 @@ func newPermissionFields(schemaAdmin PermissionAdmin) (PermissionFields, error)
 	f.updateBindFields = []PermissionField{
 		GroupsField,
 	}
+	f.auditFields = []permissionAuditField{
+		{name: "name", field: NameField},
+		{name: "groups", field: GroupsField},
+	}
 	return f, nil
 }
 
@@ -716,106 +2211,440 @@ func (f PermissionNameField) CreateHTML(ctx context.Context) (string, error) {
 	})
 }
 
-func (f PermissionNameField) UpdateHTML(ctx context.Context, e *ent.Permission) (string, error) {
+func (f PermissionNameField) UpdateHTML(ctx context.Context, e *ent.Permission) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "name",
+		Label:    "Name",
+		Value:    vent.FormatFormValue(e.Name),
+		Editable: false,
+	})
+}
+
+func (f PermissionNameField) DetailHTML(ctx context.Context, e *ent.Permission) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Name",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f PermissionNameField) ApplyCreate(_ context.Context, builder *ent.PermissionCreate, input PermissionCreateInput) error {
+	return nil
+}
+
+func (f PermissionNameField) ApplyUpdate(_ context.Context, builder *ent.PermissionUpdateOne, input PermissionUpdateInput) error {
+	return nil
+}
+
+type PermissionGroupsField struct {
+	client *ent.Client
+}
+
+// NewPermissionGroupsField returns the generated default implementation for groups.
+func NewPermissionGroupsField(client *ent.Client) PermissionGroupsField {
+	return PermissionGroupsField{client: client}
+}
+
+func (f PermissionGroupsField) ListCell(ctx context.Context, e *ent.Permission) string {
+	if len(e.Edges.Groups) == 0 {
+		return ""
+	}
+	var labels strings.Builder
+	for i, related := range e.Edges.Groups {
+		if i > 0 {
+			labels.WriteString(", ")
+		}
+		labels.WriteString(MustAdmin(ctx).PermissionGroup().Name(related))
+	}
+	return labels.String()
+}
+
+func (f PermissionGroupsField) CreateHTML(ctx context.Context) (string, error) {
+	var options []gui.SelectOption
+	var err error
+	if source := PermissionDuplicateSource(ctx); source != nil {
+		options, err = f.loadGroupsOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadGroupsOptions(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+	if initial, ok := vent.InitialValue(ctx, "groups"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
+	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
+		Name:     "groups",
+		Label:    "Groups",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  options,
+	})
+}
+
+func (f PermissionGroupsField) UpdateHTML(ctx context.Context, e *ent.Permission) (string, error) {
+	options, err := f.loadGroupsOptionsWithSelection(ctx, e)
+	if err != nil {
+		return "", err
+	}
+	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
+		Name:     "groups",
+		Label:    "Groups",
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+		Options:  options,
+	})
+}
+
+func (f PermissionGroupsField) DetailHTML(ctx context.Context, e *ent.Permission) (string, error) {
+	related, err := MustAdmin(ctx).PermissionGroup().EagerLoadQuery(e.QueryGroups()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_permission_group")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).PermissionGroup().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%spermission-groups/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Groups",
+		Links: links,
+	})
+}
+
+func (f PermissionGroupsField) ApplyCreate(_ context.Context, builder *ent.PermissionCreate, input PermissionCreateInput) error {
+	if len(input.Groups) > 0 {
+		if err := addIDs(builder.AddGroupIDs, input.Groups, "groups"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f PermissionGroupsField) ApplyUpdate(_ context.Context, builder *ent.PermissionUpdateOne, input PermissionUpdateInput) error {
+	if input.Groups != nil {
+		builder.ClearGroups()
+		if len(*input.Groups) > 0 {
+			if err := addIDs(builder.AddGroupIDs, *input.Groups, "groups"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+func (f PermissionGroupsField) loadGroupsOptions(ctx context.Context) ([]gui.SelectOption, error) {
+	entities, err := MustAdmin(ctx).PermissionGroup().EagerLoadQuery(f.client.PermissionGroup.Query()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]gui.SelectOption, len(entities))
+	for i, entity := range entities {
+		options[i] = gui.SelectOption{
+			Value: vent.FormatID(entity.ID),
+			Label: MustAdmin(ctx).PermissionGroup().Name(entity),
+		}
+	}
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Label < options[j].Label
+	})
+	return options, nil
+}
+
+func (f PermissionGroupsField) loadGroupsOptionsWithSelection(ctx context.Context, e *ent.Permission) ([]gui.SelectOption, error) {
+	options, err := f.loadGroupsOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selected := make(map[string]struct{})
+	for _, related := range e.Edges.Groups {
+		selected[vent.FormatID(related.ID)] = struct{}{}
+	}
+	for i := range options {
+		_, options[i].Selected = selected[options[i].Value]
+	}
+	return options, nil
+}
+
+// PermissionGroupField is the typed admin field contract for PermissionGroup.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// PermissionGroupDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
+type PermissionGroupField interface {
+	ListCell(ctx context.Context, e *ent.PermissionGroup) string
+	CreateHTML(ctx context.Context) (string, error)
+	UpdateHTML(ctx context.Context, e *ent.PermissionGroup) (string, error)
+	DetailHTML(ctx context.Context, e *ent.PermissionGroup) (string, error)
+	ApplyCreate(ctx context.Context, builder *ent.PermissionGroupCreate, input PermissionGroupCreateInput) error
+	ApplyUpdate(ctx context.Context, builder *ent.PermissionGroupUpdateOne, input PermissionGroupUpdateInput) error
+}
+
+type permissiongroupDuplicateSourceKey struct{}
+
+// withPermissionGroupDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withPermissionGroupDuplicateSource(ctx context.Context, e *ent.PermissionGroup) context.Context {
+	return context.WithValue(ctx, permissiongroupDuplicateSourceKey{}, e)
+}
+
+// PermissionGroupDuplicateSource returns the PermissionGroup the add form being rendered
+// duplicates, or nil for a blank add form.
+func PermissionGroupDuplicateSource(ctx context.Context) *ent.PermissionGroup {
+	e, _ := ctx.Value(permissiongroupDuplicateSourceKey{}).(*ent.PermissionGroup)
+	return e
+}
+
+// PermissionGroupFields holds the resolved admin field implementations for PermissionGroup.
+type PermissionGroupFields struct {
+	listColumns         []PermissionGroupField
+	createFormFieldSets []PermissionGroupFieldSet
+	updateFormFieldSets []PermissionGroupFieldSet
+	createBindFields    []PermissionGroupField
+	updateBindFields    []PermissionGroupField
+	// auditFields are the fields audit log entries diff.
+	auditFields []permissiongroupAuditField
+}
+
+// permissiongroupAuditField is a PermissionGroup field with the name audit log diffs use.
+type permissiongroupAuditField struct {
+	name  string
+	field PermissionGroupField
+}
+
+// PermissionGroupFieldSet is one form section: its heading props and the fields it renders.
+type PermissionGroupFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []PermissionGroupField
+}
+
+func newPermissionGroupFields(schemaAdmin PermissionGroupAdmin) (PermissionGroupFields, error) {
+	f := PermissionGroupFields{}
+	NameField := schemaAdmin.FieldName()
+	if NameField == nil {
+		return PermissionGroupFields{}, fmt.Errorf("PermissionGroupAdmin.FieldName() returned nil")
+	}
+	PermissionsField := schemaAdmin.FieldPermissions()
+	if PermissionsField == nil {
+		return PermissionGroupFields{}, fmt.Errorf("PermissionGroupAdmin.FieldPermissions() returned nil")
+	}
+	f.listColumns = []PermissionGroupField{
+		NameField,
+	}
+	f.createFormFieldSets = []PermissionGroupFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []PermissionGroupField{
+				NameField,
+				PermissionsField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []PermissionGroupFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []PermissionGroupField{
+				NameField,
+				PermissionsField,
+			},
+		},
+	}
+	f.createBindFields = []PermissionGroupField{
+		NameField,
+		PermissionsField,
+	}
+	f.updateBindFields = []PermissionGroupField{
+		NameField,
+		PermissionsField,
+	}
+	f.auditFields = []permissiongroupAuditField{
+		{name: "name", field: NameField},
+		{name: "permissions", field: PermissionsField},
+	}
+	return f, nil
+}
+
+type PermissionGroupNameField struct {
+	client *ent.Client
+}
+
+// NewPermissionGroupNameField returns the generated default implementation for name.
+func NewPermissionGroupNameField(client *ent.Client) PermissionGroupNameField {
+	return PermissionGroupNameField{client: client}
+}
+
+func (f PermissionGroupNameField) ListCell(ctx context.Context, e *ent.PermissionGroup) string {
+	return MustAdmin(ctx).PermissionGroup().Name(e)
+}
+
+func (f PermissionGroupNameField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if initial, ok := vent.InitialValue(ctx, "name"); ok {
+		value = initial
+	}
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "name",
+		Label:    "Name",
+		Value:    value,
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f PermissionGroupNameField) UpdateHTML(ctx context.Context, e *ent.PermissionGroup) (string, error) {
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:     "name",
 		Label:    "Name",
 		Value:    vent.FormatFormValue(e.Name),
-		Editable: false,
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
 
-func (f PermissionNameField) ApplyCreate(_ context.Context, builder *ent.PermissionCreate, input PermissionCreateInput) error {
+func (f PermissionGroupNameField) DetailHTML(ctx context.Context, e *ent.PermissionGroup) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Name",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f PermissionGroupNameField) ApplyCreate(_ context.Context, builder *ent.PermissionGroupCreate, input PermissionGroupCreateInput) error {
+	builder.SetName(input.Name)
 	return nil
 }
 
-func (f PermissionNameField) ApplyUpdate(_ context.Context, builder *ent.PermissionUpdateOne, input PermissionUpdateInput) error {
+func (f PermissionGroupNameField) ApplyUpdate(_ context.Context, builder *ent.PermissionGroupUpdateOne, input PermissionGroupUpdateInput) error {
+	if input.Name != nil {
+		builder.SetName(*input.Name)
+	}
 	return nil
 }
 
-type PermissionGroupsField struct {
+type PermissionGroupPermissionsField struct {
 	client *ent.Client
 }
 
-// NewPermissionGroupsField returns the generated default implementation for groups.
-func NewPermissionGroupsField(client *ent.Client) PermissionGroupsField {
-	return PermissionGroupsField{client: client}
+// NewPermissionGroupPermissionsField returns the generated default implementation for permissions.
+func NewPermissionGroupPermissionsField(client *ent.Client) PermissionGroupPermissionsField {
+	return PermissionGroupPermissionsField{client: client}
 }
 
-func (f PermissionGroupsField) ListCell(ctx context.Context, e *ent.Permission) string {
-	if len(e.Edges.Groups) == 0 {
+func (f PermissionGroupPermissionsField) ListCell(ctx context.Context, e *ent.PermissionGroup) string {
+	if len(e.Edges.Permissions) == 0 {
 		return ""
 	}
 	var labels strings.Builder
-	for i, related := range e.Edges.Groups {
+	for i, related := range e.Edges.Permissions {
 		if i > 0 {
 			labels.WriteString(", ")
 		}
-		labels.WriteString(MustAdmin(ctx).PermissionGroup().Name(related))
+		labels.WriteString(MustAdmin(ctx).Permission().Name(related))
 	}
 	return labels.String()
 }
 
-func (f PermissionGroupsField) CreateHTML(ctx context.Context) (string, error) {
-	options, err := f.loadGroupsOptions(ctx)
+func (f PermissionGroupPermissionsField) CreateHTML(ctx context.Context) (string, error) {
+	var options []gui.SelectOption
+	var err error
+	if source := PermissionGroupDuplicateSource(ctx); source != nil {
+		options, err = f.loadPermissionsOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadPermissionsOptions(ctx)
+	}
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "permissions"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
-		Name:     "groups",
-		Label:    "Groups",
+		Name:     "permissions",
+		Label:    "Permissions",
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 		Options:  options,
 	})
 }
 
-func (f PermissionGroupsField) UpdateHTML(ctx context.Context, e *ent.Permission) (string, error) {
-	options, err := f.loadGroupsOptionsWithSelection(ctx, e)
+func (f PermissionGroupPermissionsField) UpdateHTML(ctx context.Context, e *ent.PermissionGroup) (string, error) {
+	options, err := f.loadPermissionsOptionsWithSelection(ctx, e)
 	if err != nil {
 		return "", err
 	}
 	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
-		Name:     "groups",
-		Label:    "Groups",
+		Name:     "permissions",
+		Label:    "Permissions",
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 		Options:  options,
 	})
 }
 
-func (f PermissionGroupsField) ApplyCreate(_ context.Context, builder *ent.PermissionCreate, input PermissionCreateInput) error {
-	if len(input.Groups) > 0 {
-		ids, err := parseIDList(input.Groups, "groups")
-		if err != nil {
+func (f PermissionGroupPermissionsField) DetailHTML(ctx context.Context, e *ent.PermissionGroup) (string, error) {
+	related, err := MustAdmin(ctx).Permission().EagerLoadQuery(e.QueryPermissions()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_permission")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).Permission().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%spermissions/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Permissions",
+		Links: links,
+	})
+}
+
+func (f PermissionGroupPermissionsField) ApplyCreate(_ context.Context, builder *ent.PermissionGroupCreate, input PermissionGroupCreateInput) error {
+	if len(input.Permissions) > 0 {
+		if err := addIDs(builder.AddPermissionIDs, input.Permissions, "permissions"); err != nil {
 			return err
 		}
-		builder.AddGroupIDs(ids...)
 	}
 	return nil
 }
 
-func (f PermissionGroupsField) ApplyUpdate(_ context.Context, builder *ent.PermissionUpdateOne, input PermissionUpdateInput) error {
-	if input.Groups != nil {
-		builder.ClearGroups()
-		if len(*input.Groups) > 0 {
-			ids, err := parseIDList(*input.Groups, "groups")
-			if err != nil {
+func (f PermissionGroupPermissionsField) ApplyUpdate(_ context.Context, builder *ent.PermissionGroupUpdateOne, input PermissionGroupUpdateInput) error {
+	if input.Permissions != nil {
+		builder.ClearPermissions()
+		if len(*input.Permissions) > 0 {
+			if err := addIDs(builder.AddPermissionIDs, *input.Permissions, "permissions"); err != nil {
 				return err
 			}
-			builder.AddGroupIDs(ids...)
 		}
 	}
 	return nil
 }
-func (f PermissionGroupsField) loadGroupsOptions(ctx context.Context) ([]gui.SelectOption, error) {
-	entities, err := MustAdmin(ctx).PermissionGroup().EagerLoadQuery(f.client.PermissionGroup.Query()).All(ctx)
+func (f PermissionGroupPermissionsField) loadPermissionsOptions(ctx context.Context) ([]gui.SelectOption, error) {
+	entities, err := MustAdmin(ctx).Permission().EagerLoadQuery(f.client.Permission.Query()).All(ctx)
 	if err != nil {
 		return nil, err
 	}
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
-			Label: MustAdmin(ctx).PermissionGroup().Name(entity),
+			Value: vent.FormatID(entity.ID),
+			Label: MustAdmin(ctx).Permission().Name(entity),
 		}
 	}
 	sort.Slice(options, func(i, j int) bool {
@@ -824,14 +2653,14 @@ func (f PermissionGroupsField) loadGroupsOptions(ctx context.Context) ([]gui.Sel
 	return options, nil
 }
 
-func (f PermissionGroupsField) loadGroupsOptionsWithSelection(ctx context.Context, e *ent.Permission) ([]gui.SelectOption, error) {
-	options, err := f.loadGroupsOptions(ctx)
+func (f PermissionGroupPermissionsField) loadPermissionsOptionsWithSelection(ctx context.Context, e *ent.PermissionGroup) ([]gui.SelectOption, error) {
+	options, err := f.loadPermissionsOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
-	selected := make(map[int]struct{})
-	for _, related := range e.Edges.Groups {
-		selected[related.ID] = struct{}{}
+	selected := make(map[string]struct{})
+	for _, related := range e.Edges.Permissions {
+		selected[vent.FormatID(related.ID)] = struct{}{}
 	}
 	for i := range options {
 		_, options[i].Selected = selected[options[i].Value]
@@ -839,78 +2668,201 @@ func (f PermissionGroupsField) loadGroupsOptionsWithSelection(ctx context.Contex
 	return options, nil
 }
 
-// PermissionGroupField is the typed admin field contract for PermissionGroup.
-type PermissionGroupField interface {
-	ListCell(ctx context.Context, e *ent.PermissionGroup) string
+// PublisherField is the typed admin field contract for Publisher.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// PublisherDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
+type PublisherField interface {
+	ListCell(ctx context.Context, e *ent.Publisher) string
 	CreateHTML(ctx context.Context) (string, error)
-	UpdateHTML(ctx context.Context, e *ent.PermissionGroup) (string, error)
-	ApplyCreate(ctx context.Context, builder *ent.PermissionGroupCreate, input PermissionGroupCreateInput) error
-	ApplyUpdate(ctx context.Context, builder *ent.PermissionGroupUpdateOne, input PermissionGroupUpdateInput) error
+	UpdateHTML(ctx context.Context, e *ent.Publisher) (string, error)
+	DetailHTML(ctx context.Context, e *ent.Publisher) (string, error)
+	ApplyCreate(ctx context.Context, builder *ent.PublisherCreate, input PublisherCreateInput) error
+	ApplyUpdate(ctx context.Context, builder *ent.PublisherUpdateOne, input PublisherUpdateInput) error
 }
 
-// PermissionGroupFields holds the resolved admin field implementations for PermissionGroup.
-type PermissionGroupFields struct {
-	listColumns      []PermissionGroupField
-	createFormFields []PermissionGroupField
-	updateFormFields []PermissionGroupField
-	createBindFields []PermissionGroupField
-	updateBindFields []PermissionGroupField
+type publisherDuplicateSourceKey struct{}
+
+// withPublisherDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withPublisherDuplicateSource(ctx context.Context, e *ent.Publisher) context.Context {
+	return context.WithValue(ctx, publisherDuplicateSourceKey{}, e)
 }
 
-func newPermissionGroupFields(schemaAdmin PermissionGroupAdmin) (PermissionGroupFields, error) {
-	f := PermissionGroupFields{}
+// PublisherDuplicateSource returns the Publisher the add form being rendered
+// duplicates, or nil for a blank add form.
+func PublisherDuplicateSource(ctx context.Context) *ent.Publisher {
+	e, _ := ctx.Value(publisherDuplicateSourceKey{}).(*ent.Publisher)
+	return e
+}
+
+// PublisherFields holds the resolved admin field implementations for Publisher.
+type PublisherFields struct {
+	listColumns         []PublisherField
+	createFormFieldSets []PublisherFieldSet
+	updateFormFieldSets []PublisherFieldSet
+	createBindFields    []PublisherField
+	updateBindFields    []PublisherField
+	// auditFields are the fields audit log entries diff.
+	auditFields []publisherAuditField
+}
+
+// publisherAuditField is a Publisher field with the name audit log diffs use.
+type publisherAuditField struct {
+	name  string
+	field PublisherField
+}
+
+// PublisherFieldSet is one form section: its heading props and the fields it renders.
+type PublisherFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []PublisherField
+}
+
+func newPublisherFields(schemaAdmin PublisherAdmin) (PublisherFields, error) {
+	f := PublisherFields{}
+	IdField := schemaAdmin.FieldID()
+	if IdField == nil {
+		return PublisherFields{}, fmt.Errorf("PublisherAdmin.FieldID() returned nil")
+	}
 	NameField := schemaAdmin.FieldName()
 	if NameField == nil {
-		return PermissionGroupFields{}, fmt.Errorf("PermissionGroupAdmin.FieldName() returned nil")
+		return PublisherFields{}, fmt.Errorf("PublisherAdmin.FieldName() returned nil")
 	}
-	PermissionsField := schemaAdmin.FieldPermissions()
-	if PermissionsField == nil {
-		return PermissionGroupFields{}, fmt.Errorf("PermissionGroupAdmin.FieldPermissions() returned nil")
+	CatalogField := schemaAdmin.FieldCatalog()
+	if CatalogField == nil {
+		return PublisherFields{}, fmt.Errorf("PublisherAdmin.FieldCatalog() returned nil")
 	}
-	f.listColumns = []PermissionGroupField{
-		NameField,
+	BooksField := schemaAdmin.FieldBooks()
+	if BooksField == nil {
+		return PublisherFields{}, fmt.Errorf("PublisherAdmin.FieldBooks() returned nil")
 	}
-	f.createFormFields = []PermissionGroupField{
+	f.listColumns = []PublisherField{
 		NameField,
-		PermissionsField,
+		IdField,
 	}
-	f.updateFormFields = []PermissionGroupField{
+	f.createFormFieldSets = []PublisherFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []PublisherField{
+				NameField,
+				CatalogField,
+				BooksField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []PublisherFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []PublisherField{
+				IdField,
+				NameField,
+				CatalogField,
+				BooksField,
+			},
+		},
+	}
+	f.createBindFields = []PublisherField{
 		NameField,
-		PermissionsField,
+		CatalogField,
+		BooksField,
 	}
-	f.createBindFields = []PermissionGroupField{
+	f.updateBindFields = []PublisherField{
 		NameField,
-		PermissionsField,
+		CatalogField,
+		BooksField,
 	}
-	f.updateBindFields = []PermissionGroupField{
-		NameField,
-		PermissionsField,
+	f.auditFields = []publisherAuditField{
+		{name: "name", field: NameField},
+		{name: "catalog", field: CatalogField},
+		{name: "books", field: BooksField},
 	}
 	return f, nil
 }
 
-type PermissionGroupNameField struct {
+type PublisherIdField struct {
 	client *ent.Client
 }
 
-// NewPermissionGroupNameField returns the generated default implementation for name.
-func NewPermissionGroupNameField(client *ent.Client) PermissionGroupNameField {
-	return PermissionGroupNameField{client: client}
+// NewPublisherIdField returns the generated default implementation for id.
+func NewPublisherIdField(client *ent.Client) PublisherIdField {
+	return PublisherIdField{client: client}
 }
 
-func (f PermissionGroupNameField) ListCell(ctx context.Context, e *ent.PermissionGroup) string {
-	return MustAdmin(ctx).PermissionGroup().Name(e)
+func (f PublisherIdField) ListCell(ctx context.Context, e *ent.Publisher) string {
+	return vent.FormatID(e.ID)
 }
 
-func (f PermissionGroupNameField) CreateHTML(ctx context.Context) (string, error) {
+func (f PublisherIdField) CreateHTML(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (f PublisherIdField) UpdateHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
+		Name:     "id",
+		Label:    "ID",
+		Value:    vent.FormatID(e.ID),
+		Editable: false,
+	})
+}
+
+func (f PublisherIdField) DetailHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "ID",
+		Value: vent.FormatID(e.ID),
+	})
+}
+
+func (f PublisherIdField) ApplyCreate(_ context.Context, builder *ent.PublisherCreate, input PublisherCreateInput) error {
+	return nil
+}
+
+func (f PublisherIdField) ApplyUpdate(_ context.Context, builder *ent.PublisherUpdateOne, input PublisherUpdateInput) error {
+	return nil
+}
+
+type PublisherNameField struct {
+	client *ent.Client
+}
+
+// NewPublisherNameField returns the generated default implementation for name.
+func NewPublisherNameField(client *ent.Client) PublisherNameField {
+	return PublisherNameField{client: client}
+}
+
+func (f PublisherNameField) ListCell(ctx context.Context, e *ent.Publisher) string {
+	return MustAdmin(ctx).Publisher().Name(e)
+}
+
+func (f PublisherNameField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := PublisherDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Name)
+	}
+	if initial, ok := vent.InitialValue(ctx, "name"); ok {
+		value = initial
+	}
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:     "name",
 		Label:    "Name",
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
 
-func (f PermissionGroupNameField) UpdateHTML(ctx context.Context, e *ent.PermissionGroup) (string, error) {
+func (f PublisherNameField) UpdateHTML(ctx context.Context, e *ent.Publisher) (string, error) {
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:     "name",
 		Label:    "Name",
@@ -919,101 +2871,199 @@ func (f PermissionGroupNameField) UpdateHTML(ctx context.Context, e *ent.Permiss
 	})
 }
 
-func (f PermissionGroupNameField) ApplyCreate(_ context.Context, builder *ent.PermissionGroupCreate, input PermissionGroupCreateInput) error {
+func (f PublisherNameField) DetailHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Name",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f PublisherNameField) ApplyCreate(_ context.Context, builder *ent.PublisherCreate, input PublisherCreateInput) error {
 	builder.SetName(input.Name)
 	return nil
 }
 
-func (f PermissionGroupNameField) ApplyUpdate(_ context.Context, builder *ent.PermissionGroupUpdateOne, input PermissionGroupUpdateInput) error {
+func (f PublisherNameField) ApplyUpdate(_ context.Context, builder *ent.PublisherUpdateOne, input PublisherUpdateInput) error {
 	if input.Name != nil {
 		builder.SetName(*input.Name)
 	}
 	return nil
 }
 
-type PermissionGroupPermissionsField struct {
+type PublisherCatalogField struct {
 	client *ent.Client
 }
 
-// NewPermissionGroupPermissionsField returns the generated default implementation for permissions.
-func NewPermissionGroupPermissionsField(client *ent.Client) PermissionGroupPermissionsField {
-	return PermissionGroupPermissionsField{client: client}
+// NewPublisherCatalogField returns the generated default implementation for catalog.
+func NewPublisherCatalogField(client *ent.Client) PublisherCatalogField {
+	return PublisherCatalogField{client: client}
 }
 
-func (f PermissionGroupPermissionsField) ListCell(ctx context.Context, e *ent.PermissionGroup) string {
-	if len(e.Edges.Permissions) == 0 {
+func (f PublisherCatalogField) ListCell(ctx context.Context, e *ent.Publisher) string {
+	return vent.FormatFormValue(e.Catalog)
+}
+
+func (f PublisherCatalogField) CreateHTML(ctx context.Context) (string, error) {
+	return gui.RenderFileFieldHTML(ctx, gui.SchemaEntityFileFieldProps{
+		Name:      "catalog",
+		Label:     "Catalog",
+		Editable:  gui.MustRenderContext(ctx).CanUpdate,
+		Image:     false,
+		Clearable: true,
+	})
+}
+
+func (f PublisherCatalogField) UpdateHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	return gui.RenderFileFieldHTML(ctx, gui.SchemaEntityFileFieldProps{
+		Name:      "catalog",
+		Label:     "Catalog",
+		Value:     vent.FormatFormValue(e.Catalog),
+		Editable:  gui.MustRenderContext(ctx).CanUpdate,
+		Image:     false,
+		Clearable: true,
+	})
+}
+
+func (f PublisherCatalogField) DetailHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Catalog",
+		Kind:  vent.FieldKind("file"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f PublisherCatalogField) ApplyCreate(ctx context.Context, builder *ent.PublisherCreate, input PublisherCreateInput) error {
+	key, ok, err := vent.SaveUpload(ctx, "catalog", "publisher/catalog")
+	if err != nil {
+		return err
+	}
+	if ok {
+		builder.SetCatalog(key)
+	}
+	return nil
+}
+
+func (f PublisherCatalogField) ApplyUpdate(ctx context.Context, builder *ent.PublisherUpdateOne, input PublisherUpdateInput) error {
+	key, ok, err := vent.SaveUpload(ctx, "catalog", "publisher/catalog")
+	if err != nil {
+		return err
+	}
+	if ok {
+		builder.SetCatalog(key)
+		return nil
+	}
+	if input.Catalog != nil && *input.Catalog == "" {
+		builder.ClearCatalog()
+	}
+	return nil
+}
+
+type PublisherBooksField struct {
+	client *ent.Client
+}
+
+// NewPublisherBooksField returns the generated default implementation for books.
+func NewPublisherBooksField(client *ent.Client) PublisherBooksField {
+	return PublisherBooksField{client: client}
+}
+
+func (f PublisherBooksField) ListCell(ctx context.Context, e *ent.Publisher) string {
+	if len(e.Edges.Books) == 0 {
 		return ""
 	}
 	var labels strings.Builder
-	for i, related := range e.Edges.Permissions {
+	for i, related := range e.Edges.Books {
 		if i > 0 {
 			labels.WriteString(", ")
 		}
-		labels.WriteString(MustAdmin(ctx).Permission().Name(related))
+		labels.WriteString(MustAdmin(ctx).Book().Name(related))
 	}
 	return labels.String()
 }
 
-func (f PermissionGroupPermissionsField) CreateHTML(ctx context.Context) (string, error) {
-	options, err := f.loadPermissionsOptions(ctx)
+func (f PublisherBooksField) CreateHTML(ctx context.Context) (string, error) {
+	options, err := f.loadBooksOptions(ctx)
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "books"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
-		Name:     "permissions",
-		Label:    "Permissions",
+		Name:     "books",
+		Label:    "Books",
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 		Options:  options,
 	})
 }
 
-func (f PermissionGroupPermissionsField) UpdateHTML(ctx context.Context, e *ent.PermissionGroup) (string, error) {
-	options, err := f.loadPermissionsOptionsWithSelection(ctx, e)
+func (f PublisherBooksField) UpdateHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	options, err := f.loadBooksOptionsWithSelection(ctx, e)
 	if err != nil {
 		return "", err
 	}
 	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
-		Name:     "permissions",
-		Label:    "Permissions",
+		Name:     "books",
+		Label:    "Books",
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 		Options:  options,
 	})
 }
 
-func (f PermissionGroupPermissionsField) ApplyCreate(_ context.Context, builder *ent.PermissionGroupCreate, input PermissionGroupCreateInput) error {
-	if len(input.Permissions) > 0 {
-		ids, err := parseIDList(input.Permissions, "permissions")
-		if err != nil {
+func (f PublisherBooksField) DetailHTML(ctx context.Context, e *ent.Publisher) (string, error) {
+	related, err := MustAdmin(ctx).Book().EagerLoadQuery(e.QueryBooks()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_book")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).Book().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%sbooks/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Books",
+		Links: links,
+	})
+}
+
+func (f PublisherBooksField) ApplyCreate(_ context.Context, builder *ent.PublisherCreate, input PublisherCreateInput) error {
+	if len(input.Books) > 0 {
+		if err := addIDs(builder.AddBookIDs, input.Books, "books"); err != nil {
 			return err
 		}
-		builder.AddPermissionIDs(ids...)
 	}
 	return nil
 }
 
-func (f PermissionGroupPermissionsField) ApplyUpdate(_ context.Context, builder *ent.PermissionGroupUpdateOne, input PermissionGroupUpdateInput) error {
-	if input.Permissions != nil {
-		builder.ClearPermissions()
-		if len(*input.Permissions) > 0 {
-			ids, err := parseIDList(*input.Permissions, "permissions")
-			if err != nil {
+func (f PublisherBooksField) ApplyUpdate(_ context.Context, builder *ent.PublisherUpdateOne, input PublisherUpdateInput) error {
+	if input.Books != nil {
+		builder.ClearBooks()
+		if len(*input.Books) > 0 {
+			if err := addIDs(builder.AddBookIDs, *input.Books, "books"); err != nil {
 				return err
 			}
-			builder.AddPermissionIDs(ids...)
 		}
 	}
 	return nil
 }
-func (f PermissionGroupPermissionsField) loadPermissionsOptions(ctx context.Context) ([]gui.SelectOption, error) {
-	entities, err := MustAdmin(ctx).Permission().EagerLoadQuery(f.client.Permission.Query()).All(ctx)
+func (f PublisherBooksField) loadBooksOptions(ctx context.Context) ([]gui.SelectOption, error) {
+	entities, err := MustAdmin(ctx).Book().EagerLoadQuery(f.client.Book.Query()).All(ctx)
 	if err != nil {
 		return nil, err
 	}
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
-			Label: MustAdmin(ctx).Permission().Name(entity),
+			Value: vent.FormatID(entity.ID),
+			Label: MustAdmin(ctx).Book().Name(entity),
 		}
 	}
 	sort.Slice(options, func(i, j int) bool {
@@ -1022,14 +3072,14 @@ func (f PermissionGroupPermissionsField) loadPermissionsOptions(ctx context.Cont
 	return options, nil
 }
 
-func (f PermissionGroupPermissionsField) loadPermissionsOptionsWithSelection(ctx context.Context, e *ent.PermissionGroup) ([]gui.SelectOption, error) {
-	options, err := f.loadPermissionsOptions(ctx)
+func (f PublisherBooksField) loadBooksOptionsWithSelection(ctx context.Context, e *ent.Publisher) ([]gui.SelectOption, error) {
+	options, err := f.loadBooksOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
-	selected := make(map[int]struct{})
-	for _, related := range e.Edges.Permissions {
-		selected[related.ID] = struct{}{}
+	selected := make(map[string]struct{})
+	for _, related := range e.Edges.Books {
+		selected[vent.FormatID(related.ID)] = struct{}{}
 	}
 	for i := range options {
 		_, options[i].Selected = selected[options[i].Value]
@@ -1038,21 +3088,56 @@ func (f PermissionGroupPermissionsField) loadPermissionsOptionsWithSelection(ctx
 }
 
 // ReviewField is the typed admin field contract for Review.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// ReviewDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
 type ReviewField interface {
 	ListCell(ctx context.Context, e *ent.Review) string
 	CreateHTML(ctx context.Context) (string, error)
 	UpdateHTML(ctx context.Context, e *ent.Review) (string, error)
+	DetailHTML(ctx context.Context, e *ent.Review) (string, error)
 	ApplyCreate(ctx context.Context, builder *ent.ReviewCreate, input ReviewCreateInput) error
 	ApplyUpdate(ctx context.Context, builder *ent.ReviewUpdateOne, input ReviewUpdateInput) error
 }
 
+type reviewDuplicateSourceKey struct{}
+
+// withReviewDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withReviewDuplicateSource(ctx context.Context, e *ent.Review) context.Context {
+	return context.WithValue(ctx, reviewDuplicateSourceKey{}, e)
+}
+
+// ReviewDuplicateSource returns the Review the add form being rendered
+// duplicates, or nil for a blank add form.
+func ReviewDuplicateSource(ctx context.Context) *ent.Review {
+	e, _ := ctx.Value(reviewDuplicateSourceKey{}).(*ent.Review)
+	return e
+}
+
 // ReviewFields holds the resolved admin field implementations for Review.
 type ReviewFields struct {
-	listColumns      []ReviewField
-	createFormFields []ReviewField
-	updateFormFields []ReviewField
-	createBindFields []ReviewField
-	updateBindFields []ReviewField
+	listColumns         []ReviewField
+	createFormFieldSets []ReviewFieldSet
+	updateFormFieldSets []ReviewFieldSet
+	createBindFields    []ReviewField
+	updateBindFields    []ReviewField
+	// auditFields are the fields audit log entries diff.
+	auditFields []reviewAuditField
+}
+
+// reviewAuditField is a Review field with the name audit log diffs use.
+type reviewAuditField struct {
+	name  string
+	field ReviewField
+}
+
+// ReviewFieldSet is one form section: its heading props and the fields it renders.
+type ReviewFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []ReviewField
 }
 
 func newReviewFields(schemaAdmin ReviewAdmin) (ReviewFields, error) {
@@ -1071,24 +3156,44 @@ func newReviewFields(schemaAdmin ReviewAdmin) (ReviewFields, error) {
 	}
 	BookField := schemaAdmin.FieldBook()
 	if BookField == nil {
-		return ReviewFields{}, fmt.Errorf("ReviewAdmin.FieldBook() returned nil")
-	}
-	f.listColumns = []ReviewField{
-		UserField,
-		RatingField,
-		BookField,
+		return ReviewFields{}, fmt.Errorf("ReviewAdmin.FieldBook() returned nil")
 	}
-	f.createFormFields = []ReviewField{
+	f.listColumns = []ReviewField{
 		UserField,
 		RatingField,
-		BodyField,
 		BookField,
 	}
-	f.updateFormFields = []ReviewField{
-		UserField,
-		RatingField,
-		BodyField,
-		BookField,
+	f.createFormFieldSets = []ReviewFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []ReviewField{
+				UserField,
+				RatingField,
+				BodyField,
+				BookField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []ReviewFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []ReviewField{
+				UserField,
+				RatingField,
+				BodyField,
+				BookField,
+			},
+		},
 	}
 	f.createBindFields = []ReviewField{
 		UserField,
@@ -1102,6 +3207,12 @@ func newReviewFields(schemaAdmin ReviewAdmin) (ReviewFields, error) {
 		BodyField,
 		BookField,
 	}
+	f.auditFields = []reviewAuditField{
+		{name: "user", field: UserField},
+		{name: "rating", field: RatingField},
+		{name: "body", field: BodyField},
+		{name: "book", field: BookField},
+	}
 	return f, nil
 }
 
@@ -1122,10 +3233,19 @@ func (f ReviewUserField) ListCell(ctx context.Context, e *ent.Review) string {
 }
 
 func (f ReviewUserField) CreateHTML(ctx context.Context) (string, error) {
-	options, err := f.loadUserOptions(ctx)
+	var options []gui.SelectOption
+	var err error
+	if source := ReviewDuplicateSource(ctx); source != nil {
+		options, err = f.loadUserOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadUserOptions(ctx)
+	}
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "user"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyUniqueFieldHTML(ctx, gui.SchemaEntityForeignKeyUniqueFieldProps{
 		Name:     "user",
 		Label:    "User",
@@ -1147,13 +3267,34 @@ func (f ReviewUserField) UpdateHTML(ctx context.Context, e *ent.Review) (string,
 	})
 }
 
+func (f ReviewUserField) DetailHTML(ctx context.Context, e *ent.Review) (string, error) {
+	related, err := MustAdmin(ctx).User().EagerLoadQuery(e.QueryUser()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_user")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).User().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "User",
+		Links: links,
+	})
+}
+
 func (f ReviewUserField) ApplyCreate(_ context.Context, builder *ent.ReviewCreate, input ReviewCreateInput) error {
 	if input.User != "" {
-		id, err := parseID(input.User, "user")
-		if err != nil {
+		if err := setID(builder.SetUserID, input.User, "user"); err != nil {
 			return err
 		}
-		builder.SetUserID(id)
 	}
 	return nil
 }
@@ -1161,11 +3302,9 @@ func (f ReviewUserField) ApplyCreate(_ context.Context, builder *ent.ReviewCreat
 func (f ReviewUserField) ApplyUpdate(_ context.Context, builder *ent.ReviewUpdateOne, input ReviewUpdateInput) error {
 	if input.User != nil {
 		if *input.User != "" {
-			id, err := parseID(*input.User, "user")
-			if err != nil {
+			if err := setID(builder.SetUserID, *input.User, "user"); err != nil {
 				return err
 			}
-			builder.SetUserID(id)
 		} else {
 			builder.ClearUser()
 		}
@@ -1180,7 +3319,7 @@ func (f ReviewUserField) loadUserOptions(ctx context.Context) ([]gui.SelectOptio
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
+			Value: vent.FormatID(entity.ID),
 			Label: MustAdmin(ctx).User().Name(entity),
 		}
 	}
@@ -1196,7 +3335,7 @@ func (f ReviewUserField) loadUserOptionsWithSelection(ctx context.Context, e *en
 		return nil, err
 	}
 	for i := range options {
-		options[i].Selected = e.Edges.User != nil && e.Edges.User.ID == options[i].Value
+		options[i].Selected = e.Edges.User != nil && vent.FormatID(e.Edges.User.ID) == options[i].Value
 	}
 	return options, nil
 }
@@ -1215,9 +3354,17 @@ func (f ReviewRatingField) ListCell(ctx context.Context, e *ent.Review) string {
 }
 
 func (f ReviewRatingField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := ReviewDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.Rating)
+	}
+	if initial, ok := vent.InitialValue(ctx, "rating"); ok {
+		value = initial
+	}
 	return gui.RenderIntFieldHTML(ctx, gui.SchemaEntityIntFieldProps{
 		Name:     "rating",
 		Label:    "Rating",
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -1231,6 +3378,14 @@ func (f ReviewRatingField) UpdateHTML(ctx context.Context, e *ent.Review) (strin
 	})
 }
 
+func (f ReviewRatingField) DetailHTML(ctx context.Context, e *ent.Review) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Rating",
+		Kind:  vent.FieldKind("int"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f ReviewRatingField) ApplyCreate(_ context.Context, builder *ent.ReviewCreate, input ReviewCreateInput) error {
 	builder.SetRating(input.Rating)
 	return nil
@@ -1260,9 +3415,21 @@ func (f ReviewBodyField) ListCell(ctx context.Context, e *ent.Review) string {
 }
 
 func (f ReviewBodyField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := ReviewDuplicateSource(ctx); source != nil {
+		value = ""
+		if source.Body != nil {
+			value = vent.FormatFormValue(*source.Body)
+		}
+	}
+	if initial, ok := vent.InitialValue(ctx, "body"); ok {
+		value = initial
+	}
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:     "body",
-		Label:    "Body",
+		Label:    "Review",
+		Widget:   "textarea",
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -1274,12 +3441,21 @@ func (f ReviewBodyField) UpdateHTML(ctx context.Context, e *ent.Review) (string,
 	}
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:     "body",
-		Label:    "Body",
+		Label:    "Review",
+		Widget:   "textarea",
 		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
 
+func (f ReviewBodyField) DetailHTML(ctx context.Context, e *ent.Review) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Review",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f ReviewBodyField) ApplyCreate(_ context.Context, builder *ent.ReviewCreate, input ReviewCreateInput) error {
 	if input.Body != nil {
 		builder.SetNillableBody(input.Body)
@@ -1315,10 +3491,19 @@ func (f ReviewBookField) ListCell(ctx context.Context, e *ent.Review) string {
 }
 
 func (f ReviewBookField) CreateHTML(ctx context.Context) (string, error) {
-	options, err := f.loadBookOptions(ctx)
+	var options []gui.SelectOption
+	var err error
+	if source := ReviewDuplicateSource(ctx); source != nil {
+		options, err = f.loadBookOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadBookOptions(ctx)
+	}
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "book"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyUniqueFieldHTML(ctx, gui.SchemaEntityForeignKeyUniqueFieldProps{
 		Name:     "book",
 		Label:    "Book",
@@ -1340,13 +3525,34 @@ func (f ReviewBookField) UpdateHTML(ctx context.Context, e *ent.Review) (string,
 	})
 }
 
+func (f ReviewBookField) DetailHTML(ctx context.Context, e *ent.Review) (string, error) {
+	related, err := MustAdmin(ctx).Book().EagerLoadQuery(e.QueryBook()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_book")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).Book().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%sbooks/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Book",
+		Links: links,
+	})
+}
+
 func (f ReviewBookField) ApplyCreate(_ context.Context, builder *ent.ReviewCreate, input ReviewCreateInput) error {
 	if input.Book != "" {
-		id, err := parseID(input.Book, "book")
-		if err != nil {
+		if err := setID(builder.SetBookID, input.Book, "book"); err != nil {
 			return err
 		}
-		builder.SetBookID(id)
 	}
 	return nil
 }
@@ -1354,11 +3560,9 @@ func (f ReviewBookField) ApplyCreate(_ context.Context, builder *ent.ReviewCreat
 func (f ReviewBookField) ApplyUpdate(_ context.Context, builder *ent.ReviewUpdateOne, input ReviewUpdateInput) error {
 	if input.Book != nil {
 		if *input.Book != "" {
-			id, err := parseID(*input.Book, "book")
-			if err != nil {
+			if err := setID(builder.SetBookID, *input.Book, "book"); err != nil {
 				return err
 			}
-			builder.SetBookID(id)
 		} else {
 			builder.ClearBook()
 		}
@@ -1373,7 +3577,7 @@ func (f ReviewBookField) loadBookOptions(ctx context.Context) ([]gui.SelectOptio
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
+			Value: vent.FormatID(entity.ID),
 			Label: MustAdmin(ctx).Book().Name(entity),
 		}
 	}
@@ -1389,27 +3593,62 @@ func (f ReviewBookField) loadBookOptionsWithSelection(ctx context.Context, e *en
 		return nil, err
 	}
 	for i := range options {
-		options[i].Selected = e.Edges.Book != nil && e.Edges.Book.ID == options[i].Value
+		options[i].Selected = e.Edges.Book != nil && vent.FormatID(e.Edges.Book.ID) == options[i].Value
 	}
 	return options, nil
 }
 
 // UserField is the typed admin field contract for User.
+// ApplyCreate and ApplyUpdate run in the transaction that saves the entity;
+// write to other tables through ent.TxFromContext(ctx).Client() so those
+// writes roll back with a failed save. CreateHTML may pre-fill from
+// UserDuplicateSource(ctx) when the add form duplicates an entity,
+// and from vent.InitialValue(ctx, name) when the add URL's query string sets
+// the field.
 type UserField interface {
 	ListCell(ctx context.Context, e *ent.User) string
 	CreateHTML(ctx context.Context) (string, error)
 	UpdateHTML(ctx context.Context, e *ent.User) (string, error)
+	DetailHTML(ctx context.Context, e *ent.User) (string, error)
 	ApplyCreate(ctx context.Context, builder *ent.UserCreate, input UserCreateInput) error
 	ApplyUpdate(ctx context.Context, builder *ent.UserUpdateOne, input UserUpdateInput) error
 }
 
+type userDuplicateSourceKey struct{}
+
+// withUserDuplicateSource marks ctx as rendering an add form that duplicates e.
+func withUserDuplicateSource(ctx context.Context, e *ent.User) context.Context {
+	return context.WithValue(ctx, userDuplicateSourceKey{}, e)
+}
+
+// UserDuplicateSource returns the User the add form being rendered
+// duplicates, or nil for a blank add form.
+func UserDuplicateSource(ctx context.Context) *ent.User {
+	e, _ := ctx.Value(userDuplicateSourceKey{}).(*ent.User)
+	return e
+}
+
 // UserFields holds the resolved admin field implementations for User.
 type UserFields struct {
-	listColumns      []UserField
-	createFormFields []UserField
-	updateFormFields []UserField
-	createBindFields []UserField
-	updateBindFields []UserField
+	listColumns         []UserField
+	createFormFieldSets []UserFieldSet
+	updateFormFieldSets []UserFieldSet
+	createBindFields    []UserField
+	updateBindFields    []UserField
+	// auditFields are the fields audit log entries diff.
+	auditFields []userAuditField
+}
+
+// userAuditField is a User field with the name audit log diffs use.
+type userAuditField struct {
+	name  string
+	field UserField
+}
+
+// UserFieldSet is one form section: its heading props and the fields it renders.
+type UserFieldSet struct {
+	props  gui.SchemaEntityFieldSetProps
+	fields []UserField
 }
 
 func newUserFields(schemaAdmin UserAdmin) (UserFields, error) {
@@ -1426,6 +3665,10 @@ func newUserFields(schemaAdmin UserAdmin) (UserFields, error) {
 	if PasswordField == nil {
 		return UserFields{}, fmt.Errorf("UserAdmin.FieldPassword() returned nil")
 	}
+	LastLoginField := schemaAdmin.FieldLastLogin()
+	if LastLoginField == nil {
+		return UserFields{}, fmt.Errorf("UserAdmin.FieldLastLogin() returned nil")
+	}
 	IsStaffField := schemaAdmin.FieldIsStaff()
 	if IsStaffField == nil {
 		return UserFields{}, fmt.Errorf("UserAdmin.FieldIsStaff() returned nil")
@@ -1442,10 +3685,6 @@ func newUserFields(schemaAdmin UserAdmin) (UserFields, error) {
 	if GroupsField == nil {
 		return UserFields{}, fmt.Errorf("UserAdmin.FieldGroups() returned nil")
 	}
-	LastLoginField := schemaAdmin.FieldLastLogin()
-	if LastLoginField == nil {
-		return UserFields{}, fmt.Errorf("UserAdmin.FieldLastLogin() returned nil")
-	}
 	f.listColumns = []UserField{
 		EmailField,
 		IsStaffField,
@@ -1453,39 +3692,87 @@ func newUserFields(schemaAdmin UserAdmin) (UserFields, error) {
 		IsActiveField,
 		LastLoginField,
 	}
-	f.createFormFields = []UserField{
-		EmailField,
-		IsStaffField,
-		IsSuperuserField,
-		IsActiveField,
-		GroupsField,
-		LastLoginField,
-	}
-	f.updateFormFields = []UserField{
-		IdField,
-		EmailField,
-		PasswordField,
-		IsStaffField,
-		IsSuperuserField,
-		IsActiveField,
-		GroupsField,
-		LastLoginField,
+	f.createFormFieldSets = []UserFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Account",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []UserField{
+				EmailField,
+				LastLoginField,
+			},
+		},
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Access",
+				Description: "Staff users can sign in to the admin; superusers bypass permission checks.",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []UserField{
+				IsStaffField,
+				IsSuperuserField,
+				IsActiveField,
+				GroupsField,
+			},
+		},
+	}
+	f.updateFormFieldSets = []UserFieldSet{
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Account",
+				Description: "",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []UserField{
+				IdField,
+				EmailField,
+				PasswordField,
+				LastLoginField,
+			},
+		},
+		{
+			props: gui.SchemaEntityFieldSetProps{
+				Label:       "Access",
+				Description: "Staff users can sign in to the admin; superusers bypass permission checks.",
+				Collapsible: false,
+				Collapsed:   false,
+			},
+			fields: []UserField{
+				IsStaffField,
+				IsSuperuserField,
+				IsActiveField,
+				GroupsField,
+			},
+		},
 	}
 	f.createBindFields = []UserField{
 		EmailField,
+		LastLoginField,
 		IsStaffField,
 		IsSuperuserField,
 		IsActiveField,
 		GroupsField,
-		LastLoginField,
 	}
 	f.updateBindFields = []UserField{
 		EmailField,
+		LastLoginField,
 		IsStaffField,
 		IsSuperuserField,
 		IsActiveField,
 		GroupsField,
-		LastLoginField,
+	}
+	f.auditFields = []userAuditField{
+		{name: "email", field: EmailField},
+		{name: "last_login", field: LastLoginField},
+		{name: "is_staff", field: IsStaffField},
+		{name: "is_superuser", field: IsSuperuserField},
+		{name: "is_active", field: IsActiveField},
+		{name: "groups", field: GroupsField},
 	}
 	return f, nil
 }
@@ -1500,7 +3787,7 @@ func NewUserIdField(client *ent.Client) UserIdField {
 }
 
 func (f UserIdField) ListCell(ctx context.Context, e *ent.User) string {
-	return fmt.Sprintf("%d", e.ID)
+	return vent.FormatID(e.ID)
 }
 
 func (f UserIdField) CreateHTML(ctx context.Context) (string, error) {
@@ -1511,11 +3798,18 @@ func (f UserIdField) UpdateHTML(ctx context.Context, e *ent.User) (string, error
 	return gui.RenderIntFieldHTML(ctx, gui.SchemaEntityIntFieldProps{
 		Name:     "id",
 		Label:    "ID",
-		Value:    fmt.Sprintf("%d", e.ID),
+		Value:    vent.FormatID(e.ID),
 		Editable: false,
 	})
 }
 
+func (f UserIdField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "ID",
+		Value: vent.FormatID(e.ID),
+	})
+}
+
 func (f UserIdField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	return nil
 }
@@ -1538,9 +3832,14 @@ func (f UserEmailField) ListCell(ctx context.Context, e *ent.User) string {
 }
 
 func (f UserEmailField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if initial, ok := vent.InitialValue(ctx, "email"); ok {
+		value = initial
+	}
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:     "email",
 		Label:    "Email",
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -1554,6 +3853,14 @@ func (f UserEmailField) UpdateHTML(ctx context.Context, e *ent.User) (string, er
 	})
 }
 
+func (f UserEmailField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Email",
+		Kind:  vent.FieldKind("string"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f UserEmailField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	builder.SetEmail(input.Email)
 	return nil
@@ -1592,7 +3899,7 @@ func (f UserPasswordField) UpdateHTML(ctx context.Context, e *ent.User) (string,
 	actionURL := ""
 	if gui.MustRenderContext(ctx).CanUpdate {
 		actionLabel = "Manage Password"
-		actionURL = fmt.Sprintf("%susers/%d/password/", requestctx.MustAdminPath(ctx), e.ID)
+		actionURL = fmt.Sprintf("%susers/%s/password/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
 	}
 	return gui.RenderTextFieldHTML(ctx, gui.SchemaEntityTextFieldProps{
 		Name:        "password",
@@ -1604,6 +3911,17 @@ func (f UserPasswordField) UpdateHTML(ctx context.Context, e *ent.User) (string,
 	})
 }
 
+func (f UserPasswordField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	status := "Not set"
+	if vent.PasswordHashIsSet(e.PasswordHash) {
+		status = "Set"
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Password",
+		Value: status,
+	})
+}
+
 func (f UserPasswordField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	return nil
 }
@@ -1612,6 +3930,76 @@ func (f UserPasswordField) ApplyUpdate(_ context.Context, builder *ent.UserUpdat
 	return nil
 }
 
+type UserLastLoginField struct {
+	client *ent.Client
+}
+
+// NewUserLastLoginField returns the generated default implementation for last_login.
+func NewUserLastLoginField(client *ent.Client) UserLastLoginField {
+	return UserLastLoginField{client: client}
+}
+
+func (f UserLastLoginField) ListCell(ctx context.Context, e *ent.User) string {
+	return vent.FormatFormValue(e.LastLogin)
+}
+
+func (f UserLastLoginField) CreateHTML(ctx context.Context) (string, error) {
+	value := ""
+	if source := UserDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.LastLogin)
+	}
+	if initial, ok := vent.InitialValue(ctx, "last_login"); ok {
+		value = initial
+	}
+	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
+		Name:     "last_login",
+		Label:    "LastLogin",
+		Value:    value,
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f UserLastLoginField) UpdateHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
+		Name:     "last_login",
+		Label:    "LastLogin",
+		Value:    vent.FormatFormValue(e.LastLogin),
+		Editable: gui.MustRenderContext(ctx).CanUpdate,
+	})
+}
+
+func (f UserLastLoginField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "LastLogin",
+		Kind:  vent.FieldKind("time"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
+func (f UserLastLoginField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
+	if input.LastLogin != nil {
+		if *input.LastLogin != "" {
+			value, err := vent.ParseDateTimeLocal(*input.LastLogin)
+			if err != nil {
+				return vent.BadRequest("invalid last_login").WithCause(err)
+			}
+			builder.SetLastLogin(value)
+		}
+	}
+	return nil
+}
+
+func (f UserLastLoginField) ApplyUpdate(_ context.Context, builder *ent.UserUpdateOne, input UserUpdateInput) error {
+	if input.LastLogin != nil {
+		value, err := vent.ParseDateTimeLocal(*input.LastLogin)
+		if err != nil {
+			return vent.BadRequest("invalid last_login").WithCause(err)
+		}
+		builder.SetLastLogin(value)
+	}
+	return nil
+}
+
 type UserIsStaffField struct {
 	client *ent.Client
 }
@@ -1626,10 +4014,17 @@ func (f UserIsStaffField) ListCell(ctx context.Context, e *ent.User) string {
 }
 
 func (f UserIsStaffField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(user.DefaultIsStaff)
+	if source := UserDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.IsStaff)
+	}
+	if initial, ok := vent.InitialValue(ctx, "is_staff"); ok {
+		value = initial
+	}
 	return gui.RenderBoolFieldHTML(ctx, gui.SchemaEntityBoolFieldProps{
 		Name:     "is_staff",
 		Label:    "IsStaff",
-		Value:    vent.FormatFormValue(user.DefaultIsStaff),
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -1643,6 +4038,14 @@ func (f UserIsStaffField) UpdateHTML(ctx context.Context, e *ent.User) (string,
 	})
 }
 
+func (f UserIsStaffField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "IsStaff",
+		Kind:  vent.FieldKind("bool"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f UserIsStaffField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	if input.IsStaff != nil {
 		builder.SetIsStaff(*input.IsStaff)
@@ -1671,10 +4074,17 @@ func (f UserIsSuperuserField) ListCell(ctx context.Context, e *ent.User) string
 }
 
 func (f UserIsSuperuserField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(user.DefaultIsSuperuser)
+	if source := UserDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.IsSuperuser)
+	}
+	if initial, ok := vent.InitialValue(ctx, "is_superuser"); ok {
+		value = initial
+	}
 	return gui.RenderBoolFieldHTML(ctx, gui.SchemaEntityBoolFieldProps{
 		Name:     "is_superuser",
 		Label:    "IsSuperuser",
-		Value:    vent.FormatFormValue(user.DefaultIsSuperuser),
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -1688,6 +4098,14 @@ func (f UserIsSuperuserField) UpdateHTML(ctx context.Context, e *ent.User) (stri
 	})
 }
 
+func (f UserIsSuperuserField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "IsSuperuser",
+		Kind:  vent.FieldKind("bool"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f UserIsSuperuserField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	if input.IsSuperuser != nil {
 		builder.SetIsSuperuser(*input.IsSuperuser)
@@ -1716,10 +4134,17 @@ func (f UserIsActiveField) ListCell(ctx context.Context, e *ent.User) string {
 }
 
 func (f UserIsActiveField) CreateHTML(ctx context.Context) (string, error) {
+	value := vent.FormatFormValue(user.DefaultIsActive)
+	if source := UserDuplicateSource(ctx); source != nil {
+		value = vent.FormatFormValue(source.IsActive)
+	}
+	if initial, ok := vent.InitialValue(ctx, "is_active"); ok {
+		value = initial
+	}
 	return gui.RenderBoolFieldHTML(ctx, gui.SchemaEntityBoolFieldProps{
 		Name:     "is_active",
 		Label:    "IsActive",
-		Value:    vent.FormatFormValue(user.DefaultIsActive),
+		Value:    value,
 		Editable: gui.MustRenderContext(ctx).CanUpdate,
 	})
 }
@@ -1737,6 +4162,14 @@ func (f UserIsActiveField) UpdateHTML(ctx context.Context, e *ent.User) (string,
 	})
 }
 
+func (f UserIsActiveField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "IsActive",
+		Kind:  vent.FieldKind("bool"),
+		Value: f.ListCell(ctx, e),
+	})
+}
+
 func (f UserIsActiveField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	if input.IsActive != nil {
 		builder.SetIsActive(*input.IsActive)
@@ -1775,10 +4208,19 @@ func (f UserGroupsField) ListCell(ctx context.Context, e *ent.User) string {
 }
 
 func (f UserGroupsField) CreateHTML(ctx context.Context) (string, error) {
-	options, err := f.loadGroupsOptions(ctx)
+	var options []gui.SelectOption
+	var err error
+	if source := UserDuplicateSource(ctx); source != nil {
+		options, err = f.loadGroupsOptionsWithSelection(ctx, source)
+	} else {
+		options, err = f.loadGroupsOptions(ctx)
+	}
 	if err != nil {
 		return "", err
 	}
+	if initial, ok := vent.InitialValue(ctx, "groups"); ok {
+		gui.SelectOptionValues(options, vent.ParseStringList(initial))
+	}
 	return gui.RenderForeignKeyFieldHTML(ctx, gui.SchemaEntityForeignKeyFieldProps{
 		Name:     "groups",
 		Label:    "Groups",
@@ -1800,13 +4242,34 @@ func (f UserGroupsField) UpdateHTML(ctx context.Context, e *ent.User) (string, e
 	})
 }
 
+func (f UserGroupsField) DetailHTML(ctx context.Context, e *ent.User) (string, error) {
+	related, err := MustAdmin(ctx).PermissionGroup().EagerLoadQuery(e.QueryGroups()).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	canRead, err := defaultCan(ctx, "read_permission_group")
+	if err != nil {
+		return "", err
+	}
+	links := make([]gui.SchemaEntityRelatedLink, 0, len(related))
+	for _, r := range related {
+		link := gui.SchemaEntityRelatedLink{Label: MustAdmin(ctx).PermissionGroup().Name(r)}
+		if canRead {
+			link.URL = fmt.Sprintf("%spermission-groups/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(r.ID))
+		}
+		links = append(links, link)
+	}
+	return gui.RenderDetailFieldHTML(ctx, gui.SchemaEntityDetailFieldProps{
+		Label: "Groups",
+		Links: links,
+	})
+}
+
 func (f UserGroupsField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
 	if len(input.Groups) > 0 {
-		ids, err := parseIDList(input.Groups, "groups")
-		if err != nil {
+		if err := addIDs(builder.AddGroupIDs, input.Groups, "groups"); err != nil {
 			return err
 		}
-		builder.AddGroupIDs(ids...)
 	}
 	return nil
 }
@@ -1815,11 +4278,9 @@ func (f UserGroupsField) ApplyUpdate(_ context.Context, builder *ent.UserUpdateO
 	if input.Groups != nil {
 		builder.ClearGroups()
 		if len(*input.Groups) > 0 {
-			ids, err := parseIDList(*input.Groups, "groups")
-			if err != nil {
+			if err := addIDs(builder.AddGroupIDs, *input.Groups, "groups"); err != nil {
 				return err
 			}
-			builder.AddGroupIDs(ids...)
 		}
 	}
 	return nil
@@ -1832,7 +4293,7 @@ func (f UserGroupsField) loadGroupsOptions(ctx context.Context) ([]gui.SelectOpt
 	options := make([]gui.SelectOption, len(entities))
 	for i, entity := range entities {
 		options[i] = gui.SelectOption{
-			Value: entity.ID,
+			Value: vent.FormatID(entity.ID),
 			Label: MustAdmin(ctx).PermissionGroup().Name(entity),
 		}
 	}
@@ -1847,66 +4308,12 @@ func (f UserGroupsField) loadGroupsOptionsWithSelection(ctx context.Context, e *
 	if err != nil {
 		return nil, err
 	}
-	selected := make(map[int]struct{})
+	selected := make(map[string]struct{})
 	for _, related := range e.Edges.Groups {
-		selected[related.ID] = struct{}{}
+		selected[vent.FormatID(related.ID)] = struct{}{}
 	}
 	for i := range options {
 		_, options[i].Selected = selected[options[i].Value]
 	}
 	return options, nil
 }
-
-type UserLastLoginField struct {
-	client *ent.Client
-}
-
-// NewUserLastLoginField returns the generated default implementation for last_login.
-func NewUserLastLoginField(client *ent.Client) UserLastLoginField {
-	return UserLastLoginField{client: client}
-}
-
-func (f UserLastLoginField) ListCell(ctx context.Context, e *ent.User) string {
-	return vent.FormatFormValue(e.LastLogin)
-}
-
-func (f UserLastLoginField) CreateHTML(ctx context.Context) (string, error) {
-	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
-		Name:     "last_login",
-		Label:    "LastLogin",
-		Editable: gui.MustRenderContext(ctx).CanUpdate,
-	})
-}
-
-func (f UserLastLoginField) UpdateHTML(ctx context.Context, e *ent.User) (string, error) {
-	return gui.RenderTimeFieldHTML(ctx, gui.SchemaEntityTimeFieldProps{
-		Name:     "last_login",
-		Label:    "LastLogin",
-		Value:    vent.FormatFormValue(e.LastLogin),
-		Editable: gui.MustRenderContext(ctx).CanUpdate,
-	})
-}
-
-func (f UserLastLoginField) ApplyCreate(_ context.Context, builder *ent.UserCreate, input UserCreateInput) error {
-	if input.LastLogin != nil {
-		if *input.LastLogin != "" {
-			value, err := vent.ParseDateTimeLocal(*input.LastLogin)
-			if err != nil {
-				return vent.BadRequest("invalid last_login").WithCause(err)
-			}
-			builder.SetLastLogin(value)
-		}
-	}
-	return nil
-}
-
-func (f UserLastLoginField) ApplyUpdate(_ context.Context, builder *ent.UserUpdateOne, input UserUpdateInput) error {
-	if input.LastLogin != nil {
-		value, err := vent.ParseDateTimeLocal(*input.LastLogin)
-		if err != nil {
-			return vent.BadRequest("invalid last_login").WithCause(err)
-		}
-		builder.SetLastLogin(value)
-	}
-	return nil
-}
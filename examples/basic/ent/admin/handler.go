@@ -11,7 +11,6 @@ import (
 	"log"
 	"net/http"
 	"slices"
-	"strconv"
 	"strings"
 	"time"
 
@@ -24,11 +23,19 @@ import (
 	"github.com/starfederation/datastar-go/datastar"
 
 	ent "github.com/troygilman/vent/examples/basic/ent"
+	"github.com/troygilman/vent/examples/basic/ent/auditlog"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
 	"github.com/troygilman/vent/examples/basic/ent/user"
+
+	// Schema interceptors, such as SoftDeleteMixin's, are registered by Ent's
+	// runtime package.
+	_ "github.com/troygilman/vent/examples/basic/ent/runtime"
 )
 
 // AdminConfig contains configuration for the admin handler.
 // Schemas customizes per-schema fields and validation; nil slots use defaults.
+// FileStorage stores file and image field uploads; it is required when any
+// schema declares FileFields or ImageFields.
 type AdminConfig struct {
 	Client                  *ent.Client
 	SecretProvider          auth.SecretProvider
@@ -36,6 +43,7 @@ type AdminConfig struct {
 	CredentialGenerator     auth.CredentialGenerator
 	SecureCookies           bool
 	Schemas                 SchemaAdmins
+	FileStorage             vent.FileStorage
 }
 
 // AdminHandler is the main HTTP handler for the admin panel
@@ -47,6 +55,9 @@ type AdminHandler struct {
 	tokenGenerator          auth.TokenGenerator
 	secureCookies           bool
 	schemas                 SchemaAdmins
+	fileStorage             vent.FileStorage
+
+	auditLogFields AuditLogFields
 
 	authorFields AuthorFields
 
@@ -56,6 +67,8 @@ type AdminHandler struct {
 
 	permissionGroupFields PermissionGroupFields
 
+	publisherFields PublisherFields
+
 	reviewFields ReviewFields
 
 	userFields UserFields
@@ -71,6 +84,10 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 
 	schemas := config.Schemas
 
+	if schemas.AuditLog == nil {
+		schemas.AuditLog = NewDefaultAuditLogAdmin(config.Client)
+	}
+
 	if schemas.Author == nil {
 		schemas.Author = NewDefaultAuthorAdmin(config.Client)
 	}
@@ -87,6 +104,10 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 		schemas.PermissionGroup = NewDefaultPermissionGroupAdmin(config.Client)
 	}
 
+	if schemas.Publisher == nil {
+		schemas.Publisher = NewDefaultPublisherAdmin(config.Client)
+	}
+
 	if schemas.Review == nil {
 		schemas.Review = NewDefaultReviewAdmin(config.Client)
 	}
@@ -102,6 +123,18 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 		tokenGenerator:          auth.NewJwtTokenGenerator(config.SecretProvider),
 		secureCookies:           config.SecureCookies,
 		schemas:                 schemas,
+		fileStorage:             config.FileStorage,
+	}
+
+	{
+		fields, err := newAuditLogFields(schemas.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		h.auditLogFields = fields
+		if err := validateAuditLogActions(schemas.AuditLog.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	{
@@ -110,6 +143,9 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 			return nil, err
 		}
 		h.authorFields = fields
+		if err := validateAuthorActions(schemas.Author.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	{
@@ -118,6 +154,9 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 			return nil, err
 		}
 		h.bookFields = fields
+		if err := validateBookActions(schemas.Book.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	{
@@ -126,6 +165,9 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 			return nil, err
 		}
 		h.permissionFields = fields
+		if err := validatePermissionActions(schemas.Permission.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	{
@@ -134,6 +176,20 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 			return nil, err
 		}
 		h.permissionGroupFields = fields
+		if err := validatePermissionGroupActions(schemas.PermissionGroup.Actions()); err != nil {
+			return nil, err
+		}
+	}
+
+	{
+		fields, err := newPublisherFields(schemas.Publisher)
+		if err != nil {
+			return nil, err
+		}
+		h.publisherFields = fields
+		if err := validatePublisherActions(schemas.Publisher.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	{
@@ -142,6 +198,9 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 			return nil, err
 		}
 		h.reviewFields = fields
+		if err := validateReviewActions(schemas.Review.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	{
@@ -150,6 +209,9 @@ func NewAdminHandler(config AdminConfig) (*AdminHandler, error) {
 			return nil, err
 		}
 		h.userFields = fields
+		if err := validateUserActions(schemas.User.Actions()); err != nil {
+			return nil, err
+		}
 	}
 
 	routes, err := h.registerRoutes(config.SecretProvider)
@@ -190,57 +252,162 @@ func (h *AdminHandler) registerRoutes(secretProvider auth.SecretProvider) (http.
 			authed.POST("/preferences/theme/", h.postThemeHandler())
 			authed.GET("/command_palette/", h.getCommandPaletteHandler())
 			authed.GET("/{$}", h.getAdminHandler())
+			if h.fileStorage != nil {
+				authed.Group("files", func(files *route.Router) {
+					files.GET("/", vent.FileHandler(h.fileStorage, h.authorizeFile))
+				})
+			}
+
+			authed.Group("audit_logs", func(schema *route.Router) {
+				schema.GET("/", h.getAuditLogListHandler(), h.authorizePermission("read_audit_log"))
+				schema.GET("/export/{$}", h.getAuditLogExportHandler(), h.authorizePermission("read_audit_log"), h.authorizePermission("export_audit_log"))
+				schema.POST("/bulk/{$}", h.postAuditLogBulkHandler(), h.authorizePermission("read_audit_log"))
+				schema.GET("/{id}/", h.getAuditLogHandler(), h.authorizePermission("read_audit_log"))
+				schema.GET("/{id}/view/", h.getAuditLogDetailHandler(), h.authorizePermission("read_audit_log"))
+				schema.POST("/{id}/actions/{action}/", h.postAuditLogActionHandler(), h.authorizePermission("read_audit_log"))
+			})
 
 			authed.Group("authors", func(schema *route.Router) {
 				schema.GET("/", h.getAuthorListHandler(), h.authorizePermission("read_author"))
+				schema.GET("/export/{$}", h.getAuthorExportHandler(), h.authorizePermission("read_author"), h.authorizePermission("export_author"))
+				schema.POST("/bulk/{$}", h.postAuthorBulkHandler(), h.authorizePermission("read_author"))
 				schema.GET("/{id}/", h.getAuthorHandler(), h.authorizePermission("read_author"))
+				schema.GET("/{id}/view/", h.getAuthorDetailHandler(), h.authorizePermission("read_author"))
+				schema.GET("/{id}/history/", h.getAuthorHistoryHandler(), h.authorizePermission("read_author"))
+				schema.GET("/{id}/revisions/", h.getAuthorRevisionsHandler(), h.authorizePermission("read_author"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postAuthorRestoreHandler(), h.authorizePermission("read_author"))
+				schema.GET("/deleted/{$}", h.getAuthorDeletedHandler(), h.authorizePermission("delete_author"), h.authorize(h.schemas.Author.CanCreate))
+				schema.POST("/{id}/actions/{action}/", h.postAuthorActionHandler(), h.authorizePermission("read_author"))
 				schema.POST("/", h.postAuthorHandler(), h.authorize(h.schemas.Author.CanCreate))
 				schema.GET("/add/{$}", h.getAuthorAddHandler(), h.authorize(h.schemas.Author.CanCreate))
+				schema.GET("/{id}/duplicate/", h.getAuthorDuplicateHandler(), h.authorizePermission("read_author"), h.authorize(h.schemas.Author.CanCreate))
+				schema.GET("/import/{$}", h.getAuthorImportHandler(), h.authorize(h.schemas.Author.CanCreate))
+				schema.POST("/import/{$}", h.postAuthorImportHandler(), h.authorize(h.schemas.Author.CanCreate))
 				schema.PATCH("/{id}/", h.patchAuthorHandler(), h.authorizePermission("update_author"))
+				schema.GET("/{id}/delete/", h.getAuthorDeleteHandler(), h.authorizePermission("delete_author"))
 				schema.DELETE("/{id}/", h.deleteAuthorHandler(), h.authorizePermission("delete_author"))
 			})
 
 			authed.Group("books", func(schema *route.Router) {
 				schema.GET("/", h.getBookListHandler(), h.authorizePermission("read_book"))
+				schema.GET("/export/{$}", h.getBookExportHandler(), h.authorizePermission("read_book"), h.authorizePermission("export_book"))
+				schema.POST("/bulk/{$}", h.postBookBulkHandler(), h.authorizePermission("read_book"))
 				schema.GET("/{id}/", h.getBookHandler(), h.authorizePermission("read_book"))
+				schema.GET("/{id}/view/", h.getBookDetailHandler(), h.authorizePermission("read_book"))
+				schema.GET("/{id}/history/", h.getBookHistoryHandler(), h.authorizePermission("read_book"))
+				schema.GET("/{id}/revisions/", h.getBookRevisionsHandler(), h.authorizePermission("read_book"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postBookRestoreHandler(), h.authorizePermission("read_book"))
+				schema.GET("/deleted/{$}", h.getBookDeletedHandler(), h.authorizePermission("delete_book"), h.authorize(h.schemas.Book.CanCreate))
+				schema.POST("/{id}/actions/{action}/", h.postBookActionHandler(), h.authorizePermission("read_book"))
 				schema.POST("/", h.postBookHandler(), h.authorize(h.schemas.Book.CanCreate))
 				schema.GET("/add/{$}", h.getBookAddHandler(), h.authorize(h.schemas.Book.CanCreate))
+				schema.GET("/{id}/duplicate/", h.getBookDuplicateHandler(), h.authorizePermission("read_book"), h.authorize(h.schemas.Book.CanCreate))
+				schema.GET("/import/{$}", h.getBookImportHandler(), h.authorize(h.schemas.Book.CanCreate))
+				schema.POST("/import/{$}", h.postBookImportHandler(), h.authorize(h.schemas.Book.CanCreate))
 				schema.PATCH("/{id}/", h.patchBookHandler(), h.authorizePermission("update_book"))
+				schema.GET("/{id}/delete/", h.getBookDeleteHandler(), h.authorizePermission("delete_book"))
 				schema.DELETE("/{id}/", h.deleteBookHandler(), h.authorizePermission("delete_book"))
 			})
 
 			authed.Group("permissions", func(schema *route.Router) {
 				schema.GET("/", h.getPermissionListHandler(), h.authorizePermission("read_permission"))
+				schema.GET("/export/{$}", h.getPermissionExportHandler(), h.authorizePermission("read_permission"), h.authorizePermission("export_permission"))
+				schema.POST("/bulk/{$}", h.postPermissionBulkHandler(), h.authorizePermission("read_permission"))
 				schema.GET("/{id}/", h.getPermissionHandler(), h.authorizePermission("read_permission"))
+				schema.GET("/{id}/view/", h.getPermissionDetailHandler(), h.authorizePermission("read_permission"))
+				schema.GET("/{id}/history/", h.getPermissionHistoryHandler(), h.authorizePermission("read_permission"))
+				schema.GET("/{id}/revisions/", h.getPermissionRevisionsHandler(), h.authorizePermission("read_permission"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postPermissionRestoreHandler(), h.authorizePermission("read_permission"))
+				schema.POST("/{id}/actions/{action}/", h.postPermissionActionHandler(), h.authorizePermission("read_permission"))
 				schema.PATCH("/{id}/", h.patchPermissionHandler(), h.authorizePermission("update_permission"))
 			})
 
 			authed.Group("permission-groups", func(schema *route.Router) {
 				schema.GET("/", h.getPermissionGroupListHandler(), h.authorizePermission("read_permission_group"))
+				schema.GET("/export/{$}", h.getPermissionGroupExportHandler(), h.authorizePermission("read_permission_group"), h.authorizePermission("export_permission_group"))
+				schema.POST("/bulk/{$}", h.postPermissionGroupBulkHandler(), h.authorizePermission("read_permission_group"))
 				schema.GET("/{id}/", h.getPermissionGroupHandler(), h.authorizePermission("read_permission_group"))
+				schema.GET("/{id}/view/", h.getPermissionGroupDetailHandler(), h.authorizePermission("read_permission_group"))
+				schema.GET("/{id}/history/", h.getPermissionGroupHistoryHandler(), h.authorizePermission("read_permission_group"))
+				schema.GET("/{id}/revisions/", h.getPermissionGroupRevisionsHandler(), h.authorizePermission("read_permission_group"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postPermissionGroupRestoreHandler(), h.authorizePermission("read_permission_group"))
+				schema.GET("/deleted/{$}", h.getPermissionGroupDeletedHandler(), h.authorizePermission("delete_permission_group"), h.authorize(h.schemas.PermissionGroup.CanCreate))
+				schema.POST("/{id}/actions/{action}/", h.postPermissionGroupActionHandler(), h.authorizePermission("read_permission_group"))
 				schema.POST("/", h.postPermissionGroupHandler(), h.authorize(h.schemas.PermissionGroup.CanCreate))
 				schema.GET("/add/{$}", h.getPermissionGroupAddHandler(), h.authorize(h.schemas.PermissionGroup.CanCreate))
+				schema.GET("/{id}/duplicate/", h.getPermissionGroupDuplicateHandler(), h.authorizePermission("read_permission_group"), h.authorize(h.schemas.PermissionGroup.CanCreate))
+				schema.GET("/import/{$}", h.getPermissionGroupImportHandler(), h.authorize(h.schemas.PermissionGroup.CanCreate))
+				schema.POST("/import/{$}", h.postPermissionGroupImportHandler(), h.authorize(h.schemas.PermissionGroup.CanCreate))
 				schema.PATCH("/{id}/", h.patchPermissionGroupHandler(), h.authorizePermission("update_permission_group"))
+				schema.GET("/{id}/delete/", h.getPermissionGroupDeleteHandler(), h.authorizePermission("delete_permission_group"))
 				schema.DELETE("/{id}/", h.deletePermissionGroupHandler(), h.authorizePermission("delete_permission_group"))
 			})
 
+			authed.Group("publishers", func(schema *route.Router) {
+				schema.GET("/", h.getPublisherListHandler(), h.authorizePermission("read_publisher"))
+				schema.GET("/export/{$}", h.getPublisherExportHandler(), h.authorizePermission("read_publisher"), h.authorizePermission("export_publisher"))
+				schema.POST("/bulk/{$}", h.postPublisherBulkHandler(), h.authorizePermission("read_publisher"))
+				schema.GET("/{id}/", h.getPublisherHandler(), h.authorizePermission("read_publisher"))
+				schema.GET("/{id}/view/", h.getPublisherDetailHandler(), h.authorizePermission("read_publisher"))
+				schema.GET("/{id}/history/", h.getPublisherHistoryHandler(), h.authorizePermission("read_publisher"))
+				schema.GET("/{id}/revisions/", h.getPublisherRevisionsHandler(), h.authorizePermission("read_publisher"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postPublisherRestoreHandler(), h.authorizePermission("read_publisher"))
+				schema.GET("/deleted/{$}", h.getPublisherDeletedHandler(), h.authorizePermission("delete_publisher"), h.authorize(h.schemas.Publisher.CanCreate))
+				schema.POST("/{id}/actions/{action}/", h.postPublisherActionHandler(), h.authorizePermission("read_publisher"))
+				schema.POST("/", h.postPublisherHandler(), h.authorize(h.schemas.Publisher.CanCreate))
+				schema.GET("/add/{$}", h.getPublisherAddHandler(), h.authorize(h.schemas.Publisher.CanCreate))
+				schema.GET("/{id}/duplicate/", h.getPublisherDuplicateHandler(), h.authorizePermission("read_publisher"), h.authorize(h.schemas.Publisher.CanCreate))
+				schema.GET("/import/{$}", h.getPublisherImportHandler(), h.authorize(h.schemas.Publisher.CanCreate))
+				schema.POST("/import/{$}", h.postPublisherImportHandler(), h.authorize(h.schemas.Publisher.CanCreate))
+				schema.PATCH("/{id}/", h.patchPublisherHandler(), h.authorizePermission("update_publisher"))
+				schema.GET("/{id}/delete/", h.getPublisherDeleteHandler(), h.authorizePermission("delete_publisher"))
+				schema.DELETE("/{id}/", h.deletePublisherHandler(), h.authorizePermission("delete_publisher"))
+				schema.GET("/trash/{$}", h.getPublisherTrashHandler(), h.authorizePermission("read_publisher"), h.authorizePermission("delete_publisher"))
+				schema.POST("/{id}/restore/", h.postPublisherTrashRestoreHandler(), h.authorizePermission("delete_publisher"), h.authorizePermission("restore_publisher"))
+				schema.GET("/{id}/purge/", h.getPublisherPurgeHandler(), h.authorizePermission("delete_publisher"), h.authorizePermission("purge_publisher"))
+				schema.POST("/{id}/purge/", h.postPublisherPurgeHandler(), h.authorizePermission("delete_publisher"), h.authorizePermission("purge_publisher"))
+			})
+
 			authed.Group("reviews", func(schema *route.Router) {
 				schema.GET("/", h.getReviewListHandler(), h.authorizePermission("read_review"))
+				schema.GET("/export/{$}", h.getReviewExportHandler(), h.authorizePermission("read_review"), h.authorizePermission("export_review"))
+				schema.POST("/bulk/{$}", h.postReviewBulkHandler(), h.authorizePermission("read_review"))
 				schema.GET("/{id}/", h.getReviewHandler(), h.authorizePermission("read_review"))
+				schema.GET("/{id}/view/", h.getReviewDetailHandler(), h.authorizePermission("read_review"))
+				schema.GET("/{id}/history/", h.getReviewHistoryHandler(), h.authorizePermission("read_review"))
+				schema.GET("/{id}/revisions/", h.getReviewRevisionsHandler(), h.authorizePermission("read_review"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postReviewRestoreHandler(), h.authorizePermission("read_review"))
+				schema.GET("/deleted/{$}", h.getReviewDeletedHandler(), h.authorizePermission("delete_review"), h.authorize(h.schemas.Review.CanCreate))
+				schema.POST("/{id}/actions/{action}/", h.postReviewActionHandler(), h.authorizePermission("read_review"))
 				schema.POST("/", h.postReviewHandler(), h.authorize(h.schemas.Review.CanCreate))
 				schema.GET("/add/{$}", h.getReviewAddHandler(), h.authorize(h.schemas.Review.CanCreate))
+				schema.GET("/{id}/duplicate/", h.getReviewDuplicateHandler(), h.authorizePermission("read_review"), h.authorize(h.schemas.Review.CanCreate))
+				schema.GET("/import/{$}", h.getReviewImportHandler(), h.authorize(h.schemas.Review.CanCreate))
+				schema.POST("/import/{$}", h.postReviewImportHandler(), h.authorize(h.schemas.Review.CanCreate))
 				schema.PATCH("/{id}/", h.patchReviewHandler(), h.authorizePermission("update_review"))
 			})
 
 			authed.Group("users", func(schema *route.Router) {
 				schema.GET("/", h.getUserListHandler(), h.authorizePermission("read_user"))
+				schema.GET("/export/{$}", h.getUserExportHandler(), h.authorizePermission("read_user"), h.authorizePermission("export_user"))
+				schema.POST("/bulk/{$}", h.postUserBulkHandler(), h.authorizePermission("read_user"))
 				schema.GET("/{id}/", h.getUserHandler(), h.authorizePermission("read_user"))
+				schema.GET("/{id}/view/", h.getUserDetailHandler(), h.authorizePermission("read_user"))
+				schema.GET("/{id}/history/", h.getUserHistoryHandler(), h.authorizePermission("read_user"))
+				schema.GET("/{id}/revisions/", h.getUserRevisionsHandler(), h.authorizePermission("read_user"))
+				schema.POST("/{id}/revisions/{revision}/restore/", h.postUserRestoreHandler(), h.authorizePermission("read_user"))
+				schema.GET("/deleted/{$}", h.getUserDeletedHandler(), h.authorizePermission("delete_user"), h.authorize(h.schemas.User.CanCreate))
+				schema.POST("/{id}/actions/{action}/", h.postUserActionHandler(), h.authorizePermission("read_user"))
 				schema.POST("/", h.postUserHandler(), h.authorize(h.schemas.User.CanCreate))
 				schema.GET("/add/{$}", h.getUserAddHandler(), h.authorize(h.schemas.User.CanCreate))
+				schema.GET("/{id}/duplicate/", h.getUserDuplicateHandler(), h.authorizePermission("read_user"), h.authorize(h.schemas.User.CanCreate))
+				schema.GET("/import/{$}", h.getUserImportHandler(), h.authorize(h.schemas.User.CanCreate))
+				schema.POST("/import/{$}", h.postUserImportHandler(), h.authorize(h.schemas.User.CanCreate))
 				schema.PATCH("/{id}/", h.patchUserHandler(), h.authorizePermission("update_user"))
 				schema.GET("/{id}/password/", h.getUserPasswordHandler(), h.authorizePermission("read_user"))
 				schema.PUT("/{id}/password/", h.putUserPasswordHandler(), h.authorizePermission("update_user"))
 				schema.DELETE("/{id}/password/", h.deleteUserPasswordHandler(), h.authorizePermission("update_user"))
+				schema.GET("/{id}/delete/", h.getUserDeleteHandler(), h.authorizePermission("delete_user"))
 				schema.DELETE("/{id}/", h.deleteUserHandler(), h.authorizePermission("delete_user"))
 			})
 
@@ -266,6 +433,9 @@ func validateAdminConfig(config AdminConfig) error {
 	if config.CredentialGenerator == nil {
 		errs = append(errs, "CredentialGenerator is required")
 	}
+	if config.FileStorage == nil {
+		errs = append(errs, "FileStorage is required for file and image fields")
+	}
 	if len(errs) > 0 {
 		return fmt.Errorf("invalid admin config: %s", strings.Join(errs, "; "))
 	}
@@ -294,6 +464,17 @@ func (h *AdminHandler) listVisibleSchemas(ctx context.Context, schemaSearch stri
 	schemas := make([]gui.SchemaMetadata, 0)
 	query := strings.ToLower(strings.TrimSpace(schemaSearch))
 
+	if ok, err := defaultCan(ctx, "read_audit_log"); err == nil && ok {
+		displayName := "Audit log"
+		if query == "" || strings.Contains(strings.ToLower(displayName), query) || strings.Contains(strings.ToLower("AuditLog"), query) {
+			schemas = append(schemas, gui.SchemaMetadata{
+				Name:        "AuditLog",
+				DisplayName: displayName,
+				Path:        requestctx.MustAdminPath(ctx) + "audit_logs/",
+			})
+		}
+	}
+
 	if ok, err := defaultCan(ctx, "read_author"); err == nil && ok {
 		displayName := "Authors"
 		if query == "" || strings.Contains(strings.ToLower(displayName), query) || strings.Contains(strings.ToLower("Author"), query) {
@@ -338,6 +519,17 @@ func (h *AdminHandler) listVisibleSchemas(ctx context.Context, schemaSearch stri
 		}
 	}
 
+	if ok, err := defaultCan(ctx, "read_publisher"); err == nil && ok {
+		displayName := "Publishers"
+		if query == "" || strings.Contains(strings.ToLower(displayName), query) || strings.Contains(strings.ToLower("Publisher"), query) {
+			schemas = append(schemas, gui.SchemaMetadata{
+				Name:        "Publisher",
+				DisplayName: displayName,
+				Path:        requestctx.MustAdminPath(ctx) + "publishers/",
+			})
+		}
+	}
+
 	if ok, err := defaultCan(ctx, "read_review"); err == nil && ok {
 		displayName := "Reviews"
 		if query == "" || strings.Contains(strings.ToLower(displayName), query) || strings.Contains(strings.ToLower("Review"), query) {
@@ -423,6 +615,188 @@ func normalizeError(err error) *vent.HttpError {
 	}
 }
 
+// deleteRelationsError refuses to delete the entity named display when one
+// of its relations stops the delete, naming the first that does.
+func deleteRelationsError(display string, relations []gui.DeleteRelation) error {
+	for _, relation := range relations {
+		if !relation.Prevents() {
+			continue
+		}
+		if relation.Effect == vent.DeleteBlock {
+			return vent.Conflict(fmt.Sprintf("%s cannot be deleted while %d related %s refer to it", display, relation.Count, strings.ToLower(relation.Label)))
+		}
+		return vent.Forbidden(fmt.Sprintf("deleting %s would also delete %d related %s, which you may not delete", display, relation.Count, strings.ToLower(relation.Label)))
+	}
+	return nil
+}
+
+// patchFieldError shows err next to its form field when it is one field's
+// validation error (see vent.InvalidField), reporting whether it was.
+func patchFieldError(w http.ResponseWriter, r *http.Request, err error) bool {
+	he, ok := vent.AsHttpError(err)
+	if !ok || he.Field == "" {
+		return false
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityFieldError(he.Field, he.PublicMessage())); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+	return true
+}
+
+// patchToast appends a toast to the page's toast region.
+func patchToast(sse *datastar.ServerSentEventGenerator, message string, isError bool) error {
+	return sse.PatchElementTempl(
+		gui.Toast(gui.ToastProps{Message: message, Error: isError}),
+		datastar.WithSelectorID(gui.ToastRegionID),
+		datastar.WithModeAppend(),
+	)
+}
+
+// withTx runs fn in a transaction, committing when it returns nil and rolling
+// back otherwise. fn's context carries the transaction (see ent.TxFromContext),
+// so field code and h.db join it and a failed save leaves nothing behind,
+// including files its upload fields stored.
+func (h *AdminHandler) withTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			discardUploads(ctx)
+			panic(v)
+		}
+	}()
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		discardUploads(ctx)
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rolling back: %v", err, rollbackErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		discardUploads(ctx)
+		return err
+	}
+	return nil
+}
+
+// errRollback makes withTx roll back work that did not fail, such as an
+// import preview.
+var errRollback = errors.New("rolled back")
+
+// withSavepoint runs fn inside a savepoint of ctx's transaction and rolls back
+// to it when fn fails, so the rest of the transaction carries on. Postgres
+// otherwise aborts the whole transaction on the first failed statement.
+func withSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx := ent.TxFromContext(ctx)
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT vent_savepoint"); err != nil {
+		return err
+	}
+	if err := fn(ctx); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT vent_savepoint"); rollbackErr != nil {
+			return fmt.Errorf("%w: rolling back to savepoint: %v", err, rollbackErr)
+		}
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT vent_savepoint")
+	return err
+}
+
+// discardUploads deletes the files a rolled back save stored, which no row
+// refers to.
+func discardUploads(ctx context.Context) {
+	if err := vent.DiscardUploads(ctx); err != nil {
+		log.Printf("discard uploads: %v", err)
+	}
+}
+
+// authorizeFile allows a stored file to users who can read the entity whose
+// upload field holds its key. Keys start with the schema package and field
+// name, as in "book/cover/<name>.png".
+func (h *AdminHandler) authorizeFile(ctx context.Context, key string) error {
+	dir, rest, _ := strings.Cut(key, "/")
+	field, _, _ := strings.Cut(rest, "/")
+	switch dir {
+	case "book":
+		return h.authorizeBookFile(ctx, field, key)
+	case "publisher":
+		return h.authorizePublisherFile(ctx, field, key)
+	}
+	return vent.NotFound("file not found")
+}
+
+// db returns the client of the transaction ctx carries, or h.client outside
+// one.
+func (h *AdminHandler) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return h.client
+}
+
+// recordAudit writes an audit log entry for a mutation by the current user
+// in the mutation's transaction. A failure fails the mutation, so no audited
+// change is saved without its entry.
+func (h *AdminHandler) recordAudit(ctx context.Context, action vent.AuditAction, schema string, id any, name string, changes []vent.AuditChange) error {
+	builder := h.db(ctx).AuditLog.Create().
+		SetAction(auditlog.Action(action)).
+		SetSchema(schema).
+		SetEntityID(vent.FormatID(id)).
+		SetEntityName(name).
+		SetChanges(changes)
+	if user, err := GetUser(ctx); err == nil {
+		builder.SetActorID(vent.FormatID(user.ID)).SetActor(user.Email)
+	}
+	if err := builder.Exec(ctx); err != nil {
+		return fmt.Errorf("record audit log entry: %w", err)
+	}
+	return nil
+}
+
+// recordRevision stores snapshot, taken before an update or delete by the
+// current user, as a revision of the entity in the mutation's transaction. A
+// failure fails the mutation.
+func (h *AdminHandler) recordRevision(ctx context.Context, action vent.AuditAction, schema string, id any, name string, snapshot vent.RevisionSnapshot) error {
+	builder := h.db(ctx).Revision.Create().
+		SetAction(revision.Action(action)).
+		SetSchema(schema).
+		SetEntityID(vent.FormatID(id)).
+		SetEntityName(name).
+		SetSnapshot(snapshot)
+	if user, err := GetUser(ctx); err == nil {
+		builder.SetActorID(vent.FormatID(user.ID)).SetActor(user.Email)
+	}
+	if err := builder.Exec(ctx); err != nil {
+		return fmt.Errorf("record revision: %w", err)
+	}
+	return nil
+}
+
+// revisionEdgeID is the snapshot value of a unique edge with the given
+// related ids: the ID, or "" when the edge is unset.
+func revisionEdgeID[T any](ids []T, err error) (any, error) {
+	if err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return vent.FormatID(ids[0]), nil
+}
+
+// revisionEdgeIDs is the snapshot value of a many edge with the given
+// related ids.
+func revisionEdgeIDs[T any](ids []T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = vent.FormatID(id)
+	}
+	return values, nil
+}
+
 // getLoginHandler returns the handler for GET /admin/login/
 func (h *AdminHandler) getLoginHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -507,7 +881,7 @@ func (h *AdminHandler) postLoginHandler() http.Handler {
 				return invalidCredentials
 			}
 
-			claims := auth.NewClaims(user.ID)
+			claims := auth.NewClaims(vent.FormatID(user.ID))
 			token, err := h.tokenGenerator.Generate(claims)
 			if err != nil {
 				return err
@@ -584,24 +958,34 @@ func (o *OptionalInput[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func parseID(value string, label string) (int, error) {
-	id, err := strconv.Atoi(value)
+// MarshalJSON writes the submitted value, or null when it was left unset or
+// cleared.
+func (o OptionalInput[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.Value)
+}
+
+// setID parses value as the ID type accepted by set and applies it.
+func setID[T, R any](set func(T) R, value string, label string) error {
+	id, err := vent.ParseID[T](value)
 	if err != nil {
-		return 0, vent.BadRequest(fmt.Sprintf("invalid %s id", label)).WithCause(err)
+		return vent.BadRequest(fmt.Sprintf("invalid %s id", label)).WithCause(err)
 	}
-	return id, nil
+	set(id)
+	return nil
 }
 
-func parseIDList(values []string, label string) ([]int, error) {
-	ids := make([]int, 0, len(values))
+// addIDs parses values as the ID type accepted by add and applies them.
+func addIDs[T, R any](add func(...T) R, values []string, label string) error {
+	ids := make([]T, 0, len(values))
 	for _, value := range values {
-		id, err := parseID(value, label)
+		id, err := vent.ParseID[T](value)
 		if err != nil {
-			return nil, err
+			return vent.BadRequest(fmt.Sprintf("invalid %s id", label)).WithCause(err)
 		}
 		ids = append(ids, id)
 	}
-	return ids, nil
+	add(ids...)
+	return nil
 }
 
 func GetUser(ctx context.Context) (*ent.User, error) {
@@ -666,9 +1050,9 @@ func NewUserMiddleware(client *ent.Client, secureCookies bool) func(http.Handler
 				return
 			}
 
-			userID, err := strconv.Atoi(claims.Subject)
+			userID, err := parseUserID(claims.Subject)
 			if err != nil {
-				vent.HandleError(w, r, vent.Internal(fmt.Errorf("claims ID is not an int: %w", err)))
+				vent.HandleError(w, r, vent.Internal(fmt.Errorf("claims subject is not a valid ID: %w", err)))
 				return
 			}
 
@@ -722,7 +1106,11 @@ func (h *AdminHandler) authorizePermission(permission string) func(http.Handler)
 func (h *AdminHandler) adminContextMiddleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			next.ServeHTTP(w, r.WithContext(withAdmin(r.Context(), h.schemas)))
+			ctx := withAdmin(r.Context(), h.schemas)
+			if h.fileStorage != nil {
+				ctx = vent.WithFileStorage(ctx, h.fileStorage)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
@@ -22,24 +22,37 @@ var permissions = []struct {
 	Schema string
 }{
 	{Name: "read_author", Schema: "Author"},
+	{Name: "export_author", Schema: "Author"},
 	{Name: "create_author", Schema: "Author"},
 	{Name: "update_author", Schema: "Author"},
 	{Name: "delete_author", Schema: "Author"},
 	{Name: "read_book", Schema: "Book"},
+	{Name: "export_book", Schema: "Book"},
 	{Name: "create_book", Schema: "Book"},
 	{Name: "update_book", Schema: "Book"},
 	{Name: "delete_book", Schema: "Book"},
 	{Name: "publish", Schema: "Book"},
 	{Name: "read_permission", Schema: "Permission"},
+	{Name: "export_permission", Schema: "Permission"},
 	{Name: "update_permission", Schema: "Permission"},
 	{Name: "read_permission_group", Schema: "Permission Group"},
+	{Name: "export_permission_group", Schema: "Permission Group"},
 	{Name: "create_permission_group", Schema: "Permission Group"},
 	{Name: "update_permission_group", Schema: "Permission Group"},
 	{Name: "delete_permission_group", Schema: "Permission Group"},
+	{Name: "read_publisher", Schema: "Publisher"},
+	{Name: "export_publisher", Schema: "Publisher"},
+	{Name: "create_publisher", Schema: "Publisher"},
+	{Name: "update_publisher", Schema: "Publisher"},
+	{Name: "delete_publisher", Schema: "Publisher"},
+	{Name: "restore_publisher", Schema: "Publisher"},
+	{Name: "purge_publisher", Schema: "Publisher"},
 	{Name: "read_review", Schema: "Review"},
+	{Name: "export_review", Schema: "Review"},
 	{Name: "create_review", Schema: "Review"},
 	{Name: "update_review", Schema: "Review"},
 	{Name: "read_user", Schema: "User"},
+	{Name: "export_user", Schema: "User"},
 	{Name: "create_user", Schema: "User"},
 	{Name: "update_user", Schema: "User"},
 	{Name: "delete_user", Schema: "User"},
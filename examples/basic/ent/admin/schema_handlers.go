@@ -4,609 +4,606 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	uuid "github.com/google/uuid"
 	"github.com/troygilman/vent"
+	ent "github.com/troygilman/vent/examples/basic/ent"
+	"github.com/troygilman/vent/examples/basic/ent/auditlog"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
 	"github.com/troygilman/vent/examples/basic/ent/permission"
 	"github.com/troygilman/vent/examples/basic/ent/permissiongroup"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
 	"github.com/troygilman/vent/examples/basic/ent/user"
 	"github.com/troygilman/vent/requestctx"
 	"github.com/troygilman/vent/templates/gui"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/starfederation/datastar-go/datastar"
 )
 
+// Keep imports referenced even when no filter, search, or sort uses them.
+var (
+	_ = errors.Is
+	_ = sort.Slice
+	_ = strconv.Atoi
+	_ = strings.TrimSpace
+	_ = time.Now
+	_ = sql.OrderDesc
+)
+
 // ============================================================================
-// Author Handlers
+// AuditLog Handlers
 // ============================================================================
 
-// AuthorCreateInput is the typed input for creating a Author
-type AuthorCreateInput struct {
-	User   string `json:"user"`
-	Active *bool  `json:"active"`
+// parseAuditLogID parses a AuditLog ID from a URL path or form value.
+func parseAuditLogID(value string) (int, error) {
+	return vent.ParseID[int](value)
 }
 
-// AuthorUpdateInput is the typed input for updating a Author
-type AuthorUpdateInput struct {
-	User   *string `json:"user"`
-	Active *bool   `json:"active"`
+// AuditLogCreateInput is the typed input for creating a AuditLog
+type AuditLogCreateInput struct {
 }
 
-// AuthorListFilter is the typed list query for listing Author.
-type AuthorListFilter struct {
-	Active vent.BoolFilter
+// AuditLogUpdateInput is the typed input for updating a AuditLog
+type AuditLogUpdateInput struct {
 }
 
-// getAuthorListHandler returns the handler for GET /admin/authors/
-func (h *AdminHandler) getAuthorListHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		filter := AuthorListFilter{
-			Active: vent.BoolFilter(r.URL.Query().Get("filter.active")),
-		}
-		query := h.client.Author.Query()
-		if v, ok := filter.Active.Bool(); ok {
-			query = query.Where(author.ActiveEQ(v))
-		}
-
-		total, err := query.Clone().Count(r.Context())
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
-		page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
-		pagination := gui.NewSchemaTablePagination(page)
-
-		rows := []gui.SchemaTableRow{}
-		if vent.IsDatastarRequest(r) {
-			entities, err := h.schemas.Author.EagerLoadQuery(query).
-				Order(author.ByID()).
-				Offset(page.Offset()).
-				Limit(page.Limit()).
-				All(r.Context())
-			if err != nil {
-				vent.HandleError(w, r, normalizeError(err))
-				return
-			}
-
-			rows = make([]gui.SchemaTableRow, len(entities))
-			for i, e := range entities {
-				cells := make([]gui.SchemaTableCell, len(h.authorFields.listColumns))
-				for j, field := range h.authorFields.listColumns {
-					cell := gui.SchemaTableCell{Display: field.ListCell(r.Context(), e)}
-					if j == 0 {
-						cell.LinkURL = fmt.Sprintf("%sauthors/%d/", requestctx.MustAdminPath(r.Context()), e.ID)
-					}
-					cells[j] = cell
-				}
-				rows[i] = gui.SchemaTableRow{Cells: cells}
-			}
-		}
+// AuditLogListFilter is the typed list query for listing AuditLog.
+type AuditLogListFilter struct {
+	CreatedAt    string
+	CreatedAtMin string
+	CreatedAtMax string
+	Action       string
+	Schema       string
+	Actor        string
+	ActorNull    vent.BoolFilter
+}
 
-		canCreate, err := h.schemas.Author.CanCreate(r.Context())
-		if err != nil {
-			vent.HandleError(w, r, err)
-			return
-		}
-		renderCtx := gui.RenderContext{
-			CanCreate: canCreate,
-		}
+// auditlogListOrders maps AuditLog list sort keys to Ent order options.
+var auditlogListOrders = map[string]func(...sql.OrderTermOption) auditlog.OrderOption{
+	"created_at":  auditlog.ByCreatedAt,
+	"actor":       auditlog.ByActor,
+	"action":      auditlog.ByAction,
+	"schema":      auditlog.BySchema,
+	"entity_name": auditlog.ByEntityName,
+}
 
-		props := gui.SchemaTableProps{
-			LayoutProps:         h.buildLayoutProps(r.Context(), "Author", gui.SchemaListBreadcrumbs("Authors")),
-			RouteName:           "authors",
-			SingularDisplayName: "Author",
-			PluralDisplayName:   "Authors",
-			Columns: []gui.SchemaTableColumn{
-				{Name: "user", Label: "User", Type: "edge"},
-				{Name: "active", Label: "Active", Type: "bool"},
-			},
-			FilterableColumns: []gui.SchemaTableFilterableColumn{
-				{Name: "active", Label: "Active", Type: "bool", Value: filter.Active.Normalize().String()},
-			},
-			Rows:          rows,
-			Pagination:    pagination,
-			Loading:       !vent.IsDatastarRequest(r),
-			RenderContext: renderCtx,
-		}
+// auditlogDefaultOrdering is the AuditLog list ordering when the request names none.
+var auditlogDefaultOrdering = []string{"-created_at"}
 
-		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
-		}
-	})
+func auditlogListSortable(name string) bool {
+	_, ok := auditlogListOrders[name]
+	return ok
 }
 
-// buildAuthorAddPageProps builds the add page props for Author.
-func (h *AdminHandler) buildAuthorAddPageProps(ctx context.Context, errorMessage string) (gui.SchemaEntityAddProps, error) {
-	canCreate, err := h.schemas.Author.CanCreate(ctx)
-	if err != nil {
-		return gui.SchemaEntityAddProps{}, err
+// auditlogListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func auditlogListOrder(sorts []vent.ListSort) []auditlog.OrderOption {
+	orders := make([]auditlog.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, auditlogListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
 	}
-	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canCreate,
-	})
+	if !sortsByID {
+		orders = append(orders, auditlog.ByID())
+	}
+	return orders
+}
 
-	fields := []gui.SchemaEntityFieldProps{}
+// auditlogListQuery is the AuditLog list query with a request's filters, search, and sort applied.
+type auditlogListQuery struct {
+	query  *ent.AuditLogQuery
+	filter AuditLogListFilter
+	search string
+	sorts  []vent.ListSort
+}
 
-	for _, field := range h.authorFields.createFormFields {
-		html, err := field.CreateHTML(ctx)
-		if err != nil {
-			return gui.SchemaEntityAddProps{}, err
+// newAuditLogListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newAuditLogListQuery(q url.Values) auditlogListQuery {
+	filter := AuditLogListFilter{
+		CreatedAt:    vent.FilterParam(q, "filter.created_at"),
+		CreatedAtMin: q.Get("filter.created_at.min"),
+		CreatedAtMax: q.Get("filter.created_at.max"),
+		Action:       vent.FilterParam(q, "filter.action"),
+		Schema:       vent.FilterParam(q, "filter.schema"),
+		Actor:        vent.FilterParam(q, "filter.actor"),
+		ActorNull:    vent.BoolFilter(q.Get("filter.actor.null")),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), auditlogDefaultOrdering, auditlogListSortable)
+	search := strings.TrimSpace(q.Get("q"))
+	query := h.client.AuditLog.Query()
+	if from, to := vent.TimeFilterRange(filter.CreatedAt, filter.CreatedAtMin, filter.CreatedAtMax, time.Now()); !from.IsZero() || !to.IsZero() {
+		if !from.IsZero() {
+			query = query.Where(auditlog.CreatedAtGTE(from))
 		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
+		if !to.IsZero() {
+			query = query.Where(auditlog.CreatedAtLT(to))
 		}
 	}
-
-	return gui.SchemaEntityAddProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaAddBreadcrumbs(
-			requestctx.MustAdminPath(ctx),
-			"authors",
-			"Authors",
-			"Author",
-		)),
-		RouteName:           "authors",
-		SingularDisplayName: "Author",
-		ErrorMessage:        errorMessage,
-		Fields:              fields,
-	}, nil
+	if filterVals := vent.FilterValues(filter.Action); len(filterVals) > 0 {
+		values := make([]auditlog.Action, 0, len(filterVals))
+		for _, filterVal := range filterVals {
+			if v := auditlog.Action(filterVal); auditlog.ActionValidator(v) == nil {
+				values = append(values, v)
+			}
+		}
+		if len(values) > 0 {
+			query = query.Where(auditlog.ActionIn(values...))
+		}
+	}
+	if filterVal := filter.Schema; filterVal != "" {
+		query = query.Where(auditlog.SchemaContainsFold(filterVal))
+	}
+	if filterVal := filter.Actor; filterVal != "" {
+		query = query.Where(auditlog.ActorContainsFold(filterVal))
+	}
+	if isNull, ok := filter.ActorNull.Bool(); ok {
+		if isNull {
+			query = query.Where(auditlog.ActorIsNil())
+		} else {
+			query = query.Where(auditlog.ActorNotNil())
+		}
+	}
+	if search != "" {
+		query = query.Where(auditlog.Or(
+			auditlog.ActorContainsFold(search),
+			auditlog.EntityNameContainsFold(search),
+			auditlog.EntityIDContainsFold(search),
+		))
+	}
+	return auditlogListQuery{
+		query:  query,
+		filter: filter,
+		search: search,
+		sorts:  sorts,
+	}
 }
 
-func (h *AdminHandler) patchAuthorAddPageError(w http.ResponseWriter, r *http.Request, err error) {
-	props, buildErr := h.buildAuthorAddPageProps(r.Context(), normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
-		return
+// buildAuditLogListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildAuditLogListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newAuditLogListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+	search := list.search
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
 	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedAuditLogActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
 	}
-}
 
-// getAuthorAddHandler returns the handler for GET /admin/authors/add/
-func (h *AdminHandler) getAuthorAddHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		props, err := h.buildAuthorAddPageProps(r.Context(), "")
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.AuditLog.EagerLoadQuery(query).
+			Order(auditlogListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
 		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
+			return gui.SchemaTableProps{}, err
 		}
 
-		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.auditLogFields.listColumns))
+			for j, field := range h.auditLogFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%saudit_logs/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.auditlogEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
 		}
-	})
-}
-
-// buildAuthorPageProps builds the edit page props for Author.
-func (h *AdminHandler) buildAuthorPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
-	e, err := h.schemas.Author.EagerLoadQuery(h.client.Author.Query().
-		Where(author.IDEQ(id))).
-		Only(ctx)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
 	}
 
-	if err := denyIfCannot(h.schemas.Author.CanRead(ctx, e)); err != nil {
-		return gui.SchemaEntityChangeProps{}, err
-	}
-	canCreate, err := h.schemas.Author.CanCreate(ctx)
+	canCreate, err := h.schemas.AuditLog.CanCreate(ctx)
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return gui.SchemaTableProps{}, err
 	}
-	canUpdate, err := h.schemas.Author.CanUpdate(ctx, e)
+	canExport, err := defaultCan(ctx, "export_audit_log")
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return gui.SchemaTableProps{}, err
 	}
-	canDelete, err := h.schemas.Author.CanDelete(ctx, e)
+	bulkActions, err := h.listAuditLogBulkActions(ctx, actions)
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return gui.SchemaTableProps{}, err
 	}
 	renderCtx := gui.RenderContext{
 		CanCreate: canCreate,
-		CanUpdate: canUpdate,
-		CanDelete: canDelete,
-	}
-	ctx = gui.WithRenderContext(ctx, renderCtx)
-
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.authorFields.updateFormFields {
-		html, err := field.UpdateHTML(ctx, e)
-		if err != nil {
-			return gui.SchemaEntityChangeProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
-		}
+		CanExport: canExport,
 	}
 
-	entityDisplay := h.schemas.Author.Name(e)
-	props := gui.SchemaEntityChangeProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaEntityBreadcrumbs(
-			requestctx.MustAdminPath(ctx),
-			"authors",
-			"Authors",
-			entityDisplay,
-		)),
-		RouteName:     "authors",
-		EntityID:      id,
-		EntityDisplay: entityDisplay,
-		ErrorMessage:  errorMessage,
-		Fields:        fields,
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "AuditLog", gui.SchemaListBreadcrumbs("Audit log")),
+		RouteName:           "audit_logs",
+		SingularDisplayName: "Audit log entry",
+		PluralDisplayName:   "Audit log",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "created_at", Label: "CreatedAt", Type: "time.Time", Sortable: true},
+			{Name: "actor", Label: "Actor", Type: "string", Sortable: true},
+			{Name: "action", Label: "Action", Type: "enum", Sortable: true},
+			{Name: "schema", Label: "Schema", Type: "string", Sortable: true},
+			{Name: "entity_name", Label: "EntityName", Type: "string", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "created_at",
+				Label: "CreatedAt",
+				Type:  "time",
+				Value: filter.CreatedAt,
+				Range: true,
+				Min:   filter.CreatedAtMin,
+				Max:   filter.CreatedAtMax,
+			},
+			{
+				Name:    "action",
+				Label:   "Action",
+				Type:    "enum",
+				Value:   filter.Action,
+				Options: []string{"create", "update", "delete", "password", "restore", "purge"},
+			},
+			{
+				Name:  "schema",
+				Label: "Schema",
+				Type:  "string",
+				Value: filter.Schema,
+			},
+			{
+				Name:     "actor",
+				Label:    "Actor",
+				Type:     "string",
+				Value:    filter.Actor,
+				Nullable: true,
+				Null:     filter.ActorNull.Normalize().String(),
+			},
+		},
+		Searchable:    true,
+		Search:        search,
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", auditlogDefaultOrdering, auditlogListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
 		RenderContext: renderCtx,
-	}
-	return props, nil
+	}, nil
 }
 
-// getAuthorHandler returns the handler for GET /admin/authors/{id}/
-func (h *AdminHandler) getAuthorHandler() http.Handler {
+// getAuditLogListHandler returns the handler for GET /admin/auditlogs/
+func (h *AdminHandler) getAuditLogListHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
-		if err != nil {
-			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
-			return
-		}
-
-		props, err := h.buildAuthorPageProps(r.Context(), id, "")
+		props, err := h.buildAuditLogListPageProps(r)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
 
-		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
-func (h *AdminHandler) patchAuthorPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
-	props, buildErr := h.buildAuthorPageProps(r.Context(), id, normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
-		return
-	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
-	}
-}
 
-// postAuthorHandler returns the handler for POST /admin/authors/
-func (h *AdminHandler) postAuthorHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var signals struct {
-			Entity AuthorCreateInput `json:"entity"`
+// allowedAuditLogActions returns the custom AuditLog actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedAuditLogActions(ctx context.Context) ([]AuditLogAction, error) {
+	var allowed []AuditLogAction
+	for _, action := range h.schemas.AuditLog.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
 		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchAuthorAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
-			return
+		if ok {
+			allowed = append(allowed, action)
 		}
-		input := signals.Entity
+	}
+	return allowed, nil
+}
 
-		if err := h.schemas.Author.ValidateCreate(r.Context(), input); err != nil {
-			h.patchAuthorAddPageError(w, r, err)
-			return
+// lookupAuditLogAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupAuditLogAction(ctx context.Context, name string) (AuditLogAction, error) {
+	for _, action := range h.schemas.AuditLog.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return AuditLogAction{}, err
 		}
+		return action, nil
+	}
+	return AuditLogAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
 
-		builder := h.client.Author.Create()
+// canAuditLogRunAction reports whether action may run on e.
+func (h *AdminHandler) canAuditLogRunAction(ctx context.Context, action AuditLogAction, e *ent.AuditLog) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.AuditLog.CanUpdate(ctx, e)
+}
 
-		for _, field := range h.authorFields.createBindFields {
-			if err := field.ApplyCreate(r.Context(), builder, input); err != nil {
-				h.patchAuthorAddPageError(w, r, err)
-				return
-			}
+// auditlogEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) auditlogEntityActions(ctx context.Context, allowed []AuditLogAction, e *ent.AuditLog) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canAuditLogRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
 		}
-
-		if _, err := builder.Save(r.Context()); err != nil {
-			h.patchAuthorAddPageError(w, r, err)
-			return
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
 		}
+	}
+	return actions, nil
+}
 
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
-	})
+// listAuditLogBulkActions returns the bulk actions the current user may run
+// on AuditLog rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listAuditLogBulkActions(ctx context.Context, allowed []AuditLogAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
 }
 
-// patchAuthorHandler returns the handler for PATCH /admin/authors/{id}/
-func (h *AdminHandler) patchAuthorHandler() http.Handler {
+// postAuditLogBulkHandler returns the handler for POST /admin/auditlogs/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postAuditLogBulkHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
-		if err != nil {
-			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
 			return
 		}
+		result, runErr := h.runAuditLogBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
 
-		e, err := h.client.Author.Get(r.Context(), id)
+		props, err := h.buildAuditLogListPageProps(r)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		if err := denyIfCannot(h.schemas.Author.CanUpdate(r.Context(), e)); err != nil {
-			vent.HandleError(w, r, err)
-			return
-		}
-
-		var signals struct {
-			Entity AuthorUpdateInput `json:"entity"`
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
 		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchAuthorPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
 			return
 		}
-		input := signals.Entity
-
-		if err := h.schemas.Author.ValidateUpdate(r.Context(), id, input); err != nil {
-			h.patchAuthorPageError(w, r, id, err)
-			return
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
 		}
+	})
+}
 
-		builder := h.client.Author.UpdateOneID(id)
-
-		for _, field := range h.authorFields.updateBindFields {
-			if err := field.ApplyUpdate(r.Context(), builder, input); err != nil {
-				h.patchAuthorPageError(w, r, id, err)
-				return
+// runAuditLogBulkAction runs selection.Action on each selected AuditLog,
+// checking permissions per row.
+func (h *AdminHandler) runAuditLogBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.AuditLog.Query()
+	if selection.All {
+		query = h.newAuditLogListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Audit log entry")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parseAuditLogID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
 			}
+			ids[i] = id
 		}
+		query = query.Where(auditlog.IDIn(ids...))
+	}
+	entities, err := h.schemas.AuditLog.EagerLoadQuery(query).
+		Order(auditlog.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
 
-		if err := builder.Exec(r.Context()); err != nil {
-			h.patchAuthorPageError(w, r, id, err)
-			return
+	action, err := h.lookupAuditLogAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canAuditLogRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
 		}
-
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
-	})
+		result.Record(vent.FormatID(e.ID), h.schemas.AuditLog.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
 }
 
-// deleteAuthorHandler returns the handler for DELETE /admin/authors/{id}/
-func (h *AdminHandler) deleteAuthorHandler() http.Handler {
+// postAuditLogActionHandler returns the handler for POST /admin/auditlogs/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postAuditLogActionHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuditLogID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
-
-		e, err := h.client.Author.Get(r.Context(), id)
+		action, err := h.lookupAuditLogAction(r.Context(), r.PathValue("action"))
 		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
-		if err := denyIfCannot(h.schemas.Author.CanDelete(r.Context(), e)); err != nil {
 			vent.HandleError(w, r, err)
 			return
 		}
+		result, runErr := h.runAuditLogAction(r.Context(), action, id)
 
-		if err := h.schemas.Author.ValidateDelete(r.Context(), id); err != nil {
-			h.patchAuthorPageError(w, r, id, err)
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
 			return
 		}
-
-		if err := h.client.Author.DeleteOneID(id).Exec(r.Context()); err != nil {
-			h.patchAuthorPageError(w, r, id, err)
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
 			return
 		}
-
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
-	})
-}
-
-// ============================================================================
-// Book Handlers
-// ============================================================================
-
-// BookCreateInput is the typed input for creating a Book
-type BookCreateInput struct {
-	Title       string  `json:"title"`
-	Author      string  `json:"author"`
-	Pages       *int    `json:"pages"`
-	Published   *bool   `json:"published"`
-	PublishedAt *string `json:"published_at"`
-	Notes       string  `json:"notes"`
-}
-
-// BookUpdateInput is the typed input for updating a Book
-type BookUpdateInput struct {
-	Title       *string               `json:"title"`
-	Author      *string               `json:"author"`
-	Pages       *int                  `json:"pages"`
-	Published   *bool                 `json:"published"`
-	PublishedAt OptionalInput[string] `json:"published_at"`
-	Notes       *string               `json:"notes"`
-}
-
-// BookListFilter is the typed list query for listing Book.
-type BookListFilter struct {
-	Title     string
-	Published vent.BoolFilter
-	Pages     string
-}
-
-// getBookListHandler returns the handler for GET /admin/books/
-func (h *AdminHandler) getBookListHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		filter := BookListFilter{
-			Title:     r.URL.Query().Get("filter.title"),
-			Published: vent.BoolFilter(r.URL.Query().Get("filter.published")),
-			Pages:     r.URL.Query().Get("filter.pages"),
-		}
-		query := h.client.Book.Query()
-		if filterVal := filter.Title; filterVal != "" {
-			query = query.Where(book.TitleContainsFold(filterVal))
-		}
-		if v, ok := filter.Published.Bool(); ok {
-			query = query.Where(book.PublishedEQ(v))
-		}
-		if filterVal := filter.Pages; filterVal != "" {
-			if intVal, err := strconv.Atoi(filterVal); err == nil {
-				query = query.Where(book.PagesEQ(intVal))
-			}
-		}
-
-		total, err := query.Clone().Count(r.Context())
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
-		page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
-		pagination := gui.NewSchemaTablePagination(page)
-
-		rows := []gui.SchemaTableRow{}
-		if vent.IsDatastarRequest(r) {
-			entities, err := h.schemas.Book.EagerLoadQuery(query).
-				Order(book.ByID()).
-				Offset(page.Offset()).
-				Limit(page.Limit()).
-				All(r.Context())
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildAuditLogListPageProps(r)
 			if err != nil {
 				vent.HandleError(w, r, normalizeError(err))
 				return
 			}
-
-			rows = make([]gui.SchemaTableRow, len(entities))
-			for i, e := range entities {
-				cells := make([]gui.SchemaTableCell, len(h.bookFields.listColumns))
-				for j, field := range h.bookFields.listColumns {
-					cell := gui.SchemaTableCell{Display: field.ListCell(r.Context(), e)}
-					if j == 0 {
-						cell.LinkURL = fmt.Sprintf("%sbooks/%d/", requestctx.MustAdminPath(r.Context()), e.ID)
-					}
-					cells[j] = cell
-				}
-				rows[i] = gui.SchemaTableRow{Cells: cells}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildAuditLogDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "audit_logs/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildAuditLogPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "audit_logs/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
 			}
 		}
-
-		canCreate, err := h.schemas.Book.CanCreate(r.Context())
-		if err != nil {
-			vent.HandleError(w, r, err)
-			return
-		}
-		renderCtx := gui.RenderContext{
-			CanCreate: canCreate,
-		}
-
-		props := gui.SchemaTableProps{
-			LayoutProps:         h.buildLayoutProps(r.Context(), "Book", gui.SchemaListBreadcrumbs("Books")),
-			RouteName:           "books",
-			SingularDisplayName: "Book",
-			PluralDisplayName:   "Books",
-			Columns: []gui.SchemaTableColumn{
-				{Name: "title", Label: "Title", Type: "string"},
-				{Name: "author", Label: "Author", Type: "edge"},
-				{Name: "published", Label: "Published", Type: "bool"},
-				{Name: "pages", Label: "Pages", Type: "int"},
-			},
-			FilterableColumns: []gui.SchemaTableFilterableColumn{
-				{Name: "title", Label: "Title", Type: "string", Value: filter.Title},
-				{Name: "published", Label: "Published", Type: "bool", Value: filter.Published.Normalize().String()},
-				{Name: "pages", Label: "Pages", Type: "int", Value: filter.Pages},
-			},
-			Rows:          rows,
-			Pagination:    pagination,
-			Loading:       !vent.IsDatastarRequest(r),
-			RenderContext: renderCtx,
-		}
-
-		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
 
-// buildBookAddPageProps builds the add page props for Book.
-func (h *AdminHandler) buildBookAddPageProps(ctx context.Context, errorMessage string) (gui.SchemaEntityAddProps, error) {
-	canCreate, err := h.schemas.Book.CanCreate(ctx)
+// runAuditLogAction runs action on the AuditLog with id after its row check.
+func (h *AdminHandler) runAuditLogAction(ctx context.Context, action AuditLogAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.AuditLog.EagerLoadQuery(h.client.AuditLog.Query().
+		Where(auditlog.IDEQ(id))).
+		Only(ctx)
 	if err != nil {
-		return gui.SchemaEntityAddProps{}, err
-	}
-	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canCreate,
-	})
-
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.bookFields.createFormFields {
-		html, err := field.CreateHTML(ctx)
-		if err != nil {
-			return gui.SchemaEntityAddProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
-		}
-	}
-
-	return gui.SchemaEntityAddProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaAddBreadcrumbs(
-			requestctx.MustAdminPath(ctx),
-			"books",
-			"Books",
-			"Book",
-		)),
-		RouteName:           "books",
-		SingularDisplayName: "Book",
-		ErrorMessage:        errorMessage,
-		Fields:              fields,
-	}, nil
-}
-
-func (h *AdminHandler) patchBookAddPageError(w http.ResponseWriter, r *http.Request, err error) {
-	props, buildErr := h.buildBookAddPageProps(r.Context(), normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
-		return
+		return vent.ActionResult{}, err
 	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
+	if err := denyIfCannot(h.canAuditLogRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
 	}
+	return action.Run(ctx, e)
 }
 
-// getBookAddHandler returns the handler for GET /admin/books/add/
-func (h *AdminHandler) getBookAddHandler() http.Handler {
+// getAuditLogExportHandler returns the handler for GET /admin/auditlogs/export/
+func (h *AdminHandler) getAuditLogExportHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		props, err := h.buildBookAddPageProps(r.Context(), "")
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
 		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
-
-		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
+			return
 		}
+		list := h.newAuditLogListQuery(r.URL.Query())
+		query := h.schemas.AuditLog.EagerLoadQuery(list.query).Order(auditlogListOrder(list.sorts)...)
+		columns := []string{
+			"CreatedAt",
+			"Actor",
+			"Action",
+			"Schema",
+			"EntityName",
+		}
+		vent.ServeExport(w, r, format, "audit_logs", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.auditLogFields.listColumns))
+				for j, field := range h.auditLogFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
 	})
 }
 
-// buildBookPageProps builds the edit page props for Book.
-func (h *AdminHandler) buildBookPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
-	e, err := h.schemas.Book.EagerLoadQuery(h.client.Book.Query().
-		Where(book.IDEQ(id))).
+// buildAuditLogPageProps builds the edit page props for AuditLog.
+func (h *AdminHandler) buildAuditLogPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.AuditLog.EagerLoadQuery(h.client.AuditLog.Query().
+		Where(auditlog.IDEQ(id))).
 		Only(ctx)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
 
-	if err := denyIfCannot(h.schemas.Book.CanRead(ctx, e)); err != nil {
+	if err := denyIfCannot(h.schemas.AuditLog.CanRead(ctx, e)); err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
-	canCreate, err := h.schemas.Book.CanCreate(ctx)
+	canCreate, err := h.schemas.AuditLog.CanCreate(ctx)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
-	canUpdate, err := h.schemas.Book.CanUpdate(ctx, e)
+	canUpdate, err := h.schemas.AuditLog.CanUpdate(ctx, e)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
-	canDelete, err := h.schemas.Book.CanDelete(ctx, e)
+	canDelete, err := h.schemas.AuditLog.CanDelete(ctx, e)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
@@ -617,520 +614,871 @@ func (h *AdminHandler) buildBookPageProps(ctx context.Context, id int, errorMess
 	}
 	ctx = gui.WithRenderContext(ctx, renderCtx)
 
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.bookFields.updateFormFields {
-		html, err := field.UpdateHTML(ctx, e)
-		if err != nil {
-			return gui.SchemaEntityChangeProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.auditLogFields.updateFormFieldSets))
+	for _, fieldSet := range h.auditLogFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
 		}
+		fieldSets = append(fieldSets, props)
 	}
 
-	entityDisplay := h.schemas.Book.Name(e)
+	allowed, err := h.allowedAuditLogActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.auditlogEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.AuditLog.Name(e)
 	props := gui.SchemaEntityChangeProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaEntityBreadcrumbs(
+		LayoutProps: h.buildLayoutProps(ctx, "AuditLog", gui.SchemaEntityBreadcrumbs(
 			requestctx.MustAdminPath(ctx),
-			"books",
-			"Books",
+			"audit_logs",
+			"Audit log",
 			entityDisplay,
 		)),
-		RouteName:     "books",
-		EntityID:      id,
+		RouteName:     "audit_logs",
+		EntityID:      vent.FormatID(id),
 		EntityDisplay: entityDisplay,
 		ErrorMessage:  errorMessage,
-		Fields:        fields,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     false,
+		RelatedLists:  auditlogRelatedLists(ctx),
+		Actions:       actions,
 		RenderContext: renderCtx,
 	}
 	return props, nil
 }
 
-// getBookHandler returns the handler for GET /admin/books/{id}/
-func (h *AdminHandler) getBookHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
-		if err != nil {
-			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
-			return
-		}
+// auditlogRelatedLists returns the lists filtered to one AuditLog
+// that the current user may read, for its change and detail pages.
+func auditlogRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	return lists
+}
 
-		props, err := h.buildBookPageProps(r.Context(), id, "")
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
+// buildAuditLogDetailPageProps builds the read-only detail page props for AuditLog.
+func (h *AdminHandler) buildAuditLogDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.AuditLog.EagerLoadQuery(h.client.AuditLog.Query().
+		Where(auditlog.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
 
-		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
-		}
-	})
-}
-func (h *AdminHandler) patchBookPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
-	props, buildErr := h.buildBookPageProps(r.Context(), id, normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
-		return
+	if err := denyIfCannot(h.schemas.AuditLog.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
 	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
+	canUpdate, err := h.schemas.AuditLog.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
 	}
-}
-
-// postBookHandler returns the handler for POST /admin/books/
-func (h *AdminHandler) postBookHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var signals struct {
-			Entity BookCreateInput `json:"entity"`
-		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchBookAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
-			return
-		}
-		input := signals.Entity
+	canDelete, err := h.schemas.AuditLog.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
 
-		if err := h.schemas.Book.ValidateCreate(r.Context(), input); err != nil {
-			h.patchBookAddPageError(w, r, err)
-			return
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.auditLogFields.updateFormFieldSets))
+	for _, fieldSet := range h.auditLogFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
 		}
+		fieldSets = append(fieldSets, props)
+	}
 
-		builder := h.client.Book.Create()
+	allowed, err := h.allowedAuditLogActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.auditlogEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
 
-		for _, field := range h.bookFields.createBindFields {
-			if err := field.ApplyCreate(r.Context(), builder, input); err != nil {
-				h.patchBookAddPageError(w, r, err)
-				return
-			}
-		}
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.AuditLog.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "AuditLog", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"audit_logs",
+			"Audit log",
+			entityDisplay,
+		)),
+		RouteName:     "audit_logs",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  auditlogRelatedLists(ctx),
+		Actions:       actions,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
 
-		if _, err := builder.Save(r.Context()); err != nil {
-			h.patchBookAddPageError(w, r, err)
+// getAuditLogDetailHandler returns the handler for GET /admin/auditlogs/{id}/view/
+func (h *AdminHandler) getAuditLogDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseAuditLogID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
-
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
+		h.renderAuditLogDetailPage(w, r, id)
 	})
 }
 
-// patchBookHandler returns the handler for PATCH /admin/books/{id}/
-func (h *AdminHandler) patchBookHandler() http.Handler {
+func (h *AdminHandler) renderAuditLogDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildAuditLogDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// loadAuditLog loads the AuditLog with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadAuditLog(ctx context.Context, id int) (*ent.AuditLog, error) {
+	return h.schemas.AuditLog.EagerLoadQuery(h.db(ctx).AuditLog.Query().
+		Where(auditlog.IDEQ(id))).
+		Only(ctx)
+}
+
+// getAuditLogHandler returns the handler for GET /admin/auditlogs/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getAuditLogHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuditLogID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		e, err := h.client.Book.Get(r.Context(), id)
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadAuditLog(r.Context(), id)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		if err := denyIfCannot(h.schemas.Book.CanUpdate(r.Context(), e)); err != nil {
-			vent.HandleError(w, r, err)
+		canUpdate, err := h.schemas.AuditLog.CanUpdate(r.Context(), e)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-
-		var signals struct {
-			Entity BookUpdateInput `json:"entity"`
-		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchBookPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+		if !canUpdate {
+			h.renderAuditLogDetailPage(w, r, id)
 			return
 		}
-		input := signals.Entity
 
-		if err := h.schemas.Book.ValidateUpdate(r.Context(), id, input); err != nil {
-			h.patchBookPageError(w, r, id, err)
+		props, err := h.buildAuditLogPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-
-		builder := h.client.Book.UpdateOneID(id)
-
-		for _, field := range h.bookFields.updateBindFields {
-			if err := field.ApplyUpdate(r.Context(), builder, input); err != nil {
-				h.patchBookPageError(w, r, id, err)
-				return
-			}
+		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
 		}
+	})
+}
 
-		if err := builder.Exec(r.Context()); err != nil {
-			h.patchBookPageError(w, r, id, err)
-			return
+// resolveAuditLogImportRef returns the ID of the AuditLog an import
+// cell references, by ID.
+func resolveAuditLogImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parseAuditLogID(ref); err == nil {
+		exists, err := client.AuditLog.Query().Where(auditlog.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
 		}
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Audit log entry matches %q", ref))
+}
 
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
-	})
+// ============================================================================
+// Author Handlers
+// ============================================================================
+
+// parseAuthorID parses a Author ID from a URL path or form value.
+func parseAuthorID(value string) (int, error) {
+	return vent.ParseID[int](value)
 }
 
-// deleteBookHandler returns the handler for DELETE /admin/books/{id}/
-func (h *AdminHandler) deleteBookHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+// AuthorCreateInput is the typed input for creating a Author
+type AuthorCreateInput struct {
+	User   string `json:"user"`
+	Active *bool  `json:"active"`
+}
+
+// AuthorUpdateInput is the typed input for updating a Author
+type AuthorUpdateInput struct {
+	User   *string `json:"user"`
+	Active *bool   `json:"active"`
+}
+
+// AuthorListFilter is the typed list query for listing Author.
+type AuthorListFilter struct {
+	Active vent.BoolFilter
+}
+
+// authorListOrders maps Author list sort keys to Ent order options.
+var authorListOrders = map[string]func(...sql.OrderTermOption) author.OrderOption{
+	"user": func(opts ...sql.OrderTermOption) author.OrderOption {
+		return author.ByUserField("id", opts...)
+	},
+	"active": author.ByActive,
+}
+
+// authorDefaultOrdering is the Author list ordering when the request names none.
+var authorDefaultOrdering = []string{}
+
+func authorListSortable(name string) bool {
+	_, ok := authorListOrders[name]
+	return ok
+}
+
+// authorListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func authorListOrder(sorts []vent.ListSort) []author.OrderOption {
+	orders := make([]author.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, authorListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, author.ByID())
+	}
+	return orders
+}
+
+// authorListQuery is the Author list query with a request's filters, search, and sort applied.
+type authorListQuery struct {
+	query  *ent.AuthorQuery
+	filter AuthorListFilter
+	sorts  []vent.ListSort
+}
+
+// newAuthorListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newAuthorListQuery(q url.Values) authorListQuery {
+	filter := AuthorListFilter{
+		Active: vent.BoolFilter(q.Get("filter.active")),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), authorDefaultOrdering, authorListSortable)
+	query := h.client.Author.Query()
+	if v, ok := filter.Active.Bool(); ok {
+		query = query.Where(author.ActiveEQ(v))
+	}
+	return authorListQuery{
+		query:  query,
+		filter: filter,
+		sorts:  sorts,
+	}
+}
+
+// buildAuthorListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildAuthorListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newAuthorListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedAuthorActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.Author.EagerLoadQuery(query).
+			Order(authorListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
 		if err != nil {
-			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
-			return
+			return gui.SchemaTableProps{}, err
 		}
 
-		e, err := h.client.Book.Get(r.Context(), id)
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.authorFields.listColumns))
+			for j, field := range h.authorFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%sauthors/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.authorEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.Author.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_author")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listAuthorBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canViewDeleted, err := defaultCan(ctx, "delete_author")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "Author", gui.SchemaListBreadcrumbs("Authors")),
+		RouteName:           "authors",
+		SingularDisplayName: "Author",
+		PluralDisplayName:   "Authors",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "user", Label: "User", Type: "edge", Sortable: true},
+			{Name: "active", Label: "Active", Type: "bool", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "active",
+				Label: "Active",
+				Type:  "bool",
+				Value: filter.Active.Normalize().String(),
+			},
+		},
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", authorDefaultOrdering, authorListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		Deleted:       canViewDeleted,
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getAuthorListHandler returns the handler for GET /admin/authors/
+func (h *AdminHandler) getAuthorListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildAuthorListPageProps(r)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		if err := denyIfCannot(h.schemas.Book.CanDelete(r.Context(), e)); err != nil {
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
-			return
 		}
+	})
+}
 
-		if err := h.schemas.Book.ValidateDelete(r.Context(), id); err != nil {
-			h.patchBookPageError(w, r, id, err)
-			return
+// allowedAuthorActions returns the custom Author actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedAuthorActions(ctx context.Context) ([]AuthorAction, error) {
+	var allowed []AuthorAction
+	for _, action := range h.schemas.Author.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
 		}
-
-		if err := h.client.Book.DeleteOneID(id).Exec(r.Context()); err != nil {
-			h.patchBookPageError(w, r, id, err)
-			return
+		if ok {
+			allowed = append(allowed, action)
 		}
+	}
+	return allowed, nil
+}
 
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
-	})
+// lookupAuthorAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupAuthorAction(ctx context.Context, name string) (AuthorAction, error) {
+	for _, action := range h.schemas.Author.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return AuthorAction{}, err
+		}
+		return action, nil
+	}
+	return AuthorAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
 }
 
-// ============================================================================
-// Permission Handlers
-// ============================================================================
+// canAuthorRunAction reports whether action may run on e.
+func (h *AdminHandler) canAuthorRunAction(ctx context.Context, action AuthorAction, e *ent.Author) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.Author.CanUpdate(ctx, e)
+}
 
-// PermissionCreateInput is the typed input for creating a Permission
-type PermissionCreateInput struct {
-	Groups []string `json:"groups"`
+// authorEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) authorEntityActions(ctx context.Context, allowed []AuthorAction, e *ent.Author) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canAuthorRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
 }
 
-// PermissionUpdateInput is the typed input for updating a Permission
-type PermissionUpdateInput struct {
-	Groups *[]string `json:"groups"`
+// listAuthorBulkActions returns the bulk actions the current user may run
+// on Author rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listAuthorBulkActions(ctx context.Context, allowed []AuthorAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	canDelete, err := defaultCan(ctx, "delete_author")
+	if err != nil {
+		return nil, err
+	}
+	if canDelete {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    vent.BulkActionDelete,
+			Label:   "Delete",
+			Confirm: "Delete the selected Authors? This cannot be undone.",
+			Danger:  true,
+		})
+	}
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
 }
 
-// getPermissionListHandler returns the handler for GET /admin/permissions/
-func (h *AdminHandler) getPermissionListHandler() http.Handler {
+// postAuthorBulkHandler returns the handler for POST /admin/authors/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postAuthorBulkHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		query := h.client.Permission.Query()
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runAuthorBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
 
-		total, err := query.Clone().Count(r.Context())
+		props, err := h.buildAuthorListPageProps(r)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
-		pagination := gui.NewSchemaTablePagination(page)
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
 
-		rows := []gui.SchemaTableRow{}
-		if vent.IsDatastarRequest(r) {
-			entities, err := h.schemas.Permission.EagerLoadQuery(query).
-				Order(permission.ByID()).
-				Offset(page.Offset()).
-				Limit(page.Limit()).
-				All(r.Context())
+// runAuthorBulkAction runs selection.Action on each selected Author,
+// checking permissions per row.
+func (h *AdminHandler) runAuthorBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.Author.Query()
+	if selection.All {
+		query = h.newAuthorListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Author")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parseAuthorID(raw)
 			if err != nil {
-				vent.HandleError(w, r, normalizeError(err))
-				return
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
 			}
+			ids[i] = id
+		}
+		query = query.Where(author.IDIn(ids...))
+	}
+	entities, err := h.schemas.Author.EagerLoadQuery(query).
+		Order(author.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
 
-			rows = make([]gui.SchemaTableRow, len(entities))
-			for i, e := range entities {
-				cells := make([]gui.SchemaTableCell, len(h.permissionFields.listColumns))
-				for j, field := range h.permissionFields.listColumns {
-					cell := gui.SchemaTableCell{Display: field.ListCell(r.Context(), e)}
-					if j == 0 {
-						cell.LinkURL = fmt.Sprintf("%spermissions/%d/", requestctx.MustAdminPath(r.Context()), e.ID)
-					}
-					cells[j] = cell
-				}
-				rows[i] = gui.SchemaTableRow{Cells: cells}
-			}
+	if selection.Action == vent.BulkActionDelete {
+		if err := denyIfCannot(defaultCan(ctx, "delete_author")); err != nil {
+			return vent.BulkResult{}, err
+		}
+		result := vent.BulkResult{Label: "Delete"}
+		for _, e := range entities {
+			result.Record(vent.FormatID(e.ID), h.schemas.Author.Name(e), bulkErrorMessage(h.deleteAuthorRow(ctx, e)))
 		}
+		return result, nil
+	}
+
+	action, err := h.lookupAuthorAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canAuthorRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.Author.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
 
-		canCreate, err := h.schemas.Permission.CanCreate(r.Context())
+// postAuthorActionHandler returns the handler for POST /admin/authors/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postAuthorActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
-			vent.HandleError(w, r, err)
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
-		renderCtx := gui.RenderContext{
-			CanCreate: canCreate,
+		action, err := h.lookupAuthorAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
 		}
+		result, runErr := h.runAuthorAction(r.Context(), action, id)
 
-		props := gui.SchemaTableProps{
-			LayoutProps:         h.buildLayoutProps(r.Context(), "Permission", gui.SchemaListBreadcrumbs("Permissions")),
-			RouteName:           "permissions",
-			SingularDisplayName: "Permission",
-			PluralDisplayName:   "Permissions",
-			Columns: []gui.SchemaTableColumn{
-				{Name: "name", Label: "Name", Type: "string"},
-				{Name: "groups", Label: "Groups", Type: "edge"},
-			},
-			FilterableColumns: []gui.SchemaTableFilterableColumn{},
-			Rows:              rows,
-			Pagination:        pagination,
-			Loading:           !vent.IsDatastarRequest(r),
-			RenderContext:     renderCtx,
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
 		}
-
-		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildAuthorListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildAuthorDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildAuthorPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
 
-// buildPermissionPageProps builds the edit page props for Permission.
-func (h *AdminHandler) buildPermissionPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
-	e, err := h.schemas.Permission.EagerLoadQuery(h.client.Permission.Query().
-		Where(permission.IDEQ(id))).
+// runAuthorAction runs action on the Author with id after its row check.
+func (h *AdminHandler) runAuthorAction(ctx context.Context, action AuthorAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.Author.EagerLoadQuery(h.client.Author.Query().
+		Where(author.IDEQ(id))).
 		Only(ctx)
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canAuthorRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
 	}
+	return action.Run(ctx, e)
+}
 
-	if err := denyIfCannot(h.schemas.Permission.CanRead(ctx, e)); err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+// deleteAuthorRow deletes one Author with the same checks as the delete
+// route. The delete and its audit entry and revision share a transaction.
+func (h *AdminHandler) deleteAuthorRow(ctx context.Context, e *ent.Author) error {
+	if err := denyIfCannot(h.schemas.Author.CanDelete(ctx, e)); err != nil {
+		return err
 	}
-	canCreate, err := h.schemas.Permission.CanCreate(ctx)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+	if err := h.schemas.Author.ValidateDelete(ctx, e.ID); err != nil {
+		return err
 	}
-	canUpdate, err := h.schemas.Permission.CanUpdate(ctx, e)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+	if err := h.checkAuthorDelete(ctx, e); err != nil {
+		return err
 	}
-	canDelete, err := h.schemas.Permission.CanDelete(ctx, e)
+	err := h.withTx(ctx, func(ctx context.Context) error {
+		if err := h.schemas.Author.BeforeDelete(ctx, e); err != nil {
+			return err
+		}
+		snapshot, err := h.authorRevisionSnapshot(ctx, e)
+		if err != nil {
+			return err
+		}
+		if err := h.db(ctx).Author.DeleteOneID(e.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if err := h.auditAuthor(ctx, vent.AuditActionDelete, e, e.ID); err != nil {
+			return err
+		}
+		if err := h.recordRevision(ctx, vent.AuditActionDelete, "Author", e.ID, h.schemas.Author.Name(e), snapshot); err != nil {
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return err
 	}
-	renderCtx := gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canUpdate,
-		CanDelete: canDelete,
+	if err := h.schemas.Author.AfterDelete(ctx, e); err != nil {
+		log.Printf("Author after delete hook: %v", err)
 	}
-	ctx = gui.WithRenderContext(ctx, renderCtx)
-
-	fields := []gui.SchemaEntityFieldProps{}
+	return nil
+}
 
-	for _, field := range h.permissionFields.updateFormFields {
-		html, err := field.UpdateHTML(ctx, e)
+// authorDeleteRelations lists the entities a delete of e cascades to, unlinks,
+// or is blocked by, leaving out relations without any.
+func (h *AdminHandler) authorDeleteRelations(ctx context.Context, e *ent.Author) ([]gui.DeleteRelation, error) {
+	adminPath := requestctx.MustAdminPath(ctx)
+	var relations []gui.DeleteRelation
+	if count, err := e.QueryBooks().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.Book.EagerLoadQuery(e.QueryBooks()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_book")
 		if err != nil {
-			return gui.SchemaEntityChangeProps{}, err
+			return nil, err
 		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
+		relation := gui.DeleteRelation{Label: "Books", Effect: "block", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.Book.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%sbooks/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
 		}
+		relations = append(relations, relation)
 	}
+	return relations, nil
+}
 
-	entityDisplay := h.schemas.Permission.Name(e)
-	props := gui.SchemaEntityChangeProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Permission", gui.SchemaEntityBreadcrumbs(
+// checkAuthorDelete refuses to delete e while related entities block it or
+// the delete would cascade to entities the user may not delete, instead of
+// leaving it to the database's foreign keys.
+func (h *AdminHandler) checkAuthorDelete(ctx context.Context, e *ent.Author) error {
+	relations, err := h.authorDeleteRelations(ctx, e)
+	if err != nil {
+		return err
+	}
+	return deleteRelationsError(h.schemas.Author.Name(e), relations)
+}
+
+// buildAuthorDeletePageProps builds the delete confirmation props for Author.
+func (h *AdminHandler) buildAuthorDeletePageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityDeleteProps, error) {
+	e, err := h.loadAuthor(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Author.CanDelete(ctx, e)); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+
+	entityDisplay := h.schemas.Author.Name(e)
+	props := gui.SchemaEntityDeleteProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaDeleteBreadcrumbs(
 			requestctx.MustAdminPath(ctx),
-			"permissions",
-			"Permissions",
+			"authors",
+			"Authors",
 			entityDisplay,
+			vent.FormatID(id),
 		)),
-		RouteName:     "permissions",
-		EntityID:      id,
+		RouteName:     "authors",
+		EntityID:      vent.FormatID(id),
 		EntityDisplay: entityDisplay,
 		ErrorMessage:  errorMessage,
-		Fields:        fields,
-		RenderContext: renderCtx,
+	}
+	if props.Relations, err = h.authorDeleteRelations(ctx, e); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
 	}
 	return props, nil
 }
 
-// getPermissionHandler returns the handler for GET /admin/permissions/{id}/
-func (h *AdminHandler) getPermissionHandler() http.Handler {
+// getAuthorDeleteHandler returns the handler for GET /admin/authors/{id}/delete/
+func (h *AdminHandler) getAuthorDeleteHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		props, err := h.buildPermissionPageProps(r.Context(), id, "")
+		props, err := h.buildAuthorDeletePageProps(r.Context(), id, "")
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
 
-		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+		if err := gui.SchemaEntityDeletePage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
-func (h *AdminHandler) patchPermissionPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
-	props, buildErr := h.buildPermissionPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+
+func (h *AdminHandler) patchAuthorDeletePageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	props, buildErr := h.buildAuthorDeletePageProps(r.Context(), id, normalizeError(err).PublicMessage())
 	if buildErr != nil {
 		vent.HandleError(w, r, normalizeError(buildErr))
 		return
 	}
 	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityDeletePage(props)); patchErr != nil {
 		vent.HandleError(w, r, patchErr)
 	}
 }
 
-// patchPermissionHandler returns the handler for PATCH /admin/permissions/{id}/
-func (h *AdminHandler) patchPermissionHandler() http.Handler {
+// getAuthorExportHandler returns the handler for GET /admin/authors/export/
+func (h *AdminHandler) getAuthorExportHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
 		if err != nil {
-			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
-			return
-		}
-
-		e, err := h.client.Permission.Get(r.Context(), id)
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
-		if err := denyIfCannot(h.schemas.Permission.CanUpdate(r.Context(), e)); err != nil {
 			vent.HandleError(w, r, err)
 			return
 		}
-
-		var signals struct {
-			Entity PermissionUpdateInput `json:"entity"`
-		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchPermissionPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
-			return
-		}
-		input := signals.Entity
-
-		if err := h.schemas.Permission.ValidateUpdate(r.Context(), id, input); err != nil {
-			h.patchPermissionPageError(w, r, id, err)
-			return
-		}
-
-		builder := h.client.Permission.UpdateOneID(id)
-
-		for _, field := range h.permissionFields.updateBindFields {
-			if err := field.ApplyUpdate(r.Context(), builder, input); err != nil {
-				h.patchPermissionPageError(w, r, id, err)
-				return
-			}
-		}
-
-		if err := builder.Exec(r.Context()); err != nil {
-			h.patchPermissionPageError(w, r, id, err)
-			return
-		}
-
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permissions/")
-	})
-}
-
-// ============================================================================
-// PermissionGroup Handlers
-// ============================================================================
-
-// PermissionGroupCreateInput is the typed input for creating a PermissionGroup
-type PermissionGroupCreateInput struct {
-	Name        string   `json:"name"`
-	Permissions []string `json:"permissions"`
-}
-
-// PermissionGroupUpdateInput is the typed input for updating a PermissionGroup
-type PermissionGroupUpdateInput struct {
-	Name        *string   `json:"name"`
-	Permissions *[]string `json:"permissions"`
-}
-
-// PermissionGroupListFilter is the typed list query for listing PermissionGroup.
-type PermissionGroupListFilter struct {
-	Name string
-}
-
-// getPermissionGroupListHandler returns the handler for GET /admin/permissiongroups/
-func (h *AdminHandler) getPermissionGroupListHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		filter := PermissionGroupListFilter{
-			Name: r.URL.Query().Get("filter.name"),
-		}
-		query := h.client.PermissionGroup.Query()
-		if filterVal := filter.Name; filterVal != "" {
-			query = query.Where(permissiongroup.NameContainsFold(filterVal))
-		}
-
-		total, err := query.Clone().Count(r.Context())
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
+		list := h.newAuthorListQuery(r.URL.Query())
+		query := h.schemas.Author.EagerLoadQuery(list.query).Order(authorListOrder(list.sorts)...)
+		columns := []string{
+			"User",
+			"Active",
 		}
-		page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
-		pagination := gui.NewSchemaTablePagination(page)
-
-		rows := []gui.SchemaTableRow{}
-		if vent.IsDatastarRequest(r) {
-			entities, err := h.schemas.PermissionGroup.EagerLoadQuery(query).
-				Order(permissiongroup.ByID()).
-				Offset(page.Offset()).
-				Limit(page.Limit()).
-				All(r.Context())
+		vent.ServeExport(w, r, format, "authors", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
 			if err != nil {
-				vent.HandleError(w, r, normalizeError(err))
-				return
+				return nil, err
 			}
-
-			rows = make([]gui.SchemaTableRow, len(entities))
+			rows := make([][]string, len(entities))
 			for i, e := range entities {
-				cells := make([]gui.SchemaTableCell, len(h.permissionGroupFields.listColumns))
-				for j, field := range h.permissionGroupFields.listColumns {
-					cell := gui.SchemaTableCell{Display: field.ListCell(r.Context(), e)}
-					if j == 0 {
-						cell.LinkURL = fmt.Sprintf("%spermission-groups/%d/", requestctx.MustAdminPath(r.Context()), e.ID)
-					}
-					cells[j] = cell
+				rows[i] = make([]string, len(h.authorFields.listColumns))
+				for j, field := range h.authorFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
 				}
-				rows[i] = gui.SchemaTableRow{Cells: cells}
 			}
-		}
-
-		canCreate, err := h.schemas.PermissionGroup.CanCreate(r.Context())
-		if err != nil {
-			vent.HandleError(w, r, err)
-			return
-		}
-		renderCtx := gui.RenderContext{
-			CanCreate: canCreate,
-		}
+			return rows, nil
+		})
+	})
+}
 
-		props := gui.SchemaTableProps{
-			LayoutProps:         h.buildLayoutProps(r.Context(), "PermissionGroup", gui.SchemaListBreadcrumbs("Permission Groups")),
-			RouteName:           "permission-groups",
-			SingularDisplayName: "Permission Group",
-			PluralDisplayName:   "Permission Groups",
-			Columns: []gui.SchemaTableColumn{
-				{Name: "name", Label: "Name", Type: "string"},
-			},
-			FilterableColumns: []gui.SchemaTableFilterableColumn{
-				{Name: "name", Label: "Name", Type: "string", Value: filter.Name},
-			},
-			Rows:          rows,
-			Pagination:    pagination,
-			Loading:       !vent.IsDatastarRequest(r),
-			RenderContext: renderCtx,
-		}
+// authorNotCopied labels the add form fields a duplicate leaves for the user
+// to fill in.
+var authorNotCopied = []string{
+	"User",
+}
 
-		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
-		}
-	})
+// authorInitialFields are the add form fields a query parameter of the same
+// name may pre-fill.
+var authorInitialFields = []vent.InitialField{
+	{
+		Name:    "user",
+		Kind:    vent.FieldKind("foreign_key_unique"),
+		CheckID: vent.CheckID[int],
+	},
+	{
+		Name: "active",
+		Kind: vent.FieldKind("bool"),
+	},
 }
 
-// buildPermissionGroupAddPageProps builds the add page props for PermissionGroup.
-func (h *AdminHandler) buildPermissionGroupAddPageProps(ctx context.Context, errorMessage string) (gui.SchemaEntityAddProps, error) {
-	canCreate, err := h.schemas.PermissionGroup.CanCreate(ctx)
+// buildAuthorAddPageProps builds the add page props for Author. A non-nil
+// source pre-fills the form with a copy of it.
+func (h *AdminHandler) buildAuthorAddPageProps(ctx context.Context, source *ent.Author, errorMessage string) (gui.SchemaEntityAddProps, error) {
+	canCreate, err := h.schemas.Author.CanCreate(ctx)
 	if err != nil {
 		return gui.SchemaEntityAddProps{}, err
 	}
@@ -1138,35 +1486,51 @@ func (h *AdminHandler) buildPermissionGroupAddPageProps(ctx context.Context, err
 		CanCreate: canCreate,
 		CanUpdate: canCreate,
 	})
+	if source != nil {
+		ctx = withAuthorDuplicateSource(ctx, source)
+	}
 
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.permissionGroupFields.createFormFields {
-		html, err := field.CreateHTML(ctx)
-		if err != nil {
-			return gui.SchemaEntityAddProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.authorFields.createFormFieldSets))
+	for _, fieldSet := range h.authorFields.createFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.CreateHTML(ctx)
+			if err != nil {
+				return gui.SchemaEntityAddProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
 		}
+		fieldSets = append(fieldSets, props)
 	}
 
-	return gui.SchemaEntityAddProps{
-		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaAddBreadcrumbs(
+	props := gui.SchemaEntityAddProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaAddBreadcrumbs(
 			requestctx.MustAdminPath(ctx),
-			"permission-groups",
-			"Permission Groups",
-			"Permission Group",
+			"authors",
+			"Authors",
+			"Author",
 		)),
-		RouteName:           "permission-groups",
-		SingularDisplayName: "Permission Group",
+		RouteName:           "authors",
+		SingularDisplayName: "Author",
 		ErrorMessage:        errorMessage,
-		Fields:              fields,
-	}, nil
+		FieldSets:           fieldSets,
+		Tabs:                false,
+		Multipart:           false,
+	}
+	if source != nil {
+		props.DuplicateOf = h.schemas.Author.Name(source)
+		props.NotCopied = authorNotCopied
+	}
+	return props, nil
 }
 
-func (h *AdminHandler) patchPermissionGroupAddPageError(w http.ResponseWriter, r *http.Request, err error) {
-	props, buildErr := h.buildPermissionGroupAddPageProps(r.Context(), normalizeError(err).PublicMessage())
+func (h *AdminHandler) patchAuthorAddPageError(w http.ResponseWriter, r *http.Request, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildAuthorAddPageProps(r.Context(), nil, normalizeError(err).PublicMessage())
 	if buildErr != nil {
 		vent.HandleError(w, r, normalizeError(buildErr))
 		return
@@ -1177,10 +1541,49 @@ func (h *AdminHandler) patchPermissionGroupAddPageError(w http.ResponseWriter, r
 	}
 }
 
-// getPermissionGroupAddHandler returns the handler for GET /admin/permissiongroups/add/
-func (h *AdminHandler) getPermissionGroupAddHandler() http.Handler {
+// getAuthorAddHandler returns the handler for GET /admin/authors/add/
+func (h *AdminHandler) getAuthorAddHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		initial, err := vent.ParseInitialValues(r.URL.Query(), authorInitialFields)
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		ctx := vent.WithInitialValues(r.Context(), initial)
+
+		props, err := h.buildAuthorAddPageProps(ctx, nil, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(ctx, w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getAuthorDuplicateHandler returns the handler for GET /admin/authors/{id}/duplicate/,
+// the add form pre-filled from the Author with id.
+func (h *AdminHandler) getAuthorDuplicateHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		props, err := h.buildPermissionGroupAddPageProps(r.Context(), "")
+		id, err := parseAuthorID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		source, err := h.loadAuthor(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Author.CanRead(r.Context(), source)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		props, err := h.buildAuthorAddPageProps(r.Context(), source, "")
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
@@ -1192,27 +1595,27 @@ func (h *AdminHandler) getPermissionGroupAddHandler() http.Handler {
 	})
 }
 
-// buildPermissionGroupPageProps builds the edit page props for PermissionGroup.
-func (h *AdminHandler) buildPermissionGroupPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
-	e, err := h.schemas.PermissionGroup.EagerLoadQuery(h.client.PermissionGroup.Query().
-		Where(permissiongroup.IDEQ(id))).
+// buildAuthorPageProps builds the edit page props for Author.
+func (h *AdminHandler) buildAuthorPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.Author.EagerLoadQuery(h.client.Author.Query().
+		Where(author.IDEQ(id))).
 		Only(ctx)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
 
-	if err := denyIfCannot(h.schemas.PermissionGroup.CanRead(ctx, e)); err != nil {
+	if err := denyIfCannot(h.schemas.Author.CanRead(ctx, e)); err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
-	canCreate, err := h.schemas.PermissionGroup.CanCreate(ctx)
+	canCreate, err := h.schemas.Author.CanCreate(ctx)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
-	canUpdate, err := h.schemas.PermissionGroup.CanUpdate(ctx, e)
+	canUpdate, err := h.schemas.Author.CanUpdate(ctx, e)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
-	canDelete, err := h.schemas.PermissionGroup.CanDelete(ctx, e)
+	canDelete, err := h.schemas.Author.CanDelete(ctx, e)
 	if err != nil {
 		return gui.SchemaEntityChangeProps{}, err
 	}
@@ -1223,443 +1626,665 @@ func (h *AdminHandler) buildPermissionGroupPageProps(ctx context.Context, id int
 	}
 	ctx = gui.WithRenderContext(ctx, renderCtx)
 
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.permissionGroupFields.updateFormFields {
-		html, err := field.UpdateHTML(ctx, e)
-		if err != nil {
-			return gui.SchemaEntityChangeProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.authorFields.updateFormFieldSets))
+	for _, fieldSet := range h.authorFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
 		}
+		fieldSets = append(fieldSets, props)
 	}
 
-	entityDisplay := h.schemas.PermissionGroup.Name(e)
+	allowed, err := h.allowedAuthorActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.authorEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.Author.Name(e)
 	props := gui.SchemaEntityChangeProps{
-		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaEntityBreadcrumbs(
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaEntityBreadcrumbs(
 			requestctx.MustAdminPath(ctx),
-			"permission-groups",
-			"Permission Groups",
+			"authors",
+			"Authors",
 			entityDisplay,
 		)),
-		RouteName:     "permission-groups",
-		EntityID:      id,
+		RouteName:     "authors",
+		EntityID:      vent.FormatID(id),
 		EntityDisplay: entityDisplay,
 		ErrorMessage:  errorMessage,
-		Fields:        fields,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     false,
+		RelatedLists:  authorRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		Duplicate:     canCreate,
 		RenderContext: renderCtx,
 	}
 	return props, nil
 }
 
-// getPermissionGroupHandler returns the handler for GET /admin/permissiongroups/{id}/
-func (h *AdminHandler) getPermissionGroupHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
-		if err != nil {
-			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
-			return
-		}
-
-		props, err := h.buildPermissionGroupPageProps(r.Context(), id, "")
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
+// authorRelatedLists returns the lists filtered to one Author
+// that the current user may read, for its change and detail pages.
+func authorRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	if ok, err := defaultCan(ctx, "read_book"); err == nil && ok {
+		list := gui.SchemaEntityRelatedList{
+			Label:      "Books by Author",
+			RouteName:  "books",
+			FilterName: "author",
 		}
-
-		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
+		if ok, err := MustAdmin(ctx).Book().CanCreate(ctx); err == nil && ok {
+			list.AddLabel = "Add Book"
 		}
-	})
-}
-func (h *AdminHandler) patchPermissionGroupPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
-	props, buildErr := h.buildPermissionGroupPageProps(r.Context(), id, normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
-		return
-	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
+		lists = append(lists, list)
 	}
+	return lists
 }
 
-// postPermissionGroupHandler returns the handler for POST /admin/permissiongroups/
-func (h *AdminHandler) postPermissionGroupHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var signals struct {
-			Entity PermissionGroupCreateInput `json:"entity"`
-		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchPermissionGroupAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
-			return
-		}
-		input := signals.Entity
-
-		if err := h.schemas.PermissionGroup.ValidateCreate(r.Context(), input); err != nil {
-			h.patchPermissionGroupAddPageError(w, r, err)
-			return
-		}
+// buildAuthorDetailPageProps builds the read-only detail page props for Author.
+func (h *AdminHandler) buildAuthorDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.Author.EagerLoadQuery(h.client.Author.Query().
+		Where(author.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
 
-		builder := h.client.PermissionGroup.Create()
+	if err := denyIfCannot(h.schemas.Author.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.Author.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.Author.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
 
-		for _, field := range h.permissionGroupFields.createBindFields {
-			if err := field.ApplyCreate(r.Context(), builder, input); err != nil {
-				h.patchPermissionGroupAddPageError(w, r, err)
-				return
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.authorFields.updateFormFieldSets))
+	for _, fieldSet := range h.authorFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
 			}
 		}
+		fieldSets = append(fieldSets, props)
+	}
 
-		if _, err := builder.Save(r.Context()); err != nil {
-			h.patchPermissionGroupAddPageError(w, r, err)
-			return
-		}
+	allowed, err := h.allowedAuthorActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.authorEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
 
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
-	})
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.Author.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"authors",
+			"Authors",
+			entityDisplay,
+		)),
+		RouteName:     "authors",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  authorRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	if ok, err := defaultCan(ctx, "read_book"); err == nil && ok {
+		related, err := h.schemas.Book.EagerLoadQuery(e.QueryBooks()).
+			Limit(vent.DetailRelationLimit + 1).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaEntityDetailProps{}, err
+		}
+		relation := gui.SchemaEntityDetailRelation{
+			Label: "Books",
+			More:  len(related) > vent.DetailRelationLimit,
+		}
+		if relation.More {
+			related = related[:vent.DetailRelationLimit]
+		}
+		for _, r := range related {
+			relation.Items = append(relation.Items, gui.SchemaEntityRelatedLink{
+				Label: h.schemas.Book.Name(r),
+				URL:   fmt.Sprintf("%sbooks/%s/", adminPath, vent.FormatIDPath(r.ID)),
+			})
+		}
+		relation.AllURL = fmt.Sprintf("%sbooks/?%s", adminPath, url.Values{"filter.author": {vent.FormatID(id)}}.Encode())
+		props.Relations = append(props.Relations, relation)
+	}
+	return props, nil
 }
 
-// patchPermissionGroupHandler returns the handler for PATCH /admin/permissiongroups/{id}/
-func (h *AdminHandler) patchPermissionGroupHandler() http.Handler {
+// getAuthorDetailHandler returns the handler for GET /admin/authors/{id}/view/
+func (h *AdminHandler) getAuthorDetailHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
+		h.renderAuthorDetailPage(w, r, id)
+	})
+}
 
-		e, err := h.client.PermissionGroup.Get(r.Context(), id)
-		if err != nil {
-			vent.HandleError(w, r, normalizeError(err))
-			return
-		}
-		if err := denyIfCannot(h.schemas.PermissionGroup.CanUpdate(r.Context(), e)); err != nil {
-			vent.HandleError(w, r, err)
-			return
-		}
+func (h *AdminHandler) renderAuthorDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildAuthorDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
 
-		var signals struct {
-			Entity PermissionGroupUpdateInput `json:"entity"`
-		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchPermissionGroupPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
-			return
-		}
-		input := signals.Entity
+// loadAuthor loads the Author with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadAuthor(ctx context.Context, id int) (*ent.Author, error) {
+	return h.schemas.Author.EagerLoadQuery(h.db(ctx).Author.Query().
+		Where(author.IDEQ(id))).
+		Only(ctx)
+}
 
-		if err := h.schemas.PermissionGroup.ValidateUpdate(r.Context(), id, input); err != nil {
-			h.patchPermissionGroupPageError(w, r, id, err)
-			return
+// auditAuthor records a Author mutation in the audit log, diffing
+// before against the saved Author with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditAuthor(ctx context.Context, action vent.AuditAction, before *ent.Author, id int) error {
+	var after *ent.Author
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadAuthor(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
 		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.authorAuditSnapshot(ctx, before), h.authorAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "Author", id, h.schemas.Author.Name(named), changes)
+}
 
-		builder := h.client.PermissionGroup.UpdateOneID(id)
+// authorAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) authorAuditSnapshot(ctx context.Context, e *ent.Author) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.authorFields.auditFields))
+	for _, f := range h.authorFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
 
-		for _, field := range h.permissionGroupFields.updateBindFields {
-			if err := field.ApplyUpdate(r.Context(), builder, input); err != nil {
-				h.patchPermissionGroupPageError(w, r, id, err)
-				return
-			}
-		}
+// buildAuthorHistoryPageProps builds the History tab props for Author.
+func (h *AdminHandler) buildAuthorHistoryPageProps(ctx context.Context, id int) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadAuthor(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Author.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.Author.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
 
-		if err := builder.Exec(r.Context()); err != nil {
-			h.patchPermissionGroupPageError(w, r, id, err)
-			return
-		}
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("Author"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
 
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
-	})
+	entityDisplay := h.schemas.Author.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"authors",
+			"Authors",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "authors",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
 }
 
-// deletePermissionGroupHandler returns the handler for DELETE /admin/permissiongroups/{id}/
-func (h *AdminHandler) deletePermissionGroupHandler() http.Handler {
+// getAuthorHistoryHandler returns the handler for GET /admin/authors/{id}/history/
+func (h *AdminHandler) getAuthorHistoryHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		e, err := h.client.PermissionGroup.Get(r.Context(), id)
+		props, err := h.buildAuthorHistoryPageProps(r.Context(), id)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		if err := denyIfCannot(h.schemas.PermissionGroup.CanDelete(r.Context(), e)); err != nil {
-			vent.HandleError(w, r, err)
-			return
-		}
-
-		if err := h.schemas.PermissionGroup.ValidateDelete(r.Context(), id); err != nil {
-			h.patchPermissionGroupPageError(w, r, id, err)
-			return
-		}
 
-		if err := h.client.PermissionGroup.DeleteOneID(id).Exec(r.Context()); err != nil {
-			h.patchPermissionGroupPageError(w, r, id, err)
-			return
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
 		}
-
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
 	})
 }
 
-// ============================================================================
-// Review Handlers
-// ============================================================================
-
-// ReviewCreateInput is the typed input for creating a Review
-type ReviewCreateInput struct {
-	User   string  `json:"user"`
-	Rating int     `json:"rating"`
-	Body   *string `json:"body"`
-	Book   string  `json:"book"`
+// authorRevisionFields are the fields a Author revision snapshots, in form order.
+var authorRevisionFields = []string{
+	"user",
+	"active",
 }
 
-// ReviewUpdateInput is the typed input for updating a Review
-type ReviewUpdateInput struct {
-	User   *string               `json:"user"`
-	Rating *int                  `json:"rating"`
-	Body   OptionalInput[string] `json:"body"`
-	Book   *string               `json:"book"`
+// authorRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) authorRevisionSnapshot(ctx context.Context, e *ent.Author) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	if snapshot["user"], err = revisionEdgeID(h.db(ctx).Author.QueryUser(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	snapshot["active"] = e.Active
+	return snapshot, nil
 }
 
-// ReviewListFilter is the typed list query for listing Review.
-type ReviewListFilter struct {
-	Rating string
+// buildAuthorRevisionsPageProps builds the Revisions tab props for the Author
+// with id, comparing the revisions from and to. A deleted Author is shown
+// from its revisions.
+func (h *AdminHandler) buildAuthorRevisionsPageProps(ctx context.Context, id int, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Author"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "authors",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadAuthor(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		canCreate, err := h.schemas.Author.CanCreate(ctx)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = canCreate
+		props.LayoutProps = h.buildLayoutProps(ctx, "Author", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"authors",
+			"Authors",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.Author.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.Author.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.authorRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.Author.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "Author", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"authors",
+			"Authors",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%sauthors/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(authorRevisionFields, fromSnapshot, toSnapshot)
+	}
+	return props, nil
 }
 
-// getReviewListHandler returns the handler for GET /admin/reviews/
-func (h *AdminHandler) getReviewListHandler() http.Handler {
+// getAuthorRevisionsHandler returns the handler for GET /admin/authors/{id}/revisions/
+func (h *AdminHandler) getAuthorRevisionsHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		filter := ReviewListFilter{
-			Rating: r.URL.Query().Get("filter.rating"),
-		}
-		query := h.client.Review.Query()
-		if filterVal := filter.Rating; filterVal != "" {
-			if intVal, err := strconv.Atoi(filterVal); err == nil {
-				query = query.Where(review.RatingEQ(intVal))
-			}
+		id, err := parseAuthorID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
 		}
 
-		total, err := query.Clone().Count(r.Context())
+		query := r.URL.Query()
+		props, err := h.buildAuthorRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
-		pagination := gui.NewSchemaTablePagination(page)
 
-		rows := []gui.SchemaTableRow{}
-		if vent.IsDatastarRequest(r) {
-			entities, err := h.schemas.Review.EagerLoadQuery(query).
-				Order(review.ByID()).
-				Offset(page.Offset()).
-				Limit(page.Limit()).
-				All(r.Context())
-			if err != nil {
-				vent.HandleError(w, r, normalizeError(err))
-				return
-			}
-
-			rows = make([]gui.SchemaTableRow, len(entities))
-			for i, e := range entities {
-				cells := make([]gui.SchemaTableCell, len(h.reviewFields.listColumns))
-				for j, field := range h.reviewFields.listColumns {
-					cell := gui.SchemaTableCell{Display: field.ListCell(r.Context(), e)}
-					if j == 0 {
-						cell.LinkURL = fmt.Sprintf("%sreviews/%d/", requestctx.MustAdminPath(r.Context()), e.ID)
-					}
-					cells[j] = cell
-				}
-				rows[i] = gui.SchemaTableRow{Cells: cells}
-			}
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
 		}
+	})
+}
 
-		canCreate, err := h.schemas.Review.CanCreate(r.Context())
+// postAuthorRestoreHandler returns the handler for POST /admin/authors/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postAuthorRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
-			vent.HandleError(w, r, err)
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
 			return
 		}
-		renderCtx := gui.RenderContext{
-			CanCreate: canCreate,
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("Author"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
 		}
 
-		props := gui.SchemaTableProps{
-			LayoutProps:         h.buildLayoutProps(r.Context(), "Review", gui.SchemaListBreadcrumbs("Reviews")),
-			RouteName:           "reviews",
-			SingularDisplayName: "Review",
-			PluralDisplayName:   "Reviews",
-			Columns: []gui.SchemaTableColumn{
-				{Name: "user", Label: "User", Type: "edge"},
-				{Name: "rating", Label: "Rating", Type: "int"},
-				{Name: "book", Label: "Book", Type: "edge"},
-			},
-			FilterableColumns: []gui.SchemaTableFilterableColumn{
-				{Name: "rating", Label: "Rating", Type: "int", Value: filter.Rating},
-			},
-			Rows:          rows,
-			Pagination:    pagination,
-			Loading:       !vent.IsDatastarRequest(r),
-			RenderContext: renderCtx,
-		}
+		var (
+			e     *ent.Author
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restoreAuthorRevision(ctx, id, rev)
+			return err
+		})
 
-		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
 		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%sauthors/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
 	})
 }
 
-// buildReviewAddPageProps builds the add page props for Review.
-func (h *AdminHandler) buildReviewAddPageProps(ctx context.Context, errorMessage string) (gui.SchemaEntityAddProps, error) {
-	canCreate, err := h.schemas.Review.CanCreate(ctx)
+// restoreAuthorRevision replays rev onto the Author with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the Author as it was before. A deleted Author is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restoreAuthorRevision(ctx context.Context, id int, rev *ent.Revision) (*ent.Author, func(context.Context), error) {
+	e, err := h.loadAuthor(ctx, id)
+	if ent.IsNotFound(err) {
+		e, err := h.recreateAuthor(ctx, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, func(ctx context.Context) {
+			h.afterAuthorCreate(ctx, e.ID)
+		}, nil
+	}
 	if err != nil {
-		return gui.SchemaEntityAddProps{}, err
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_author")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.Author.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
 	}
-	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canCreate,
-	})
 
-	fields := []gui.SchemaEntityFieldProps{}
+	var input AuthorUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	if err := h.updateAuthor(ctx, e, input); err != nil {
+		return nil, nil, err
+	}
+	return e, func(ctx context.Context) {
+		h.afterAuthorUpdate(ctx, e, id)
+	}, nil
+}
 
-	for _, field := range h.reviewFields.createFormFields {
-		html, err := field.CreateHTML(ctx)
-		if err != nil {
-			return gui.SchemaEntityAddProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
-		}
+// recreateAuthor recreates a deleted Author from rev in ctx's transaction,
+// through the same create path as the add form. The new Author gets a new ID,
+// which is recorded on the deleted entity's revisions.
+func (h *AdminHandler) recreateAuthor(ctx context.Context, rev *ent.Revision) (*ent.Author, error) {
+	deleted := revision.And(
+		revision.SchemaEQ(rev.Schema),
+		revision.EntityIDEQ(rev.EntityID),
+		revision.ActionEQ(revision.ActionDelete),
+	)
+	restored, err := h.db(ctx).Revision.Query().
+		Where(deleted, revision.RestoredIDNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restored {
+		return nil, vent.BadRequest("this author has already been restored")
+	}
+	if err := denyIfCannot(h.schemas.Author.CanCreate(ctx)); err != nil {
+		return nil, err
 	}
 
-	return gui.SchemaEntityAddProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaAddBreadcrumbs(
-			requestctx.MustAdminPath(ctx),
-			"reviews",
-			"Reviews",
-			"Review",
-		)),
-		RouteName:           "reviews",
-		SingularDisplayName: "Review",
-		ErrorMessage:        errorMessage,
-		Fields:              fields,
-	}, nil
+	var input AuthorCreateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	e, err := h.createAuthor(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db(ctx).Revision.Update().
+		Where(deleted).
+		SetRestoredID(vent.FormatID(e.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record restored revision: %w", err)
+	}
+	return e, nil
 }
 
-func (h *AdminHandler) patchReviewAddPageError(w http.ResponseWriter, r *http.Request, err error) {
-	props, buildErr := h.buildReviewAddPageProps(r.Context(), normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
-		return
+// buildAuthorDeletedPageProps lists the deleted Author entities that have
+// not been restored, newest first.
+func (h *AdminHandler) buildAuthorDeletedPageProps(ctx context.Context) (gui.SchemaDeletedProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Author"),
+			revision.ActionEQ(revision.ActionDelete),
+			revision.RestoredIDIsNil(),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaDeletedProps{}, err
 	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
+
+	props := gui.SchemaDeletedProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaDeletedBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"authors",
+			"Authors",
+		)),
+		RouteName:         "authors",
+		PluralDisplayName: "Authors",
+		Truncated:         len(revs) > vent.RevisionLimit,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
 	}
+	for _, rev := range revs {
+		props.Entries = append(props.Entries, gui.DeletedEntry{
+			EntityID:      rev.EntityID,
+			EntityDisplay: rev.EntityName,
+			At:            rev.CreatedAt,
+			Actor:         rev.Actor,
+		})
+	}
+	return props, nil
 }
 
-// getReviewAddHandler returns the handler for GET /admin/reviews/add/
-func (h *AdminHandler) getReviewAddHandler() http.Handler {
+// getAuthorDeletedHandler returns the handler for GET /admin/authors/deleted/
+func (h *AdminHandler) getAuthorDeletedHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		props, err := h.buildReviewAddPageProps(r.Context(), "")
+		props, err := h.buildAuthorDeletedPageProps(r.Context())
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
 
-		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+		if err := gui.SchemaDeletedPage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
 
-// buildReviewPageProps builds the edit page props for Review.
-func (h *AdminHandler) buildReviewPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
-	e, err := h.schemas.Review.EagerLoadQuery(h.client.Review.Query().
-		Where(review.IDEQ(id))).
-		Only(ctx)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
-	}
-
-	if err := denyIfCannot(h.schemas.Review.CanRead(ctx, e)); err != nil {
-		return gui.SchemaEntityChangeProps{}, err
-	}
-	canCreate, err := h.schemas.Review.CanCreate(ctx)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
-	}
-	canUpdate, err := h.schemas.Review.CanUpdate(ctx, e)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
-	}
-	canDelete, err := h.schemas.Review.CanDelete(ctx, e)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
-	}
-	renderCtx := gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canUpdate,
-		CanDelete: canDelete,
-	}
-	ctx = gui.WithRenderContext(ctx, renderCtx)
-
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.reviewFields.updateFormFields {
-		html, err := field.UpdateHTML(ctx, e)
-		if err != nil {
-			return gui.SchemaEntityChangeProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
-		}
-	}
-
-	entityDisplay := h.schemas.Review.Name(e)
-	props := gui.SchemaEntityChangeProps{
-		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaEntityBreadcrumbs(
-			requestctx.MustAdminPath(ctx),
-			"reviews",
-			"Reviews",
-			entityDisplay,
-		)),
-		RouteName:     "reviews",
-		EntityID:      id,
-		EntityDisplay: entityDisplay,
-		ErrorMessage:  errorMessage,
-		Fields:        fields,
-		RenderContext: renderCtx,
-	}
-	return props, nil
-}
-
-// getReviewHandler returns the handler for GET /admin/reviews/{id}/
-func (h *AdminHandler) getReviewHandler() http.Handler {
+// getAuthorHandler returns the handler for GET /admin/authors/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getAuthorHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		props, err := h.buildReviewPageProps(r.Context(), id, "")
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadAuthor(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.Author.CanUpdate(r.Context(), e)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
+		if !canUpdate {
+			h.renderAuthorDetailPage(w, r, id)
+			return
+		}
 
+		props, err := h.buildAuthorPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
 		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
-func (h *AdminHandler) patchReviewPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
-	props, buildErr := h.buildReviewPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+func (h *AdminHandler) patchAuthorPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildAuthorPageProps(r.Context(), id, normalizeError(err).PublicMessage())
 	if buildErr != nil {
 		vent.HandleError(w, r, normalizeError(buildErr))
 		return
@@ -1670,362 +2295,11002 @@ func (h *AdminHandler) patchReviewPageError(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// postReviewHandler returns the handler for POST /admin/reviews/
-func (h *AdminHandler) postReviewHandler() http.Handler {
+// postAuthorHandler returns the handler for POST /admin/authors/
+func (h *AdminHandler) postAuthorHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var signals struct {
-			Entity ReviewCreateInput `json:"entity"`
+			Entity AuthorCreateInput `json:"entity"`
 		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchReviewAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchAuthorAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
 			return
 		}
 		input := signals.Entity
 
-		if err := h.schemas.Review.ValidateCreate(r.Context(), input); err != nil {
-			h.patchReviewAddPageError(w, r, err)
+		var id int
+		err := h.withTx(r.Context(), func(ctx context.Context) error {
+			e, err := h.createAuthor(ctx, input)
+			if err != nil {
+				return err
+			}
+			id = e.ID
+			return nil
+		})
+		if err != nil {
+			h.patchAuthorAddPageError(w, r, err)
 			return
 		}
+		h.afterAuthorCreate(r.Context(), id)
 
-		builder := h.client.Review.Create()
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
+	})
+}
 
-		for _, field := range h.reviewFields.createBindFields {
-			if err := field.ApplyCreate(r.Context(), builder, input); err != nil {
-				h.patchReviewAddPageError(w, r, err)
-				return
-			}
+// createAuthor saves input as a new Author in ctx's transaction:
+// ValidateCreate, the create fields, BeforeCreate and the audit log entry.
+func (h *AdminHandler) createAuthor(ctx context.Context, input AuthorCreateInput) (*ent.Author, error) {
+	if err := h.schemas.Author.ValidateCreate(ctx, input); err != nil {
+		return nil, err
+	}
+
+	builder := h.db(ctx).Author.Create()
+
+	for _, field := range h.authorFields.createBindFields {
+		if err := field.ApplyCreate(ctx, builder, input); err != nil {
+			return nil, err
 		}
+	}
+	if err := h.schemas.Author.BeforeCreate(ctx, builder); err != nil {
+		return nil, err
+	}
 
-		if _, err := builder.Save(r.Context()); err != nil {
-			h.patchReviewAddPageError(w, r, err)
-			return
+	e, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.auditAuthor(ctx, vent.AuditActionCreate, nil, e.ID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// afterAuthorCreate runs the AfterCreate hook on the Author saved with id.
+func (h *AdminHandler) afterAuthorCreate(ctx context.Context, id int) {
+	e, err := h.loadAuthor(ctx, id)
+	if err == nil {
+		err = h.schemas.Author.AfterCreate(ctx, e)
+	}
+	if err != nil {
+		log.Printf("Author after create hook: %v", err)
+	}
+}
+
+// authorImportColumns are the Author fields an import file can fill.
+var authorImportColumns = []vent.ImportColumn{
+	{Name: "user", Label: "User"},
+	{Name: "active", Label: "Active"},
+}
+
+// buildAuthorImportPageProps builds the import page props for Author.
+func (h *AdminHandler) buildAuthorImportPageProps(ctx context.Context) gui.SchemaImportProps {
+	props := gui.SchemaImportProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Author", gui.SchemaImportBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"authors",
+			"Authors",
+		)),
+		RouteName:         "authors",
+		PluralDisplayName: "Authors",
+		Columns: []gui.SchemaImportColumn{
+			{Name: "user", Label: "User", Hint: "ID or email"},
+			{Name: "active", Label: "Active"},
+		},
+	}
+	return props
+}
+
+// getAuthorImportHandler returns the handler for GET /admin/authors/import/
+func (h *AdminHandler) getAuthorImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := gui.SchemaImportPage(h.buildAuthorImportPageProps(r.Context())).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
 		}
+	})
+}
 
+// postAuthorImportHandler returns the handler for POST /admin/authors/import/.
+// With ?dry_run=1 the rows are saved and then rolled back, so the preview
+// reports the same errors a real import would.
+func (h *AdminHandler) postAuthorImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props := gui.SchemaImportResultProps{
+			RouteName:         "authors",
+			PluralDisplayName: "Authors",
+		}
+		result, err := h.importAuthorFile(r, r.URL.Query().Get("dry_run") != "")
+		if err != nil {
+			props.ErrorMessage = normalizeError(err).PublicMessage()
+		} else {
+			props.Result = &result
+		}
 		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "reviews/")
+		if err := sse.PatchElementTempl(gui.SchemaImportResult(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
 	})
 }
 
-// patchReviewHandler returns the handler for PATCH /admin/reviews/{id}/
-func (h *AdminHandler) patchReviewHandler() http.Handler {
+func (h *AdminHandler) importAuthorFile(r *http.Request, dryRun bool) (vent.ImportResult, error) {
+	file, err := vent.ReadImportUpload(r, "file")
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	mapping := vent.MapImportColumns(file.Headers, authorImportColumns)
+	if strings.Join(mapping, "") == "" {
+		return vent.ImportResult{}, vent.BadRequest("no column in the file matches a Author field")
+	}
+	upsert := r.FormValue("upsert")
+	if upsert != "" {
+		return vent.ImportResult{}, vent.BadRequest(fmt.Sprintf("cannot upsert on %q", upsert))
+	}
+
+	result := vent.NewImportResult(file, mapping, authorImportColumns, dryRun)
+	var afterHooks []func(context.Context)
+	err = h.withTx(r.Context(), func(ctx context.Context) error {
+		for _, row := range file.Rows {
+			// Each row gets a savepoint, so a failed row does not abort the
+			// transaction for the rows after it.
+			action := vent.ImportActionCreate
+			err := withSavepoint(ctx, func(ctx context.Context) error {
+				var (
+					after func(context.Context)
+					err   error
+				)
+				action, after, err = h.importAuthorRow(ctx, row.Fields(mapping), upsert)
+				if err == nil {
+					afterHooks = append(afterHooks, after)
+				}
+				return err
+			})
+			message := ""
+			if err != nil {
+				message = normalizeError(err).PublicMessage()
+			}
+			result.Record(row, mapping, action, message)
+		}
+		if dryRun || result.Failed > 0 {
+			return errRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		return result, nil
+	}
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	result.Committed = true
+	for _, after := range afterHooks {
+		after(r.Context())
+	}
+	return result, nil
+}
+
+// importAuthorRow saves one import row in ctx's transaction through the
+// same path as the add form. The returned func
+// runs the After hook once the import commits.
+func (h *AdminHandler) importAuthorRow(ctx context.Context, values map[string]string, upsert string) (vent.ImportAction, func(context.Context), error) {
+	client := h.db(ctx)
+	var input AuthorCreateInput
+	if err := vent.DecodeImportValues(values, &input); err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	if input.User != "" {
+		id, err := resolveUserImportRef(ctx, client, input.User)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.User = id
+	}
+
+	e, err := h.createAuthor(ctx, input)
+	if err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	return vent.ImportActionCreate, func(ctx context.Context) {
+		h.afterAuthorCreate(ctx, e.ID)
+	}, nil
+}
+
+// resolveAuthorImportRef returns the ID of the Author an import
+// cell references, by ID.
+func resolveAuthorImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parseAuthorID(ref); err == nil {
+		exists, err := client.Author.Query().Where(author.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Author matches %q", ref))
+}
+
+// patchAuthorHandler returns the handler for PATCH /admin/authors/{id}/
+func (h *AdminHandler) patchAuthorHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseAuthorID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		e, err := h.client.Review.Get(r.Context(), id)
+		e, err := h.loadAuthor(r.Context(), id)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		if err := denyIfCannot(h.schemas.Review.CanUpdate(r.Context(), e)); err != nil {
+		if err := denyIfCannot(h.schemas.Author.CanUpdate(r.Context(), e)); err != nil {
 			vent.HandleError(w, r, err)
 			return
 		}
 
 		var signals struct {
-			Entity ReviewUpdateInput `json:"entity"`
+			Entity AuthorUpdateInput `json:"entity"`
 		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
-			h.patchReviewPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchAuthorPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
 			return
 		}
 		input := signals.Entity
 
-		if err := h.schemas.Review.ValidateUpdate(r.Context(), id, input); err != nil {
-			h.patchReviewPageError(w, r, id, err)
-			return
-		}
-
-		builder := h.client.Review.UpdateOneID(id)
-
-		for _, field := range h.reviewFields.updateBindFields {
-			if err := field.ApplyUpdate(r.Context(), builder, input); err != nil {
-				h.patchReviewPageError(w, r, id, err)
-				return
-			}
-		}
-
-		if err := builder.Exec(r.Context()); err != nil {
-			h.patchReviewPageError(w, r, id, err)
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updateAuthor(ctx, e, input)
+		})
+		if err != nil {
+			h.patchAuthorPageError(w, r, id, err)
 			return
 		}
+		h.afterAuthorUpdate(r.Context(), e, id)
 
 		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "reviews/")
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
 	})
 }
 
-// ============================================================================
-// User Handlers
-// ============================================================================
+// updateAuthor saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+func (h *AdminHandler) updateAuthor(ctx context.Context, e *ent.Author, input AuthorUpdateInput) error {
+	if err := h.schemas.Author.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
 
-// UserCreateInput is the typed input for creating a User
-type UserCreateInput struct {
-	Email       string   `json:"email"`
-	IsStaff     *bool    `json:"is_staff"`
-	IsSuperuser *bool    `json:"is_superuser"`
-	IsActive    *bool    `json:"is_active"`
-	Groups      []string `json:"groups"`
-	LastLogin   *string  `json:"last_login"`
-}
+	snapshot, err := h.authorRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
 
-// UserUpdateInput is the typed input for updating a User
-type UserUpdateInput struct {
-	Email       *string   `json:"email"`
-	IsStaff     *bool     `json:"is_staff"`
-	IsSuperuser *bool     `json:"is_superuser"`
-	IsActive    *bool     `json:"is_active"`
-	Groups      *[]string `json:"groups"`
-	LastLogin   *string   `json:"last_login"`
+	builder := h.db(ctx).Author.UpdateOneID(e.ID)
+
+	for _, field := range h.authorFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
+		}
+	}
+	if err := h.schemas.Author.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	if err := h.auditAuthor(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "Author", e.ID, h.schemas.Author.Name(e), snapshot)
 }
 
-// UserListFilter is the typed list query for listing User.
-type UserListFilter struct {
-	Email    string
-	IsStaff  vent.BoolFilter
-	IsActive vent.BoolFilter
+// afterAuthorUpdate runs the AfterUpdate hook on the Author with id, which
+// was prev before the save.
+func (h *AdminHandler) afterAuthorUpdate(ctx context.Context, prev *ent.Author, id int) {
+	e, err := h.loadAuthor(ctx, id)
+	if err == nil {
+		err = h.schemas.Author.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("Author after update hook: %v", err)
+	}
 }
 
-// getUserListHandler returns the handler for GET /admin/users/
-func (h *AdminHandler) getUserListHandler() http.Handler {
+// deleteAuthorHandler returns the handler for DELETE /admin/authors/{id}/
+func (h *AdminHandler) deleteAuthorHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		filter := UserListFilter{
-			Email:    r.URL.Query().Get("filter.email"),
-			IsStaff:  vent.BoolFilter(r.URL.Query().Get("filter.is_staff")),
-			IsActive: vent.BoolFilter(r.URL.Query().Get("filter.is_active")),
-		}
-		query := h.client.User.Query()
-		if filterVal := filter.Email; filterVal != "" {
-			query = query.Where(user.EmailContainsFold(filterVal))
-		}
-		if v, ok := filter.IsStaff.Bool(); ok {
-			query = query.Where(user.IsStaffEQ(v))
-		}
-		if v, ok := filter.IsActive.Bool(); ok {
-			query = query.Where(user.IsActiveEQ(v))
+		id, err := parseAuthorID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
 		}
 
-		total, err := query.Clone().Count(r.Context())
+		e, err := h.loadAuthor(r.Context(), id)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
-		page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
-		pagination := gui.NewSchemaTablePagination(page)
-
-		rows := []gui.SchemaTableRow{}
-		if vent.IsDatastarRequest(r) {
-			entities, err := h.schemas.User.EagerLoadQuery(query).
-				Order(user.ByID()).
-				Offset(page.Offset()).
-				Limit(page.Limit()).
-				All(r.Context())
-			if err != nil {
-				vent.HandleError(w, r, normalizeError(err))
-				return
-			}
-
-			rows = make([]gui.SchemaTableRow, len(entities))
-			for i, e := range entities {
-				cells := make([]gui.SchemaTableCell, len(h.userFields.listColumns))
-				for j, field := range h.userFields.listColumns {
-					cell := gui.SchemaTableCell{Display: field.ListCell(r.Context(), e)}
-					if j == 0 {
-						cell.LinkURL = fmt.Sprintf("%susers/%d/", requestctx.MustAdminPath(r.Context()), e.ID)
-					}
-					cells[j] = cell
-				}
-				rows[i] = gui.SchemaTableRow{Cells: cells}
-			}
-		}
-
-		canCreate, err := h.schemas.User.CanCreate(r.Context())
-		if err != nil {
+		if err := denyIfCannot(h.schemas.Author.CanDelete(r.Context(), e)); err != nil {
 			vent.HandleError(w, r, err)
 			return
 		}
-		renderCtx := gui.RenderContext{
-			CanCreate: canCreate,
-		}
 
-		props := gui.SchemaTableProps{
-			LayoutProps:         h.buildLayoutProps(r.Context(), "User", gui.SchemaListBreadcrumbs("Users")),
-			RouteName:           "users",
-			SingularDisplayName: "User",
-			PluralDisplayName:   "Users",
-			Columns: []gui.SchemaTableColumn{
-				{Name: "email", Label: "Email", Type: "string"},
-				{Name: "is_staff", Label: "IsStaff", Type: "bool"},
-				{Name: "is_superuser", Label: "IsSuperuser", Type: "bool"},
-				{Name: "is_active", Label: "IsActive", Type: "bool"},
-				{Name: "last_login", Label: "LastLogin", Type: "time.Time"},
-			},
-			FilterableColumns: []gui.SchemaTableFilterableColumn{
-				{Name: "email", Label: "Email", Type: "string", Value: filter.Email},
-				{Name: "is_staff", Label: "IsStaff", Type: "bool", Value: filter.IsStaff.Normalize().String()},
-				{Name: "is_active", Label: "IsActive", Type: "bool", Value: filter.IsActive.Normalize().String()},
-			},
-			Rows:          rows,
-			Pagination:    pagination,
-			Loading:       !vent.IsDatastarRequest(r),
-			RenderContext: renderCtx,
+		if err := h.deleteAuthorRow(r.Context(), e); err != nil {
+			h.patchAuthorDeletePageError(w, r, id, err)
+			return
 		}
 
-		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
-			vent.HandleError(w, r, err)
-		}
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "authors/")
 	})
 }
 
-// buildUserAddPageProps builds the add page props for User.
-func (h *AdminHandler) buildUserAddPageProps(ctx context.Context, errorMessage string) (gui.SchemaEntityAddProps, error) {
-	canCreate, err := h.schemas.User.CanCreate(ctx)
-	if err != nil {
-		return gui.SchemaEntityAddProps{}, err
-	}
-	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canCreate,
-	})
-
-	fields := []gui.SchemaEntityFieldProps{}
-
-	for _, field := range h.userFields.createFormFields {
-		html, err := field.CreateHTML(ctx)
+// ============================================================================
+// Book Handlers
+// ============================================================================
+
+// parseBookID parses a Book ID from a URL path or form value.
+func parseBookID(value string) (int, error) {
+	return vent.ParseID[int](value)
+}
+
+// BookCreateInput is the typed input for creating a Book
+type BookCreateInput struct {
+	Title       string  `json:"title"`
+	Slug        *string `json:"slug"`
+	Cover       *string `json:"cover"`
+	Author      string  `json:"author"`
+	Publisher   string  `json:"publisher"`
+	Pages       *int    `json:"pages"`
+	Format      *string `json:"format"`
+	Published   *bool   `json:"published"`
+	PublishedAt *string `json:"published_at"`
+	Tags        *string `json:"tags"`
+	Editions    *string `json:"editions"`
+	Metadata    *string `json:"metadata"`
+	Notes       string  `json:"notes"`
+}
+
+// BookUpdateInput is the typed input for updating a Book
+type BookUpdateInput struct {
+	Title       *string               `json:"title"`
+	Slug        *string               `json:"slug"`
+	Cover       *string               `json:"cover"`
+	Author      *string               `json:"author"`
+	Publisher   *string               `json:"publisher"`
+	Pages       *int                  `json:"pages"`
+	Format      *string               `json:"format"`
+	Published   *bool                 `json:"published"`
+	PublishedAt OptionalInput[string] `json:"published_at"`
+	Tags        *string               `json:"tags"`
+	Editions    *string               `json:"editions"`
+	Metadata    *string               `json:"metadata"`
+	Notes       *string               `json:"notes"`
+}
+
+// BookListFilter is the typed list query for listing Book.
+type BookListFilter struct {
+	Title           string
+	Author          string
+	Format          string
+	Published       vent.BoolFilter
+	Pages           string
+	PagesMin        string
+	PagesMax        string
+	PublishedAt     string
+	PublishedAtMin  string
+	PublishedAtMax  string
+	PublishedAtNull vent.BoolFilter
+}
+
+// bookListOrders maps Book list sort keys to Ent order options.
+var bookListOrders = map[string]func(...sql.OrderTermOption) book.OrderOption{
+	"cover": book.ByCover,
+	"title": book.ByTitle,
+	"author": func(opts ...sql.OrderTermOption) book.OrderOption {
+		return book.ByAuthorField("id", opts...)
+	},
+	"format":       book.ByFormat,
+	"published":    book.ByPublished,
+	"pages":        book.ByPages,
+	"published_at": book.ByPublishedAt,
+}
+
+// bookDefaultOrdering is the Book list ordering when the request names none.
+var bookDefaultOrdering = []string{"-published_at", "title"}
+
+func bookListSortable(name string) bool {
+	_, ok := bookListOrders[name]
+	return ok
+}
+
+// bookListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func bookListOrder(sorts []vent.ListSort) []book.OrderOption {
+	orders := make([]book.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, bookListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, book.ByID())
+	}
+	return orders
+}
+
+// bookListQuery is the Book list query with a request's filters, search, and sort applied.
+type bookListQuery struct {
+	query  *ent.BookQuery
+	filter BookListFilter
+	search string
+	sorts  []vent.ListSort
+}
+
+// newBookListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newBookListQuery(q url.Values) bookListQuery {
+	filter := BookListFilter{
+		Title:           vent.FilterParam(q, "filter.title"),
+		Author:          vent.FilterParam(q, "filter.author"),
+		Format:          vent.FilterParam(q, "filter.format"),
+		Published:       vent.BoolFilter(q.Get("filter.published")),
+		Pages:           vent.FilterParam(q, "filter.pages"),
+		PagesMin:        q.Get("filter.pages.min"),
+		PagesMax:        q.Get("filter.pages.max"),
+		PublishedAt:     vent.FilterParam(q, "filter.published_at"),
+		PublishedAtMin:  q.Get("filter.published_at.min"),
+		PublishedAtMax:  q.Get("filter.published_at.max"),
+		PublishedAtNull: vent.BoolFilter(q.Get("filter.published_at.null")),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), bookDefaultOrdering, bookListSortable)
+	search := strings.TrimSpace(q.Get("q"))
+	query := h.client.Book.Query()
+	if filterVal := filter.Title; filterVal != "" {
+		query = query.Where(book.TitleContainsFold(filterVal))
+	}
+	if filterVals := vent.FilterValues(filter.Author); len(filterVals) > 0 {
+		ids := make([]int, 0, len(filterVals))
+		for _, filterVal := range filterVals {
+			if id, err := parseAuthorID(filterVal); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > 0 {
+			query = query.Where(book.HasAuthorWith(author.IDIn(ids...)))
+		}
+	}
+	if filterVals := vent.FilterValues(filter.Format); len(filterVals) > 0 {
+		values := make([]book.Format, 0, len(filterVals))
+		for _, filterVal := range filterVals {
+			if v := book.Format(filterVal); book.FormatValidator(v) == nil {
+				values = append(values, v)
+			}
+		}
+		if len(values) > 0 {
+			query = query.Where(book.FormatIn(values...))
+		}
+	}
+	if v, ok := filter.Published.Bool(); ok {
+		query = query.Where(book.PublishedEQ(v))
+	}
+	if values := vent.ParseIntFilterValues[int](filter.Pages); len(values) > 0 {
+		query = query.Where(book.PagesIn(values...))
+	}
+	if v, ok := vent.ParseIntFilter[int](filter.PagesMin); ok {
+		query = query.Where(book.PagesGTE(v))
+	}
+	if v, ok := vent.ParseIntFilter[int](filter.PagesMax); ok {
+		query = query.Where(book.PagesLTE(v))
+	}
+	if from, to := vent.TimeFilterRange(filter.PublishedAt, filter.PublishedAtMin, filter.PublishedAtMax, time.Now()); !from.IsZero() || !to.IsZero() {
+		if !from.IsZero() {
+			query = query.Where(book.PublishedAtGTE(from))
+		}
+		if !to.IsZero() {
+			query = query.Where(book.PublishedAtLT(to))
+		}
+	}
+	if isNull, ok := filter.PublishedAtNull.Bool(); ok {
+		if isNull {
+			query = query.Where(book.PublishedAtIsNil())
+		} else {
+			query = query.Where(book.PublishedAtNotNil())
+		}
+	}
+	if search != "" {
+		query = query.Where(book.Or(
+			book.TitleContainsFold(search),
+			book.HasPublisherWith(publisher.NameContainsFold(search)),
+			book.HasAuthorWith(author.HasUserWith(user.EmailContainsFold(search))),
+		))
+	}
+	return bookListQuery{
+		query:  query,
+		filter: filter,
+		search: search,
+		sorts:  sorts,
+	}
+}
+
+// buildBookListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildBookListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newBookListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+	search := list.search
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedBookActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.Book.EagerLoadQuery(query).
+			Order(bookListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaTableProps{}, err
+		}
+
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.bookFields.listColumns))
+			for j, field := range h.bookFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%sbooks/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.bookEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.Book.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_book")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listBookBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canViewDeleted, err := defaultCan(ctx, "delete_book")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+	filterChoicesAuthor, err := h.loadBookAuthorFilterChoices(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "Book", gui.SchemaListBreadcrumbs("Books")),
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		PluralDisplayName:   "Books",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "cover", Label: "Cover", Type: "image", Sortable: true},
+			{Name: "title", Label: "Title", Type: "string", Sortable: true},
+			{Name: "author", Label: "Author", Type: "edge", Sortable: true},
+			{Name: "format", Label: "Format", Type: "enum", Sortable: true},
+			{Name: "published", Label: "Published", Type: "bool", Sortable: true},
+			{Name: "pages", Label: "Pp.", Type: "int", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "title",
+				Label: "Title",
+				Type:  "string",
+				Value: filter.Title,
+			},
+			{
+				Name:    "author",
+				Label:   "Author",
+				Type:    "edge",
+				Value:   filter.Author,
+				Choices: filterChoicesAuthor,
+			},
+			{
+				Name:    "format",
+				Label:   "Format",
+				Type:    "enum",
+				Value:   filter.Format,
+				Options: []string{"hardcover", "paperback", "ebook", "audiobook"},
+			},
+			{
+				Name:  "published",
+				Label: "Published",
+				Type:  "bool",
+				Value: filter.Published.Normalize().String(),
+			},
+			{
+				Name:  "pages",
+				Label: "Pages",
+				Type:  "int",
+				Value: filter.Pages,
+				Range: true,
+				Min:   filter.PagesMin,
+				Max:   filter.PagesMax,
+			},
+			{
+				Name:     "published_at",
+				Label:    "Publication date",
+				Type:     "time",
+				Value:    filter.PublishedAt,
+				Range:    true,
+				Min:      filter.PublishedAtMin,
+				Max:      filter.PublishedAtMax,
+				Nullable: true,
+				Null:     filter.PublishedAtNull.Normalize().String(),
+			},
+		},
+		Searchable:    true,
+		Search:        search,
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", bookDefaultOrdering, bookListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		Deleted:       canViewDeleted,
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getBookListHandler returns the handler for GET /admin/books/
+func (h *AdminHandler) getBookListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildBookListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// allowedBookActions returns the custom Book actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedBookActions(ctx context.Context) ([]BookAction, error) {
+	var allowed []BookAction
+	for _, action := range h.schemas.Book.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed, nil
+}
+
+// lookupBookAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupBookAction(ctx context.Context, name string) (BookAction, error) {
+	for _, action := range h.schemas.Book.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return BookAction{}, err
+		}
+		return action, nil
+	}
+	return BookAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
+
+// canBookRunAction reports whether action may run on e.
+func (h *AdminHandler) canBookRunAction(ctx context.Context, action BookAction, e *ent.Book) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.Book.CanUpdate(ctx, e)
+}
+
+// bookEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) bookEntityActions(ctx context.Context, allowed []BookAction, e *ent.Book) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canBookRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
+}
+
+// listBookBulkActions returns the bulk actions the current user may run
+// on Book rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listBookBulkActions(ctx context.Context, allowed []BookAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	canDelete, err := defaultCan(ctx, "delete_book")
+	if err != nil {
+		return nil, err
+	}
+	if canDelete {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    vent.BulkActionDelete,
+			Label:   "Delete",
+			Confirm: "Delete the selected Books? This cannot be undone.",
+			Danger:  true,
+		})
+	}
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
+}
+
+// postBookBulkHandler returns the handler for POST /admin/books/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postBookBulkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runBookBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
+
+		props, err := h.buildBookListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runBookBulkAction runs selection.Action on each selected Book,
+// checking permissions per row.
+func (h *AdminHandler) runBookBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.Book.Query()
+	if selection.All {
+		query = h.newBookListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Book")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parseBookID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
+			}
+			ids[i] = id
+		}
+		query = query.Where(book.IDIn(ids...))
+	}
+	entities, err := h.schemas.Book.EagerLoadQuery(query).
+		Order(book.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
+
+	if selection.Action == vent.BulkActionDelete {
+		if err := denyIfCannot(defaultCan(ctx, "delete_book")); err != nil {
+			return vent.BulkResult{}, err
+		}
+		result := vent.BulkResult{Label: "Delete"}
+		for _, e := range entities {
+			result.Record(vent.FormatID(e.ID), h.schemas.Book.Name(e), bulkErrorMessage(h.deleteBookRow(ctx, e)))
+		}
+		return result, nil
+	}
+
+	action, err := h.lookupBookAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canBookRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.Book.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
+
+// postBookActionHandler returns the handler for POST /admin/books/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postBookActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		action, err := h.lookupBookAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		result, runErr := h.runBookAction(r.Context(), action, id)
+
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildBookListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildBookDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildBookPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runBookAction runs action on the Book with id after its row check.
+func (h *AdminHandler) runBookAction(ctx context.Context, action BookAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.Book.EagerLoadQuery(h.client.Book.Query().
+		Where(book.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canBookRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
+	}
+	return action.Run(ctx, e)
+}
+
+// deleteBookRow deletes one Book with the same checks as the delete
+// route. The delete and its audit entry and revision share a transaction.
+func (h *AdminHandler) deleteBookRow(ctx context.Context, e *ent.Book) error {
+	if err := denyIfCannot(h.schemas.Book.CanDelete(ctx, e)); err != nil {
+		return err
+	}
+	if err := h.schemas.Book.ValidateDelete(ctx, e.ID); err != nil {
+		return err
+	}
+	if err := h.checkBookDelete(ctx, e); err != nil {
+		return err
+	}
+	err := h.withTx(ctx, func(ctx context.Context) error {
+		if err := h.schemas.Book.BeforeDelete(ctx, e); err != nil {
+			return err
+		}
+		snapshot, err := h.bookRevisionSnapshot(ctx, e)
+		if err != nil {
+			return err
+		}
+		if err := h.db(ctx).Book.DeleteOneID(e.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if err := h.auditBook(ctx, vent.AuditActionDelete, e, e.ID); err != nil {
+			return err
+		}
+		if err := h.recordRevision(ctx, vent.AuditActionDelete, "Book", e.ID, h.schemas.Book.Name(e), snapshot); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	h.removeBookFiles(ctx, e, nil)
+	if err := h.schemas.Book.AfterDelete(ctx, e); err != nil {
+		log.Printf("Book after delete hook: %v", err)
+	}
+	return nil
+}
+
+// bookDeleteRelations lists the entities a delete of e cascades to, unlinks,
+// or is blocked by, leaving out relations without any.
+func (h *AdminHandler) bookDeleteRelations(ctx context.Context, e *ent.Book) ([]gui.DeleteRelation, error) {
+	adminPath := requestctx.MustAdminPath(ctx)
+	var relations []gui.DeleteRelation
+	if count, err := e.QueryReviews().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.Review.EagerLoadQuery(e.QueryReviews()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_review")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Reviews", Effect: "block", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.Review.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%sreviews/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+// checkBookDelete refuses to delete e while related entities block it or
+// the delete would cascade to entities the user may not delete, instead of
+// leaving it to the database's foreign keys.
+func (h *AdminHandler) checkBookDelete(ctx context.Context, e *ent.Book) error {
+	relations, err := h.bookDeleteRelations(ctx, e)
+	if err != nil {
+		return err
+	}
+	return deleteRelationsError(h.schemas.Book.Name(e), relations)
+}
+
+// buildBookDeletePageProps builds the delete confirmation props for Book.
+func (h *AdminHandler) buildBookDeletePageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityDeleteProps, error) {
+	e, err := h.loadBook(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Book.CanDelete(ctx, e)); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+
+	entityDisplay := h.schemas.Book.Name(e)
+	props := gui.SchemaEntityDeleteProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaDeleteBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "books",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+	}
+	if props.Relations, err = h.bookDeleteRelations(ctx, e); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	return props, nil
+}
+
+// getBookDeleteHandler returns the handler for GET /admin/books/{id}/delete/
+func (h *AdminHandler) getBookDeleteHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildBookDeletePageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityDeletePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) patchBookDeletePageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	props, buildErr := h.buildBookDeletePageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityDeletePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getBookExportHandler returns the handler for GET /admin/books/export/
+func (h *AdminHandler) getBookExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		list := h.newBookListQuery(r.URL.Query())
+		query := h.schemas.Book.EagerLoadQuery(list.query).Order(bookListOrder(list.sorts)...)
+		columns := []string{
+			"Cover",
+			"Title",
+			"Author",
+			"Format",
+			"Published",
+			"Pp.",
+		}
+		vent.ServeExport(w, r, format, "books", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.bookFields.listColumns))
+				for j, field := range h.bookFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
+	})
+}
+
+// loadBookAuthorFilterChoices lists the Author choices for the author list filter.
+func (h *AdminHandler) loadBookAuthorFilterChoices(ctx context.Context) ([]gui.SelectOption, error) {
+	entities, err := h.schemas.Author.EagerLoadQuery(h.client.Author.Query()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	choices := make([]gui.SelectOption, len(entities))
+	for i, entity := range entities {
+		choices[i] = gui.SelectOption{
+			Value: vent.FormatID(entity.ID),
+			Label: h.schemas.Author.Name(entity),
+		}
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].Label < choices[j].Label
+	})
+	return choices, nil
+}
+
+// bookNotCopied labels the add form fields a duplicate leaves for the user
+// to fill in.
+var bookNotCopied = []string{
+	"Cover",
+}
+
+// bookInitialFields are the add form fields a query parameter of the same
+// name may pre-fill.
+var bookInitialFields = []vent.InitialField{
+	{
+		Name: "title",
+		Kind: vent.FieldKind("string"),
+	},
+	{
+		Name: "slug",
+		Kind: vent.FieldKind("string"),
+	},
+	{
+		Name:    "author",
+		Kind:    vent.FieldKind("foreign_key_unique"),
+		CheckID: vent.CheckID[int],
+	},
+	{
+		Name:    "publisher",
+		Kind:    vent.FieldKind("foreign_key_unique"),
+		CheckID: vent.CheckID[uuid.UUID],
+	},
+	{
+		Name: "pages",
+		Kind: vent.FieldKind("int"),
+	},
+	{
+		Name:    "format",
+		Kind:    vent.FieldKind("enum"),
+		Options: []string{"hardcover", "paperback", "ebook", "audiobook"},
+	},
+	{
+		Name: "published",
+		Kind: vent.FieldKind("bool"),
+	},
+	{
+		Name: "published_at",
+		Kind: vent.FieldKind("time"),
+	},
+	{
+		Name: "tags",
+		Kind: vent.FieldKind("strings"),
+	},
+	{
+		Name: "editions",
+		Kind: vent.FieldKind("ints"),
+	},
+	{
+		Name: "metadata",
+		Kind: vent.FieldKind("json"),
+	},
+	{
+		Name: "notes",
+		Kind: vent.FieldKind("string"),
+	},
+}
+
+// buildBookAddPageProps builds the add page props for Book. A non-nil
+// source pre-fills the form with a copy of it.
+func (h *AdminHandler) buildBookAddPageProps(ctx context.Context, source *ent.Book, errorMessage string) (gui.SchemaEntityAddProps, error) {
+	canCreate, err := h.schemas.Book.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityAddProps{}, err
+	}
+	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canCreate,
+	})
+	if source != nil {
+		ctx = withBookDuplicateSource(ctx, source)
+	}
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.bookFields.createFormFieldSets))
+	for _, fieldSet := range h.bookFields.createFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.CreateHTML(ctx)
+			if err != nil {
+				return gui.SchemaEntityAddProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	props := gui.SchemaEntityAddProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaAddBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+			"Book",
+		)),
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		ErrorMessage:        errorMessage,
+		FieldSets:           fieldSets,
+		Tabs:                false,
+		Multipart:           true,
+	}
+	if source != nil {
+		props.DuplicateOf = h.schemas.Book.Name(source)
+		props.NotCopied = bookNotCopied
+	}
+	return props, nil
+}
+
+func (h *AdminHandler) patchBookAddPageError(w http.ResponseWriter, r *http.Request, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildBookAddPageProps(r.Context(), nil, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getBookAddHandler returns the handler for GET /admin/books/add/
+func (h *AdminHandler) getBookAddHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		initial, err := vent.ParseInitialValues(r.URL.Query(), bookInitialFields)
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		ctx := vent.WithInitialValues(r.Context(), initial)
+
+		props, err := h.buildBookAddPageProps(ctx, nil, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(ctx, w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getBookDuplicateHandler returns the handler for GET /admin/books/{id}/duplicate/,
+// the add form pre-filled from the Book with id.
+func (h *AdminHandler) getBookDuplicateHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		source, err := h.loadBook(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Book.CanRead(r.Context(), source)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		props, err := h.buildBookAddPageProps(r.Context(), source, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// buildBookPageProps builds the edit page props for Book.
+func (h *AdminHandler) buildBookPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.Book.EagerLoadQuery(h.client.Book.Query().
+		Where(book.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Book.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canCreate, err := h.schemas.Book.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canUpdate, err := h.schemas.Book.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canDelete, err := h.schemas.Book.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.bookFields.updateFormFieldSets))
+	for _, fieldSet := range h.bookFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedBookActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.bookEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	loaded, err := h.bookRevisionSnapshot(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	original, err := vent.EncodeEditOriginal(loaded)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.Book.Name(e)
+	props := gui.SchemaEntityChangeProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaEntityBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+			entityDisplay,
+		)),
+		RouteName:     "books",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     true,
+		RelatedLists:  bookRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		Version:       bookVersion(e),
+		Original:      original,
+		Duplicate:     canCreate,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// bookRelatedLists returns the lists filtered to one Book
+// that the current user may read, for its change and detail pages.
+func bookRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	if ok, err := defaultCan(ctx, "read_review"); err == nil && ok {
+		list := gui.SchemaEntityRelatedList{
+			Label:      "Reviews by Book",
+			RouteName:  "reviews",
+			FilterName: "book",
+		}
+		if ok, err := MustAdmin(ctx).Review().CanCreate(ctx); err == nil && ok {
+			list.AddLabel = "Add Review"
+		}
+		lists = append(lists, list)
+	}
+	return lists
+}
+
+// buildBookDetailPageProps builds the read-only detail page props for Book.
+func (h *AdminHandler) buildBookDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.Book.EagerLoadQuery(h.client.Book.Query().
+		Where(book.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Book.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.Book.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.Book.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.bookFields.updateFormFieldSets))
+	for _, fieldSet := range h.bookFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedBookActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.bookEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.Book.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"books",
+			"Books",
+			entityDisplay,
+		)),
+		RouteName:     "books",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  bookRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	if ok, err := defaultCan(ctx, "read_review"); err == nil && ok {
+		related, err := h.schemas.Review.EagerLoadQuery(e.QueryReviews()).
+			Limit(vent.DetailRelationLimit + 1).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaEntityDetailProps{}, err
+		}
+		relation := gui.SchemaEntityDetailRelation{
+			Label: "Reviews",
+			More:  len(related) > vent.DetailRelationLimit,
+		}
+		if relation.More {
+			related = related[:vent.DetailRelationLimit]
+		}
+		for _, r := range related {
+			relation.Items = append(relation.Items, gui.SchemaEntityRelatedLink{
+				Label: h.schemas.Review.Name(r),
+				URL:   fmt.Sprintf("%sreviews/%s/", adminPath, vent.FormatIDPath(r.ID)),
+			})
+		}
+		relation.AllURL = fmt.Sprintf("%sreviews/?%s", adminPath, url.Values{"filter.book": {vent.FormatID(id)}}.Encode())
+		props.Relations = append(props.Relations, relation)
+	}
+	return props, nil
+}
+
+// getBookDetailHandler returns the handler for GET /admin/books/{id}/view/
+func (h *AdminHandler) getBookDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		h.renderBookDetailPage(w, r, id)
+	})
+}
+
+func (h *AdminHandler) renderBookDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildBookDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// authorizeBookFile allows the file key stored in the Book upload field
+// to users who can read the Book holding it.
+func (h *AdminHandler) authorizeBookFile(ctx context.Context, field, key string) error {
+	query := h.client.Book.Query()
+	switch field {
+	case "cover":
+		query.Where(book.CoverEQ(key))
+	default:
+		return vent.NotFound("file not found")
+	}
+	if err := denyIfCannot(defaultCan(ctx, "read_book")); err != nil {
+		return err
+	}
+	e, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		return vent.NotFound("file not found")
+	}
+	if err != nil {
+		return err
+	}
+	return denyIfCannot(h.schemas.Book.CanRead(ctx, e))
+}
+
+// removeBookFiles deletes the stored files whose keys prev's upload fields held
+// and e's no longer do, or all of them when e is nil. It runs once the change
+// has committed, so failures are only logged.
+func (h *AdminHandler) removeBookFiles(ctx context.Context, prev, e *ent.Book) {
+	if prev.Cover != "" && (e == nil || e.Cover != prev.Cover) {
+		h.removeBookFile(ctx, "cover", prev.Cover)
+	}
+}
+
+// removeBookFile deletes the stored file key unless another Book, trashed
+// or not, still holds it in field.
+func (h *AdminHandler) removeBookFile(ctx context.Context, field, key string) {
+	if h.fileStorage == nil {
+		return
+	}
+	query := h.client.Book.Query()
+	switch field {
+	case "cover":
+		query.Where(book.CoverEQ(key))
+	}
+	used, err := query.Exist(vent.SkipSoftDelete(ctx))
+	if err == nil && !used {
+		err = h.fileStorage.Delete(ctx, key)
+	}
+	if err != nil {
+		log.Printf("Book remove file %s: %v", key, err)
+	}
+}
+
+// loadBook loads the Book with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadBook(ctx context.Context, id int) (*ent.Book, error) {
+	return h.schemas.Book.EagerLoadQuery(h.db(ctx).Book.Query().
+		Where(book.IDEQ(id))).
+		Only(ctx)
+}
+
+// auditBook records a Book mutation in the audit log, diffing
+// before against the saved Book with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditBook(ctx context.Context, action vent.AuditAction, before *ent.Book, id int) error {
+	var after *ent.Book
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadBook(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
+		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.bookAuditSnapshot(ctx, before), h.bookAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "Book", id, h.schemas.Book.Name(named), changes)
+}
+
+// bookAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) bookAuditSnapshot(ctx context.Context, e *ent.Book) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.bookFields.auditFields))
+	for _, f := range h.bookFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
+
+// buildBookHistoryPageProps builds the History tab props for Book.
+func (h *AdminHandler) buildBookHistoryPageProps(ctx context.Context, id int) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadBook(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Book.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.Book.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("Book"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entityDisplay := h.schemas.Book.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "books",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
+}
+
+// getBookHistoryHandler returns the handler for GET /admin/books/{id}/history/
+func (h *AdminHandler) getBookHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildBookHistoryPageProps(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// bookRevisionFields are the fields a Book revision snapshots, in form order.
+var bookRevisionFields = []string{
+	"title",
+	"slug",
+	"author",
+	"publisher",
+	"pages",
+	"format",
+	"published",
+	"published_at",
+	"tags",
+	"editions",
+	"metadata",
+}
+
+// bookRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) bookRevisionSnapshot(ctx context.Context, e *ent.Book) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	snapshot["title"] = e.Title
+	snapshot["slug"] = e.Slug
+	if snapshot["author"], err = revisionEdgeID(h.db(ctx).Book.QueryAuthor(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	if snapshot["publisher"], err = revisionEdgeID(h.db(ctx).Book.QueryPublisher(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	snapshot["pages"] = e.Pages
+	snapshot["format"] = string(e.Format)
+	snapshot["published"] = e.Published
+	snapshot["published_at"] = nil
+	if e.PublishedAt != nil {
+		snapshot["published_at"] = vent.FormatFormValue(*e.PublishedAt)
+	}
+	snapshot["tags"] = vent.FormatListFormValue(e.Tags)
+	snapshot["editions"] = vent.FormatListFormValue(e.Editions)
+	snapshot["metadata"] = vent.FormatJSONFormValue(e.Metadata)
+	return snapshot, nil
+}
+
+// buildBookRevisionsPageProps builds the Revisions tab props for the Book
+// with id, comparing the revisions from and to. A deleted Book is shown
+// from its revisions.
+func (h *AdminHandler) buildBookRevisionsPageProps(ctx context.Context, id int, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Book"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "books",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadBook(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		canCreate, err := h.schemas.Book.CanCreate(ctx)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = canCreate
+		props.LayoutProps = h.buildLayoutProps(ctx, "Book", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.Book.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.Book.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.bookRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.Book.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "Book", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%sbooks/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(bookRevisionFields, fromSnapshot, toSnapshot)
+	}
+	return props, nil
+}
+
+// getBookRevisionsHandler returns the handler for GET /admin/books/{id}/revisions/
+func (h *AdminHandler) getBookRevisionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		query := r.URL.Query()
+		props, err := h.buildBookRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postBookRestoreHandler returns the handler for POST /admin/books/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postBookRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
+			return
+		}
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("Book"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		var (
+			e     *ent.Book
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restoreBookRevision(ctx, id, rev)
+			return err
+		})
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%sbooks/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
+	})
+}
+
+// restoreBookRevision replays rev onto the Book with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the Book as it was before. A deleted Book is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restoreBookRevision(ctx context.Context, id int, rev *ent.Revision) (*ent.Book, func(context.Context), error) {
+	e, err := h.loadBook(ctx, id)
+	if ent.IsNotFound(err) {
+		e, err := h.recreateBook(ctx, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, func(ctx context.Context) {
+			h.afterBookCreate(ctx, e.ID)
+		}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_book")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.Book.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
+	}
+
+	var input BookUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	if err := h.updateBook(ctx, e, input, e.Version); err != nil {
+		return nil, nil, err
+	}
+	return e, func(ctx context.Context) {
+		h.afterBookUpdate(ctx, e, id)
+	}, nil
+}
+
+// recreateBook recreates a deleted Book from rev in ctx's transaction,
+// through the same create path as the add form. The new Book gets a new ID,
+// which is recorded on the deleted entity's revisions.
+func (h *AdminHandler) recreateBook(ctx context.Context, rev *ent.Revision) (*ent.Book, error) {
+	deleted := revision.And(
+		revision.SchemaEQ(rev.Schema),
+		revision.EntityIDEQ(rev.EntityID),
+		revision.ActionEQ(revision.ActionDelete),
+	)
+	restored, err := h.db(ctx).Revision.Query().
+		Where(deleted, revision.RestoredIDNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restored {
+		return nil, vent.BadRequest("this book has already been restored")
+	}
+	if err := denyIfCannot(h.schemas.Book.CanCreate(ctx)); err != nil {
+		return nil, err
+	}
+
+	var input BookCreateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	e, err := h.createBook(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db(ctx).Revision.Update().
+		Where(deleted).
+		SetRestoredID(vent.FormatID(e.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record restored revision: %w", err)
+	}
+	return e, nil
+}
+
+// buildBookDeletedPageProps lists the deleted Book entities that have
+// not been restored, newest first.
+func (h *AdminHandler) buildBookDeletedPageProps(ctx context.Context) (gui.SchemaDeletedProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Book"),
+			revision.ActionEQ(revision.ActionDelete),
+			revision.RestoredIDIsNil(),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaDeletedProps{}, err
+	}
+
+	props := gui.SchemaDeletedProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaDeletedBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+		)),
+		RouteName:         "books",
+		PluralDisplayName: "Books",
+		Truncated:         len(revs) > vent.RevisionLimit,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+	for _, rev := range revs {
+		props.Entries = append(props.Entries, gui.DeletedEntry{
+			EntityID:      rev.EntityID,
+			EntityDisplay: rev.EntityName,
+			At:            rev.CreatedAt,
+			Actor:         rev.Actor,
+		})
+	}
+	return props, nil
+}
+
+// getBookDeletedHandler returns the handler for GET /admin/books/deleted/
+func (h *AdminHandler) getBookDeletedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildBookDeletedPageProps(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaDeletedPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getBookHandler returns the handler for GET /admin/books/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getBookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadBook(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.Book.CanUpdate(r.Context(), e)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if !canUpdate {
+			h.renderBookDetailPage(w, r, id)
+			return
+		}
+
+		props, err := h.buildBookPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+func (h *AdminHandler) patchBookPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildBookPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// postBookHandler returns the handler for POST /admin/books/
+func (h *AdminHandler) postBookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Entity BookCreateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchBookAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+		r = r.WithContext(vent.WithUploadForm(r.Context(), r.MultipartForm))
+
+		var id int
+		err := h.withTx(r.Context(), func(ctx context.Context) error {
+			e, err := h.createBook(ctx, input)
+			if err != nil {
+				return err
+			}
+			id = e.ID
+			return nil
+		})
+		if err != nil {
+			h.patchBookAddPageError(w, r, err)
+			return
+		}
+		h.afterBookCreate(r.Context(), id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
+	})
+}
+
+// createBook saves input as a new Book in ctx's transaction:
+// ValidateCreate, the create fields, BeforeCreate and the audit log entry.
+func (h *AdminHandler) createBook(ctx context.Context, input BookCreateInput) (*ent.Book, error) {
+	if err := h.schemas.Book.ValidateCreate(ctx, input); err != nil {
+		return nil, err
+	}
+
+	builder := h.db(ctx).Book.Create()
+
+	for _, field := range h.bookFields.createBindFields {
+		if err := field.ApplyCreate(ctx, builder, input); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.schemas.Book.BeforeCreate(ctx, builder); err != nil {
+		return nil, err
+	}
+
+	e, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.auditBook(ctx, vent.AuditActionCreate, nil, e.ID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// afterBookCreate runs the AfterCreate hook on the Book saved with id.
+func (h *AdminHandler) afterBookCreate(ctx context.Context, id int) {
+	e, err := h.loadBook(ctx, id)
+	if err == nil {
+		err = h.schemas.Book.AfterCreate(ctx, e)
+	}
+	if err != nil {
+		log.Printf("Book after create hook: %v", err)
+	}
+}
+
+// bookImportColumns are the Book fields an import file can fill.
+var bookImportColumns = []vent.ImportColumn{
+	{Name: "title", Label: "Title"},
+	{Name: "slug", Label: "Slug"},
+	{Name: "author", Label: "Author"},
+	{Name: "publisher", Label: "Publisher"},
+	{Name: "pages", Label: "Pages"},
+	{Name: "format", Label: "Format"},
+	{Name: "published", Label: "Published"},
+	{Name: "published_at", Label: "Publication date"},
+	{Name: "tags", Label: "Tags"},
+	{Name: "editions", Label: "Editions"},
+	{Name: "metadata", Label: "Metadata"},
+	{Name: "notes", Label: "Notes"},
+}
+
+// buildBookImportPageProps builds the import page props for Book.
+func (h *AdminHandler) buildBookImportPageProps(ctx context.Context) gui.SchemaImportProps {
+	props := gui.SchemaImportProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Book", gui.SchemaImportBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"books",
+			"Books",
+		)),
+		RouteName:         "books",
+		PluralDisplayName: "Books",
+		Columns: []gui.SchemaImportColumn{
+			{Name: "title", Label: "Title"},
+			{Name: "slug", Label: "Slug"},
+			{Name: "author", Label: "Author", Hint: "ID"},
+			{Name: "publisher", Label: "Publisher", Hint: "ID"},
+			{Name: "pages", Label: "Pages"},
+			{Name: "format", Label: "Format"},
+			{Name: "published", Label: "Published"},
+			{Name: "published_at", Label: "Publication date"},
+			{Name: "tags", Label: "Tags"},
+			{Name: "editions", Label: "Editions"},
+			{Name: "metadata", Label: "Metadata"},
+			{Name: "notes", Label: "Notes"},
+		},
+	}
+	return props
+}
+
+// getBookImportHandler returns the handler for GET /admin/books/import/
+func (h *AdminHandler) getBookImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := gui.SchemaImportPage(h.buildBookImportPageProps(r.Context())).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postBookImportHandler returns the handler for POST /admin/books/import/.
+// With ?dry_run=1 the rows are saved and then rolled back, so the preview
+// reports the same errors a real import would.
+func (h *AdminHandler) postBookImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props := gui.SchemaImportResultProps{
+			RouteName:         "books",
+			PluralDisplayName: "Books",
+		}
+		result, err := h.importBookFile(r, r.URL.Query().Get("dry_run") != "")
+		if err != nil {
+			props.ErrorMessage = normalizeError(err).PublicMessage()
+		} else {
+			props.Result = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.PatchElementTempl(gui.SchemaImportResult(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) importBookFile(r *http.Request, dryRun bool) (vent.ImportResult, error) {
+	file, err := vent.ReadImportUpload(r, "file")
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	mapping := vent.MapImportColumns(file.Headers, bookImportColumns)
+	if strings.Join(mapping, "") == "" {
+		return vent.ImportResult{}, vent.BadRequest("no column in the file matches a Book field")
+	}
+	upsert := r.FormValue("upsert")
+	if upsert != "" {
+		return vent.ImportResult{}, vent.BadRequest(fmt.Sprintf("cannot upsert on %q", upsert))
+	}
+
+	result := vent.NewImportResult(file, mapping, bookImportColumns, dryRun)
+	var afterHooks []func(context.Context)
+	err = h.withTx(r.Context(), func(ctx context.Context) error {
+		for _, row := range file.Rows {
+			// Each row gets a savepoint, so a failed row does not abort the
+			// transaction for the rows after it.
+			action := vent.ImportActionCreate
+			err := withSavepoint(ctx, func(ctx context.Context) error {
+				var (
+					after func(context.Context)
+					err   error
+				)
+				action, after, err = h.importBookRow(ctx, row.Fields(mapping), upsert)
+				if err == nil {
+					afterHooks = append(afterHooks, after)
+				}
+				return err
+			})
+			message := ""
+			if err != nil {
+				message = normalizeError(err).PublicMessage()
+			}
+			result.Record(row, mapping, action, message)
+		}
+		if dryRun || result.Failed > 0 {
+			return errRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		return result, nil
+	}
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	result.Committed = true
+	for _, after := range afterHooks {
+		after(r.Context())
+	}
+	return result, nil
+}
+
+// importBookRow saves one import row in ctx's transaction through the
+// same path as the add form. The returned func
+// runs the After hook once the import commits.
+func (h *AdminHandler) importBookRow(ctx context.Context, values map[string]string, upsert string) (vent.ImportAction, func(context.Context), error) {
+	client := h.db(ctx)
+	var input BookCreateInput
+	if err := vent.DecodeImportValues(values, &input); err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	if input.Author != "" {
+		id, err := resolveAuthorImportRef(ctx, client, input.Author)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.Author = id
+	}
+	if input.Publisher != "" {
+		id, err := resolvePublisherImportRef(ctx, client, input.Publisher)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.Publisher = id
+	}
+
+	e, err := h.createBook(ctx, input)
+	if err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	return vent.ImportActionCreate, func(ctx context.Context) {
+		h.afterBookCreate(ctx, e.ID)
+	}, nil
+}
+
+// resolveBookImportRef returns the ID of the Book an import
+// cell references, by ID.
+func resolveBookImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parseBookID(ref); err == nil {
+		exists, err := client.Book.Query().Where(book.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Book matches %q", ref))
+}
+
+// patchBookHandler returns the handler for PATCH /admin/books/{id}/
+func (h *AdminHandler) patchBookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadBook(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Book.CanUpdate(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		var signals struct {
+			Entity   BookUpdateInput `json:"entity"`
+			Version  string          `json:"entityVersion"`
+			Original string          `json:"entityOriginal"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchBookPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+		version, err := parseBookVersion(signals.Version)
+		if err != nil {
+			h.patchBookPageError(w, r, id, vent.BadRequest("invalid version").WithCause(err))
+			return
+		}
+		r = r.WithContext(vent.WithUploadForm(r.Context(), r.MultipartForm))
+
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updateBook(ctx, e, input, version)
+		})
+		if errors.Is(err, errBookEditConflict) {
+			h.patchBookConflict(w, r, id, signals.Original, input)
+			return
+		}
+		if err != nil {
+			h.patchBookPageError(w, r, id, err)
+			return
+		}
+		h.afterBookUpdate(r.Context(), e, id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
+	})
+}
+
+// errBookEditConflict is returned by updateBook when the Book
+// moved past the version the save was based on.
+var errBookEditConflict = vent.Conflict("this Book was changed by someone else")
+
+// updateBook saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+// The save only matches while e is still at version.
+func (h *AdminHandler) updateBook(ctx context.Context, e *ent.Book, input BookUpdateInput, version int) error {
+	if err := h.schemas.Book.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
+
+	snapshot, err := h.bookRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	builder := h.db(ctx).Book.UpdateOneID(e.ID)
+	builder.Where(book.VersionEQ(version))
+	advanceBookVersion(builder)
+
+	for _, field := range h.bookFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
+		}
+	}
+	if err := h.schemas.Book.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errBookEditConflict
+		}
+		return err
+	}
+	if err := h.auditBook(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "Book", e.ID, h.schemas.Book.Name(e), snapshot)
+}
+
+// afterBookUpdate runs the AfterUpdate hook on the Book with id, which
+// was prev before the save, after deleting the files the save replaced or
+// cleared.
+func (h *AdminHandler) afterBookUpdate(ctx context.Context, prev *ent.Book, id int) {
+	e, err := h.loadBook(ctx, id)
+	if err == nil {
+		h.removeBookFiles(ctx, prev, e)
+		err = h.schemas.Book.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("Book after update hook: %v", err)
+	}
+}
+
+// bookVersion formats e's version for the change form.
+func bookVersion(e *ent.Book) string {
+	return strconv.FormatInt(int64(e.Version), 10)
+}
+
+// parseBookVersion parses the version a change form was loaded at.
+func parseBookVersion(value string) (int, error) {
+	version, err := strconv.ParseInt(value, 10, 64)
+	return int(version), err
+}
+
+// advanceBookVersion moves version on, so change forms loaded before the
+// save conflict with it.
+func advanceBookVersion(builder *ent.BookUpdateOne) {
+	builder.AddVersion(1)
+}
+
+// patchBookConflict shows a change form whose save lost to someone else's
+// edit which fields they changed since original, the form's values when it
+// loaded, and which ones the user changed, leaving the user's values on the
+// form to overwrite with or discard.
+func (h *AdminHandler) patchBookConflict(w http.ResponseWriter, r *http.Request, id int, original string, input BookUpdateInput) {
+	e, err := h.loadBook(r.Context(), id)
+	if err != nil {
+		h.patchBookPageError(w, r, id, err)
+		return
+	}
+	saved, err := h.bookRevisionSnapshot(r.Context(), e)
+	if err != nil {
+		h.patchBookPageError(w, r, id, err)
+		return
+	}
+	fields, err := vent.EditConflictDiff(bookRevisionFields, original, saved, input)
+	if err != nil {
+		h.patchBookPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+		return
+	}
+	current, err := vent.EncodeEditOriginal(saved)
+	if err != nil {
+		h.patchBookPageError(w, r, id, err)
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if err := sse.PatchElementTempl(gui.SchemaEntityConflict(gui.SchemaEntityConflictProps{
+		RouteName: "books",
+		EntityID:  vent.FormatID(id),
+		Multipart: true,
+		Version:   bookVersion(e),
+		Original:  current,
+		Fields:    fields,
+	})); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// deleteBookHandler returns the handler for DELETE /admin/books/{id}/
+func (h *AdminHandler) deleteBookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseBookID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadBook(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Book.CanDelete(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		if err := h.deleteBookRow(r.Context(), e); err != nil {
+			h.patchBookDeletePageError(w, r, id, err)
+			return
+		}
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "books/")
+	})
+}
+
+// ============================================================================
+// Permission Handlers
+// ============================================================================
+
+// parsePermissionID parses a Permission ID from a URL path or form value.
+func parsePermissionID(value string) (int, error) {
+	return vent.ParseID[int](value)
+}
+
+// PermissionCreateInput is the typed input for creating a Permission
+type PermissionCreateInput struct {
+	Groups []string `json:"groups"`
+}
+
+// PermissionUpdateInput is the typed input for updating a Permission
+type PermissionUpdateInput struct {
+	Groups *[]string `json:"groups"`
+}
+
+// PermissionListFilter is the typed list query for listing Permission.
+type PermissionListFilter struct {
+	Name string
+}
+
+// permissionListOrders maps Permission list sort keys to Ent order options.
+var permissionListOrders = map[string]func(...sql.OrderTermOption) permission.OrderOption{
+	"name":   permission.ByName,
+	"groups": permission.ByGroupsCount,
+}
+
+// permissionDefaultOrdering is the Permission list ordering when the request names none.
+var permissionDefaultOrdering = []string{}
+
+func permissionListSortable(name string) bool {
+	_, ok := permissionListOrders[name]
+	return ok
+}
+
+// permissionListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func permissionListOrder(sorts []vent.ListSort) []permission.OrderOption {
+	orders := make([]permission.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, permissionListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, permission.ByID())
+	}
+	return orders
+}
+
+// permissionListQuery is the Permission list query with a request's filters, search, and sort applied.
+type permissionListQuery struct {
+	query  *ent.PermissionQuery
+	filter PermissionListFilter
+	search string
+	sorts  []vent.ListSort
+}
+
+// newPermissionListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newPermissionListQuery(q url.Values) permissionListQuery {
+	filter := PermissionListFilter{
+		Name: vent.FilterParam(q, "filter.name"),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), permissionDefaultOrdering, permissionListSortable)
+	search := strings.TrimSpace(q.Get("q"))
+	query := h.client.Permission.Query()
+	if filterVal := filter.Name; filterVal != "" {
+		query = query.Where(permission.NameContainsFold(filterVal))
+	}
+	if search != "" {
+		query = query.Where(permission.Or(
+			permission.NameContainsFold(search),
+		))
+	}
+	return permissionListQuery{
+		query:  query,
+		filter: filter,
+		search: search,
+		sorts:  sorts,
+	}
+}
+
+// buildPermissionListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildPermissionListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newPermissionListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+	search := list.search
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedPermissionActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.Permission.EagerLoadQuery(query).
+			Order(permissionListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaTableProps{}, err
+		}
+
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.permissionFields.listColumns))
+			for j, field := range h.permissionFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%spermissions/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.permissionEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.Permission.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_permission")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listPermissionBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "Permission", gui.SchemaListBreadcrumbs("Permissions")),
+		RouteName:           "permissions",
+		SingularDisplayName: "Permission",
+		PluralDisplayName:   "Permissions",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "name", Label: "Name", Type: "string", Sortable: true},
+			{Name: "groups", Label: "Groups", Type: "edge", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "name",
+				Label: "Name",
+				Type:  "string",
+				Value: filter.Name,
+			},
+		},
+		Searchable:    true,
+		Search:        search,
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", permissionDefaultOrdering, permissionListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getPermissionListHandler returns the handler for GET /admin/permissions/
+func (h *AdminHandler) getPermissionListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildPermissionListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// allowedPermissionActions returns the custom Permission actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedPermissionActions(ctx context.Context) ([]PermissionAction, error) {
+	var allowed []PermissionAction
+	for _, action := range h.schemas.Permission.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed, nil
+}
+
+// lookupPermissionAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupPermissionAction(ctx context.Context, name string) (PermissionAction, error) {
+	for _, action := range h.schemas.Permission.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return PermissionAction{}, err
+		}
+		return action, nil
+	}
+	return PermissionAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
+
+// canPermissionRunAction reports whether action may run on e.
+func (h *AdminHandler) canPermissionRunAction(ctx context.Context, action PermissionAction, e *ent.Permission) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.Permission.CanUpdate(ctx, e)
+}
+
+// permissionEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) permissionEntityActions(ctx context.Context, allowed []PermissionAction, e *ent.Permission) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canPermissionRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
+}
+
+// listPermissionBulkActions returns the bulk actions the current user may run
+// on Permission rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listPermissionBulkActions(ctx context.Context, allowed []PermissionAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
+}
+
+// postPermissionBulkHandler returns the handler for POST /admin/permissions/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postPermissionBulkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runPermissionBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
+
+		props, err := h.buildPermissionListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runPermissionBulkAction runs selection.Action on each selected Permission,
+// checking permissions per row.
+func (h *AdminHandler) runPermissionBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.Permission.Query()
+	if selection.All {
+		query = h.newPermissionListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Permission")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parsePermissionID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
+			}
+			ids[i] = id
+		}
+		query = query.Where(permission.IDIn(ids...))
+	}
+	entities, err := h.schemas.Permission.EagerLoadQuery(query).
+		Order(permission.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
+
+	action, err := h.lookupPermissionAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canPermissionRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.Permission.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
+
+// postPermissionActionHandler returns the handler for POST /admin/permissions/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postPermissionActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		action, err := h.lookupPermissionAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		result, runErr := h.runPermissionAction(r.Context(), action, id)
+
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildPermissionListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildPermissionDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permissions/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildPermissionPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permissions/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runPermissionAction runs action on the Permission with id after its row check.
+func (h *AdminHandler) runPermissionAction(ctx context.Context, action PermissionAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.Permission.EagerLoadQuery(h.client.Permission.Query().
+		Where(permission.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canPermissionRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
+	}
+	return action.Run(ctx, e)
+}
+
+// getPermissionExportHandler returns the handler for GET /admin/permissions/export/
+func (h *AdminHandler) getPermissionExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		list := h.newPermissionListQuery(r.URL.Query())
+		query := h.schemas.Permission.EagerLoadQuery(list.query).Order(permissionListOrder(list.sorts)...)
+		columns := []string{
+			"Name",
+			"Groups",
+		}
+		vent.ServeExport(w, r, format, "permissions", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.permissionFields.listColumns))
+				for j, field := range h.permissionFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
+	})
+}
+
+// buildPermissionPageProps builds the edit page props for Permission.
+func (h *AdminHandler) buildPermissionPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.Permission.EagerLoadQuery(h.client.Permission.Query().
+		Where(permission.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Permission.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canCreate, err := h.schemas.Permission.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canUpdate, err := h.schemas.Permission.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canDelete, err := h.schemas.Permission.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.permissionFields.updateFormFieldSets))
+	for _, fieldSet := range h.permissionFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedPermissionActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.permissionEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.Permission.Name(e)
+	props := gui.SchemaEntityChangeProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Permission", gui.SchemaEntityBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permissions",
+			"Permissions",
+			entityDisplay,
+		)),
+		RouteName:     "permissions",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     false,
+		RelatedLists:  permissionRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// permissionRelatedLists returns the lists filtered to one Permission
+// that the current user may read, for its change and detail pages.
+func permissionRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	return lists
+}
+
+// buildPermissionDetailPageProps builds the read-only detail page props for Permission.
+func (h *AdminHandler) buildPermissionDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.Permission.EagerLoadQuery(h.client.Permission.Query().
+		Where(permission.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Permission.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.Permission.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.Permission.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.permissionFields.updateFormFieldSets))
+	for _, fieldSet := range h.permissionFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedPermissionActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.permissionEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.Permission.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Permission", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"permissions",
+			"Permissions",
+			entityDisplay,
+		)),
+		RouteName:     "permissions",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  permissionRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// getPermissionDetailHandler returns the handler for GET /admin/permissions/{id}/view/
+func (h *AdminHandler) getPermissionDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		h.renderPermissionDetailPage(w, r, id)
+	})
+}
+
+func (h *AdminHandler) renderPermissionDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildPermissionDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// loadPermission loads the Permission with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadPermission(ctx context.Context, id int) (*ent.Permission, error) {
+	return h.schemas.Permission.EagerLoadQuery(h.db(ctx).Permission.Query().
+		Where(permission.IDEQ(id))).
+		Only(ctx)
+}
+
+// auditPermission records a Permission mutation in the audit log, diffing
+// before against the saved Permission with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditPermission(ctx context.Context, action vent.AuditAction, before *ent.Permission, id int) error {
+	var after *ent.Permission
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadPermission(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
+		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.permissionAuditSnapshot(ctx, before), h.permissionAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "Permission", id, h.schemas.Permission.Name(named), changes)
+}
+
+// permissionAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) permissionAuditSnapshot(ctx context.Context, e *ent.Permission) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.permissionFields.auditFields))
+	for _, f := range h.permissionFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
+
+// buildPermissionHistoryPageProps builds the History tab props for Permission.
+func (h *AdminHandler) buildPermissionHistoryPageProps(ctx context.Context, id int) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadPermission(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Permission.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.Permission.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("Permission"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entityDisplay := h.schemas.Permission.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Permission", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permissions",
+			"Permissions",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "permissions",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
+}
+
+// getPermissionHistoryHandler returns the handler for GET /admin/permissions/{id}/history/
+func (h *AdminHandler) getPermissionHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildPermissionHistoryPageProps(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// permissionRevisionFields are the fields a Permission revision snapshots, in form order.
+var permissionRevisionFields = []string{
+	"groups",
+}
+
+// permissionRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) permissionRevisionSnapshot(ctx context.Context, e *ent.Permission) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	if snapshot["groups"], err = revisionEdgeIDs(h.db(ctx).Permission.QueryGroups(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// buildPermissionRevisionsPageProps builds the Revisions tab props for the Permission
+// with id, comparing the revisions from and to. A deleted Permission is shown
+// from its revisions.
+func (h *AdminHandler) buildPermissionRevisionsPageProps(ctx context.Context, id int, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Permission"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "permissions",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadPermission(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		props.LayoutProps = h.buildLayoutProps(ctx, "Permission", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permissions",
+			"Permissions",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.Permission.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.Permission.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.permissionRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.Permission.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "Permission", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permissions",
+			"Permissions",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%spermissions/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(permissionRevisionFields, fromSnapshot, toSnapshot)
+	}
+	return props, nil
+}
+
+// getPermissionRevisionsHandler returns the handler for GET /admin/permissions/{id}/revisions/
+func (h *AdminHandler) getPermissionRevisionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		query := r.URL.Query()
+		props, err := h.buildPermissionRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postPermissionRestoreHandler returns the handler for POST /admin/permissions/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postPermissionRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
+			return
+		}
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("Permission"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		var (
+			e     *ent.Permission
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restorePermissionRevision(ctx, id, rev)
+			return err
+		})
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%spermissions/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
+	})
+}
+
+// restorePermissionRevision replays rev onto the Permission with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the Permission as it was before. A deleted Permission is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restorePermissionRevision(ctx context.Context, id int, rev *ent.Revision) (*ent.Permission, func(context.Context), error) {
+	e, err := h.loadPermission(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, nil, vent.BadRequest("a deleted permission cannot be recreated")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_permission")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.Permission.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
+	}
+
+	var input PermissionUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	if err := h.updatePermission(ctx, e, input); err != nil {
+		return nil, nil, err
+	}
+	return e, func(ctx context.Context) {
+		h.afterPermissionUpdate(ctx, e, id)
+	}, nil
+}
+
+// getPermissionHandler returns the handler for GET /admin/permissions/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getPermissionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadPermission(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.Permission.CanUpdate(r.Context(), e)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if !canUpdate {
+			h.renderPermissionDetailPage(w, r, id)
+			return
+		}
+
+		props, err := h.buildPermissionPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+func (h *AdminHandler) patchPermissionPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildPermissionPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// resolvePermissionImportRef returns the ID of the Permission an import
+// cell references, by ID or by name.
+func resolvePermissionImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parsePermissionID(ref); err == nil {
+		exists, err := client.Permission.Query().Where(permission.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	id, err := client.Permission.Query().Where(permission.NameEQ(ref)).OnlyID(ctx)
+	if err == nil {
+		return vent.FormatID(id), nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", err
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Permission matches %q", ref))
+}
+
+// patchPermissionHandler returns the handler for PATCH /admin/permissions/{id}/
+func (h *AdminHandler) patchPermissionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadPermission(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Permission.CanUpdate(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		var signals struct {
+			Entity PermissionUpdateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchPermissionPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updatePermission(ctx, e, input)
+		})
+		if err != nil {
+			h.patchPermissionPageError(w, r, id, err)
+			return
+		}
+		h.afterPermissionUpdate(r.Context(), e, id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permissions/")
+	})
+}
+
+// updatePermission saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+func (h *AdminHandler) updatePermission(ctx context.Context, e *ent.Permission, input PermissionUpdateInput) error {
+	if err := h.schemas.Permission.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
+
+	snapshot, err := h.permissionRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	builder := h.db(ctx).Permission.UpdateOneID(e.ID)
+
+	for _, field := range h.permissionFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
+		}
+	}
+	if err := h.schemas.Permission.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	if err := h.auditPermission(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "Permission", e.ID, h.schemas.Permission.Name(e), snapshot)
+}
+
+// afterPermissionUpdate runs the AfterUpdate hook on the Permission with id, which
+// was prev before the save.
+func (h *AdminHandler) afterPermissionUpdate(ctx context.Context, prev *ent.Permission, id int) {
+	e, err := h.loadPermission(ctx, id)
+	if err == nil {
+		err = h.schemas.Permission.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("Permission after update hook: %v", err)
+	}
+}
+
+// ============================================================================
+// PermissionGroup Handlers
+// ============================================================================
+
+// parsePermissionGroupID parses a PermissionGroup ID from a URL path or form value.
+func parsePermissionGroupID(value string) (int, error) {
+	return vent.ParseID[int](value)
+}
+
+// PermissionGroupCreateInput is the typed input for creating a PermissionGroup
+type PermissionGroupCreateInput struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// PermissionGroupUpdateInput is the typed input for updating a PermissionGroup
+type PermissionGroupUpdateInput struct {
+	Name        *string   `json:"name"`
+	Permissions *[]string `json:"permissions"`
+}
+
+// PermissionGroupListFilter is the typed list query for listing PermissionGroup.
+type PermissionGroupListFilter struct {
+	Name string
+}
+
+// permissiongroupListOrders maps PermissionGroup list sort keys to Ent order options.
+var permissiongroupListOrders = map[string]func(...sql.OrderTermOption) permissiongroup.OrderOption{
+	"name": permissiongroup.ByName,
+}
+
+// permissiongroupDefaultOrdering is the PermissionGroup list ordering when the request names none.
+var permissiongroupDefaultOrdering = []string{}
+
+func permissiongroupListSortable(name string) bool {
+	_, ok := permissiongroupListOrders[name]
+	return ok
+}
+
+// permissiongroupListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func permissiongroupListOrder(sorts []vent.ListSort) []permissiongroup.OrderOption {
+	orders := make([]permissiongroup.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, permissiongroupListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, permissiongroup.ByID())
+	}
+	return orders
+}
+
+// permissiongroupListQuery is the PermissionGroup list query with a request's filters, search, and sort applied.
+type permissiongroupListQuery struct {
+	query  *ent.PermissionGroupQuery
+	filter PermissionGroupListFilter
+	search string
+	sorts  []vent.ListSort
+}
+
+// newPermissionGroupListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newPermissionGroupListQuery(q url.Values) permissiongroupListQuery {
+	filter := PermissionGroupListFilter{
+		Name: vent.FilterParam(q, "filter.name"),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), permissiongroupDefaultOrdering, permissiongroupListSortable)
+	search := strings.TrimSpace(q.Get("q"))
+	query := h.client.PermissionGroup.Query()
+	if filterVal := filter.Name; filterVal != "" {
+		query = query.Where(permissiongroup.NameContainsFold(filterVal))
+	}
+	if search != "" {
+		query = query.Where(permissiongroup.Or(
+			permissiongroup.NameContainsFold(search),
+		))
+	}
+	return permissiongroupListQuery{
+		query:  query,
+		filter: filter,
+		search: search,
+		sorts:  sorts,
+	}
+}
+
+// buildPermissionGroupListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildPermissionGroupListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newPermissionGroupListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+	search := list.search
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedPermissionGroupActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.PermissionGroup.EagerLoadQuery(query).
+			Order(permissiongroupListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaTableProps{}, err
+		}
+
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.permissionGroupFields.listColumns))
+			for j, field := range h.permissionGroupFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%spermission-groups/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.permissiongroupEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.PermissionGroup.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_permission_group")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listPermissionGroupBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canViewDeleted, err := defaultCan(ctx, "delete_permission_group")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaListBreadcrumbs("Permission Groups")),
+		RouteName:           "permission-groups",
+		SingularDisplayName: "Permission Group",
+		PluralDisplayName:   "Permission Groups",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "name", Label: "Name", Type: "string", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "name",
+				Label: "Name",
+				Type:  "string",
+				Value: filter.Name,
+			},
+		},
+		Searchable:    true,
+		Search:        search,
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", permissiongroupDefaultOrdering, permissiongroupListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		Deleted:       canViewDeleted,
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getPermissionGroupListHandler returns the handler for GET /admin/permissiongroups/
+func (h *AdminHandler) getPermissionGroupListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildPermissionGroupListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// allowedPermissionGroupActions returns the custom PermissionGroup actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedPermissionGroupActions(ctx context.Context) ([]PermissionGroupAction, error) {
+	var allowed []PermissionGroupAction
+	for _, action := range h.schemas.PermissionGroup.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed, nil
+}
+
+// lookupPermissionGroupAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupPermissionGroupAction(ctx context.Context, name string) (PermissionGroupAction, error) {
+	for _, action := range h.schemas.PermissionGroup.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return PermissionGroupAction{}, err
+		}
+		return action, nil
+	}
+	return PermissionGroupAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
+
+// canPermissionGroupRunAction reports whether action may run on e.
+func (h *AdminHandler) canPermissionGroupRunAction(ctx context.Context, action PermissionGroupAction, e *ent.PermissionGroup) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.PermissionGroup.CanUpdate(ctx, e)
+}
+
+// permissiongroupEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) permissiongroupEntityActions(ctx context.Context, allowed []PermissionGroupAction, e *ent.PermissionGroup) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canPermissionGroupRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
+}
+
+// listPermissionGroupBulkActions returns the bulk actions the current user may run
+// on PermissionGroup rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listPermissionGroupBulkActions(ctx context.Context, allowed []PermissionGroupAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	canDelete, err := defaultCan(ctx, "delete_permission_group")
+	if err != nil {
+		return nil, err
+	}
+	if canDelete {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    vent.BulkActionDelete,
+			Label:   "Delete",
+			Confirm: "Delete the selected Permission Groups? This cannot be undone.",
+			Danger:  true,
+		})
+	}
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
+}
+
+// postPermissionGroupBulkHandler returns the handler for POST /admin/permissiongroups/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postPermissionGroupBulkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runPermissionGroupBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
+
+		props, err := h.buildPermissionGroupListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runPermissionGroupBulkAction runs selection.Action on each selected PermissionGroup,
+// checking permissions per row.
+func (h *AdminHandler) runPermissionGroupBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.PermissionGroup.Query()
+	if selection.All {
+		query = h.newPermissionGroupListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Permission Group")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parsePermissionGroupID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
+			}
+			ids[i] = id
+		}
+		query = query.Where(permissiongroup.IDIn(ids...))
+	}
+	entities, err := h.schemas.PermissionGroup.EagerLoadQuery(query).
+		Order(permissiongroup.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
+
+	if selection.Action == vent.BulkActionDelete {
+		if err := denyIfCannot(defaultCan(ctx, "delete_permission_group")); err != nil {
+			return vent.BulkResult{}, err
+		}
+		result := vent.BulkResult{Label: "Delete"}
+		for _, e := range entities {
+			result.Record(vent.FormatID(e.ID), h.schemas.PermissionGroup.Name(e), bulkErrorMessage(h.deletePermissionGroupRow(ctx, e)))
+		}
+		return result, nil
+	}
+
+	action, err := h.lookupPermissionGroupAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canPermissionGroupRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.PermissionGroup.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
+
+// postPermissionGroupActionHandler returns the handler for POST /admin/permissiongroups/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postPermissionGroupActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		action, err := h.lookupPermissionGroupAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		result, runErr := h.runPermissionGroupAction(r.Context(), action, id)
+
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildPermissionGroupListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildPermissionGroupDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildPermissionGroupPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runPermissionGroupAction runs action on the PermissionGroup with id after its row check.
+func (h *AdminHandler) runPermissionGroupAction(ctx context.Context, action PermissionGroupAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.PermissionGroup.EagerLoadQuery(h.client.PermissionGroup.Query().
+		Where(permissiongroup.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canPermissionGroupRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
+	}
+	return action.Run(ctx, e)
+}
+
+// deletePermissionGroupRow deletes one PermissionGroup with the same checks as the delete
+// route. The delete and its audit entry and revision share a transaction.
+func (h *AdminHandler) deletePermissionGroupRow(ctx context.Context, e *ent.PermissionGroup) error {
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanDelete(ctx, e)); err != nil {
+		return err
+	}
+	if err := h.schemas.PermissionGroup.ValidateDelete(ctx, e.ID); err != nil {
+		return err
+	}
+	if err := h.checkPermissionGroupDelete(ctx, e); err != nil {
+		return err
+	}
+	err := h.withTx(ctx, func(ctx context.Context) error {
+		if err := h.schemas.PermissionGroup.BeforeDelete(ctx, e); err != nil {
+			return err
+		}
+		snapshot, err := h.permissiongroupRevisionSnapshot(ctx, e)
+		if err != nil {
+			return err
+		}
+		if err := h.db(ctx).PermissionGroup.DeleteOneID(e.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if err := h.auditPermissionGroup(ctx, vent.AuditActionDelete, e, e.ID); err != nil {
+			return err
+		}
+		if err := h.recordRevision(ctx, vent.AuditActionDelete, "PermissionGroup", e.ID, h.schemas.PermissionGroup.Name(e), snapshot); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := h.schemas.PermissionGroup.AfterDelete(ctx, e); err != nil {
+		log.Printf("PermissionGroup after delete hook: %v", err)
+	}
+	return nil
+}
+
+// permissiongroupDeleteRelations lists the entities a delete of e cascades to, unlinks,
+// or is blocked by, leaving out relations without any.
+func (h *AdminHandler) permissiongroupDeleteRelations(ctx context.Context, e *ent.PermissionGroup) ([]gui.DeleteRelation, error) {
+	adminPath := requestctx.MustAdminPath(ctx)
+	var relations []gui.DeleteRelation
+	if count, err := e.QueryPermissions().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.Permission.EagerLoadQuery(e.QueryPermissions()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_permission")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Permissions", Effect: "unlink", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.Permission.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%spermissions/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	if count, err := e.QueryUsers().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.User.EagerLoadQuery(e.QueryUsers()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_user")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Users", Effect: "unlink", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.User.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%susers/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+// checkPermissionGroupDelete refuses to delete e while related entities block it or
+// the delete would cascade to entities the user may not delete, instead of
+// leaving it to the database's foreign keys.
+func (h *AdminHandler) checkPermissionGroupDelete(ctx context.Context, e *ent.PermissionGroup) error {
+	relations, err := h.permissiongroupDeleteRelations(ctx, e)
+	if err != nil {
+		return err
+	}
+	return deleteRelationsError(h.schemas.PermissionGroup.Name(e), relations)
+}
+
+// buildPermissionGroupDeletePageProps builds the delete confirmation props for PermissionGroup.
+func (h *AdminHandler) buildPermissionGroupDeletePageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityDeleteProps, error) {
+	e, err := h.loadPermissionGroup(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanDelete(ctx, e)); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+
+	entityDisplay := h.schemas.PermissionGroup.Name(e)
+	props := gui.SchemaEntityDeleteProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaDeleteBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "permission-groups",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+	}
+	if props.Relations, err = h.permissiongroupDeleteRelations(ctx, e); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	return props, nil
+}
+
+// getPermissionGroupDeleteHandler returns the handler for GET /admin/permissiongroups/{id}/delete/
+func (h *AdminHandler) getPermissionGroupDeleteHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildPermissionGroupDeletePageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityDeletePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) patchPermissionGroupDeletePageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	props, buildErr := h.buildPermissionGroupDeletePageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityDeletePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getPermissionGroupExportHandler returns the handler for GET /admin/permissiongroups/export/
+func (h *AdminHandler) getPermissionGroupExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		list := h.newPermissionGroupListQuery(r.URL.Query())
+		query := h.schemas.PermissionGroup.EagerLoadQuery(list.query).Order(permissiongroupListOrder(list.sorts)...)
+		columns := []string{
+			"Name",
+		}
+		vent.ServeExport(w, r, format, "permission-groups", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.permissionGroupFields.listColumns))
+				for j, field := range h.permissionGroupFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
+	})
+}
+
+// permissiongroupNotCopied labels the add form fields a duplicate leaves for the user
+// to fill in.
+var permissiongroupNotCopied = []string{
+	"Name",
+}
+
+// permissiongroupInitialFields are the add form fields a query parameter of the same
+// name may pre-fill.
+var permissiongroupInitialFields = []vent.InitialField{
+	{
+		Name: "name",
+		Kind: vent.FieldKind("string"),
+	},
+	{
+		Name:    "permissions",
+		Kind:    vent.FieldKind("foreign_key"),
+		CheckID: vent.CheckID[int],
+	},
+}
+
+// buildPermissionGroupAddPageProps builds the add page props for PermissionGroup. A non-nil
+// source pre-fills the form with a copy of it.
+func (h *AdminHandler) buildPermissionGroupAddPageProps(ctx context.Context, source *ent.PermissionGroup, errorMessage string) (gui.SchemaEntityAddProps, error) {
+	canCreate, err := h.schemas.PermissionGroup.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityAddProps{}, err
+	}
+	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canCreate,
+	})
+	if source != nil {
+		ctx = withPermissionGroupDuplicateSource(ctx, source)
+	}
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.permissionGroupFields.createFormFieldSets))
+	for _, fieldSet := range h.permissionGroupFields.createFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.CreateHTML(ctx)
+			if err != nil {
+				return gui.SchemaEntityAddProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	props := gui.SchemaEntityAddProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaAddBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+			"Permission Group",
+		)),
+		RouteName:           "permission-groups",
+		SingularDisplayName: "Permission Group",
+		ErrorMessage:        errorMessage,
+		FieldSets:           fieldSets,
+		Tabs:                false,
+		Multipart:           false,
+	}
+	if source != nil {
+		props.DuplicateOf = h.schemas.PermissionGroup.Name(source)
+		props.NotCopied = permissiongroupNotCopied
+	}
+	return props, nil
+}
+
+func (h *AdminHandler) patchPermissionGroupAddPageError(w http.ResponseWriter, r *http.Request, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildPermissionGroupAddPageProps(r.Context(), nil, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getPermissionGroupAddHandler returns the handler for GET /admin/permissiongroups/add/
+func (h *AdminHandler) getPermissionGroupAddHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		initial, err := vent.ParseInitialValues(r.URL.Query(), permissiongroupInitialFields)
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		ctx := vent.WithInitialValues(r.Context(), initial)
+
+		props, err := h.buildPermissionGroupAddPageProps(ctx, nil, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(ctx, w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getPermissionGroupDuplicateHandler returns the handler for GET /admin/permissiongroups/{id}/duplicate/,
+// the add form pre-filled from the PermissionGroup with id.
+func (h *AdminHandler) getPermissionGroupDuplicateHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		source, err := h.loadPermissionGroup(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.PermissionGroup.CanRead(r.Context(), source)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		props, err := h.buildPermissionGroupAddPageProps(r.Context(), source, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// buildPermissionGroupPageProps builds the edit page props for PermissionGroup.
+func (h *AdminHandler) buildPermissionGroupPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.PermissionGroup.EagerLoadQuery(h.client.PermissionGroup.Query().
+		Where(permissiongroup.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canCreate, err := h.schemas.PermissionGroup.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canUpdate, err := h.schemas.PermissionGroup.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canDelete, err := h.schemas.PermissionGroup.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.permissionGroupFields.updateFormFieldSets))
+	for _, fieldSet := range h.permissionGroupFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedPermissionGroupActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.permissiongroupEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.PermissionGroup.Name(e)
+	props := gui.SchemaEntityChangeProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaEntityBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+			entityDisplay,
+		)),
+		RouteName:     "permission-groups",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     false,
+		RelatedLists:  permissiongroupRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		Duplicate:     canCreate,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// permissiongroupRelatedLists returns the lists filtered to one PermissionGroup
+// that the current user may read, for its change and detail pages.
+func permissiongroupRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	return lists
+}
+
+// buildPermissionGroupDetailPageProps builds the read-only detail page props for PermissionGroup.
+func (h *AdminHandler) buildPermissionGroupDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.PermissionGroup.EagerLoadQuery(h.client.PermissionGroup.Query().
+		Where(permissiongroup.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.PermissionGroup.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.PermissionGroup.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.permissionGroupFields.updateFormFieldSets))
+	for _, fieldSet := range h.permissionGroupFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedPermissionGroupActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.permissiongroupEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.PermissionGroup.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"permission-groups",
+			"Permission Groups",
+			entityDisplay,
+		)),
+		RouteName:     "permission-groups",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  permissiongroupRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	if ok, err := defaultCan(ctx, "read_user"); err == nil && ok {
+		related, err := h.schemas.User.EagerLoadQuery(e.QueryUsers()).
+			Limit(vent.DetailRelationLimit + 1).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaEntityDetailProps{}, err
+		}
+		relation := gui.SchemaEntityDetailRelation{
+			Label: "Users",
+			More:  len(related) > vent.DetailRelationLimit,
+		}
+		if relation.More {
+			related = related[:vent.DetailRelationLimit]
+		}
+		for _, r := range related {
+			relation.Items = append(relation.Items, gui.SchemaEntityRelatedLink{
+				Label: h.schemas.User.Name(r),
+				URL:   fmt.Sprintf("%susers/%s/", adminPath, vent.FormatIDPath(r.ID)),
+			})
+		}
+		props.Relations = append(props.Relations, relation)
+	}
+	return props, nil
+}
+
+// getPermissionGroupDetailHandler returns the handler for GET /admin/permissiongroups/{id}/view/
+func (h *AdminHandler) getPermissionGroupDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		h.renderPermissionGroupDetailPage(w, r, id)
+	})
+}
+
+func (h *AdminHandler) renderPermissionGroupDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildPermissionGroupDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// loadPermissionGroup loads the PermissionGroup with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadPermissionGroup(ctx context.Context, id int) (*ent.PermissionGroup, error) {
+	return h.schemas.PermissionGroup.EagerLoadQuery(h.db(ctx).PermissionGroup.Query().
+		Where(permissiongroup.IDEQ(id))).
+		Only(ctx)
+}
+
+// auditPermissionGroup records a PermissionGroup mutation in the audit log, diffing
+// before against the saved PermissionGroup with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditPermissionGroup(ctx context.Context, action vent.AuditAction, before *ent.PermissionGroup, id int) error {
+	var after *ent.PermissionGroup
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadPermissionGroup(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
+		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.permissiongroupAuditSnapshot(ctx, before), h.permissiongroupAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "PermissionGroup", id, h.schemas.PermissionGroup.Name(named), changes)
+}
+
+// permissiongroupAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) permissiongroupAuditSnapshot(ctx context.Context, e *ent.PermissionGroup) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.permissionGroupFields.auditFields))
+	for _, f := range h.permissionGroupFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
+
+// buildPermissionGroupHistoryPageProps builds the History tab props for PermissionGroup.
+func (h *AdminHandler) buildPermissionGroupHistoryPageProps(ctx context.Context, id int) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadPermissionGroup(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.PermissionGroup.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("PermissionGroup"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entityDisplay := h.schemas.PermissionGroup.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "permission-groups",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
+}
+
+// getPermissionGroupHistoryHandler returns the handler for GET /admin/permissiongroups/{id}/history/
+func (h *AdminHandler) getPermissionGroupHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildPermissionGroupHistoryPageProps(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// permissiongroupRevisionFields are the fields a PermissionGroup revision snapshots, in form order.
+var permissiongroupRevisionFields = []string{
+	"name",
+	"permissions",
+}
+
+// permissiongroupRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) permissiongroupRevisionSnapshot(ctx context.Context, e *ent.PermissionGroup) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	snapshot["name"] = e.Name
+	if snapshot["permissions"], err = revisionEdgeIDs(h.db(ctx).PermissionGroup.QueryPermissions(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// buildPermissionGroupRevisionsPageProps builds the Revisions tab props for the PermissionGroup
+// with id, comparing the revisions from and to. A deleted PermissionGroup is shown
+// from its revisions.
+func (h *AdminHandler) buildPermissionGroupRevisionsPageProps(ctx context.Context, id int, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("PermissionGroup"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "permission-groups",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadPermissionGroup(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		canCreate, err := h.schemas.PermissionGroup.CanCreate(ctx)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = canCreate
+		props.LayoutProps = h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.PermissionGroup.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.PermissionGroup.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.permissiongroupRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.PermissionGroup.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%spermission-groups/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(permissiongroupRevisionFields, fromSnapshot, toSnapshot)
+	}
+	return props, nil
+}
+
+// getPermissionGroupRevisionsHandler returns the handler for GET /admin/permissiongroups/{id}/revisions/
+func (h *AdminHandler) getPermissionGroupRevisionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		query := r.URL.Query()
+		props, err := h.buildPermissionGroupRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postPermissionGroupRestoreHandler returns the handler for POST /admin/permissiongroups/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postPermissionGroupRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
+			return
+		}
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("PermissionGroup"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		var (
+			e     *ent.PermissionGroup
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restorePermissionGroupRevision(ctx, id, rev)
+			return err
+		})
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%spermission-groups/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
+	})
+}
+
+// restorePermissionGroupRevision replays rev onto the PermissionGroup with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the PermissionGroup as it was before. A deleted PermissionGroup is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restorePermissionGroupRevision(ctx context.Context, id int, rev *ent.Revision) (*ent.PermissionGroup, func(context.Context), error) {
+	e, err := h.loadPermissionGroup(ctx, id)
+	if ent.IsNotFound(err) {
+		e, err := h.recreatePermissionGroup(ctx, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, func(ctx context.Context) {
+			h.afterPermissionGroupCreate(ctx, e.ID)
+		}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_permission_group")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
+	}
+
+	var input PermissionGroupUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	if err := h.updatePermissionGroup(ctx, e, input); err != nil {
+		return nil, nil, err
+	}
+	return e, func(ctx context.Context) {
+		h.afterPermissionGroupUpdate(ctx, e, id)
+	}, nil
+}
+
+// recreatePermissionGroup recreates a deleted PermissionGroup from rev in ctx's transaction,
+// through the same create path as the add form. The new PermissionGroup gets a new ID,
+// which is recorded on the deleted entity's revisions.
+func (h *AdminHandler) recreatePermissionGroup(ctx context.Context, rev *ent.Revision) (*ent.PermissionGroup, error) {
+	deleted := revision.And(
+		revision.SchemaEQ(rev.Schema),
+		revision.EntityIDEQ(rev.EntityID),
+		revision.ActionEQ(revision.ActionDelete),
+	)
+	restored, err := h.db(ctx).Revision.Query().
+		Where(deleted, revision.RestoredIDNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restored {
+		return nil, vent.BadRequest("this permission group has already been restored")
+	}
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanCreate(ctx)); err != nil {
+		return nil, err
+	}
+
+	var input PermissionGroupCreateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	e, err := h.createPermissionGroup(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db(ctx).Revision.Update().
+		Where(deleted).
+		SetRestoredID(vent.FormatID(e.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record restored revision: %w", err)
+	}
+	return e, nil
+}
+
+// buildPermissionGroupDeletedPageProps lists the deleted PermissionGroup entities that have
+// not been restored, newest first.
+func (h *AdminHandler) buildPermissionGroupDeletedPageProps(ctx context.Context) (gui.SchemaDeletedProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("PermissionGroup"),
+			revision.ActionEQ(revision.ActionDelete),
+			revision.RestoredIDIsNil(),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaDeletedProps{}, err
+	}
+
+	props := gui.SchemaDeletedProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaDeletedBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+		)),
+		RouteName:         "permission-groups",
+		PluralDisplayName: "Permission Groups",
+		Truncated:         len(revs) > vent.RevisionLimit,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+	for _, rev := range revs {
+		props.Entries = append(props.Entries, gui.DeletedEntry{
+			EntityID:      rev.EntityID,
+			EntityDisplay: rev.EntityName,
+			At:            rev.CreatedAt,
+			Actor:         rev.Actor,
+		})
+	}
+	return props, nil
+}
+
+// getPermissionGroupDeletedHandler returns the handler for GET /admin/permissiongroups/deleted/
+func (h *AdminHandler) getPermissionGroupDeletedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildPermissionGroupDeletedPageProps(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaDeletedPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getPermissionGroupHandler returns the handler for GET /admin/permissiongroups/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getPermissionGroupHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadPermissionGroup(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.PermissionGroup.CanUpdate(r.Context(), e)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if !canUpdate {
+			h.renderPermissionGroupDetailPage(w, r, id)
+			return
+		}
+
+		props, err := h.buildPermissionGroupPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+func (h *AdminHandler) patchPermissionGroupPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildPermissionGroupPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// postPermissionGroupHandler returns the handler for POST /admin/permissiongroups/
+func (h *AdminHandler) postPermissionGroupHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Entity PermissionGroupCreateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchPermissionGroupAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+
+		var id int
+		err := h.withTx(r.Context(), func(ctx context.Context) error {
+			e, err := h.createPermissionGroup(ctx, input)
+			if err != nil {
+				return err
+			}
+			id = e.ID
+			return nil
+		})
+		if err != nil {
+			h.patchPermissionGroupAddPageError(w, r, err)
+			return
+		}
+		h.afterPermissionGroupCreate(r.Context(), id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
+	})
+}
+
+// createPermissionGroup saves input as a new PermissionGroup in ctx's transaction:
+// ValidateCreate, the create fields, BeforeCreate and the audit log entry.
+func (h *AdminHandler) createPermissionGroup(ctx context.Context, input PermissionGroupCreateInput) (*ent.PermissionGroup, error) {
+	if err := h.schemas.PermissionGroup.ValidateCreate(ctx, input); err != nil {
+		return nil, err
+	}
+
+	builder := h.db(ctx).PermissionGroup.Create()
+
+	for _, field := range h.permissionGroupFields.createBindFields {
+		if err := field.ApplyCreate(ctx, builder, input); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.schemas.PermissionGroup.BeforeCreate(ctx, builder); err != nil {
+		return nil, err
+	}
+
+	e, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.auditPermissionGroup(ctx, vent.AuditActionCreate, nil, e.ID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// afterPermissionGroupCreate runs the AfterCreate hook on the PermissionGroup saved with id.
+func (h *AdminHandler) afterPermissionGroupCreate(ctx context.Context, id int) {
+	e, err := h.loadPermissionGroup(ctx, id)
+	if err == nil {
+		err = h.schemas.PermissionGroup.AfterCreate(ctx, e)
+	}
+	if err != nil {
+		log.Printf("PermissionGroup after create hook: %v", err)
+	}
+}
+
+// permissiongroupImportColumns are the PermissionGroup fields an import file can fill.
+var permissiongroupImportColumns = []vent.ImportColumn{
+	{Name: "name", Label: "Name"},
+	{Name: "permissions", Label: "Permissions"},
+}
+
+// buildPermissionGroupImportPageProps builds the import page props for PermissionGroup.
+func (h *AdminHandler) buildPermissionGroupImportPageProps(ctx context.Context) gui.SchemaImportProps {
+	props := gui.SchemaImportProps{
+		LayoutProps: h.buildLayoutProps(ctx, "PermissionGroup", gui.SchemaImportBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"permission-groups",
+			"Permission Groups",
+		)),
+		RouteName:         "permission-groups",
+		PluralDisplayName: "Permission Groups",
+		Columns: []gui.SchemaImportColumn{
+			{Name: "name", Label: "Name"},
+			{Name: "permissions", Label: "Permissions", Hint: "IDs or names, comma-separated"},
+		},
+	}
+	if ok, err := defaultCan(ctx, "update_permission_group"); err == nil && ok {
+		props.UpsertOptions = []gui.SelectOption{
+			{Value: "name", Label: "Name"},
+		}
+	}
+	return props
+}
+
+// getPermissionGroupImportHandler returns the handler for GET /admin/permissiongroups/import/
+func (h *AdminHandler) getPermissionGroupImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := gui.SchemaImportPage(h.buildPermissionGroupImportPageProps(r.Context())).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postPermissionGroupImportHandler returns the handler for POST /admin/permissiongroups/import/.
+// With ?dry_run=1 the rows are saved and then rolled back, so the preview
+// reports the same errors a real import would.
+func (h *AdminHandler) postPermissionGroupImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props := gui.SchemaImportResultProps{
+			RouteName:         "permission-groups",
+			PluralDisplayName: "Permission Groups",
+		}
+		result, err := h.importPermissionGroupFile(r, r.URL.Query().Get("dry_run") != "")
+		if err != nil {
+			props.ErrorMessage = normalizeError(err).PublicMessage()
+		} else {
+			props.Result = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.PatchElementTempl(gui.SchemaImportResult(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) importPermissionGroupFile(r *http.Request, dryRun bool) (vent.ImportResult, error) {
+	file, err := vent.ReadImportUpload(r, "file")
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	mapping := vent.MapImportColumns(file.Headers, permissiongroupImportColumns)
+	if strings.Join(mapping, "") == "" {
+		return vent.ImportResult{}, vent.BadRequest("no column in the file matches a Permission Group field")
+	}
+	upsert := r.FormValue("upsert")
+	switch upsert {
+	case "", "name":
+	default:
+		return vent.ImportResult{}, vent.BadRequest(fmt.Sprintf("cannot upsert on %q", upsert))
+	}
+	if upsert != "" {
+		if err := denyIfCannot(defaultCan(r.Context(), "update_permission_group")); err != nil {
+			return vent.ImportResult{}, err
+		}
+	}
+
+	result := vent.NewImportResult(file, mapping, permissiongroupImportColumns, dryRun)
+	var afterHooks []func(context.Context)
+	err = h.withTx(r.Context(), func(ctx context.Context) error {
+		for _, row := range file.Rows {
+			// Each row gets a savepoint, so a failed row does not abort the
+			// transaction for the rows after it.
+			action := vent.ImportActionCreate
+			err := withSavepoint(ctx, func(ctx context.Context) error {
+				var (
+					after func(context.Context)
+					err   error
+				)
+				action, after, err = h.importPermissionGroupRow(ctx, row.Fields(mapping), upsert)
+				if err == nil {
+					afterHooks = append(afterHooks, after)
+				}
+				return err
+			})
+			message := ""
+			if err != nil {
+				message = normalizeError(err).PublicMessage()
+			}
+			result.Record(row, mapping, action, message)
+		}
+		if dryRun || result.Failed > 0 {
+			return errRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		return result, nil
+	}
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	result.Committed = true
+	for _, after := range afterHooks {
+		after(r.Context())
+	}
+	return result, nil
+}
+
+// importPermissionGroupRow saves one import row in ctx's transaction through the
+// same path as the add form, or as the change form when upsert names a
+// field and an existing PermissionGroup matches the row on it. The returned func
+// runs the After hook once the import commits.
+func (h *AdminHandler) importPermissionGroupRow(ctx context.Context, values map[string]string, upsert string) (vent.ImportAction, func(context.Context), error) {
+	client := h.db(ctx)
+	var input PermissionGroupCreateInput
+	if err := vent.DecodeImportValues(values, &input); err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	for i, ref := range input.Permissions {
+		id, err := resolvePermissionImportRef(ctx, client, ref)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.Permissions[i] = id
+	}
+
+	switch upsert {
+	case "name":
+		if strings.TrimSpace(values["name"]) == "" {
+			return vent.ImportActionUpdate, nil, vent.BadRequest("name is required to update existing rows")
+		}
+		var key struct {
+			Value string `json:"name"`
+		}
+		if err := vent.DecodeImportValues(values, &key); err != nil {
+			return vent.ImportActionUpdate, nil, err
+		}
+		id, err := client.PermissionGroup.Query().Where(permissiongroup.NameEQ(key.Value)).OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return vent.ImportActionUpdate, nil, err
+		}
+		return h.importPermissionGroupUpdate(ctx, id, values, input)
+	}
+
+	e, err := h.createPermissionGroup(ctx, input)
+	if err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	return vent.ImportActionCreate, func(ctx context.Context) {
+		h.afterPermissionGroupCreate(ctx, e.ID)
+	}, nil
+}
+
+// importPermissionGroupUpdate saves an import row over the existing PermissionGroup with
+// id, changing only the columns the file has.
+func (h *AdminHandler) importPermissionGroupUpdate(ctx context.Context, id int, values map[string]string, create PermissionGroupCreateInput) (vent.ImportAction, func(context.Context), error) {
+	e, err := h.loadPermissionGroup(ctx, id)
+	if err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	if err := denyIfCannot(h.schemas.PermissionGroup.CanUpdate(ctx, e)); err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	var input PermissionGroupUpdateInput
+	if err := vent.ImportUpdateInput(values, create, &input); err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	if err := h.updatePermissionGroup(ctx, e, input); err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	return vent.ImportActionUpdate, func(ctx context.Context) {
+		h.afterPermissionGroupUpdate(ctx, e, id)
+	}, nil
+}
+
+// resolvePermissionGroupImportRef returns the ID of the PermissionGroup an import
+// cell references, by ID or by name.
+func resolvePermissionGroupImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parsePermissionGroupID(ref); err == nil {
+		exists, err := client.PermissionGroup.Query().Where(permissiongroup.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	id, err := client.PermissionGroup.Query().Where(permissiongroup.NameEQ(ref)).OnlyID(ctx)
+	if err == nil {
+		return vent.FormatID(id), nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", err
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Permission Group matches %q", ref))
+}
+
+// patchPermissionGroupHandler returns the handler for PATCH /admin/permissiongroups/{id}/
+func (h *AdminHandler) patchPermissionGroupHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadPermissionGroup(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.PermissionGroup.CanUpdate(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		var signals struct {
+			Entity PermissionGroupUpdateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchPermissionGroupPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updatePermissionGroup(ctx, e, input)
+		})
+		if err != nil {
+			h.patchPermissionGroupPageError(w, r, id, err)
+			return
+		}
+		h.afterPermissionGroupUpdate(r.Context(), e, id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
+	})
+}
+
+// updatePermissionGroup saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+func (h *AdminHandler) updatePermissionGroup(ctx context.Context, e *ent.PermissionGroup, input PermissionGroupUpdateInput) error {
+	if err := h.schemas.PermissionGroup.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
+
+	snapshot, err := h.permissiongroupRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	builder := h.db(ctx).PermissionGroup.UpdateOneID(e.ID)
+
+	for _, field := range h.permissionGroupFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
+		}
+	}
+	if err := h.schemas.PermissionGroup.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	if err := h.auditPermissionGroup(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "PermissionGroup", e.ID, h.schemas.PermissionGroup.Name(e), snapshot)
+}
+
+// afterPermissionGroupUpdate runs the AfterUpdate hook on the PermissionGroup with id, which
+// was prev before the save.
+func (h *AdminHandler) afterPermissionGroupUpdate(ctx context.Context, prev *ent.PermissionGroup, id int) {
+	e, err := h.loadPermissionGroup(ctx, id)
+	if err == nil {
+		err = h.schemas.PermissionGroup.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("PermissionGroup after update hook: %v", err)
+	}
+}
+
+// deletePermissionGroupHandler returns the handler for DELETE /admin/permissiongroups/{id}/
+func (h *AdminHandler) deletePermissionGroupHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePermissionGroupID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadPermissionGroup(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.PermissionGroup.CanDelete(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		if err := h.deletePermissionGroupRow(r.Context(), e); err != nil {
+			h.patchPermissionGroupDeletePageError(w, r, id, err)
+			return
+		}
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "permission-groups/")
+	})
+}
+
+// ============================================================================
+// Publisher Handlers
+// ============================================================================
+
+// parsePublisherID parses a Publisher ID from a URL path or form value.
+func parsePublisherID(value string) (uuid.UUID, error) {
+	return vent.ParseID[uuid.UUID](value)
+}
+
+// PublisherCreateInput is the typed input for creating a Publisher
+type PublisherCreateInput struct {
+	Name    string   `json:"name"`
+	Catalog *string  `json:"catalog"`
+	Books   []string `json:"books"`
+}
+
+// PublisherUpdateInput is the typed input for updating a Publisher
+type PublisherUpdateInput struct {
+	Name    *string   `json:"name"`
+	Catalog *string   `json:"catalog"`
+	Books   *[]string `json:"books"`
+}
+
+// PublisherListFilter is the typed list query for listing Publisher.
+type PublisherListFilter struct {
+	Name string
+	ID   string
+}
+
+// publisherListOrders maps Publisher list sort keys to Ent order options.
+var publisherListOrders = map[string]func(...sql.OrderTermOption) publisher.OrderOption{
+	"name": publisher.ByName,
+	"id":   publisher.ByID,
+}
+
+// publisherDefaultOrdering is the Publisher list ordering when the request names none.
+var publisherDefaultOrdering = []string{}
+
+func publisherListSortable(name string) bool {
+	_, ok := publisherListOrders[name]
+	return ok
+}
+
+// publisherListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func publisherListOrder(sorts []vent.ListSort) []publisher.OrderOption {
+	orders := make([]publisher.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, publisherListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, publisher.ByID())
+	}
+	return orders
+}
+
+// publisherListQuery is the Publisher list query with a request's filters, search, and sort applied.
+type publisherListQuery struct {
+	query  *ent.PublisherQuery
+	filter PublisherListFilter
+	sorts  []vent.ListSort
+}
+
+// newPublisherListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newPublisherListQuery(q url.Values) publisherListQuery {
+	filter := PublisherListFilter{
+		Name: vent.FilterParam(q, "filter.name"),
+		ID:   vent.FilterParam(q, "filter.id"),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), publisherDefaultOrdering, publisherListSortable)
+	query := h.client.Publisher.Query()
+	if filterVal := filter.Name; filterVal != "" {
+		query = query.Where(publisher.NameContainsFold(filterVal))
+	}
+	if filterVals := vent.FilterValues(filter.ID); len(filterVals) > 0 {
+		ids := make([]uuid.UUID, 0, len(filterVals))
+		for _, filterVal := range filterVals {
+			if id, err := parsePublisherID(filterVal); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > 0 {
+			query = query.Where(publisher.IDIn(ids...))
+		}
+	}
+	return publisherListQuery{
+		query:  query,
+		filter: filter,
+		sorts:  sorts,
+	}
+}
+
+// buildPublisherListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildPublisherListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newPublisherListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedPublisherActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.Publisher.EagerLoadQuery(query).
+			Order(publisherListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaTableProps{}, err
+		}
+
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.publisherFields.listColumns))
+			for j, field := range h.publisherFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%spublishers/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.publisherEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.Publisher.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_publisher")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listPublisherBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canRestore, canPurge, err := h.publisherTrashPermissions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canViewDeleted, err := defaultCan(ctx, "delete_publisher")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "Publisher", gui.SchemaListBreadcrumbs("Publishers")),
+		RouteName:           "publishers",
+		SingularDisplayName: "Publisher",
+		PluralDisplayName:   "Publishers",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "name", Label: "Name", Type: "string", Sortable: true},
+			{Name: "id", Label: "ID", Type: "id", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "name",
+				Label: "Name",
+				Type:  "string",
+				Value: filter.Name,
+			},
+			{
+				Name:  "id",
+				Label: "ID",
+				Type:  "id",
+				Value: filter.ID,
+			},
+		},
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", publisherDefaultOrdering, publisherListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		Deleted:       canViewDeleted,
+		Trash:         canRestore || canPurge,
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getPublisherListHandler returns the handler for GET /admin/publishers/
+func (h *AdminHandler) getPublisherListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildPublisherListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// allowedPublisherActions returns the custom Publisher actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedPublisherActions(ctx context.Context) ([]PublisherAction, error) {
+	var allowed []PublisherAction
+	for _, action := range h.schemas.Publisher.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed, nil
+}
+
+// lookupPublisherAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupPublisherAction(ctx context.Context, name string) (PublisherAction, error) {
+	for _, action := range h.schemas.Publisher.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return PublisherAction{}, err
+		}
+		return action, nil
+	}
+	return PublisherAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
+
+// canPublisherRunAction reports whether action may run on e.
+func (h *AdminHandler) canPublisherRunAction(ctx context.Context, action PublisherAction, e *ent.Publisher) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.Publisher.CanUpdate(ctx, e)
+}
+
+// publisherEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) publisherEntityActions(ctx context.Context, allowed []PublisherAction, e *ent.Publisher) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canPublisherRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
+}
+
+// listPublisherBulkActions returns the bulk actions the current user may run
+// on Publisher rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listPublisherBulkActions(ctx context.Context, allowed []PublisherAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	canDelete, err := defaultCan(ctx, "delete_publisher")
+	if err != nil {
+		return nil, err
+	}
+	if canDelete {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    vent.BulkActionDelete,
+			Label:   "Delete",
+			Confirm: "Delete the selected Publishers? This cannot be undone.",
+			Danger:  true,
+		})
+	}
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
+}
+
+// postPublisherBulkHandler returns the handler for POST /admin/publishers/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postPublisherBulkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runPublisherBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
+
+		props, err := h.buildPublisherListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runPublisherBulkAction runs selection.Action on each selected Publisher,
+// checking permissions per row.
+func (h *AdminHandler) runPublisherBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.Publisher.Query()
+	if selection.All {
+		query = h.newPublisherListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Publisher")
+		}
+		ids := make([]uuid.UUID, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parsePublisherID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
+			}
+			ids[i] = id
+		}
+		query = query.Where(publisher.IDIn(ids...))
+	}
+	entities, err := h.schemas.Publisher.EagerLoadQuery(query).
+		Order(publisher.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
+
+	if selection.Action == vent.BulkActionDelete {
+		if err := denyIfCannot(defaultCan(ctx, "delete_publisher")); err != nil {
+			return vent.BulkResult{}, err
+		}
+		result := vent.BulkResult{Label: "Delete"}
+		for _, e := range entities {
+			result.Record(vent.FormatID(e.ID), h.schemas.Publisher.Name(e), bulkErrorMessage(h.deletePublisherRow(ctx, e)))
+		}
+		return result, nil
+	}
+
+	action, err := h.lookupPublisherAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canPublisherRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.Publisher.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
+
+// postPublisherActionHandler returns the handler for POST /admin/publishers/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postPublisherActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		action, err := h.lookupPublisherAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		result, runErr := h.runPublisherAction(r.Context(), action, id)
+
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildPublisherListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildPublisherDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "publishers/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildPublisherPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "publishers/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runPublisherAction runs action on the Publisher with id after its row check.
+func (h *AdminHandler) runPublisherAction(ctx context.Context, action PublisherAction, id uuid.UUID) (vent.ActionResult, error) {
+	e, err := h.schemas.Publisher.EagerLoadQuery(h.client.Publisher.Query().
+		Where(publisher.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canPublisherRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
+	}
+	return action.Run(ctx, e)
+}
+
+// deletePublisherRow deletes one Publisher with the same checks as the delete
+// route. The delete and its audit entry and revision share a transaction.
+func (h *AdminHandler) deletePublisherRow(ctx context.Context, e *ent.Publisher) error {
+	if err := denyIfCannot(h.schemas.Publisher.CanDelete(ctx, e)); err != nil {
+		return err
+	}
+	if err := h.schemas.Publisher.ValidateDelete(ctx, e.ID); err != nil {
+		return err
+	}
+	err := h.withTx(ctx, func(ctx context.Context) error {
+		if err := h.schemas.Publisher.BeforeDelete(ctx, e); err != nil {
+			return err
+		}
+		if err := h.db(ctx).Publisher.UpdateOneID(e.ID).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+			return err
+		}
+		if err := h.auditPublisher(ctx, vent.AuditActionDelete, e, e.ID); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := h.schemas.Publisher.AfterDelete(ctx, e); err != nil {
+		log.Printf("Publisher after delete hook: %v", err)
+	}
+	return nil
+}
+
+// publisherDeleteRelations lists the entities a delete of e cascades to, unlinks,
+// or is blocked by, leaving out relations without any.
+func (h *AdminHandler) publisherDeleteRelations(ctx context.Context, e *ent.Publisher) ([]gui.DeleteRelation, error) {
+	adminPath := requestctx.MustAdminPath(ctx)
+	var relations []gui.DeleteRelation
+	if count, err := e.QueryBooks().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.Book.EagerLoadQuery(e.QueryBooks()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_book")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Books", Effect: "unlink", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.Book.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%sbooks/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+// checkPublisherDelete refuses to delete e while related entities block it or
+// the delete would cascade to entities the user may not delete, instead of
+// leaving it to the database's foreign keys.
+func (h *AdminHandler) checkPublisherDelete(ctx context.Context, e *ent.Publisher) error {
+	relations, err := h.publisherDeleteRelations(ctx, e)
+	if err != nil {
+		return err
+	}
+	return deleteRelationsError(h.schemas.Publisher.Name(e), relations)
+}
+
+// buildPublisherDeletePageProps builds the delete confirmation props for Publisher.
+func (h *AdminHandler) buildPublisherDeletePageProps(ctx context.Context, id uuid.UUID, errorMessage string) (gui.SchemaEntityDeleteProps, error) {
+	e, err := h.loadPublisher(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanDelete(ctx, e)); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+
+	entityDisplay := h.schemas.Publisher.Name(e)
+	props := gui.SchemaEntityDeleteProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaDeleteBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "publishers",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+	}
+	props.Trash = true
+	return props, nil
+}
+
+// getPublisherDeleteHandler returns the handler for GET /admin/publishers/{id}/delete/
+func (h *AdminHandler) getPublisherDeleteHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildPublisherDeletePageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityDeletePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) patchPublisherDeletePageError(w http.ResponseWriter, r *http.Request, id uuid.UUID, err error) {
+	props, buildErr := h.buildPublisherDeletePageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityDeletePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getPublisherExportHandler returns the handler for GET /admin/publishers/export/
+func (h *AdminHandler) getPublisherExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		list := h.newPublisherListQuery(r.URL.Query())
+		query := h.schemas.Publisher.EagerLoadQuery(list.query).Order(publisherListOrder(list.sorts)...)
+		columns := []string{
+			"Name",
+			"ID",
+		}
+		vent.ServeExport(w, r, format, "publishers", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.publisherFields.listColumns))
+				for j, field := range h.publisherFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
+	})
+}
+
+// publisherNotCopied labels the add form fields a duplicate leaves for the user
+// to fill in.
+var publisherNotCopied = []string{
+	"Catalog",
+	"Books",
+}
+
+// publisherInitialFields are the add form fields a query parameter of the same
+// name may pre-fill.
+var publisherInitialFields = []vent.InitialField{
+	{
+		Name: "name",
+		Kind: vent.FieldKind("string"),
+	},
+	{
+		Name:    "books",
+		Kind:    vent.FieldKind("foreign_key"),
+		CheckID: vent.CheckID[int],
+	},
+}
+
+// buildPublisherAddPageProps builds the add page props for Publisher. A non-nil
+// source pre-fills the form with a copy of it.
+func (h *AdminHandler) buildPublisherAddPageProps(ctx context.Context, source *ent.Publisher, errorMessage string) (gui.SchemaEntityAddProps, error) {
+	canCreate, err := h.schemas.Publisher.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityAddProps{}, err
+	}
+	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canCreate,
+	})
+	if source != nil {
+		ctx = withPublisherDuplicateSource(ctx, source)
+	}
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.publisherFields.createFormFieldSets))
+	for _, fieldSet := range h.publisherFields.createFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.CreateHTML(ctx)
+			if err != nil {
+				return gui.SchemaEntityAddProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	props := gui.SchemaEntityAddProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaAddBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			"Publisher",
+		)),
+		RouteName:           "publishers",
+		SingularDisplayName: "Publisher",
+		ErrorMessage:        errorMessage,
+		FieldSets:           fieldSets,
+		Tabs:                false,
+		Multipart:           true,
+	}
+	if source != nil {
+		props.DuplicateOf = h.schemas.Publisher.Name(source)
+		props.NotCopied = publisherNotCopied
+	}
+	return props, nil
+}
+
+func (h *AdminHandler) patchPublisherAddPageError(w http.ResponseWriter, r *http.Request, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildPublisherAddPageProps(r.Context(), nil, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getPublisherAddHandler returns the handler for GET /admin/publishers/add/
+func (h *AdminHandler) getPublisherAddHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		initial, err := vent.ParseInitialValues(r.URL.Query(), publisherInitialFields)
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		ctx := vent.WithInitialValues(r.Context(), initial)
+
+		props, err := h.buildPublisherAddPageProps(ctx, nil, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(ctx, w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getPublisherDuplicateHandler returns the handler for GET /admin/publishers/{id}/duplicate/,
+// the add form pre-filled from the Publisher with id.
+func (h *AdminHandler) getPublisherDuplicateHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		source, err := h.loadPublisher(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Publisher.CanRead(r.Context(), source)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		props, err := h.buildPublisherAddPageProps(r.Context(), source, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// buildPublisherPageProps builds the edit page props for Publisher.
+func (h *AdminHandler) buildPublisherPageProps(ctx context.Context, id uuid.UUID, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.Publisher.EagerLoadQuery(h.client.Publisher.Query().
+		Where(publisher.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Publisher.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canCreate, err := h.schemas.Publisher.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canUpdate, err := h.schemas.Publisher.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canDelete, err := h.schemas.Publisher.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.publisherFields.updateFormFieldSets))
+	for _, fieldSet := range h.publisherFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedPublisherActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.publisherEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.Publisher.Name(e)
+	props := gui.SchemaEntityChangeProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaEntityBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			entityDisplay,
+		)),
+		RouteName:     "publishers",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     true,
+		RelatedLists:  publisherRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		Duplicate:     canCreate,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// publisherRelatedLists returns the lists filtered to one Publisher
+// that the current user may read, for its change and detail pages.
+func publisherRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	return lists
+}
+
+// buildPublisherDetailPageProps builds the read-only detail page props for Publisher.
+func (h *AdminHandler) buildPublisherDetailPageProps(ctx context.Context, id uuid.UUID) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.Publisher.EagerLoadQuery(h.client.Publisher.Query().
+		Where(publisher.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Publisher.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.Publisher.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.Publisher.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.publisherFields.updateFormFieldSets))
+	for _, fieldSet := range h.publisherFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedPublisherActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.publisherEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.Publisher.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"publishers",
+			"Publishers",
+			entityDisplay,
+		)),
+		RouteName:     "publishers",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  publisherRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// getPublisherDetailHandler returns the handler for GET /admin/publishers/{id}/view/
+func (h *AdminHandler) getPublisherDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		h.renderPublisherDetailPage(w, r, id)
+	})
+}
+
+func (h *AdminHandler) renderPublisherDetailPage(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	props, err := h.buildPublisherDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// authorizePublisherFile allows the file key stored in the Publisher upload field
+// to users who can read the Publisher holding it.
+func (h *AdminHandler) authorizePublisherFile(ctx context.Context, field, key string) error {
+	query := h.client.Publisher.Query()
+	switch field {
+	case "catalog":
+		query.Where(publisher.CatalogEQ(key))
+	default:
+		return vent.NotFound("file not found")
+	}
+	if err := denyIfCannot(defaultCan(ctx, "read_publisher")); err != nil {
+		return err
+	}
+	// Files of trashed entities are shown to those who can see the trash.
+	e, err := query.First(vent.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return vent.NotFound("file not found")
+	}
+	if err != nil {
+		return err
+	}
+	if e.DeletedAt != nil {
+		if err := denyIfCannot(defaultCan(ctx, "delete_publisher")); err != nil {
+			return err
+		}
+	}
+	return denyIfCannot(h.schemas.Publisher.CanRead(ctx, e))
+}
+
+// removePublisherFiles deletes the stored files whose keys prev's upload fields held
+// and e's no longer do, or all of them when e is nil. It runs once the change
+// has committed, so failures are only logged.
+func (h *AdminHandler) removePublisherFiles(ctx context.Context, prev, e *ent.Publisher) {
+	if prev.Catalog != "" && (e == nil || e.Catalog != prev.Catalog) {
+		h.removePublisherFile(ctx, "catalog", prev.Catalog)
+	}
+}
+
+// removePublisherFile deletes the stored file key unless another Publisher, trashed
+// or not, still holds it in field.
+func (h *AdminHandler) removePublisherFile(ctx context.Context, field, key string) {
+	if h.fileStorage == nil {
+		return
+	}
+	query := h.client.Publisher.Query()
+	switch field {
+	case "catalog":
+		query.Where(publisher.CatalogEQ(key))
+	}
+	used, err := query.Exist(vent.SkipSoftDelete(ctx))
+	if err == nil && !used {
+		err = h.fileStorage.Delete(ctx, key)
+	}
+	if err != nil {
+		log.Printf("Publisher remove file %s: %v", key, err)
+	}
+}
+
+// loadPublisher loads the Publisher with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadPublisher(ctx context.Context, id uuid.UUID) (*ent.Publisher, error) {
+	return h.schemas.Publisher.EagerLoadQuery(h.db(ctx).Publisher.Query().
+		Where(publisher.IDEQ(id))).
+		Only(ctx)
+}
+
+// auditPublisher records a Publisher mutation in the audit log, diffing
+// before against the saved Publisher with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditPublisher(ctx context.Context, action vent.AuditAction, before *ent.Publisher, id uuid.UUID) error {
+	var after *ent.Publisher
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadPublisher(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
+		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.publisherAuditSnapshot(ctx, before), h.publisherAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "Publisher", id, h.schemas.Publisher.Name(named), changes)
+}
+
+// publisherAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) publisherAuditSnapshot(ctx context.Context, e *ent.Publisher) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.publisherFields.auditFields))
+	for _, f := range h.publisherFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
+
+// buildPublisherHistoryPageProps builds the History tab props for Publisher.
+func (h *AdminHandler) buildPublisherHistoryPageProps(ctx context.Context, id uuid.UUID) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadPublisher(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.Publisher.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("Publisher"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entityDisplay := h.schemas.Publisher.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "publishers",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
+}
+
+// getPublisherHistoryHandler returns the handler for GET /admin/publishers/{id}/history/
+func (h *AdminHandler) getPublisherHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildPublisherHistoryPageProps(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// publisherRevisionFields are the fields a Publisher revision snapshots, in form order.
+var publisherRevisionFields = []string{
+	"name",
+	"books",
+}
+
+// publisherRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) publisherRevisionSnapshot(ctx context.Context, e *ent.Publisher) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	snapshot["name"] = e.Name
+	if snapshot["books"], err = revisionEdgeIDs(h.db(ctx).Publisher.QueryBooks(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// buildPublisherRevisionsPageProps builds the Revisions tab props for the Publisher
+// with id, comparing the revisions from and to. A deleted Publisher is shown
+// from its revisions.
+func (h *AdminHandler) buildPublisherRevisionsPageProps(ctx context.Context, id uuid.UUID, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Publisher"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "publishers",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadPublisher(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		canCreate, err := h.schemas.Publisher.CanCreate(ctx)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = canCreate
+		if props.InTrash, err = h.client.Publisher.Query().Where(publisher.IDEQ(id)).Exist(vent.SkipSoftDelete(ctx)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = props.CanRestore && !props.InTrash
+		props.LayoutProps = h.buildLayoutProps(ctx, "Publisher", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.Publisher.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.Publisher.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.publisherRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.Publisher.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "Publisher", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%spublishers/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(publisherRevisionFields, fromSnapshot, toSnapshot)
+	}
+	return props, nil
+}
+
+// getPublisherRevisionsHandler returns the handler for GET /admin/publishers/{id}/revisions/
+func (h *AdminHandler) getPublisherRevisionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		query := r.URL.Query()
+		props, err := h.buildPublisherRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postPublisherRestoreHandler returns the handler for POST /admin/publishers/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postPublisherRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
+			return
+		}
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("Publisher"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		var (
+			e     *ent.Publisher
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restorePublisherRevision(ctx, id, rev)
+			return err
+		})
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%spublishers/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
+	})
+}
+
+// restorePublisherRevision replays rev onto the Publisher with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the Publisher as it was before. A deleted Publisher is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restorePublisherRevision(ctx context.Context, id uuid.UUID, rev *ent.Revision) (*ent.Publisher, func(context.Context), error) {
+	e, err := h.loadPublisher(ctx, id)
+	if ent.IsNotFound(err) {
+		inTrash, err := h.db(ctx).Publisher.Query().Where(publisher.IDEQ(id)).Exist(vent.SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, nil, err
+		}
+		if inTrash {
+			return nil, nil, vent.BadRequest("this publisher is in the trash; restore it from there")
+		}
+		e, err := h.recreatePublisher(ctx, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, func(ctx context.Context) {
+			h.afterPublisherCreate(ctx, e.ID)
+		}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_publisher")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
+	}
+
+	var input PublisherUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	if err := h.updatePublisher(ctx, e, input); err != nil {
+		return nil, nil, err
+	}
+	return e, func(ctx context.Context) {
+		h.afterPublisherUpdate(ctx, e, id)
+	}, nil
+}
+
+// recreatePublisher recreates a deleted Publisher from rev in ctx's transaction,
+// through the same create path as the add form. The new Publisher gets a new ID,
+// which is recorded on the deleted entity's revisions.
+func (h *AdminHandler) recreatePublisher(ctx context.Context, rev *ent.Revision) (*ent.Publisher, error) {
+	deleted := revision.And(
+		revision.SchemaEQ(rev.Schema),
+		revision.EntityIDEQ(rev.EntityID),
+		revision.ActionEQ(revision.ActionDelete),
+	)
+	restored, err := h.db(ctx).Revision.Query().
+		Where(deleted, revision.RestoredIDNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restored {
+		return nil, vent.BadRequest("this publisher has already been restored")
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanCreate(ctx)); err != nil {
+		return nil, err
+	}
+
+	var input PublisherCreateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	e, err := h.createPublisher(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db(ctx).Revision.Update().
+		Where(deleted).
+		SetRestoredID(vent.FormatID(e.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record restored revision: %w", err)
+	}
+	return e, nil
+}
+
+// buildPublisherDeletedPageProps lists the deleted Publisher entities that have
+// not been restored, newest first.
+func (h *AdminHandler) buildPublisherDeletedPageProps(ctx context.Context) (gui.SchemaDeletedProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Publisher"),
+			revision.ActionEQ(revision.ActionDelete),
+			revision.RestoredIDIsNil(),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaDeletedProps{}, err
+	}
+
+	props := gui.SchemaDeletedProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaDeletedBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+		)),
+		RouteName:         "publishers",
+		PluralDisplayName: "Publishers",
+		Truncated:         len(revs) > vent.RevisionLimit,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+	for _, rev := range revs {
+		props.Entries = append(props.Entries, gui.DeletedEntry{
+			EntityID:      rev.EntityID,
+			EntityDisplay: rev.EntityName,
+			At:            rev.CreatedAt,
+			Actor:         rev.Actor,
+		})
+	}
+	return props, nil
+}
+
+// getPublisherDeletedHandler returns the handler for GET /admin/publishers/deleted/
+func (h *AdminHandler) getPublisherDeletedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildPublisherDeletedPageProps(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaDeletedPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// publisherTrashPermissions reports whether the user may restore and purge
+// Publisher entities in the trash. Both need the delete permission too.
+func (h *AdminHandler) publisherTrashPermissions(ctx context.Context) (bool, bool, error) {
+	canDelete, err := defaultCan(ctx, "delete_publisher")
+	if err != nil || !canDelete {
+		return false, false, err
+	}
+	canRestore, err := defaultCan(ctx, "restore_publisher")
+	if err != nil {
+		return false, false, err
+	}
+	canPurge, err := defaultCan(ctx, "purge_publisher")
+	if err != nil {
+		return false, false, err
+	}
+	return canRestore, canPurge, nil
+}
+
+// loadTrashedPublisher loads the Publisher with id only if it is in the trash.
+func (h *AdminHandler) loadTrashedPublisher(ctx context.Context, id uuid.UUID) (*ent.Publisher, error) {
+	e, err := h.schemas.Publisher.EagerLoadQuery(h.client.Publisher.Query().
+		Where(publisher.IDEQ(id), publisher.DeletedAtNotNil())).
+		Only(vent.SkipSoftDelete(ctx))
+	if ent.IsNotFound(err) {
+		return nil, vent.NotFound("publisher is not in the trash")
+	}
+	return e, err
+}
+
+// buildPublisherTrashPageProps lists the Publisher entities in the trash, most
+// recently deleted first.
+func (h *AdminHandler) buildPublisherTrashPageProps(ctx context.Context) (gui.SchemaTrashProps, error) {
+	canRestore, canPurge, err := h.publisherTrashPermissions(ctx)
+	if err != nil {
+		return gui.SchemaTrashProps{}, err
+	}
+	if !canRestore && !canPurge {
+		return gui.SchemaTrashProps{}, vent.Forbidden("forbidden")
+	}
+
+	trashCtx := vent.SkipSoftDelete(ctx)
+	entities, err := h.schemas.Publisher.EagerLoadQuery(h.client.Publisher.Query().
+		Where(publisher.DeletedAtNotNil())).
+		Order(publisher.ByDeletedAt(sql.OrderDesc()), publisher.ByID(sql.OrderDesc())).
+		Limit(vent.TrashLimit + 1).
+		All(trashCtx)
+	if err != nil {
+		return gui.SchemaTrashProps{}, err
+	}
+
+	props := gui.SchemaTrashProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaTrashBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+		)),
+		RouteName:         "publishers",
+		PluralDisplayName: "Publishers",
+		Columns: []string{
+			"Name",
+			"ID",
+		},
+		Truncated:  len(entities) > vent.TrashLimit,
+		CanRestore: canRestore,
+		CanPurge:   canPurge,
+	}
+	if props.Truncated {
+		entities = entities[:vent.TrashLimit]
+	}
+	for _, e := range entities {
+		cells := make([]string, len(h.publisherFields.listColumns))
+		for i, field := range h.publisherFields.listColumns {
+			cells[i] = field.ListCell(trashCtx, e)
+		}
+		props.Rows = append(props.Rows, gui.SchemaTrashRow{
+			ID:        vent.FormatID(e.ID),
+			Display:   h.schemas.Publisher.Name(e),
+			Cells:     cells,
+			DeletedAt: *e.DeletedAt,
+		})
+	}
+	return props, nil
+}
+
+// getPublisherTrashHandler returns the handler for GET /admin/publishers/trash/
+func (h *AdminHandler) getPublisherTrashHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildPublisherTrashPageProps(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTrashPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// restoreTrashedPublisher takes the Publisher with id out of the trash.
+func (h *AdminHandler) restoreTrashedPublisher(ctx context.Context, id uuid.UUID) error {
+	e, err := h.loadTrashedPublisher(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanDelete(ctx, e)); err != nil {
+		return err
+	}
+	return h.withTx(ctx, func(ctx context.Context) error {
+		n, err := h.db(ctx).Publisher.Update().
+			Where(publisher.IDEQ(id), publisher.DeletedAtNotNil()).
+			ClearDeletedAt().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return vent.NotFound("publisher is not in the trash")
+		}
+		return h.auditPublisher(ctx, vent.AuditActionRestore, nil, id)
+	})
+}
+
+// purgePublisher deletes the Publisher with id from the trash for good,
+// with its stored files.
+func (h *AdminHandler) purgePublisher(ctx context.Context, id uuid.UUID) error {
+	e, err := h.loadTrashedPublisher(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanDelete(ctx, e)); err != nil {
+		return err
+	}
+	if err := h.checkPublisherDelete(ctx, e); err != nil {
+		return err
+	}
+	snapshot, err := h.publisherRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+	err = h.withTx(ctx, func(ctx context.Context) error {
+		n, err := h.db(ctx).Publisher.Delete().
+			Where(publisher.IDEQ(id), publisher.DeletedAtNotNil()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return vent.NotFound("publisher is not in the trash")
+		}
+		if err := h.auditPublisher(ctx, vent.AuditActionPurge, e, id); err != nil {
+			return err
+		}
+		if err := h.recordRevision(ctx, vent.AuditActionDelete, "Publisher", id, h.schemas.Publisher.Name(e), snapshot); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err == nil {
+		h.removePublisherFiles(ctx, e, nil)
+	}
+	return err
+}
+
+// postPublisherTrashRestoreHandler returns the handler for POST /admin/publishers/{id}/restore/
+func (h *AdminHandler) postPublisherTrashRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		restoreErr := h.restoreTrashedPublisher(r.Context(), id)
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		sse.Redirect(fmt.Sprintf("%spublishers/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(id)))
+	})
+}
+
+// buildPublisherPurgePageProps builds the purge confirmation props for the
+// Publisher in the trash with id, listing what deleting it for good does to the
+// entities related to it.
+func (h *AdminHandler) buildPublisherPurgePageProps(ctx context.Context, id uuid.UUID, errorMessage string) (gui.SchemaEntityDeleteProps, error) {
+	e, err := h.loadTrashedPublisher(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Publisher.CanDelete(ctx, e)); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+
+	entityDisplay := h.schemas.Publisher.Name(e)
+	props := gui.SchemaEntityDeleteProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaPurgeBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+			entityDisplay,
+		)),
+		RouteName:     "publishers",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		Purge:         true,
+	}
+	if props.Relations, err = h.publisherDeleteRelations(ctx, e); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	return props, nil
+}
+
+// getPublisherPurgeHandler returns the handler for GET /admin/publishers/{id}/purge/
+func (h *AdminHandler) getPublisherPurgeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildPublisherPurgePageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityDeletePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) patchPublisherPurgePageError(w http.ResponseWriter, r *http.Request, id uuid.UUID, err error) {
+	props, buildErr := h.buildPublisherPurgePageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityDeletePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// postPublisherPurgeHandler returns the handler for POST /admin/publishers/{id}/purge/
+func (h *AdminHandler) postPublisherPurgeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		if err := h.purgePublisher(r.Context(), id); err != nil {
+			h.patchPublisherPurgePageError(w, r, id, err)
+			return
+		}
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "publishers/trash/")
+	})
+}
+
+// getPublisherHandler returns the handler for GET /admin/publishers/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getPublisherHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadPublisher(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.Publisher.CanUpdate(r.Context(), e)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if !canUpdate {
+			h.renderPublisherDetailPage(w, r, id)
+			return
+		}
+
+		props, err := h.buildPublisherPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+func (h *AdminHandler) patchPublisherPageError(w http.ResponseWriter, r *http.Request, id uuid.UUID, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildPublisherPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// postPublisherHandler returns the handler for POST /admin/publishers/
+func (h *AdminHandler) postPublisherHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Entity PublisherCreateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchPublisherAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+		r = r.WithContext(vent.WithUploadForm(r.Context(), r.MultipartForm))
+
+		var id uuid.UUID
+		err := h.withTx(r.Context(), func(ctx context.Context) error {
+			e, err := h.createPublisher(ctx, input)
+			if err != nil {
+				return err
+			}
+			id = e.ID
+			return nil
+		})
+		if err != nil {
+			h.patchPublisherAddPageError(w, r, err)
+			return
+		}
+		h.afterPublisherCreate(r.Context(), id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "publishers/")
+	})
+}
+
+// createPublisher saves input as a new Publisher in ctx's transaction:
+// ValidateCreate, the create fields, BeforeCreate and the audit log entry.
+func (h *AdminHandler) createPublisher(ctx context.Context, input PublisherCreateInput) (*ent.Publisher, error) {
+	if err := h.schemas.Publisher.ValidateCreate(ctx, input); err != nil {
+		return nil, err
+	}
+
+	builder := h.db(ctx).Publisher.Create()
+
+	for _, field := range h.publisherFields.createBindFields {
+		if err := field.ApplyCreate(ctx, builder, input); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.schemas.Publisher.BeforeCreate(ctx, builder); err != nil {
+		return nil, err
+	}
+
+	e, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.auditPublisher(ctx, vent.AuditActionCreate, nil, e.ID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// afterPublisherCreate runs the AfterCreate hook on the Publisher saved with id.
+func (h *AdminHandler) afterPublisherCreate(ctx context.Context, id uuid.UUID) {
+	e, err := h.loadPublisher(ctx, id)
+	if err == nil {
+		err = h.schemas.Publisher.AfterCreate(ctx, e)
+	}
+	if err != nil {
+		log.Printf("Publisher after create hook: %v", err)
+	}
+}
+
+// publisherImportColumns are the Publisher fields an import file can fill.
+var publisherImportColumns = []vent.ImportColumn{
+	{Name: "name", Label: "Name"},
+	{Name: "books", Label: "Books"},
+}
+
+// buildPublisherImportPageProps builds the import page props for Publisher.
+func (h *AdminHandler) buildPublisherImportPageProps(ctx context.Context) gui.SchemaImportProps {
+	props := gui.SchemaImportProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Publisher", gui.SchemaImportBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"publishers",
+			"Publishers",
+		)),
+		RouteName:         "publishers",
+		PluralDisplayName: "Publishers",
+		Columns: []gui.SchemaImportColumn{
+			{Name: "name", Label: "Name"},
+			{Name: "books", Label: "Books", Hint: "IDs, comma-separated"},
+		},
+	}
+	return props
+}
+
+// getPublisherImportHandler returns the handler for GET /admin/publishers/import/
+func (h *AdminHandler) getPublisherImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := gui.SchemaImportPage(h.buildPublisherImportPageProps(r.Context())).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postPublisherImportHandler returns the handler for POST /admin/publishers/import/.
+// With ?dry_run=1 the rows are saved and then rolled back, so the preview
+// reports the same errors a real import would.
+func (h *AdminHandler) postPublisherImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props := gui.SchemaImportResultProps{
+			RouteName:         "publishers",
+			PluralDisplayName: "Publishers",
+		}
+		result, err := h.importPublisherFile(r, r.URL.Query().Get("dry_run") != "")
+		if err != nil {
+			props.ErrorMessage = normalizeError(err).PublicMessage()
+		} else {
+			props.Result = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.PatchElementTempl(gui.SchemaImportResult(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) importPublisherFile(r *http.Request, dryRun bool) (vent.ImportResult, error) {
+	file, err := vent.ReadImportUpload(r, "file")
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	mapping := vent.MapImportColumns(file.Headers, publisherImportColumns)
+	if strings.Join(mapping, "") == "" {
+		return vent.ImportResult{}, vent.BadRequest("no column in the file matches a Publisher field")
+	}
+	upsert := r.FormValue("upsert")
+	if upsert != "" {
+		return vent.ImportResult{}, vent.BadRequest(fmt.Sprintf("cannot upsert on %q", upsert))
+	}
+
+	result := vent.NewImportResult(file, mapping, publisherImportColumns, dryRun)
+	var afterHooks []func(context.Context)
+	err = h.withTx(r.Context(), func(ctx context.Context) error {
+		for _, row := range file.Rows {
+			// Each row gets a savepoint, so a failed row does not abort the
+			// transaction for the rows after it.
+			action := vent.ImportActionCreate
+			err := withSavepoint(ctx, func(ctx context.Context) error {
+				var (
+					after func(context.Context)
+					err   error
+				)
+				action, after, err = h.importPublisherRow(ctx, row.Fields(mapping), upsert)
+				if err == nil {
+					afterHooks = append(afterHooks, after)
+				}
+				return err
+			})
+			message := ""
+			if err != nil {
+				message = normalizeError(err).PublicMessage()
+			}
+			result.Record(row, mapping, action, message)
+		}
+		if dryRun || result.Failed > 0 {
+			return errRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		return result, nil
+	}
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	result.Committed = true
+	for _, after := range afterHooks {
+		after(r.Context())
+	}
+	return result, nil
+}
+
+// importPublisherRow saves one import row in ctx's transaction through the
+// same path as the add form. The returned func
+// runs the After hook once the import commits.
+func (h *AdminHandler) importPublisherRow(ctx context.Context, values map[string]string, upsert string) (vent.ImportAction, func(context.Context), error) {
+	client := h.db(ctx)
+	var input PublisherCreateInput
+	if err := vent.DecodeImportValues(values, &input); err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	for i, ref := range input.Books {
+		id, err := resolveBookImportRef(ctx, client, ref)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.Books[i] = id
+	}
+
+	e, err := h.createPublisher(ctx, input)
+	if err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	return vent.ImportActionCreate, func(ctx context.Context) {
+		h.afterPublisherCreate(ctx, e.ID)
+	}, nil
+}
+
+// resolvePublisherImportRef returns the ID of the Publisher an import
+// cell references, by ID.
+func resolvePublisherImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parsePublisherID(ref); err == nil {
+		exists, err := client.Publisher.Query().Where(publisher.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Publisher matches %q", ref))
+}
+
+// patchPublisherHandler returns the handler for PATCH /admin/publishers/{id}/
+func (h *AdminHandler) patchPublisherHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadPublisher(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Publisher.CanUpdate(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		var signals struct {
+			Entity PublisherUpdateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchPublisherPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+		r = r.WithContext(vent.WithUploadForm(r.Context(), r.MultipartForm))
+
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updatePublisher(ctx, e, input)
+		})
+		if err != nil {
+			h.patchPublisherPageError(w, r, id, err)
+			return
+		}
+		h.afterPublisherUpdate(r.Context(), e, id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "publishers/")
+	})
+}
+
+// updatePublisher saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+func (h *AdminHandler) updatePublisher(ctx context.Context, e *ent.Publisher, input PublisherUpdateInput) error {
+	if err := h.schemas.Publisher.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
+
+	snapshot, err := h.publisherRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	builder := h.db(ctx).Publisher.UpdateOneID(e.ID)
+
+	for _, field := range h.publisherFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
+		}
+	}
+	if err := h.schemas.Publisher.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	if err := h.auditPublisher(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "Publisher", e.ID, h.schemas.Publisher.Name(e), snapshot)
+}
+
+// afterPublisherUpdate runs the AfterUpdate hook on the Publisher with id, which
+// was prev before the save, after deleting the files the save replaced or
+// cleared.
+func (h *AdminHandler) afterPublisherUpdate(ctx context.Context, prev *ent.Publisher, id uuid.UUID) {
+	e, err := h.loadPublisher(ctx, id)
+	if err == nil {
+		h.removePublisherFiles(ctx, prev, e)
+		err = h.schemas.Publisher.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("Publisher after update hook: %v", err)
+	}
+}
+
+// deletePublisherHandler returns the handler for DELETE /admin/publishers/{id}/
+func (h *AdminHandler) deletePublisherHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parsePublisherID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadPublisher(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Publisher.CanDelete(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		if err := h.deletePublisherRow(r.Context(), e); err != nil {
+			h.patchPublisherDeletePageError(w, r, id, err)
+			return
+		}
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "publishers/")
+	})
+}
+
+// ============================================================================
+// Review Handlers
+// ============================================================================
+
+// parseReviewID parses a Review ID from a URL path or form value.
+func parseReviewID(value string) (int, error) {
+	return vent.ParseID[int](value)
+}
+
+// ReviewCreateInput is the typed input for creating a Review
+type ReviewCreateInput struct {
+	User   string  `json:"user"`
+	Rating int     `json:"rating"`
+	Body   *string `json:"body"`
+	Book   string  `json:"book"`
+}
+
+// ReviewUpdateInput is the typed input for updating a Review
+type ReviewUpdateInput struct {
+	User   *string               `json:"user"`
+	Rating *int                  `json:"rating"`
+	Body   OptionalInput[string] `json:"body"`
+	Book   *string               `json:"book"`
+}
+
+// ReviewListFilter is the typed list query for listing Review.
+type ReviewListFilter struct {
+	Rating    string
+	RatingMin string
+	RatingMax string
+	Book      string
+}
+
+// reviewListOrders maps Review list sort keys to Ent order options.
+var reviewListOrders = map[string]func(...sql.OrderTermOption) review.OrderOption{
+	"user": func(opts ...sql.OrderTermOption) review.OrderOption {
+		return review.ByUserField("id", opts...)
+	},
+	"rating": review.ByRating,
+	"book": func(opts ...sql.OrderTermOption) review.OrderOption {
+		return review.ByBookField("id", opts...)
+	},
+}
+
+// reviewDefaultOrdering is the Review list ordering when the request names none.
+var reviewDefaultOrdering = []string{}
+
+func reviewListSortable(name string) bool {
+	_, ok := reviewListOrders[name]
+	return ok
+}
+
+// reviewListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func reviewListOrder(sorts []vent.ListSort) []review.OrderOption {
+	orders := make([]review.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, reviewListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, review.ByID())
+	}
+	return orders
+}
+
+// reviewListQuery is the Review list query with a request's filters, search, and sort applied.
+type reviewListQuery struct {
+	query  *ent.ReviewQuery
+	filter ReviewListFilter
+	search string
+	sorts  []vent.ListSort
+}
+
+// newReviewListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newReviewListQuery(q url.Values) reviewListQuery {
+	filter := ReviewListFilter{
+		Rating:    vent.FilterParam(q, "filter.rating"),
+		RatingMin: q.Get("filter.rating.min"),
+		RatingMax: q.Get("filter.rating.max"),
+		Book:      vent.FilterParam(q, "filter.book"),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), reviewDefaultOrdering, reviewListSortable)
+	search := strings.TrimSpace(q.Get("q"))
+	query := h.client.Review.Query()
+	if values := vent.ParseIntFilterValues[int](filter.Rating); len(values) > 0 {
+		query = query.Where(review.RatingIn(values...))
+	}
+	if v, ok := vent.ParseIntFilter[int](filter.RatingMin); ok {
+		query = query.Where(review.RatingGTE(v))
+	}
+	if v, ok := vent.ParseIntFilter[int](filter.RatingMax); ok {
+		query = query.Where(review.RatingLTE(v))
+	}
+	if filterVals := vent.FilterValues(filter.Book); len(filterVals) > 0 {
+		ids := make([]int, 0, len(filterVals))
+		for _, filterVal := range filterVals {
+			if id, err := parseBookID(filterVal); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > 0 {
+			query = query.Where(review.HasBookWith(book.IDIn(ids...)))
+		}
+	}
+	if search != "" {
+		query = query.Where(review.Or(
+			review.BodyContainsFold(search),
+			review.HasBookWith(book.TitleContainsFold(search)),
+			review.HasUserWith(user.EmailContainsFold(search)),
+		))
+	}
+	return reviewListQuery{
+		query:  query,
+		filter: filter,
+		search: search,
+		sorts:  sorts,
+	}
+}
+
+// buildReviewListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildReviewListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newReviewListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+	search := list.search
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedReviewActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.Review.EagerLoadQuery(query).
+			Order(reviewListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaTableProps{}, err
+		}
+
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.reviewFields.listColumns))
+			for j, field := range h.reviewFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%sreviews/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.reviewEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.Review.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_review")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listReviewBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canViewDeleted, err := defaultCan(ctx, "delete_review")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+	filterChoicesBook, err := h.loadReviewBookFilterChoices(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "Review", gui.SchemaListBreadcrumbs("Reviews")),
+		RouteName:           "reviews",
+		SingularDisplayName: "Review",
+		PluralDisplayName:   "Reviews",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "user", Label: "User", Type: "edge", Sortable: true},
+			{Name: "rating", Label: "Rating", Type: "int", Sortable: true},
+			{Name: "book", Label: "Book", Type: "edge", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "rating",
+				Label: "Rating",
+				Type:  "int",
+				Value: filter.Rating,
+				Range: true,
+				Min:   filter.RatingMin,
+				Max:   filter.RatingMax,
+			},
+			{
+				Name:    "book",
+				Label:   "Book",
+				Type:    "edge",
+				Value:   filter.Book,
+				Choices: filterChoicesBook,
+			},
+		},
+		Searchable:    true,
+		Search:        search,
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", reviewDefaultOrdering, reviewListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		Deleted:       canViewDeleted,
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getReviewListHandler returns the handler for GET /admin/reviews/
+func (h *AdminHandler) getReviewListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildReviewListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// allowedReviewActions returns the custom Review actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedReviewActions(ctx context.Context) ([]ReviewAction, error) {
+	var allowed []ReviewAction
+	for _, action := range h.schemas.Review.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed, nil
+}
+
+// lookupReviewAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupReviewAction(ctx context.Context, name string) (ReviewAction, error) {
+	for _, action := range h.schemas.Review.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return ReviewAction{}, err
+		}
+		return action, nil
+	}
+	return ReviewAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
+
+// canReviewRunAction reports whether action may run on e.
+func (h *AdminHandler) canReviewRunAction(ctx context.Context, action ReviewAction, e *ent.Review) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.Review.CanUpdate(ctx, e)
+}
+
+// reviewEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) reviewEntityActions(ctx context.Context, allowed []ReviewAction, e *ent.Review) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canReviewRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
+}
+
+// listReviewBulkActions returns the bulk actions the current user may run
+// on Review rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listReviewBulkActions(ctx context.Context, allowed []ReviewAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
+}
+
+// postReviewBulkHandler returns the handler for POST /admin/reviews/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postReviewBulkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runReviewBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
+
+		props, err := h.buildReviewListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runReviewBulkAction runs selection.Action on each selected Review,
+// checking permissions per row.
+func (h *AdminHandler) runReviewBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.Review.Query()
+	if selection.All {
+		query = h.newReviewListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one Review")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parseReviewID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
+			}
+			ids[i] = id
+		}
+		query = query.Where(review.IDIn(ids...))
+	}
+	entities, err := h.schemas.Review.EagerLoadQuery(query).
+		Order(review.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
+
+	action, err := h.lookupReviewAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canReviewRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.Review.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
+
+// postReviewActionHandler returns the handler for POST /admin/reviews/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postReviewActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		action, err := h.lookupReviewAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		result, runErr := h.runReviewAction(r.Context(), action, id)
+
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildReviewListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildReviewDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "reviews/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildReviewPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "reviews/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runReviewAction runs action on the Review with id after its row check.
+func (h *AdminHandler) runReviewAction(ctx context.Context, action ReviewAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.Review.EagerLoadQuery(h.client.Review.Query().
+		Where(review.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canReviewRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
+	}
+	return action.Run(ctx, e)
+}
+
+// getReviewExportHandler returns the handler for GET /admin/reviews/export/
+func (h *AdminHandler) getReviewExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		list := h.newReviewListQuery(r.URL.Query())
+		query := h.schemas.Review.EagerLoadQuery(list.query).Order(reviewListOrder(list.sorts)...)
+		columns := []string{
+			"User",
+			"Rating",
+			"Book",
+		}
+		vent.ServeExport(w, r, format, "reviews", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.reviewFields.listColumns))
+				for j, field := range h.reviewFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
+	})
+}
+
+// loadReviewBookFilterChoices lists the Book choices for the book list filter.
+func (h *AdminHandler) loadReviewBookFilterChoices(ctx context.Context) ([]gui.SelectOption, error) {
+	entities, err := h.schemas.Book.EagerLoadQuery(h.client.Book.Query()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	choices := make([]gui.SelectOption, len(entities))
+	for i, entity := range entities {
+		choices[i] = gui.SelectOption{
+			Value: vent.FormatID(entity.ID),
+			Label: h.schemas.Book.Name(entity),
+		}
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].Label < choices[j].Label
+	})
+	return choices, nil
+}
+
+// reviewNotCopied labels the add form fields a duplicate leaves for the user
+// to fill in.
+var reviewNotCopied = []string{}
+
+// reviewInitialFields are the add form fields a query parameter of the same
+// name may pre-fill.
+var reviewInitialFields = []vent.InitialField{
+	{
+		Name:    "user",
+		Kind:    vent.FieldKind("foreign_key_unique"),
+		CheckID: vent.CheckID[int],
+	},
+	{
+		Name: "rating",
+		Kind: vent.FieldKind("int"),
+	},
+	{
+		Name: "body",
+		Kind: vent.FieldKind("string"),
+	},
+	{
+		Name:    "book",
+		Kind:    vent.FieldKind("foreign_key_unique"),
+		CheckID: vent.CheckID[int],
+	},
+}
+
+// buildReviewAddPageProps builds the add page props for Review. A non-nil
+// source pre-fills the form with a copy of it.
+func (h *AdminHandler) buildReviewAddPageProps(ctx context.Context, source *ent.Review, errorMessage string) (gui.SchemaEntityAddProps, error) {
+	canCreate, err := h.schemas.Review.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityAddProps{}, err
+	}
+	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canCreate,
+	})
+	if source != nil {
+		ctx = withReviewDuplicateSource(ctx, source)
+	}
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.reviewFields.createFormFieldSets))
+	for _, fieldSet := range h.reviewFields.createFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.CreateHTML(ctx)
+			if err != nil {
+				return gui.SchemaEntityAddProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	props := gui.SchemaEntityAddProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaAddBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+			"Review",
+		)),
+		RouteName:           "reviews",
+		SingularDisplayName: "Review",
+		ErrorMessage:        errorMessage,
+		FieldSets:           fieldSets,
+		Tabs:                false,
+		Multipart:           false,
+	}
+	if source != nil {
+		props.DuplicateOf = h.schemas.Review.Name(source)
+		props.NotCopied = reviewNotCopied
+	}
+	return props, nil
+}
+
+func (h *AdminHandler) patchReviewAddPageError(w http.ResponseWriter, r *http.Request, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildReviewAddPageProps(r.Context(), nil, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getReviewAddHandler returns the handler for GET /admin/reviews/add/
+func (h *AdminHandler) getReviewAddHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		initial, err := vent.ParseInitialValues(r.URL.Query(), reviewInitialFields)
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		ctx := vent.WithInitialValues(r.Context(), initial)
+
+		props, err := h.buildReviewAddPageProps(ctx, nil, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(ctx, w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getReviewDuplicateHandler returns the handler for GET /admin/reviews/{id}/duplicate/,
+// the add form pre-filled from the Review with id.
+func (h *AdminHandler) getReviewDuplicateHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		source, err := h.loadReview(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Review.CanRead(r.Context(), source)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		props, err := h.buildReviewAddPageProps(r.Context(), source, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// buildReviewPageProps builds the edit page props for Review.
+func (h *AdminHandler) buildReviewPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.Review.EagerLoadQuery(h.client.Review.Query().
+		Where(review.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Review.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canCreate, err := h.schemas.Review.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canUpdate, err := h.schemas.Review.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canDelete, err := h.schemas.Review.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.reviewFields.updateFormFieldSets))
+	for _, fieldSet := range h.reviewFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedReviewActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.reviewEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.Review.Name(e)
+	props := gui.SchemaEntityChangeProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaEntityBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+			entityDisplay,
+		)),
+		RouteName:     "reviews",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		FieldSets:     fieldSets,
+		Tabs:          false,
+		Multipart:     false,
+		RelatedLists:  reviewRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		Duplicate:     canCreate,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// reviewRelatedLists returns the lists filtered to one Review
+// that the current user may read, for its change and detail pages.
+func reviewRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	return lists
+}
+
+// buildReviewDetailPageProps builds the read-only detail page props for Review.
+func (h *AdminHandler) buildReviewDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.Review.EagerLoadQuery(h.client.Review.Query().
+		Where(review.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.Review.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.Review.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.Review.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.reviewFields.updateFormFieldSets))
+	for _, fieldSet := range h.reviewFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedReviewActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.reviewEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.Review.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"reviews",
+			"Reviews",
+			entityDisplay,
+		)),
+		RouteName:     "reviews",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  reviewRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	return props, nil
+}
+
+// getReviewDetailHandler returns the handler for GET /admin/reviews/{id}/view/
+func (h *AdminHandler) getReviewDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		h.renderReviewDetailPage(w, r, id)
+	})
+}
+
+func (h *AdminHandler) renderReviewDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildReviewDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
+		return
+	}
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// loadReview loads the Review with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadReview(ctx context.Context, id int) (*ent.Review, error) {
+	return h.schemas.Review.EagerLoadQuery(h.db(ctx).Review.Query().
+		Where(review.IDEQ(id))).
+		Only(ctx)
+}
+
+// auditReview records a Review mutation in the audit log, diffing
+// before against the saved Review with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditReview(ctx context.Context, action vent.AuditAction, before *ent.Review, id int) error {
+	var after *ent.Review
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadReview(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
+		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.reviewAuditSnapshot(ctx, before), h.reviewAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "Review", id, h.schemas.Review.Name(named), changes)
+}
+
+// reviewAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) reviewAuditSnapshot(ctx context.Context, e *ent.Review) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.reviewFields.auditFields))
+	for _, f := range h.reviewFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
+
+// buildReviewHistoryPageProps builds the History tab props for Review.
+func (h *AdminHandler) buildReviewHistoryPageProps(ctx context.Context, id int) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadReview(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.Review.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.Review.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("Review"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entityDisplay := h.schemas.Review.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "reviews",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
+}
+
+// getReviewHistoryHandler returns the handler for GET /admin/reviews/{id}/history/
+func (h *AdminHandler) getReviewHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildReviewHistoryPageProps(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// reviewRevisionFields are the fields a Review revision snapshots, in form order.
+var reviewRevisionFields = []string{
+	"user",
+	"rating",
+	"body",
+	"book",
+}
+
+// reviewRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) reviewRevisionSnapshot(ctx context.Context, e *ent.Review) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	if snapshot["user"], err = revisionEdgeID(h.db(ctx).Review.QueryUser(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	snapshot["rating"] = e.Rating
+	snapshot["body"] = nil
+	if e.Body != nil {
+		snapshot["body"] = *e.Body
+	}
+	if snapshot["book"], err = revisionEdgeID(h.db(ctx).Review.QueryBook(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// buildReviewRevisionsPageProps builds the Revisions tab props for the Review
+// with id, comparing the revisions from and to. A deleted Review is shown
+// from its revisions.
+func (h *AdminHandler) buildReviewRevisionsPageProps(ctx context.Context, id int, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Review"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "reviews",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadReview(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		canCreate, err := h.schemas.Review.CanCreate(ctx)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = canCreate
+		props.LayoutProps = h.buildLayoutProps(ctx, "Review", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.Review.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.Review.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.reviewRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.Review.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "Review", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%sreviews/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(reviewRevisionFields, fromSnapshot, toSnapshot)
+	}
+	return props, nil
+}
+
+// getReviewRevisionsHandler returns the handler for GET /admin/reviews/{id}/revisions/
+func (h *AdminHandler) getReviewRevisionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		query := r.URL.Query()
+		props, err := h.buildReviewRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postReviewRestoreHandler returns the handler for POST /admin/reviews/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postReviewRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
+			return
+		}
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("Review"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		var (
+			e     *ent.Review
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restoreReviewRevision(ctx, id, rev)
+			return err
+		})
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%sreviews/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
+	})
+}
+
+// restoreReviewRevision replays rev onto the Review with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the Review as it was before. A deleted Review is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restoreReviewRevision(ctx context.Context, id int, rev *ent.Revision) (*ent.Review, func(context.Context), error) {
+	e, err := h.loadReview(ctx, id)
+	if ent.IsNotFound(err) {
+		e, err := h.recreateReview(ctx, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, func(ctx context.Context) {
+			h.afterReviewCreate(ctx, e.ID)
+		}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_review")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.Review.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
+	}
+
+	var input ReviewUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	if err := h.updateReview(ctx, e, input); err != nil {
+		return nil, nil, err
+	}
+	return e, func(ctx context.Context) {
+		h.afterReviewUpdate(ctx, e, id)
+	}, nil
+}
+
+// recreateReview recreates a deleted Review from rev in ctx's transaction,
+// through the same create path as the add form. The new Review gets a new ID,
+// which is recorded on the deleted entity's revisions.
+func (h *AdminHandler) recreateReview(ctx context.Context, rev *ent.Revision) (*ent.Review, error) {
+	deleted := revision.And(
+		revision.SchemaEQ(rev.Schema),
+		revision.EntityIDEQ(rev.EntityID),
+		revision.ActionEQ(revision.ActionDelete),
+	)
+	restored, err := h.db(ctx).Revision.Query().
+		Where(deleted, revision.RestoredIDNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restored {
+		return nil, vent.BadRequest("this review has already been restored")
+	}
+	if err := denyIfCannot(h.schemas.Review.CanCreate(ctx)); err != nil {
+		return nil, err
+	}
+
+	var input ReviewCreateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	e, err := h.createReview(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db(ctx).Revision.Update().
+		Where(deleted).
+		SetRestoredID(vent.FormatID(e.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record restored revision: %w", err)
+	}
+	return e, nil
+}
+
+// buildReviewDeletedPageProps lists the deleted Review entities that have
+// not been restored, newest first.
+func (h *AdminHandler) buildReviewDeletedPageProps(ctx context.Context) (gui.SchemaDeletedProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("Review"),
+			revision.ActionEQ(revision.ActionDelete),
+			revision.RestoredIDIsNil(),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaDeletedProps{}, err
+	}
+
+	props := gui.SchemaDeletedProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaDeletedBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+		)),
+		RouteName:         "reviews",
+		PluralDisplayName: "Reviews",
+		Truncated:         len(revs) > vent.RevisionLimit,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+	for _, rev := range revs {
+		props.Entries = append(props.Entries, gui.DeletedEntry{
+			EntityID:      rev.EntityID,
+			EntityDisplay: rev.EntityName,
+			At:            rev.CreatedAt,
+			Actor:         rev.Actor,
+		})
+	}
+	return props, nil
+}
+
+// getReviewDeletedHandler returns the handler for GET /admin/reviews/deleted/
+func (h *AdminHandler) getReviewDeletedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildReviewDeletedPageProps(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaDeletedPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getReviewHandler returns the handler for GET /admin/reviews/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
+func (h *AdminHandler) getReviewHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadReview(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.Review.CanUpdate(r.Context(), e)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if !canUpdate {
+			h.renderReviewDetailPage(w, r, id)
+			return
+		}
+
+		props, err := h.buildReviewPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+func (h *AdminHandler) patchReviewPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildReviewPageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// postReviewHandler returns the handler for POST /admin/reviews/
+func (h *AdminHandler) postReviewHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Entity ReviewCreateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchReviewAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+
+		var id int
+		err := h.withTx(r.Context(), func(ctx context.Context) error {
+			e, err := h.createReview(ctx, input)
+			if err != nil {
+				return err
+			}
+			id = e.ID
+			return nil
+		})
+		if err != nil {
+			h.patchReviewAddPageError(w, r, err)
+			return
+		}
+		h.afterReviewCreate(r.Context(), id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "reviews/")
+	})
+}
+
+// createReview saves input as a new Review in ctx's transaction:
+// ValidateCreate, the create fields, BeforeCreate and the audit log entry.
+func (h *AdminHandler) createReview(ctx context.Context, input ReviewCreateInput) (*ent.Review, error) {
+	if err := h.schemas.Review.ValidateCreate(ctx, input); err != nil {
+		return nil, err
+	}
+
+	builder := h.db(ctx).Review.Create()
+
+	for _, field := range h.reviewFields.createBindFields {
+		if err := field.ApplyCreate(ctx, builder, input); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.schemas.Review.BeforeCreate(ctx, builder); err != nil {
+		return nil, err
+	}
+
+	e, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.auditReview(ctx, vent.AuditActionCreate, nil, e.ID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// afterReviewCreate runs the AfterCreate hook on the Review saved with id.
+func (h *AdminHandler) afterReviewCreate(ctx context.Context, id int) {
+	e, err := h.loadReview(ctx, id)
+	if err == nil {
+		err = h.schemas.Review.AfterCreate(ctx, e)
+	}
+	if err != nil {
+		log.Printf("Review after create hook: %v", err)
+	}
+}
+
+// reviewImportColumns are the Review fields an import file can fill.
+var reviewImportColumns = []vent.ImportColumn{
+	{Name: "user", Label: "User"},
+	{Name: "rating", Label: "Rating"},
+	{Name: "body", Label: "Review"},
+	{Name: "book", Label: "Book"},
+}
+
+// buildReviewImportPageProps builds the import page props for Review.
+func (h *AdminHandler) buildReviewImportPageProps(ctx context.Context) gui.SchemaImportProps {
+	props := gui.SchemaImportProps{
+		LayoutProps: h.buildLayoutProps(ctx, "Review", gui.SchemaImportBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"reviews",
+			"Reviews",
+		)),
+		RouteName:         "reviews",
+		PluralDisplayName: "Reviews",
+		Columns: []gui.SchemaImportColumn{
+			{Name: "user", Label: "User", Hint: "ID or email"},
+			{Name: "rating", Label: "Rating"},
+			{Name: "body", Label: "Review"},
+			{Name: "book", Label: "Book", Hint: "ID"},
+		},
+	}
+	return props
+}
+
+// getReviewImportHandler returns the handler for GET /admin/reviews/import/
+func (h *AdminHandler) getReviewImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := gui.SchemaImportPage(h.buildReviewImportPageProps(r.Context())).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// postReviewImportHandler returns the handler for POST /admin/reviews/import/.
+// With ?dry_run=1 the rows are saved and then rolled back, so the preview
+// reports the same errors a real import would.
+func (h *AdminHandler) postReviewImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props := gui.SchemaImportResultProps{
+			RouteName:         "reviews",
+			PluralDisplayName: "Reviews",
+		}
+		result, err := h.importReviewFile(r, r.URL.Query().Get("dry_run") != "")
+		if err != nil {
+			props.ErrorMessage = normalizeError(err).PublicMessage()
+		} else {
+			props.Result = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.PatchElementTempl(gui.SchemaImportResult(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) importReviewFile(r *http.Request, dryRun bool) (vent.ImportResult, error) {
+	file, err := vent.ReadImportUpload(r, "file")
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	mapping := vent.MapImportColumns(file.Headers, reviewImportColumns)
+	if strings.Join(mapping, "") == "" {
+		return vent.ImportResult{}, vent.BadRequest("no column in the file matches a Review field")
+	}
+	upsert := r.FormValue("upsert")
+	if upsert != "" {
+		return vent.ImportResult{}, vent.BadRequest(fmt.Sprintf("cannot upsert on %q", upsert))
+	}
+
+	result := vent.NewImportResult(file, mapping, reviewImportColumns, dryRun)
+	var afterHooks []func(context.Context)
+	err = h.withTx(r.Context(), func(ctx context.Context) error {
+		for _, row := range file.Rows {
+			// Each row gets a savepoint, so a failed row does not abort the
+			// transaction for the rows after it.
+			action := vent.ImportActionCreate
+			err := withSavepoint(ctx, func(ctx context.Context) error {
+				var (
+					after func(context.Context)
+					err   error
+				)
+				action, after, err = h.importReviewRow(ctx, row.Fields(mapping), upsert)
+				if err == nil {
+					afterHooks = append(afterHooks, after)
+				}
+				return err
+			})
+			message := ""
+			if err != nil {
+				message = normalizeError(err).PublicMessage()
+			}
+			result.Record(row, mapping, action, message)
+		}
+		if dryRun || result.Failed > 0 {
+			return errRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		return result, nil
+	}
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	result.Committed = true
+	for _, after := range afterHooks {
+		after(r.Context())
+	}
+	return result, nil
+}
+
+// importReviewRow saves one import row in ctx's transaction through the
+// same path as the add form. The returned func
+// runs the After hook once the import commits.
+func (h *AdminHandler) importReviewRow(ctx context.Context, values map[string]string, upsert string) (vent.ImportAction, func(context.Context), error) {
+	client := h.db(ctx)
+	var input ReviewCreateInput
+	if err := vent.DecodeImportValues(values, &input); err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	if input.User != "" {
+		id, err := resolveUserImportRef(ctx, client, input.User)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.User = id
+	}
+	if input.Book != "" {
+		id, err := resolveBookImportRef(ctx, client, input.Book)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.Book = id
+	}
+
+	e, err := h.createReview(ctx, input)
+	if err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	return vent.ImportActionCreate, func(ctx context.Context) {
+		h.afterReviewCreate(ctx, e.ID)
+	}, nil
+}
+
+// resolveReviewImportRef returns the ID of the Review an import
+// cell references, by ID.
+func resolveReviewImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parseReviewID(ref); err == nil {
+		exists, err := client.Review.Query().Where(review.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no Review matches %q", ref))
+}
+
+// patchReviewHandler returns the handler for PATCH /admin/reviews/{id}/
+func (h *AdminHandler) patchReviewHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseReviewID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		e, err := h.loadReview(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.Review.CanUpdate(r.Context(), e)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		var signals struct {
+			Entity ReviewUpdateInput `json:"entity"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			h.patchReviewPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
+			return
+		}
+		input := signals.Entity
+
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updateReview(ctx, e, input)
+		})
+		if err != nil {
+			h.patchReviewPageError(w, r, id, err)
+			return
+		}
+		h.afterReviewUpdate(r.Context(), e, id)
+
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "reviews/")
+	})
+}
+
+// updateReview saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+func (h *AdminHandler) updateReview(ctx context.Context, e *ent.Review, input ReviewUpdateInput) error {
+	if err := h.schemas.Review.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
+
+	snapshot, err := h.reviewRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	builder := h.db(ctx).Review.UpdateOneID(e.ID)
+
+	for _, field := range h.reviewFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
+		}
+	}
+	if err := h.schemas.Review.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	if err := h.auditReview(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "Review", e.ID, h.schemas.Review.Name(e), snapshot)
+}
+
+// afterReviewUpdate runs the AfterUpdate hook on the Review with id, which
+// was prev before the save.
+func (h *AdminHandler) afterReviewUpdate(ctx context.Context, prev *ent.Review, id int) {
+	e, err := h.loadReview(ctx, id)
+	if err == nil {
+		err = h.schemas.Review.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("Review after update hook: %v", err)
+	}
+}
+
+// ============================================================================
+// User Handlers
+// ============================================================================
+
+// parseUserID parses a User ID from a URL path or form value.
+func parseUserID(value string) (int, error) {
+	return vent.ParseID[int](value)
+}
+
+// UserCreateInput is the typed input for creating a User
+type UserCreateInput struct {
+	Email       string   `json:"email"`
+	LastLogin   *string  `json:"last_login"`
+	IsStaff     *bool    `json:"is_staff"`
+	IsSuperuser *bool    `json:"is_superuser"`
+	IsActive    *bool    `json:"is_active"`
+	Groups      []string `json:"groups"`
+}
+
+// UserUpdateInput is the typed input for updating a User
+type UserUpdateInput struct {
+	Email       *string   `json:"email"`
+	LastLogin   *string   `json:"last_login"`
+	IsStaff     *bool     `json:"is_staff"`
+	IsSuperuser *bool     `json:"is_superuser"`
+	IsActive    *bool     `json:"is_active"`
+	Groups      *[]string `json:"groups"`
+}
+
+// UserListFilter is the typed list query for listing User.
+type UserListFilter struct {
+	Email    string
+	IsStaff  vent.BoolFilter
+	IsActive vent.BoolFilter
+}
+
+// userListOrders maps User list sort keys to Ent order options.
+var userListOrders = map[string]func(...sql.OrderTermOption) user.OrderOption{
+	"email":        user.ByEmail,
+	"is_staff":     user.ByIsStaff,
+	"is_superuser": user.ByIsSuperuser,
+	"is_active":    user.ByIsActive,
+	"last_login":   user.ByLastLogin,
+}
+
+// userDefaultOrdering is the User list ordering when the request names none.
+var userDefaultOrdering = []string{}
+
+func userListSortable(name string) bool {
+	_, ok := userListOrders[name]
+	return ok
+}
+
+// userListOrder converts sorts to order options, ending with
+// the ID so pagination stays stable across equal sort values.
+func userListOrder(sorts []vent.ListSort) []user.OrderOption {
+	orders := make([]user.OrderOption, 0, len(sorts)+1)
+	sortsByID := false
+	for _, sort := range sorts {
+		orders = append(orders, userListOrders[sort.Name](sort.OrderTermOptions()...))
+		sortsByID = sortsByID || sort.Name == "id"
+	}
+	if !sortsByID {
+		orders = append(orders, user.ByID())
+	}
+	return orders
+}
+
+// userListQuery is the User list query with a request's filters, search, and sort applied.
+type userListQuery struct {
+	query  *ent.UserQuery
+	filter UserListFilter
+	search string
+	sorts  []vent.ListSort
+}
+
+// newUserListQuery parses the list query parameters in q. The list
+// and export handlers share it so an export matches the list on screen.
+func (h *AdminHandler) newUserListQuery(q url.Values) userListQuery {
+	filter := UserListFilter{
+		Email:    vent.FilterParam(q, "filter.email"),
+		IsStaff:  vent.BoolFilter(q.Get("filter.is_staff")),
+		IsActive: vent.BoolFilter(q.Get("filter.is_active")),
+	}
+	sorts := vent.ParseListSort(q.Get("sort"), q.Get("dir"), userDefaultOrdering, userListSortable)
+	search := strings.TrimSpace(q.Get("q"))
+	query := h.client.User.Query()
+	if filterVal := filter.Email; filterVal != "" {
+		query = query.Where(user.EmailContainsFold(filterVal))
+	}
+	if v, ok := filter.IsStaff.Bool(); ok {
+		query = query.Where(user.IsStaffEQ(v))
+	}
+	if v, ok := filter.IsActive.Bool(); ok {
+		query = query.Where(user.IsActiveEQ(v))
+	}
+	if search != "" {
+		query = query.Where(user.Or(
+			user.EmailContainsFold(search),
+		))
+	}
+	return userListQuery{
+		query:  query,
+		filter: filter,
+		search: search,
+		sorts:  sorts,
+	}
+}
+
+// buildUserListPageProps builds the list page props for the list query in
+// r's URL. Rows are only loaded for Datastar requests; the first paint is chrome.
+func (h *AdminHandler) buildUserListPageProps(r *http.Request) (gui.SchemaTableProps, error) {
+	ctx := r.Context()
+	list := h.newUserListQuery(r.URL.Query())
+	query, sorts := list.query, list.sorts
+	filter := list.filter
+	search := list.search
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	page := vent.ParseListPage(r.URL.Query().Get("page"), 100).WithTotal(total)
+	pagination := gui.NewSchemaTablePagination(page)
+
+	actions, err := h.allowedUserActions(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+
+	rows := []gui.SchemaTableRow{}
+	if vent.IsDatastarRequest(r) {
+		entities, err := h.schemas.User.EagerLoadQuery(query).
+			Order(userListOrder(sorts)...).
+			Offset(page.Offset()).
+			Limit(page.Limit()).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaTableProps{}, err
+		}
+
+		rows = make([]gui.SchemaTableRow, len(entities))
+		for i, e := range entities {
+			cells := make([]gui.SchemaTableCell, len(h.userFields.listColumns))
+			for j, field := range h.userFields.listColumns {
+				cell := gui.SchemaTableCell{Display: field.ListCell(ctx, e)}
+				if j == 0 {
+					cell.LinkURL = fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(ctx), vent.FormatIDPath(e.ID))
+				}
+				cells[j] = cell
+			}
+			rowActions, err := h.userEntityActions(ctx, actions, e)
+			if err != nil {
+				return gui.SchemaTableProps{}, err
+			}
+			rows[i] = gui.SchemaTableRow{ID: vent.FormatID(e.ID), Cells: cells, Actions: rowActions}
+		}
+	}
+
+	canCreate, err := h.schemas.User.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canExport, err := defaultCan(ctx, "export_user")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	bulkActions, err := h.listUserBulkActions(ctx, actions)
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	canViewDeleted, err := defaultCan(ctx, "delete_user")
+	if err != nil {
+		return gui.SchemaTableProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanExport: canExport,
+	}
+
+	return gui.SchemaTableProps{
+		LayoutProps:         h.buildLayoutProps(ctx, "User", gui.SchemaListBreadcrumbs("Users")),
+		RouteName:           "users",
+		SingularDisplayName: "User",
+		PluralDisplayName:   "Users",
+		Columns: []gui.SchemaTableColumn{
+			{Name: "email", Label: "Email", Type: "string", Sortable: true},
+			{Name: "is_staff", Label: "IsStaff", Type: "bool", Sortable: true},
+			{Name: "is_superuser", Label: "IsSuperuser", Type: "bool", Sortable: true},
+			{Name: "is_active", Label: "IsActive", Type: "bool", Sortable: true},
+			{Name: "last_login", Label: "LastLogin", Type: "time.Time", Sortable: true},
+		},
+		FilterableColumns: []gui.SchemaTableFilterableColumn{
+			{
+				Name:  "email",
+				Label: "Email",
+				Type:  "string",
+				Value: filter.Email,
+			},
+			{
+				Name:  "is_staff",
+				Label: "IsStaff",
+				Type:  "bool",
+				Value: filter.IsStaff.Normalize().String(),
+			},
+			{
+				Name:  "is_active",
+				Label: "IsActive",
+				Type:  "bool",
+				Value: filter.IsActive.Normalize().String(),
+			},
+		},
+		Searchable:    true,
+		Search:        search,
+		Sort:          sorts,
+		DefaultSort:   vent.ParseListSort("", "", userDefaultOrdering, userListSortable),
+		Rows:          rows,
+		Pagination:    pagination,
+		BulkActions:   bulkActions,
+		Loading:       !vent.IsDatastarRequest(r),
+		Deleted:       canViewDeleted,
+		RenderContext: renderCtx,
+	}, nil
+}
+
+// getUserListHandler returns the handler for GET /admin/users/
+func (h *AdminHandler) getUserListHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildUserListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaTablePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// allowedUserActions returns the custom User actions whose permission the
+// current user holds.
+func (h *AdminHandler) allowedUserActions(ctx context.Context) ([]UserAction, error) {
+	var allowed []UserAction
+	for _, action := range h.schemas.User.Actions() {
+		ok, err := defaultCan(ctx, action.Permission)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed, nil
+}
+
+// lookupUserAction returns the custom action called name, checking its
+// permission.
+func (h *AdminHandler) lookupUserAction(ctx context.Context, name string) (UserAction, error) {
+	for _, action := range h.schemas.User.Actions() {
+		if action.Name != name {
+			continue
+		}
+		if err := denyIfCannot(defaultCan(ctx, action.Permission)); err != nil {
+			return UserAction{}, err
+		}
+		return action, nil
+	}
+	return UserAction{}, vent.NotFound(fmt.Sprintf("unknown action %q", name))
+}
+
+// canUserRunAction reports whether action may run on e.
+func (h *AdminHandler) canUserRunAction(ctx context.Context, action UserAction, e *ent.User) (bool, error) {
+	if action.Can != nil {
+		return action.Can(ctx, e)
+	}
+	return h.schemas.User.CanUpdate(ctx, e)
+}
+
+// userEntityActions returns the actions from allowed that may run on e,
+// for its change page and list row menu.
+func (h *AdminHandler) userEntityActions(ctx context.Context, allowed []UserAction, e *ent.User) ([]gui.EntityAction, error) {
+	var actions []gui.EntityAction
+	for _, action := range allowed {
+		ok, err := h.canUserRunAction(ctx, action, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			actions = append(actions, gui.EntityAction{
+				Name:    action.Name,
+				Label:   action.Label,
+				Confirm: action.Confirm,
+			})
+		}
+	}
+	return actions, nil
+}
+
+// listUserBulkActions returns the bulk actions the current user may run
+// on User rows: built-in delete first, then the allowed custom actions.
+func (h *AdminHandler) listUserBulkActions(ctx context.Context, allowed []UserAction) ([]gui.SchemaTableBulkAction, error) {
+	var actions []gui.SchemaTableBulkAction
+	canDelete, err := defaultCan(ctx, "delete_user")
+	if err != nil {
+		return nil, err
+	}
+	if canDelete {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    vent.BulkActionDelete,
+			Label:   "Delete",
+			Confirm: "Delete the selected Users? This cannot be undone.",
+			Danger:  true,
+		})
+	}
+	for _, action := range allowed {
+		actions = append(actions, gui.SchemaTableBulkAction{
+			Name:    action.Name,
+			Label:   action.Label,
+			Confirm: action.Confirm,
+		})
+	}
+	return actions, nil
+}
+
+// postUserBulkHandler returns the handler for POST /admin/users/bulk/.
+// The URL carries the list query, so "select all" matches the list on screen
+// and the re-rendered list keeps its filters and page.
+func (h *AdminHandler) postUserBulkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var signals struct {
+			Bulk vent.BulkSelection `json:"bulk"`
+		}
+		if err := vent.ReadSignals(r, &signals); err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid bulk action").WithCause(err))
+			return
+		}
+		result, runErr := h.runUserBulkAction(r.Context(), r.URL.Query(), signals.Bulk)
+
+		props, err := h.buildUserListPageProps(r)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if runErr != nil {
+			props.BulkError = normalizeError(runErr).PublicMessage()
+		} else {
+			props.BulkResult = &result
+		}
+		sse := datastar.NewSSE(w, r)
+		if err := sse.MarshalAndPatchSignals(map[string]any{
+			"bulk": vent.BulkSelection{IDs: []string{}},
+		}); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runUserBulkAction runs selection.Action on each selected User,
+// checking permissions per row.
+func (h *AdminHandler) runUserBulkAction(ctx context.Context, q url.Values, selection vent.BulkSelection) (vent.BulkResult, error) {
+	query := h.client.User.Query()
+	if selection.All {
+		query = h.newUserListQuery(q).query
+	} else {
+		if len(selection.IDs) == 0 {
+			return vent.BulkResult{}, vent.BadRequest("select at least one User")
+		}
+		ids := make([]int, len(selection.IDs))
+		for i, raw := range selection.IDs {
+			id, err := parseUserID(raw)
+			if err != nil {
+				return vent.BulkResult{}, vent.BadRequest("invalid id").WithCause(err)
+			}
+			ids[i] = id
+		}
+		query = query.Where(user.IDIn(ids...))
+	}
+	entities, err := h.schemas.User.EagerLoadQuery(query).
+		Order(user.ByID()).
+		Limit(vent.MaxBulkActionRows + 1).
+		All(ctx)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	if len(entities) > vent.MaxBulkActionRows {
+		return vent.BulkResult{}, vent.BadRequest(fmt.Sprintf("bulk actions can run on at most %d rows", vent.MaxBulkActionRows))
+	}
+
+	if selection.Action == vent.BulkActionDelete {
+		if err := denyIfCannot(defaultCan(ctx, "delete_user")); err != nil {
+			return vent.BulkResult{}, err
+		}
+		result := vent.BulkResult{Label: "Delete"}
+		for _, e := range entities {
+			result.Record(vent.FormatID(e.ID), h.schemas.User.Name(e), bulkErrorMessage(h.deleteUserRow(ctx, e)))
+		}
+		return result, nil
+	}
+
+	action, err := h.lookupUserAction(ctx, selection.Action)
+	if err != nil {
+		return vent.BulkResult{}, err
+	}
+	result := vent.BulkResult{Label: action.Label}
+	for _, e := range entities {
+		err := denyIfCannot(h.canUserRunAction(ctx, action, e))
+		if err == nil {
+			_, err = action.Run(ctx, e)
+		}
+		result.Record(vent.FormatID(e.ID), h.schemas.User.Name(e), bulkErrorMessage(err))
+	}
+	return result, nil
+}
+
+// postUserActionHandler returns the handler for POST /admin/users/{id}/actions/{action}/.
+// Requests from the list row menu carry the list query and from=list, so
+// the list is re-rendered in place of the change page; from=detail
+// re-renders the detail page.
+func (h *AdminHandler) postUserActionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		action, err := h.lookupUserAction(r.Context(), r.PathValue("action"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		result, runErr := h.runUserAction(r.Context(), action, id)
+
+		sse := datastar.NewSSE(w, r)
+		if runErr != nil {
+			if err := patchToast(sse, normalizeError(runErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		if result.Redirect != "" {
+			sse.Redirect(result.Redirect)
+			return
+		}
+		if r.URL.Query().Get("from") == "list" {
+			props, err := h.buildUserListPageProps(r)
+			if err != nil {
+				vent.HandleError(w, r, normalizeError(err))
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaTablePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else if r.URL.Query().Get("from") == "detail" {
+			props, err := h.buildUserDetailPageProps(r.Context(), id)
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityDetailPage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		} else {
+			props, err := h.buildUserPageProps(r.Context(), id, "")
+			if err != nil {
+				// The action removed the entity or the user's access to it.
+				sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
+				return
+			}
+			if err := sse.PatchElementTempl(gui.SchemaEntityChangePage(props)); err != nil {
+				vent.HandleError(w, r, err)
+				return
+			}
+		}
+		if err := patchToast(sse, result.ToastMessage(action.Label), false); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// runUserAction runs action on the User with id after its row check.
+func (h *AdminHandler) runUserAction(ctx context.Context, action UserAction, id int) (vent.ActionResult, error) {
+	e, err := h.schemas.User.EagerLoadQuery(h.client.User.Query().
+		Where(user.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return vent.ActionResult{}, err
+	}
+	if err := denyIfCannot(h.canUserRunAction(ctx, action, e)); err != nil {
+		return vent.ActionResult{}, err
+	}
+	return action.Run(ctx, e)
+}
+
+// deleteUserRow deletes one User with the same checks as the delete
+// route. The delete and its audit entry and revision share a transaction.
+func (h *AdminHandler) deleteUserRow(ctx context.Context, e *ent.User) error {
+	if err := denyIfCannot(h.schemas.User.CanDelete(ctx, e)); err != nil {
+		return err
+	}
+	if err := h.schemas.User.ValidateDelete(ctx, e.ID); err != nil {
+		return err
+	}
+	if err := h.checkUserDelete(ctx, e); err != nil {
+		return err
+	}
+	err := h.withTx(ctx, func(ctx context.Context) error {
+		if err := h.schemas.User.BeforeDelete(ctx, e); err != nil {
+			return err
+		}
+		snapshot, err := h.userRevisionSnapshot(ctx, e)
+		if err != nil {
+			return err
+		}
+		if err := h.db(ctx).User.DeleteOneID(e.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if err := h.auditUser(ctx, vent.AuditActionDelete, e, e.ID); err != nil {
+			return err
+		}
+		if err := h.recordRevision(ctx, vent.AuditActionDelete, "User", e.ID, h.schemas.User.Name(e), snapshot); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := h.schemas.User.AfterDelete(ctx, e); err != nil {
+		log.Printf("User after delete hook: %v", err)
+	}
+	return nil
+}
+
+// userDeleteRelations lists the entities a delete of e cascades to, unlinks,
+// or is blocked by, leaving out relations without any.
+func (h *AdminHandler) userDeleteRelations(ctx context.Context, e *ent.User) ([]gui.DeleteRelation, error) {
+	adminPath := requestctx.MustAdminPath(ctx)
+	var relations []gui.DeleteRelation
+	if count, err := e.QueryGroups().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.PermissionGroup.EagerLoadQuery(e.QueryGroups()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_permission_group")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Groups", Effect: "unlink", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.PermissionGroup.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%spermission-groups/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	if count, err := e.QueryAuthor().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.Author.EagerLoadQuery(e.QueryAuthor()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_author")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Author", Effect: "block", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.Author.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%sauthors/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	if count, err := e.QueryReviews().Count(ctx); err != nil {
+		return nil, err
+	} else if count > 0 {
+		related, err := h.schemas.Review.EagerLoadQuery(e.QueryReviews()).
+			Limit(vent.DeleteRelationLimit).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		canRead, err := defaultCan(ctx, "read_review")
+		if err != nil {
+			return nil, err
+		}
+		relation := gui.DeleteRelation{Label: "Reviews", Effect: "block", Count: count}
+		for _, r := range related {
+			link := gui.SchemaEntityRelatedLink{Label: h.schemas.Review.Name(r)}
+			if canRead {
+				link.URL = fmt.Sprintf("%sreviews/%s/", adminPath, vent.FormatIDPath(r.ID))
+			}
+			relation.Items = append(relation.Items, link)
+		}
+		relations = append(relations, relation)
+	}
+	return relations, nil
+}
+
+// checkUserDelete refuses to delete e while related entities block it or
+// the delete would cascade to entities the user may not delete, instead of
+// leaving it to the database's foreign keys.
+func (h *AdminHandler) checkUserDelete(ctx context.Context, e *ent.User) error {
+	relations, err := h.userDeleteRelations(ctx, e)
+	if err != nil {
+		return err
+	}
+	return deleteRelationsError(h.schemas.User.Name(e), relations)
+}
+
+// buildUserDeletePageProps builds the delete confirmation props for User.
+func (h *AdminHandler) buildUserDeletePageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityDeleteProps, error) {
+	e, err := h.loadUser(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.User.CanDelete(ctx, e)); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+
+	entityDisplay := h.schemas.User.Name(e)
+	props := gui.SchemaEntityDeleteProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaDeleteBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"users",
+			"Users",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "users",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+	}
+	if props.Relations, err = h.userDeleteRelations(ctx, e); err != nil {
+		return gui.SchemaEntityDeleteProps{}, err
+	}
+	return props, nil
+}
+
+// getUserDeleteHandler returns the handler for GET /admin/users/{id}/delete/
+func (h *AdminHandler) getUserDeleteHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildUserDeletePageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityDeletePage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) patchUserDeletePageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	props, buildErr := h.buildUserDeletePageProps(r.Context(), id, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityDeletePage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getUserExportHandler returns the handler for GET /admin/users/export/
+func (h *AdminHandler) getUserExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, err := vent.ParseExportFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		list := h.newUserListQuery(r.URL.Query())
+		query := h.schemas.User.EagerLoadQuery(list.query).Order(userListOrder(list.sorts)...)
+		columns := []string{
+			"Email",
+			"IsStaff",
+			"IsSuperuser",
+			"IsActive",
+			"LastLogin",
+		}
+		vent.ServeExport(w, r, format, "users", columns, func(ctx context.Context, offset, limit int) ([][]string, error) {
+			entities, err := query.Clone().Offset(offset).Limit(limit).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, len(entities))
+			for i, e := range entities {
+				rows[i] = make([]string, len(h.userFields.listColumns))
+				for j, field := range h.userFields.listColumns {
+					rows[i][j] = field.ListCell(ctx, e)
+				}
+			}
+			return rows, nil
+		})
+	})
+}
+
+// userNotCopied labels the add form fields a duplicate leaves for the user
+// to fill in.
+var userNotCopied = []string{
+	"Email",
+}
+
+// userInitialFields are the add form fields a query parameter of the same
+// name may pre-fill.
+var userInitialFields = []vent.InitialField{
+	{
+		Name: "email",
+		Kind: vent.FieldKind("string"),
+	},
+	{
+		Name: "last_login",
+		Kind: vent.FieldKind("time"),
+	},
+	{
+		Name: "is_staff",
+		Kind: vent.FieldKind("bool"),
+	},
+	{
+		Name: "is_superuser",
+		Kind: vent.FieldKind("bool"),
+	},
+	{
+		Name: "is_active",
+		Kind: vent.FieldKind("bool"),
+	},
+	{
+		Name:    "groups",
+		Kind:    vent.FieldKind("foreign_key"),
+		CheckID: vent.CheckID[int],
+	},
+}
+
+// buildUserAddPageProps builds the add page props for User. A non-nil
+// source pre-fills the form with a copy of it.
+func (h *AdminHandler) buildUserAddPageProps(ctx context.Context, source *ent.User, errorMessage string) (gui.SchemaEntityAddProps, error) {
+	canCreate, err := h.schemas.User.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityAddProps{}, err
+	}
+	ctx = gui.WithRenderContext(ctx, gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canCreate,
+	})
+	if source != nil {
+		ctx = withUserDuplicateSource(ctx, source)
+	}
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.userFields.createFormFieldSets))
+	for _, fieldSet := range h.userFields.createFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.CreateHTML(ctx)
+			if err != nil {
+				return gui.SchemaEntityAddProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	props := gui.SchemaEntityAddProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaAddBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"users",
+			"Users",
+			"User",
+		)),
+		RouteName:           "users",
+		SingularDisplayName: "User",
+		ErrorMessage:        errorMessage,
+		FieldSets:           fieldSets,
+		Tabs:                true,
+		Multipart:           false,
+	}
+	if source != nil {
+		props.DuplicateOf = h.schemas.User.Name(source)
+		props.NotCopied = userNotCopied
+	}
+	return props, nil
+}
+
+func (h *AdminHandler) patchUserAddPageError(w http.ResponseWriter, r *http.Request, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
+	props, buildErr := h.buildUserAddPageProps(r.Context(), nil, normalizeError(err).PublicMessage())
+	if buildErr != nil {
+		vent.HandleError(w, r, normalizeError(buildErr))
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
+		vent.HandleError(w, r, patchErr)
+	}
+}
+
+// getUserAddHandler returns the handler for GET /admin/users/add/
+func (h *AdminHandler) getUserAddHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		initial, err := vent.ParseInitialValues(r.URL.Query(), userInitialFields)
+		if err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+		ctx := vent.WithInitialValues(r.Context(), initial)
+
+		props, err := h.buildUserAddPageProps(ctx, nil, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityAddPage(props).Render(ctx, w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getUserDuplicateHandler returns the handler for GET /admin/users/{id}/duplicate/,
+// the add form pre-filled from the User with id.
+func (h *AdminHandler) getUserDuplicateHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		source, err := h.loadUser(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		if err := denyIfCannot(h.schemas.User.CanRead(r.Context(), source)); err != nil {
+			vent.HandleError(w, r, err)
+			return
+		}
+
+		props, err := h.buildUserAddPageProps(r.Context(), source, "")
 		if err != nil {
-			return gui.SchemaEntityAddProps{}, err
+			vent.HandleError(w, r, normalizeError(err))
+			return
 		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
+
+		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
 		}
+	})
+}
+
+// buildUserPageProps builds the edit page props for User.
+func (h *AdminHandler) buildUserPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
+	e, err := h.schemas.User.EagerLoadQuery(h.client.User.Query().
+		Where(user.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
 	}
 
-	return gui.SchemaEntityAddProps{
-		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaAddBreadcrumbs(
+	if err := denyIfCannot(h.schemas.User.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canCreate, err := h.schemas.User.CanCreate(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canUpdate, err := h.schemas.User.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	canDelete, err := h.schemas.User.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanCreate: canCreate,
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.userFields.updateFormFieldSets))
+	for _, fieldSet := range h.userFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.UpdateHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityChangeProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedUserActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+	actions, err := h.userEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityChangeProps{}, err
+	}
+
+	entityDisplay := h.schemas.User.Name(e)
+	props := gui.SchemaEntityChangeProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaEntityBreadcrumbs(
 			requestctx.MustAdminPath(ctx),
 			"users",
 			"Users",
-			"User",
+			entityDisplay,
 		)),
-		RouteName:           "users",
-		SingularDisplayName: "User",
-		ErrorMessage:        errorMessage,
-		Fields:              fields,
-	}, nil
+		RouteName:     "users",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		ErrorMessage:  errorMessage,
+		FieldSets:     fieldSets,
+		Tabs:          true,
+		Multipart:     false,
+		RelatedLists:  userRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		Duplicate:     canCreate,
+		RenderContext: renderCtx,
+	}
+	return props, nil
 }
 
-func (h *AdminHandler) patchUserAddPageError(w http.ResponseWriter, r *http.Request, err error) {
-	props, buildErr := h.buildUserAddPageProps(r.Context(), normalizeError(err).PublicMessage())
-	if buildErr != nil {
-		vent.HandleError(w, r, normalizeError(buildErr))
+// userRelatedLists returns the lists filtered to one User
+// that the current user may read, for its change and detail pages.
+func userRelatedLists(ctx context.Context) []gui.SchemaEntityRelatedList {
+	var lists []gui.SchemaEntityRelatedList
+	return lists
+}
+
+// buildUserDetailPageProps builds the read-only detail page props for User.
+func (h *AdminHandler) buildUserDetailPageProps(ctx context.Context, id int) (gui.SchemaEntityDetailProps, error) {
+	e, err := h.schemas.User.EagerLoadQuery(h.client.User.Query().
+		Where(user.IDEQ(id))).
+		Only(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	if err := denyIfCannot(h.schemas.User.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canUpdate, err := h.schemas.User.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	canDelete, err := h.schemas.User.CanDelete(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	renderCtx := gui.RenderContext{
+		CanUpdate: canUpdate,
+		CanDelete: canDelete,
+	}
+	ctx = gui.WithRenderContext(ctx, renderCtx)
+
+	fieldSets := make([]gui.SchemaEntityFieldSetProps, 0, len(h.userFields.updateFormFieldSets))
+	for _, fieldSet := range h.userFields.updateFormFieldSets {
+		props := fieldSet.props
+		for _, field := range fieldSet.fields {
+			html, err := field.DetailHTML(ctx, e)
+			if err != nil {
+				return gui.SchemaEntityDetailProps{}, err
+			}
+			if html != "" {
+				props.Fields = append(props.Fields, gui.SchemaEntityFieldProps{HTML: html})
+			}
+		}
+		fieldSets = append(fieldSets, props)
+	}
+
+	allowed, err := h.allowedUserActions(ctx)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+	actions, err := h.userEntityActions(ctx, allowed, e)
+	if err != nil {
+		return gui.SchemaEntityDetailProps{}, err
+	}
+
+	adminPath := requestctx.MustAdminPath(ctx)
+	entityDisplay := h.schemas.User.Name(e)
+	props := gui.SchemaEntityDetailProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaEntityBreadcrumbs(
+			adminPath,
+			"users",
+			"Users",
+			entityDisplay,
+		)),
+		RouteName:     "users",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		FieldSets:     fieldSets,
+		RelatedLists:  userRelatedLists(ctx),
+		Actions:       actions,
+		History:       true,
+		Revisions:     true,
+		RenderContext: renderCtx,
+	}
+	if ok, err := defaultCan(ctx, "read_author"); err == nil && ok {
+		related, err := h.schemas.Author.EagerLoadQuery(e.QueryAuthor()).
+			Limit(vent.DetailRelationLimit + 1).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaEntityDetailProps{}, err
+		}
+		relation := gui.SchemaEntityDetailRelation{
+			Label: "Author",
+			More:  len(related) > vent.DetailRelationLimit,
+		}
+		if relation.More {
+			related = related[:vent.DetailRelationLimit]
+		}
+		for _, r := range related {
+			relation.Items = append(relation.Items, gui.SchemaEntityRelatedLink{
+				Label: h.schemas.Author.Name(r),
+				URL:   fmt.Sprintf("%sauthors/%s/", adminPath, vent.FormatIDPath(r.ID)),
+			})
+		}
+		props.Relations = append(props.Relations, relation)
+	}
+	if ok, err := defaultCan(ctx, "read_review"); err == nil && ok {
+		related, err := h.schemas.Review.EagerLoadQuery(e.QueryReviews()).
+			Limit(vent.DetailRelationLimit + 1).
+			All(ctx)
+		if err != nil {
+			return gui.SchemaEntityDetailProps{}, err
+		}
+		relation := gui.SchemaEntityDetailRelation{
+			Label: "Reviews",
+			More:  len(related) > vent.DetailRelationLimit,
+		}
+		if relation.More {
+			related = related[:vent.DetailRelationLimit]
+		}
+		for _, r := range related {
+			relation.Items = append(relation.Items, gui.SchemaEntityRelatedLink{
+				Label: h.schemas.Review.Name(r),
+				URL:   fmt.Sprintf("%sreviews/%s/", adminPath, vent.FormatIDPath(r.ID)),
+			})
+		}
+		props.Relations = append(props.Relations, relation)
+	}
+	return props, nil
+}
+
+// getUserDetailHandler returns the handler for GET /admin/users/{id}/view/
+func (h *AdminHandler) getUserDetailHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		h.renderUserDetailPage(w, r, id)
+	})
+}
+
+func (h *AdminHandler) renderUserDetailPage(w http.ResponseWriter, r *http.Request, id int) {
+	props, err := h.buildUserDetailPageProps(r.Context(), id)
+	if err != nil {
+		vent.HandleError(w, r, normalizeError(err))
 		return
 	}
-	sse := datastar.NewSSE(w, r)
-	if patchErr := sse.PatchElementTempl(gui.SchemaEntityAddPage(props)); patchErr != nil {
-		vent.HandleError(w, r, patchErr)
+	if err := gui.SchemaEntityDetailPage(props).Render(r.Context(), w); err != nil {
+		vent.HandleError(w, r, err)
+	}
+}
+
+// loadUser loads the User with id and the edges its admin eager-loads,
+// in ctx's transaction when it carries one.
+func (h *AdminHandler) loadUser(ctx context.Context, id int) (*ent.User, error) {
+	return h.schemas.User.EagerLoadQuery(h.db(ctx).User.Query().
+		Where(user.IDEQ(id))).
+		Only(ctx)
+}
+
+// auditUser records a User mutation in the audit log, diffing
+// before against the saved User with id. before is nil for creates and
+// restores, and deletes and purges diff against nothing.
+func (h *AdminHandler) auditUser(ctx context.Context, action vent.AuditAction, before *ent.User, id int) error {
+	var after *ent.User
+	if action != vent.AuditActionDelete && action != vent.AuditActionPurge {
+		e, err := h.loadUser(ctx, id)
+		if err != nil {
+			return fmt.Errorf("record audit log entry: %w", err)
+		}
+		after = e
+	}
+	named := after
+	if named == nil {
+		named = before
+	}
+	changes := vent.AuditDiff(h.userAuditSnapshot(ctx, before), h.userAuditSnapshot(ctx, after))
+	return h.recordAudit(ctx, action, "User", id, h.schemas.User.Name(named), changes)
+}
+
+// userAuditSnapshot returns e's audited field values as list cells,
+// or nil when e is nil.
+func (h *AdminHandler) userAuditSnapshot(ctx context.Context, e *ent.User) []vent.AuditValue {
+	if e == nil {
+		return nil
+	}
+	values := make([]vent.AuditValue, 0, len(h.userFields.auditFields))
+	for _, f := range h.userFields.auditFields {
+		values = append(values, vent.AuditValue{Field: f.name, Value: f.field.ListCell(ctx, e)})
+	}
+	return values
+}
+
+// buildUserHistoryPageProps builds the History tab props for User.
+func (h *AdminHandler) buildUserHistoryPageProps(ctx context.Context, id int) (gui.SchemaEntityHistoryProps, error) {
+	e, err := h.loadUser(ctx, id)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	if err := denyIfCannot(h.schemas.User.CanRead(ctx, e)); err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+	canUpdate, err := h.schemas.User.CanUpdate(ctx, e)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entries, err := h.client.AuditLog.Query().
+		Where(
+			auditlog.SchemaEQ("User"),
+			auditlog.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(vent.HistoryLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityHistoryProps{}, err
+	}
+
+	entityDisplay := h.schemas.User.Name(e)
+	props := gui.SchemaEntityHistoryProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaHistoryBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"users",
+			"Users",
+			entityDisplay,
+			vent.FormatID(id),
+		)),
+		RouteName:     "users",
+		EntityID:      vent.FormatID(id),
+		EntityDisplay: entityDisplay,
+		Truncated:     len(entries) > vent.HistoryLimit,
+		Revisions:     true,
+		RenderContext: gui.RenderContext{CanUpdate: canUpdate},
+	}
+	if props.Truncated {
+		entries = entries[:vent.HistoryLimit]
+	}
+	for _, entry := range entries {
+		props.Entries = append(props.Entries, gui.AuditEntry{
+			At:      entry.CreatedAt,
+			Actor:   entry.Actor,
+			Action:  vent.AuditAction(entry.Action),
+			Changes: entry.Changes,
+		})
+	}
+	return props, nil
+}
+
+// getUserHistoryHandler returns the handler for GET /admin/users/{id}/history/
+func (h *AdminHandler) getUserHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		props, err := h.buildUserHistoryPageProps(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaEntityHistoryPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// userRevisionFields are the fields a User revision snapshots, in form order.
+var userRevisionFields = []string{
+	"email",
+	"last_login",
+	"is_staff",
+	"is_superuser",
+	"is_active",
+	"groups",
+}
+
+// userRevisionSnapshot returns e's form values as a revision snapshot. Its
+// edges include entities in the trash, which stay linked until purged.
+func (h *AdminHandler) userRevisionSnapshot(ctx context.Context, e *ent.User) (vent.RevisionSnapshot, error) {
+	snapshot := vent.RevisionSnapshot{}
+	var err error
+	snapshot["email"] = e.Email
+	snapshot["last_login"] = vent.FormatFormValue(e.LastLogin)
+	snapshot["is_staff"] = e.IsStaff
+	snapshot["is_superuser"] = e.IsSuperuser
+	snapshot["is_active"] = e.IsActive
+	if snapshot["groups"], err = revisionEdgeIDs(h.db(ctx).User.QueryGroups(e).IDs(vent.SkipSoftDelete(ctx))); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// buildUserRevisionsPageProps builds the Revisions tab props for the User
+// with id, comparing the revisions from and to. A deleted User is shown
+// from its revisions.
+func (h *AdminHandler) buildUserRevisionsPageProps(ctx context.Context, id int, from, to string) (gui.SchemaEntityRevisionsProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("User"),
+			revision.EntityIDEQ(vent.FormatID(id)),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaEntityRevisionsProps{}, err
+	}
+
+	props := gui.SchemaEntityRevisionsProps{
+		RouteName: "users",
+		EntityID:  vent.FormatID(id),
+		Truncated: len(revs) > vent.RevisionLimit,
+		History:   true,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+
+	snapshots := make(map[string]vent.RevisionSnapshot, len(revs)+1)
+	e, err := h.loadUser(ctx, id)
+	switch {
+	case ent.IsNotFound(err) && len(revs) > 0:
+		props.Deleted = true
+		props.EntityDisplay = revs[0].EntityName
+		canCreate, err := h.schemas.User.CanCreate(ctx)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		props.CanRestore = canCreate
+		props.LayoutProps = h.buildLayoutProps(ctx, "User", gui.SchemaDeletedRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"users",
+			"Users",
+			props.EntityDisplay,
+		))
+	case err != nil:
+		return gui.SchemaEntityRevisionsProps{}, err
+	default:
+		if err := denyIfCannot(h.schemas.User.CanRead(ctx, e)); err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		canUpdate, err := h.schemas.User.CanUpdate(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		current, err := h.userRevisionSnapshot(ctx, e)
+		if err != nil {
+			return gui.SchemaEntityRevisionsProps{}, err
+		}
+		snapshots[gui.CurrentRevision] = current
+		if from == "" && to == "" && len(revs) > 0 {
+			from, to = vent.FormatID(revs[0].ID), gui.CurrentRevision
+		}
+		props.EntityDisplay = h.schemas.User.Name(e)
+		props.CanRestore = canUpdate
+		props.RenderContext = gui.RenderContext{CanUpdate: canUpdate}
+		props.LayoutProps = h.buildLayoutProps(ctx, "User", gui.SchemaRevisionsBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"users",
+			"Users",
+			props.EntityDisplay,
+			vent.FormatID(id),
+		))
+	}
+
+	for _, rev := range revs {
+		revID := vent.FormatID(rev.ID)
+		snapshots[revID] = rev.Snapshot
+		props.Revisions = append(props.Revisions, gui.RevisionEntry{
+			ID:     revID,
+			At:     rev.CreatedAt,
+			Actor:  rev.Actor,
+			Action: vent.AuditAction(rev.Action),
+		})
+		if rev.RestoredID != "" {
+			props.RestoredURL = fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(ctx), url.PathEscape(rev.RestoredID))
+		}
+	}
+	fromSnapshot, fromOK := snapshots[from]
+	toSnapshot, toOK := snapshots[to]
+	if fromOK && toOK {
+		props.From, props.To = from, to
+		props.Changes = vent.RevisionDiff(userRevisionFields, fromSnapshot, toSnapshot)
 	}
+	return props, nil
 }
 
-// getUserAddHandler returns the handler for GET /admin/users/add/
-func (h *AdminHandler) getUserAddHandler() http.Handler {
+// getUserRevisionsHandler returns the handler for GET /admin/users/{id}/revisions/
+func (h *AdminHandler) getUserRevisionsHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		props, err := h.buildUserAddPageProps(r.Context(), "")
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+
+		query := r.URL.Query()
+		props, err := h.buildUserRevisionsPageProps(r.Context(), id, query.Get("from"), query.Get("to"))
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
 
-		if err := gui.SchemaEntityAddPage(props).Render(r.Context(), w); err != nil {
+		if err := gui.SchemaEntityRevisionsPage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
 
-// buildUserPageProps builds the edit page props for User.
-func (h *AdminHandler) buildUserPageProps(ctx context.Context, id int, errorMessage string) (gui.SchemaEntityChangeProps, error) {
-	e, err := h.schemas.User.EagerLoadQuery(h.client.User.Query().
-		Where(user.IDEQ(id))).
-		Only(ctx)
+// postUserRestoreHandler returns the handler for POST /admin/users/{id}/revisions/{revision}/restore/
+func (h *AdminHandler) postUserRestoreHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUserID(r.PathValue("id"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
+			return
+		}
+		revisionID, err := vent.ParseID[int](r.PathValue("revision"))
+		if err != nil {
+			vent.HandleError(w, r, vent.BadRequest("invalid revision id").WithCause(err))
+			return
+		}
+		rev, err := h.client.Revision.Query().
+			Where(
+				revision.IDEQ(revisionID),
+				revision.SchemaEQ("User"),
+				revision.EntityIDEQ(vent.FormatID(id)),
+			).
+			Only(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		var (
+			e     *ent.User
+			after func(context.Context)
+		)
+		restoreErr := h.withTx(r.Context(), func(ctx context.Context) error {
+			var err error
+			e, after, err = h.restoreUserRevision(ctx, id, rev)
+			return err
+		})
+
+		sse := datastar.NewSSE(w, r)
+		if restoreErr != nil {
+			if err := patchToast(sse, normalizeError(restoreErr).PublicMessage(), true); err != nil {
+				vent.HandleError(w, r, err)
+			}
+			return
+		}
+		after(r.Context())
+		sse.Redirect(fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(e.ID)))
+	})
+}
+
+// restoreUserRevision replays rev onto the User with id in ctx's
+// transaction, through the same update path as the change form, and returns
+// the User as it was before. A deleted User is recreated from rev
+// instead. The returned func runs the After hook once the transaction commits.
+func (h *AdminHandler) restoreUserRevision(ctx context.Context, id int, rev *ent.Revision) (*ent.User, func(context.Context), error) {
+	e, err := h.loadUser(ctx, id)
+	if ent.IsNotFound(err) {
+		e, err := h.recreateUser(ctx, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, func(ctx context.Context) {
+			h.afterUserCreate(ctx, e.ID)
+		}, nil
+	}
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return nil, nil, err
+	}
+	if err := denyIfCannot(defaultCan(ctx, "update_user")); err != nil {
+		return nil, nil, err
+	}
+	if err := denyIfCannot(h.schemas.User.CanUpdate(ctx, e)); err != nil {
+		return nil, nil, err
 	}
 
-	if err := denyIfCannot(h.schemas.User.CanRead(ctx, e)); err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+	var input UserUpdateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, nil, vent.BadRequest("invalid revision").WithCause(err)
 	}
-	canCreate, err := h.schemas.User.CanCreate(ctx)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+	if err := h.updateUser(ctx, e, input); err != nil {
+		return nil, nil, err
 	}
-	canUpdate, err := h.schemas.User.CanUpdate(ctx, e)
+	return e, func(ctx context.Context) {
+		h.afterUserUpdate(ctx, e, id)
+	}, nil
+}
+
+// recreateUser recreates a deleted User from rev in ctx's transaction,
+// through the same create path as the add form. The new User gets a new ID,
+// which is recorded on the deleted entity's revisions.
+func (h *AdminHandler) recreateUser(ctx context.Context, rev *ent.Revision) (*ent.User, error) {
+	deleted := revision.And(
+		revision.SchemaEQ(rev.Schema),
+		revision.EntityIDEQ(rev.EntityID),
+		revision.ActionEQ(revision.ActionDelete),
+	)
+	restored, err := h.db(ctx).Revision.Query().
+		Where(deleted, revision.RestoredIDNotNil()).
+		Exist(ctx)
 	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+		return nil, err
 	}
-	canDelete, err := h.schemas.User.CanDelete(ctx, e)
-	if err != nil {
-		return gui.SchemaEntityChangeProps{}, err
+	if restored {
+		return nil, vent.BadRequest("this user has already been restored")
 	}
-	renderCtx := gui.RenderContext{
-		CanCreate: canCreate,
-		CanUpdate: canUpdate,
-		CanDelete: canDelete,
+	if err := denyIfCannot(h.schemas.User.CanCreate(ctx)); err != nil {
+		return nil, err
 	}
-	ctx = gui.WithRenderContext(ctx, renderCtx)
 
-	fields := []gui.SchemaEntityFieldProps{}
+	var input UserCreateInput
+	if err := rev.Snapshot.Decode(&input); err != nil {
+		return nil, vent.BadRequest("invalid revision").WithCause(err)
+	}
+	e, err := h.createUser(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db(ctx).Revision.Update().
+		Where(deleted).
+		SetRestoredID(vent.FormatID(e.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record restored revision: %w", err)
+	}
+	return e, nil
+}
 
-	for _, field := range h.userFields.updateFormFields {
-		html, err := field.UpdateHTML(ctx, e)
-		if err != nil {
-			return gui.SchemaEntityChangeProps{}, err
-		}
-		if html != "" {
-			fields = append(fields, gui.SchemaEntityFieldProps{HTML: html})
-		}
+// buildUserDeletedPageProps lists the deleted User entities that have
+// not been restored, newest first.
+func (h *AdminHandler) buildUserDeletedPageProps(ctx context.Context) (gui.SchemaDeletedProps, error) {
+	revs, err := h.client.Revision.Query().
+		Where(
+			revision.SchemaEQ("User"),
+			revision.ActionEQ(revision.ActionDelete),
+			revision.RestoredIDIsNil(),
+		).
+		Order(revision.ByCreatedAt(sql.OrderDesc()), revision.ByID(sql.OrderDesc())).
+		Limit(vent.RevisionLimit + 1).
+		All(ctx)
+	if err != nil {
+		return gui.SchemaDeletedProps{}, err
 	}
 
-	entityDisplay := h.schemas.User.Name(e)
-	props := gui.SchemaEntityChangeProps{
-		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaEntityBreadcrumbs(
+	props := gui.SchemaDeletedProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaDeletedBreadcrumbs(
 			requestctx.MustAdminPath(ctx),
 			"users",
 			"Users",
-			entityDisplay,
 		)),
-		RouteName:     "users",
-		EntityID:      id,
-		EntityDisplay: entityDisplay,
-		ErrorMessage:  errorMessage,
-		Fields:        fields,
-		RenderContext: renderCtx,
+		RouteName:         "users",
+		PluralDisplayName: "Users",
+		Truncated:         len(revs) > vent.RevisionLimit,
+	}
+	if props.Truncated {
+		revs = revs[:vent.RevisionLimit]
+	}
+	for _, rev := range revs {
+		props.Entries = append(props.Entries, gui.DeletedEntry{
+			EntityID:      rev.EntityID,
+			EntityDisplay: rev.EntityName,
+			At:            rev.CreatedAt,
+			Actor:         rev.Actor,
+		})
 	}
 	return props, nil
 }
 
-// getUserHandler returns the handler for GET /admin/users/{id}/
+// getUserDeletedHandler returns the handler for GET /admin/users/deleted/
+func (h *AdminHandler) getUserDeletedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props, err := h.buildUserDeletedPageProps(r.Context())
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+
+		if err := gui.SchemaDeletedPage(props).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+// getUserHandler returns the handler for GET /admin/users/{id}/.
+// Users who may not update the entity get its detail page instead of a
+// disabled form.
 func (h *AdminHandler) getUserHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseUserID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		props, err := h.buildUserPageProps(r.Context(), id, "")
+		// Users who cannot update get the detail page, so check before
+		// building the change form and its option queries.
+		e, err := h.loadUser(r.Context(), id)
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
+		canUpdate, err := h.schemas.User.CanUpdate(r.Context(), e)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
 		}
+		if !canUpdate {
+			h.renderUserDetailPage(w, r, id)
+			return
+		}
 
+		props, err := h.buildUserPageProps(r.Context(), id, "")
+		if err != nil {
+			vent.HandleError(w, r, normalizeError(err))
+			return
+		}
 		if err := gui.SchemaEntityChangePage(props).Render(r.Context(), w); err != nil {
 			vent.HandleError(w, r, err)
 		}
 	})
 }
 func (h *AdminHandler) patchUserPageError(w http.ResponseWriter, r *http.Request, id int, err error) {
+	if patchFieldError(w, r, err) {
+		return
+	}
 	props, buildErr := h.buildUserPageProps(r.Context(), id, normalizeError(err).PublicMessage())
 	if buildErr != nil {
 		vent.HandleError(w, r, normalizeError(buildErr))
@@ -2043,46 +13308,305 @@ func (h *AdminHandler) postUserHandler() http.Handler {
 		var signals struct {
 			Entity UserCreateInput `json:"entity"`
 		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
+		if err := vent.ReadSignals(r, &signals); err != nil {
 			h.patchUserAddPageError(w, r, vent.BadRequest("invalid form data").WithCause(err))
 			return
 		}
 		input := signals.Entity
 
-		if err := h.schemas.User.ValidateCreate(r.Context(), input); err != nil {
+		var id int
+		err := h.withTx(r.Context(), func(ctx context.Context) error {
+			e, err := h.createUser(ctx, input)
+			if err != nil {
+				return err
+			}
+			id = e.ID
+			return nil
+		})
+		if err != nil {
 			h.patchUserAddPageError(w, r, err)
 			return
 		}
+		h.afterUserCreate(r.Context(), id)
 
-		builder := h.client.User.Create()
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
+	})
+}
 
-		for _, field := range h.userFields.createBindFields {
-			if err := field.ApplyCreate(r.Context(), builder, input); err != nil {
-				h.patchUserAddPageError(w, r, err)
-				return
-			}
+// createUser saves input as a new User in ctx's transaction:
+// ValidateCreate, the create fields, BeforeCreate and the audit log entry.
+func (h *AdminHandler) createUser(ctx context.Context, input UserCreateInput) (*ent.User, error) {
+	if err := h.schemas.User.ValidateCreate(ctx, input); err != nil {
+		return nil, err
+	}
+
+	builder := h.db(ctx).User.Create()
+
+	for _, field := range h.userFields.createBindFields {
+		if err := field.ApplyCreate(ctx, builder, input); err != nil {
+			return nil, err
 		}
+	}
+	if err := h.schemas.User.BeforeCreate(ctx, builder); err != nil {
+		return nil, err
+	}
 
-		if _, err := builder.Save(r.Context()); err != nil {
-			h.patchUserAddPageError(w, r, err)
-			return
+	e, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.auditUser(ctx, vent.AuditActionCreate, nil, e.ID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// afterUserCreate runs the AfterCreate hook on the User saved with id.
+func (h *AdminHandler) afterUserCreate(ctx context.Context, id int) {
+	e, err := h.loadUser(ctx, id)
+	if err == nil {
+		err = h.schemas.User.AfterCreate(ctx, e)
+	}
+	if err != nil {
+		log.Printf("User after create hook: %v", err)
+	}
+}
+
+// userImportColumns are the User fields an import file can fill.
+var userImportColumns = []vent.ImportColumn{
+	{Name: "email", Label: "Email"},
+	{Name: "last_login", Label: "LastLogin"},
+	{Name: "is_staff", Label: "IsStaff"},
+	{Name: "is_superuser", Label: "IsSuperuser"},
+	{Name: "is_active", Label: "IsActive"},
+	{Name: "groups", Label: "Groups"},
+}
+
+// buildUserImportPageProps builds the import page props for User.
+func (h *AdminHandler) buildUserImportPageProps(ctx context.Context) gui.SchemaImportProps {
+	props := gui.SchemaImportProps{
+		LayoutProps: h.buildLayoutProps(ctx, "User", gui.SchemaImportBreadcrumbs(
+			requestctx.MustAdminPath(ctx),
+			"users",
+			"Users",
+		)),
+		RouteName:         "users",
+		PluralDisplayName: "Users",
+		Columns: []gui.SchemaImportColumn{
+			{Name: "email", Label: "Email"},
+			{Name: "last_login", Label: "LastLogin"},
+			{Name: "is_staff", Label: "IsStaff"},
+			{Name: "is_superuser", Label: "IsSuperuser"},
+			{Name: "is_active", Label: "IsActive"},
+			{Name: "groups", Label: "Groups", Hint: "IDs or names, comma-separated"},
+		},
+	}
+	if ok, err := defaultCan(ctx, "update_user"); err == nil && ok {
+		props.UpsertOptions = []gui.SelectOption{
+			{Value: "email", Label: "Email"},
+		}
+	}
+	return props
+}
+
+// getUserImportHandler returns the handler for GET /admin/users/import/
+func (h *AdminHandler) getUserImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := gui.SchemaImportPage(h.buildUserImportPageProps(r.Context())).Render(r.Context(), w); err != nil {
+			vent.HandleError(w, r, err)
 		}
+	})
+}
 
+// postUserImportHandler returns the handler for POST /admin/users/import/.
+// With ?dry_run=1 the rows are saved and then rolled back, so the preview
+// reports the same errors a real import would.
+func (h *AdminHandler) postUserImportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		props := gui.SchemaImportResultProps{
+			RouteName:         "users",
+			PluralDisplayName: "Users",
+		}
+		result, err := h.importUserFile(r, r.URL.Query().Get("dry_run") != "")
+		if err != nil {
+			props.ErrorMessage = normalizeError(err).PublicMessage()
+		} else {
+			props.Result = &result
+		}
 		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
+		if err := sse.PatchElementTempl(gui.SchemaImportResult(props)); err != nil {
+			vent.HandleError(w, r, err)
+		}
+	})
+}
+
+func (h *AdminHandler) importUserFile(r *http.Request, dryRun bool) (vent.ImportResult, error) {
+	file, err := vent.ReadImportUpload(r, "file")
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	mapping := vent.MapImportColumns(file.Headers, userImportColumns)
+	if strings.Join(mapping, "") == "" {
+		return vent.ImportResult{}, vent.BadRequest("no column in the file matches a User field")
+	}
+	upsert := r.FormValue("upsert")
+	switch upsert {
+	case "", "email":
+	default:
+		return vent.ImportResult{}, vent.BadRequest(fmt.Sprintf("cannot upsert on %q", upsert))
+	}
+	if upsert != "" {
+		if err := denyIfCannot(defaultCan(r.Context(), "update_user")); err != nil {
+			return vent.ImportResult{}, err
+		}
+	}
+
+	result := vent.NewImportResult(file, mapping, userImportColumns, dryRun)
+	var afterHooks []func(context.Context)
+	err = h.withTx(r.Context(), func(ctx context.Context) error {
+		for _, row := range file.Rows {
+			// Each row gets a savepoint, so a failed row does not abort the
+			// transaction for the rows after it.
+			action := vent.ImportActionCreate
+			err := withSavepoint(ctx, func(ctx context.Context) error {
+				var (
+					after func(context.Context)
+					err   error
+				)
+				action, after, err = h.importUserRow(ctx, row.Fields(mapping), upsert)
+				if err == nil {
+					afterHooks = append(afterHooks, after)
+				}
+				return err
+			})
+			message := ""
+			if err != nil {
+				message = normalizeError(err).PublicMessage()
+			}
+			result.Record(row, mapping, action, message)
+		}
+		if dryRun || result.Failed > 0 {
+			return errRollback
+		}
+		return nil
 	})
+	if errors.Is(err, errRollback) {
+		return result, nil
+	}
+	if err != nil {
+		return vent.ImportResult{}, err
+	}
+	result.Committed = true
+	for _, after := range afterHooks {
+		after(r.Context())
+	}
+	return result, nil
+}
+
+// importUserRow saves one import row in ctx's transaction through the
+// same path as the add form, or as the change form when upsert names a
+// field and an existing User matches the row on it. The returned func
+// runs the After hook once the import commits.
+func (h *AdminHandler) importUserRow(ctx context.Context, values map[string]string, upsert string) (vent.ImportAction, func(context.Context), error) {
+	client := h.db(ctx)
+	var input UserCreateInput
+	if err := vent.DecodeImportValues(values, &input); err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	for i, ref := range input.Groups {
+		id, err := resolvePermissionGroupImportRef(ctx, client, ref)
+		if err != nil {
+			return vent.ImportActionCreate, nil, err
+		}
+		input.Groups[i] = id
+	}
+
+	switch upsert {
+	case "email":
+		if strings.TrimSpace(values["email"]) == "" {
+			return vent.ImportActionUpdate, nil, vent.BadRequest("email is required to update existing rows")
+		}
+		var key struct {
+			Value string `json:"email"`
+		}
+		if err := vent.DecodeImportValues(values, &key); err != nil {
+			return vent.ImportActionUpdate, nil, err
+		}
+		id, err := client.User.Query().Where(user.EmailEQ(key.Value)).OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return vent.ImportActionUpdate, nil, err
+		}
+		return h.importUserUpdate(ctx, id, values, input)
+	}
+
+	e, err := h.createUser(ctx, input)
+	if err != nil {
+		return vent.ImportActionCreate, nil, err
+	}
+	return vent.ImportActionCreate, func(ctx context.Context) {
+		h.afterUserCreate(ctx, e.ID)
+	}, nil
+}
+
+// importUserUpdate saves an import row over the existing User with
+// id, changing only the columns the file has.
+func (h *AdminHandler) importUserUpdate(ctx context.Context, id int, values map[string]string, create UserCreateInput) (vent.ImportAction, func(context.Context), error) {
+	e, err := h.loadUser(ctx, id)
+	if err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	if err := denyIfCannot(h.schemas.User.CanUpdate(ctx, e)); err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	var input UserUpdateInput
+	if err := vent.ImportUpdateInput(values, create, &input); err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	if err := h.updateUser(ctx, e, input); err != nil {
+		return vent.ImportActionUpdate, nil, err
+	}
+	return vent.ImportActionUpdate, func(ctx context.Context) {
+		h.afterUserUpdate(ctx, e, id)
+	}, nil
+}
+
+// resolveUserImportRef returns the ID of the User an import
+// cell references, by ID or by email.
+func resolveUserImportRef(ctx context.Context, client *ent.Client, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := parseUserID(ref); err == nil {
+		exists, err := client.User.Query().Where(user.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return vent.FormatID(id), nil
+		}
+	}
+	id, err := client.User.Query().Where(user.EmailEQ(ref)).OnlyID(ctx)
+	if err == nil {
+		return vent.FormatID(id), nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", err
+	}
+	return "", vent.BadRequest(fmt.Sprintf("no User matches %q", ref))
 }
 
 // patchUserHandler returns the handler for PATCH /admin/users/{id}/
 func (h *AdminHandler) patchUserHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseUserID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		e, err := h.client.User.Get(r.Context(), id)
+		e, err := h.loadUser(r.Context(), id)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
@@ -2095,34 +13619,68 @@ func (h *AdminHandler) patchUserHandler() http.Handler {
 		var signals struct {
 			Entity UserUpdateInput `json:"entity"`
 		}
-		if err := datastar.ReadSignals(r, &signals); err != nil {
+		if err := vent.ReadSignals(r, &signals); err != nil {
 			h.patchUserPageError(w, r, id, vent.BadRequest("invalid form data").WithCause(err))
 			return
 		}
 		input := signals.Entity
 
-		if err := h.schemas.User.ValidateUpdate(r.Context(), id, input); err != nil {
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			return h.updateUser(ctx, e, input)
+		})
+		if err != nil {
 			h.patchUserPageError(w, r, id, err)
 			return
 		}
+		h.afterUserUpdate(r.Context(), e, id)
 
-		builder := h.client.User.UpdateOneID(id)
+		sse := datastar.NewSSE(w, r)
+		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
+	})
+}
 
-		for _, field := range h.userFields.updateBindFields {
-			if err := field.ApplyUpdate(r.Context(), builder, input); err != nil {
-				h.patchUserPageError(w, r, id, err)
-				return
-			}
-		}
+// updateUser saves input over e in ctx's transaction: ValidateUpdate,
+// the update fields, BeforeUpdate, the audit log entry and the revision.
+func (h *AdminHandler) updateUser(ctx context.Context, e *ent.User, input UserUpdateInput) error {
+	if err := h.schemas.User.ValidateUpdate(ctx, e.ID, input); err != nil {
+		return err
+	}
 
-		if err := builder.Exec(r.Context()); err != nil {
-			h.patchUserPageError(w, r, id, err)
-			return
+	snapshot, err := h.userRevisionSnapshot(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	builder := h.db(ctx).User.UpdateOneID(e.ID)
+
+	for _, field := range h.userFields.updateBindFields {
+		if err := field.ApplyUpdate(ctx, builder, input); err != nil {
+			return err
 		}
+	}
+	if err := h.schemas.User.BeforeUpdate(ctx, e, builder); err != nil {
+		return err
+	}
 
-		sse := datastar.NewSSE(w, r)
-		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
-	})
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	if err := h.auditUser(ctx, vent.AuditActionUpdate, e, e.ID); err != nil {
+		return err
+	}
+	return h.recordRevision(ctx, vent.AuditActionUpdate, "User", e.ID, h.schemas.User.Name(e), snapshot)
+}
+
+// afterUserUpdate runs the AfterUpdate hook on the User with id, which
+// was prev before the save.
+func (h *AdminHandler) afterUserUpdate(ctx context.Context, prev *ent.User, id int) {
+	e, err := h.loadUser(ctx, id)
+	if err == nil {
+		err = h.schemas.User.AfterUpdate(ctx, prev, e)
+	}
+	if err != nil {
+		log.Printf("User after update hook: %v", err)
+	}
 }
 
 type UserPasswordInput struct {
@@ -2154,10 +13712,10 @@ func (h *AdminHandler) buildUserPasswordPageProps(ctx context.Context, id int, e
 			"users",
 			"Users",
 			entityDisplay,
-			id,
+			vent.FormatID(id),
 		)),
 		RouteName:     "users",
-		EntityID:      id,
+		EntityID:      vent.FormatID(id),
 		EntityDisplay: entityDisplay,
 		PasswordSet:   vent.PasswordHashIsSet(e.PasswordHash),
 		ErrorMessage:  errorMessage,
@@ -2180,7 +13738,7 @@ func (h *AdminHandler) patchUserPasswordPageError(w http.ResponseWriter, r *http
 // getUserPasswordHandler returns the handler for GET /admin/users/{id}/password/.
 func (h *AdminHandler) getUserPasswordHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseUserID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
@@ -2201,7 +13759,7 @@ func (h *AdminHandler) getUserPasswordHandler() http.Handler {
 // putUserPasswordHandler returns the handler for PUT /admin/users/{id}/password/.
 func (h *AdminHandler) putUserPasswordHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseUserID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
@@ -2239,20 +13797,26 @@ func (h *AdminHandler) putUserPasswordHandler() http.Handler {
 			h.patchUserPasswordPageError(w, r, id, err)
 			return
 		}
-		if err := h.client.User.UpdateOneID(id).SetPasswordHash(hash).Exec(r.Context()); err != nil {
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			if err := h.db(ctx).User.UpdateOneID(id).SetPasswordHash(hash).Exec(ctx); err != nil {
+				return err
+			}
+			return h.auditUserPassword(ctx, e, "Set")
+		})
+		if err != nil {
 			h.patchUserPasswordPageError(w, r, id, err)
 			return
 		}
 
 		sse := datastar.NewSSE(w, r)
-		sse.Redirect(fmt.Sprintf("%susers/%d/", requestctx.MustAdminPath(r.Context()), id))
+		sse.Redirect(fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(id)))
 	})
 }
 
 // deleteUserPasswordHandler returns the handler for DELETE /admin/users/{id}/password/.
 func (h *AdminHandler) deleteUserPasswordHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseUserID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
@@ -2278,26 +13842,45 @@ func (h *AdminHandler) deleteUserPasswordHandler() http.Handler {
 			return
 		}
 
-		if err := h.client.User.UpdateOneID(id).ClearPasswordHash().Exec(r.Context()); err != nil {
+		err = h.withTx(r.Context(), func(ctx context.Context) error {
+			if err := h.db(ctx).User.UpdateOneID(id).ClearPasswordHash().Exec(ctx); err != nil {
+				return err
+			}
+			return h.auditUserPassword(ctx, e, "Not set")
+		})
+		if err != nil {
 			h.patchUserPasswordPageError(w, r, id, err)
 			return
 		}
 
 		sse := datastar.NewSSE(w, r)
-		sse.Redirect(fmt.Sprintf("%susers/%d/", requestctx.MustAdminPath(r.Context()), id))
+		sse.Redirect(fmt.Sprintf("%susers/%s/", requestctx.MustAdminPath(r.Context()), vent.FormatIDPath(id)))
 	})
 }
 
+// auditUserPassword records a password change on e in the audit log.
+// status is the password's state afterwards; the hash is never recorded.
+func (h *AdminHandler) auditUserPassword(ctx context.Context, e *ent.User, status string) error {
+	before := "Not set"
+	if vent.PasswordHashIsSet(e.PasswordHash) {
+		before = "Set"
+	}
+	changes := []vent.AuditChange{
+		{Field: "password", Before: before, After: status},
+	}
+	return h.recordAudit(ctx, vent.AuditActionPassword, "User", e.ID, h.schemas.User.Name(e), changes)
+}
+
 // deleteUserHandler returns the handler for DELETE /admin/users/{id}/
 func (h *AdminHandler) deleteUserHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := strconv.Atoi(r.PathValue("id"))
+		id, err := parseUserID(r.PathValue("id"))
 		if err != nil {
 			vent.HandleError(w, r, vent.BadRequest("invalid id").WithCause(err))
 			return
 		}
 
-		e, err := h.client.User.Get(r.Context(), id)
+		e, err := h.loadUser(r.Context(), id)
 		if err != nil {
 			vent.HandleError(w, r, normalizeError(err))
 			return
@@ -2307,13 +13890,8 @@ func (h *AdminHandler) deleteUserHandler() http.Handler {
 			return
 		}
 
-		if err := h.schemas.User.ValidateDelete(r.Context(), id); err != nil {
-			h.patchUserPageError(w, r, id, err)
-			return
-		}
-
-		if err := h.client.User.DeleteOneID(id).Exec(r.Context()); err != nil {
-			h.patchUserPageError(w, r, id, err)
+		if err := h.deleteUserRow(r.Context(), e); err != nil {
+			h.patchUserDeletePageError(w, r, id, err)
 			return
 		}
 
@@ -2321,3 +13899,12 @@ func (h *AdminHandler) deleteUserHandler() http.Handler {
 		sse.Redirect(requestctx.MustAdminPath(r.Context()) + "users/")
 	})
 }
+
+// bulkErrorMessage is the client-safe message for a bulk action row error, or
+// "" when the row succeeded.
+func bulkErrorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return normalizeError(err).PublicMessage()
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	uuid "github.com/google/uuid"
 	"github.com/troygilman/vent"
 	ent "github.com/troygilman/vent/examples/basic/ent"
 )
@@ -13,10 +14,12 @@ import (
 // SchemaAdmins holds per-schema admin surface implementations.
 // A nil slot uses the generated Default*Admin for that schema.
 type SchemaAdmins struct {
+	AuditLog        AuditLogAdmin
 	Author          AuthorAdmin
 	Book            BookAdmin
 	Permission      PermissionAdmin
 	PermissionGroup PermissionGroupAdmin
+	Publisher       PublisherAdmin
 	Review          ReviewAdmin
 	User            UserAdmin
 }
@@ -42,6 +45,10 @@ func MustAdmin(ctx context.Context) Admin {
 	return Admin{schemas: admins}
 }
 
+func (a Admin) AuditLog() AuditLogAdmin {
+	return a.schemas.AuditLog
+}
+
 func (a Admin) Author() AuthorAdmin {
 	return a.schemas.Author
 }
@@ -58,6 +65,10 @@ func (a Admin) PermissionGroup() PermissionGroupAdmin {
 	return a.schemas.PermissionGroup
 }
 
+func (a Admin) Publisher() PublisherAdmin {
+	return a.schemas.Publisher
+}
+
 func (a Admin) Review() ReviewAdmin {
 	return a.schemas.Review
 }
@@ -66,14 +77,200 @@ func (a Admin) User() UserAdmin {
 	return a.schemas.User
 }
 
+// AuditLogAdmin is the customizable admin surface for AuditLog.
+// Embed DefaultAuditLogAdmin and override only the methods you need.
+//
+// Field* methods supply field implementations. EagerLoadQuery controls which
+// edges are loaded for lists, detail pages, and FK option labels. Validate*
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
+type AuditLogAdmin interface {
+	FieldCreatedAt() AuditLogField
+	FieldActor() AuditLogField
+	FieldAction() AuditLogField
+	FieldSchema() AuditLogField
+	FieldEntityId() AuditLogField
+	FieldEntityName() AuditLogField
+	FieldChanges() AuditLogField
+	Name(e *ent.AuditLog) string
+	EagerLoadQuery(q *ent.AuditLogQuery) *ent.AuditLogQuery
+	ValidateCreate(ctx context.Context, input AuditLogCreateInput) error
+	ValidateUpdate(ctx context.Context, id int, input AuditLogUpdateInput) error
+	ValidateDelete(ctx context.Context, id int) error
+	CanRead(ctx context.Context, e *ent.AuditLog) (bool, error)
+	CanCreate(ctx context.Context) (bool, error)
+	CanUpdate(ctx context.Context, e *ent.AuditLog) (bool, error)
+	CanDelete(ctx context.Context, e *ent.AuditLog) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.AuditLogCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.AuditLog, builder *ent.AuditLogUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.AuditLog) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.AuditLog) error
+	AfterUpdate(ctx context.Context, prev, e *ent.AuditLog) error
+	AfterDelete(ctx context.Context, e *ent.AuditLog) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []AuditLogAction
+}
+
+// AuditLogAction is a custom action on AuditLog entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type AuditLogAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.AuditLog) (bool, error)
+	Run func(ctx context.Context, e *ent.AuditLog) (vent.ActionResult, error)
+}
+
+func validateAuditLogActions(actions []AuditLogAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("AuditLogAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("AuditLogAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("AuditLogAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
+}
+
+// DefaultAuditLogAdmin is the generated default AuditLog admin surface.
+// Embed it to keep defaults while overriding individual methods.
+// Client is required for default field implementations.
+type DefaultAuditLogAdmin struct {
+	Client *ent.Client
+}
+
+// NewDefaultAuditLogAdmin returns a default AuditLog admin using client.
+func NewDefaultAuditLogAdmin(client *ent.Client) DefaultAuditLogAdmin {
+	return DefaultAuditLogAdmin{Client: client}
+}
+
+func (DefaultAuditLogAdmin) Name(e *ent.AuditLog) string {
+	return fmt.Sprintf("%v", e.ID)
+}
+
+func (DefaultAuditLogAdmin) EagerLoadQuery(q *ent.AuditLogQuery) *ent.AuditLogQuery {
+	return q
+}
+
+func (a DefaultAuditLogAdmin) FieldCreatedAt() AuditLogField {
+	return NewAuditLogCreatedAtField(a.Client)
+}
+
+func (a DefaultAuditLogAdmin) FieldActor() AuditLogField {
+	return NewAuditLogActorField(a.Client)
+}
+
+func (a DefaultAuditLogAdmin) FieldAction() AuditLogField {
+	return NewAuditLogActionField(a.Client)
+}
+
+func (a DefaultAuditLogAdmin) FieldSchema() AuditLogField {
+	return NewAuditLogSchemaField(a.Client)
+}
+
+func (a DefaultAuditLogAdmin) FieldEntityId() AuditLogField {
+	return NewAuditLogEntityIdField(a.Client)
+}
+
+func (a DefaultAuditLogAdmin) FieldEntityName() AuditLogField {
+	return NewAuditLogEntityNameField(a.Client)
+}
+
+func (a DefaultAuditLogAdmin) FieldChanges() AuditLogField {
+	return NewAuditLogChangesField(a.Client)
+}
+
+func (DefaultAuditLogAdmin) Actions() []AuditLogAction {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) ValidateCreate(context.Context, AuditLogCreateInput) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) ValidateUpdate(ctx context.Context, id int, input AuditLogUpdateInput) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) ValidateDelete(ctx context.Context, id int) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) BeforeCreate(context.Context, *ent.AuditLogCreate) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) BeforeUpdate(context.Context, *ent.AuditLog, *ent.AuditLogUpdateOne) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) BeforeDelete(context.Context, *ent.AuditLog) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) AfterCreate(context.Context, *ent.AuditLog) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) AfterUpdate(context.Context, *ent.AuditLog, *ent.AuditLog) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) AfterDelete(context.Context, *ent.AuditLog) error {
+	return nil
+}
+
+func (DefaultAuditLogAdmin) CanRead(ctx context.Context, _ *ent.AuditLog) (bool, error) {
+	return defaultCan(ctx, "read_audit_log")
+}
+
+func (DefaultAuditLogAdmin) CanCreate(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (DefaultAuditLogAdmin) CanUpdate(ctx context.Context, e *ent.AuditLog) (bool, error) {
+	return false, nil
+}
+
+func (DefaultAuditLogAdmin) CanDelete(ctx context.Context, e *ent.AuditLog) (bool, error) {
+	return false, nil
+}
+
 // AuthorAdmin is the customizable admin surface for Author.
 // Embed DefaultAuthorAdmin and override only the methods you need.
 //
 // Field* methods supply field implementations. EagerLoadQuery controls which
 // edges are loaded for lists, detail pages, and FK option labels. Validate*
-// methods own mutation policy. CanRead/CanUpdate/CanDelete take the target
-// entity. CanCreate and schema CRUD permissions (read_/create_/...) own
-// schema-level access for routes, menu visibility, and create.
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
 type AuthorAdmin interface {
 	FieldUser() AuthorField
 	FieldActive() AuthorField
@@ -86,6 +283,57 @@ type AuthorAdmin interface {
 	CanCreate(ctx context.Context) (bool, error)
 	CanUpdate(ctx context.Context, e *ent.Author) (bool, error)
 	CanDelete(ctx context.Context, e *ent.Author) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.AuthorCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.Author, builder *ent.AuthorUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.Author) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.Author) error
+	AfterUpdate(ctx context.Context, prev, e *ent.Author) error
+	AfterDelete(ctx context.Context, e *ent.Author) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []AuthorAction
+}
+
+// AuthorAction is a custom action on Author entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type AuthorAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.Author) (bool, error)
+	Run func(ctx context.Context, e *ent.Author) (vent.ActionResult, error)
+}
+
+func validateAuthorActions(actions []AuthorAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("AuthorAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("AuthorAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("AuthorAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
 }
 
 // DefaultAuthorAdmin is the generated default Author admin surface.
@@ -117,6 +365,10 @@ func (a DefaultAuthorAdmin) FieldActive() AuthorField {
 	return NewAuthorActiveField(a.Client)
 }
 
+func (DefaultAuthorAdmin) Actions() []AuthorAction {
+	return nil
+}
+
 func (DefaultAuthorAdmin) ValidateCreate(context.Context, AuthorCreateInput) error {
 	return nil
 }
@@ -129,6 +381,30 @@ func (DefaultAuthorAdmin) ValidateDelete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (DefaultAuthorAdmin) BeforeCreate(context.Context, *ent.AuthorCreate) error {
+	return nil
+}
+
+func (DefaultAuthorAdmin) BeforeUpdate(context.Context, *ent.Author, *ent.AuthorUpdateOne) error {
+	return nil
+}
+
+func (DefaultAuthorAdmin) BeforeDelete(context.Context, *ent.Author) error {
+	return nil
+}
+
+func (DefaultAuthorAdmin) AfterCreate(context.Context, *ent.Author) error {
+	return nil
+}
+
+func (DefaultAuthorAdmin) AfterUpdate(context.Context, *ent.Author, *ent.Author) error {
+	return nil
+}
+
+func (DefaultAuthorAdmin) AfterDelete(context.Context, *ent.Author) error {
+	return nil
+}
+
 func (DefaultAuthorAdmin) CanRead(ctx context.Context, _ *ent.Author) (bool, error) {
 	return defaultCan(ctx, "read_author")
 }
@@ -158,16 +434,26 @@ func (DefaultAuthorAdmin) CanDelete(ctx context.Context, e *ent.Author) (bool, e
 //
 // Field* methods supply field implementations. EagerLoadQuery controls which
 // edges are loaded for lists, detail pages, and FK option labels. Validate*
-// methods own mutation policy. CanRead/CanUpdate/CanDelete take the target
-// entity. CanCreate and schema CRUD permissions (read_/create_/...) own
-// schema-level access for routes, menu visibility, and create.
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
 type BookAdmin interface {
 	FieldTitle() BookField
+	FieldSlug() BookField
+	FieldCover() BookField
 	FieldAuthor() BookField
+	FieldPublisher() BookField
 	FieldPages() BookField
+	FieldFormat() BookField
 	FieldPublished() BookField
 	FieldPublishedAt() BookField
+	FieldTags() BookField
+	FieldEditions() BookField
+	FieldMetadata() BookField
 	FieldCreatedAt() BookField
+	FieldVersion() BookField
 	FieldNotes() BookField
 	Name(e *ent.Book) string
 	EagerLoadQuery(q *ent.BookQuery) *ent.BookQuery
@@ -178,6 +464,57 @@ type BookAdmin interface {
 	CanCreate(ctx context.Context) (bool, error)
 	CanUpdate(ctx context.Context, e *ent.Book) (bool, error)
 	CanDelete(ctx context.Context, e *ent.Book) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.BookCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.Book, builder *ent.BookUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.Book) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.Book) error
+	AfterUpdate(ctx context.Context, prev, e *ent.Book) error
+	AfterDelete(ctx context.Context, e *ent.Book) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []BookAction
+}
+
+// BookAction is a custom action on Book entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type BookAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.Book) (bool, error)
+	Run func(ctx context.Context, e *ent.Book) (vent.ActionResult, error)
+}
+
+func validateBookActions(actions []BookAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("BookAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("BookAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("BookAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
 }
 
 // DefaultBookAdmin is the generated default Book admin surface.
@@ -198,6 +535,7 @@ func (DefaultBookAdmin) Name(e *ent.Book) string {
 
 func (DefaultBookAdmin) EagerLoadQuery(q *ent.BookQuery) *ent.BookQuery {
 	q = q.WithAuthor()
+	q = q.WithPublisher()
 	return q
 }
 
@@ -205,14 +543,30 @@ func (a DefaultBookAdmin) FieldTitle() BookField {
 	return NewBookTitleField(a.Client)
 }
 
+func (a DefaultBookAdmin) FieldSlug() BookField {
+	return NewBookSlugField(a.Client)
+}
+
+func (a DefaultBookAdmin) FieldCover() BookField {
+	return NewBookCoverField(a.Client)
+}
+
 func (a DefaultBookAdmin) FieldAuthor() BookField {
 	return NewBookAuthorField(a.Client)
 }
 
+func (a DefaultBookAdmin) FieldPublisher() BookField {
+	return NewBookPublisherField(a.Client)
+}
+
 func (a DefaultBookAdmin) FieldPages() BookField {
 	return NewBookPagesField(a.Client)
 }
 
+func (a DefaultBookAdmin) FieldFormat() BookField {
+	return NewBookFormatField(a.Client)
+}
+
 func (a DefaultBookAdmin) FieldPublished() BookField {
 	return NewBookPublishedField(a.Client)
 }
@@ -221,14 +575,34 @@ func (a DefaultBookAdmin) FieldPublishedAt() BookField {
 	return NewBookPublishedAtField(a.Client)
 }
 
+func (a DefaultBookAdmin) FieldTags() BookField {
+	return NewBookTagsField(a.Client)
+}
+
+func (a DefaultBookAdmin) FieldEditions() BookField {
+	return NewBookEditionsField(a.Client)
+}
+
+func (a DefaultBookAdmin) FieldMetadata() BookField {
+	return NewBookMetadataField(a.Client)
+}
+
 func (a DefaultBookAdmin) FieldCreatedAt() BookField {
 	return NewBookCreatedAtField(a.Client)
 }
 
+func (a DefaultBookAdmin) FieldVersion() BookField {
+	return NewBookVersionField(a.Client)
+}
+
 func (a DefaultBookAdmin) FieldNotes() BookField {
 	return nil
 }
 
+func (DefaultBookAdmin) Actions() []BookAction {
+	return nil
+}
+
 func (DefaultBookAdmin) ValidateCreate(context.Context, BookCreateInput) error {
 	return nil
 }
@@ -241,6 +615,30 @@ func (DefaultBookAdmin) ValidateDelete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (DefaultBookAdmin) BeforeCreate(context.Context, *ent.BookCreate) error {
+	return nil
+}
+
+func (DefaultBookAdmin) BeforeUpdate(context.Context, *ent.Book, *ent.BookUpdateOne) error {
+	return nil
+}
+
+func (DefaultBookAdmin) BeforeDelete(context.Context, *ent.Book) error {
+	return nil
+}
+
+func (DefaultBookAdmin) AfterCreate(context.Context, *ent.Book) error {
+	return nil
+}
+
+func (DefaultBookAdmin) AfterUpdate(context.Context, *ent.Book, *ent.Book) error {
+	return nil
+}
+
+func (DefaultBookAdmin) AfterDelete(context.Context, *ent.Book) error {
+	return nil
+}
+
 func (DefaultBookAdmin) CanRead(ctx context.Context, _ *ent.Book) (bool, error) {
 	return defaultCan(ctx, "read_book")
 }
@@ -270,9 +668,11 @@ func (DefaultBookAdmin) CanDelete(ctx context.Context, e *ent.Book) (bool, error
 //
 // Field* methods supply field implementations. EagerLoadQuery controls which
 // edges are loaded for lists, detail pages, and FK option labels. Validate*
-// methods own mutation policy. CanRead/CanUpdate/CanDelete take the target
-// entity. CanCreate and schema CRUD permissions (read_/create_/...) own
-// schema-level access for routes, menu visibility, and create.
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
 type PermissionAdmin interface {
 	FieldName() PermissionField
 	FieldGroups() PermissionField
@@ -285,6 +685,57 @@ type PermissionAdmin interface {
 	CanCreate(ctx context.Context) (bool, error)
 	CanUpdate(ctx context.Context, e *ent.Permission) (bool, error)
 	CanDelete(ctx context.Context, e *ent.Permission) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.PermissionCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.Permission, builder *ent.PermissionUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.Permission) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.Permission) error
+	AfterUpdate(ctx context.Context, prev, e *ent.Permission) error
+	AfterDelete(ctx context.Context, e *ent.Permission) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []PermissionAction
+}
+
+// PermissionAction is a custom action on Permission entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type PermissionAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.Permission) (bool, error)
+	Run func(ctx context.Context, e *ent.Permission) (vent.ActionResult, error)
+}
+
+func validatePermissionActions(actions []PermissionAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("PermissionAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("PermissionAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("PermissionAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
 }
 
 // DefaultPermissionAdmin is the generated default Permission admin surface.
@@ -324,6 +775,10 @@ func (a DefaultPermissionAdmin) FieldGroups() PermissionField {
 	return NewPermissionGroupsField(a.Client)
 }
 
+func (DefaultPermissionAdmin) Actions() []PermissionAction {
+	return nil
+}
+
 func (DefaultPermissionAdmin) ValidateCreate(context.Context, PermissionCreateInput) error {
 	return nil
 }
@@ -336,6 +791,30 @@ func (DefaultPermissionAdmin) ValidateDelete(ctx context.Context, id int) error
 	return nil
 }
 
+func (DefaultPermissionAdmin) BeforeCreate(context.Context, *ent.PermissionCreate) error {
+	return nil
+}
+
+func (DefaultPermissionAdmin) BeforeUpdate(context.Context, *ent.Permission, *ent.PermissionUpdateOne) error {
+	return nil
+}
+
+func (DefaultPermissionAdmin) BeforeDelete(context.Context, *ent.Permission) error {
+	return nil
+}
+
+func (DefaultPermissionAdmin) AfterCreate(context.Context, *ent.Permission) error {
+	return nil
+}
+
+func (DefaultPermissionAdmin) AfterUpdate(context.Context, *ent.Permission, *ent.Permission) error {
+	return nil
+}
+
+func (DefaultPermissionAdmin) AfterDelete(context.Context, *ent.Permission) error {
+	return nil
+}
+
 func (DefaultPermissionAdmin) CanRead(ctx context.Context, _ *ent.Permission) (bool, error) {
 	return defaultCan(ctx, "read_permission")
 }
@@ -361,9 +840,11 @@ func (DefaultPermissionAdmin) CanDelete(ctx context.Context, e *ent.Permission)
 //
 // Field* methods supply field implementations. EagerLoadQuery controls which
 // edges are loaded for lists, detail pages, and FK option labels. Validate*
-// methods own mutation policy. CanRead/CanUpdate/CanDelete take the target
-// entity. CanCreate and schema CRUD permissions (read_/create_/...) own
-// schema-level access for routes, menu visibility, and create.
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
 type PermissionGroupAdmin interface {
 	FieldName() PermissionGroupField
 	FieldPermissions() PermissionGroupField
@@ -376,6 +857,57 @@ type PermissionGroupAdmin interface {
 	CanCreate(ctx context.Context) (bool, error)
 	CanUpdate(ctx context.Context, e *ent.PermissionGroup) (bool, error)
 	CanDelete(ctx context.Context, e *ent.PermissionGroup) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.PermissionGroupCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.PermissionGroup, builder *ent.PermissionGroupUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.PermissionGroup) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.PermissionGroup) error
+	AfterUpdate(ctx context.Context, prev, e *ent.PermissionGroup) error
+	AfterDelete(ctx context.Context, e *ent.PermissionGroup) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []PermissionGroupAction
+}
+
+// PermissionGroupAction is a custom action on PermissionGroup entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type PermissionGroupAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.PermissionGroup) (bool, error)
+	Run func(ctx context.Context, e *ent.PermissionGroup) (vent.ActionResult, error)
+}
+
+func validatePermissionGroupActions(actions []PermissionGroupAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("PermissionGroupAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("PermissionGroupAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("PermissionGroupAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
 }
 
 // DefaultPermissionGroupAdmin is the generated default PermissionGroup admin surface.
@@ -407,6 +939,10 @@ func (a DefaultPermissionGroupAdmin) FieldPermissions() PermissionGroupField {
 	return NewPermissionGroupPermissionsField(a.Client)
 }
 
+func (DefaultPermissionGroupAdmin) Actions() []PermissionGroupAction {
+	return nil
+}
+
 func (DefaultPermissionGroupAdmin) ValidateCreate(context.Context, PermissionGroupCreateInput) error {
 	return nil
 }
@@ -419,6 +955,30 @@ func (DefaultPermissionGroupAdmin) ValidateDelete(ctx context.Context, id int) e
 	return nil
 }
 
+func (DefaultPermissionGroupAdmin) BeforeCreate(context.Context, *ent.PermissionGroupCreate) error {
+	return nil
+}
+
+func (DefaultPermissionGroupAdmin) BeforeUpdate(context.Context, *ent.PermissionGroup, *ent.PermissionGroupUpdateOne) error {
+	return nil
+}
+
+func (DefaultPermissionGroupAdmin) BeforeDelete(context.Context, *ent.PermissionGroup) error {
+	return nil
+}
+
+func (DefaultPermissionGroupAdmin) AfterCreate(context.Context, *ent.PermissionGroup) error {
+	return nil
+}
+
+func (DefaultPermissionGroupAdmin) AfterUpdate(context.Context, *ent.PermissionGroup, *ent.PermissionGroup) error {
+	return nil
+}
+
+func (DefaultPermissionGroupAdmin) AfterDelete(context.Context, *ent.PermissionGroup) error {
+	return nil
+}
+
 func (DefaultPermissionGroupAdmin) CanRead(ctx context.Context, _ *ent.PermissionGroup) (bool, error) {
 	return defaultCan(ctx, "read_permission_group")
 }
@@ -443,14 +1003,194 @@ func (DefaultPermissionGroupAdmin) CanDelete(ctx context.Context, e *ent.Permiss
 	return true, nil
 }
 
+// PublisherAdmin is the customizable admin surface for Publisher.
+// Embed DefaultPublisherAdmin and override only the methods you need.
+//
+// Field* methods supply field implementations. EagerLoadQuery controls which
+// edges are loaded for lists, detail pages, and FK option labels. Validate*
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
+type PublisherAdmin interface {
+	FieldID() PublisherField
+	FieldName() PublisherField
+	FieldCatalog() PublisherField
+	FieldBooks() PublisherField
+	Name(e *ent.Publisher) string
+	EagerLoadQuery(q *ent.PublisherQuery) *ent.PublisherQuery
+	ValidateCreate(ctx context.Context, input PublisherCreateInput) error
+	ValidateUpdate(ctx context.Context, id uuid.UUID, input PublisherUpdateInput) error
+	ValidateDelete(ctx context.Context, id uuid.UUID) error
+	CanRead(ctx context.Context, e *ent.Publisher) (bool, error)
+	CanCreate(ctx context.Context) (bool, error)
+	CanUpdate(ctx context.Context, e *ent.Publisher) (bool, error)
+	CanDelete(ctx context.Context, e *ent.Publisher) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.PublisherCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.Publisher, builder *ent.PublisherUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.Publisher) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.Publisher) error
+	AfterUpdate(ctx context.Context, prev, e *ent.Publisher) error
+	AfterDelete(ctx context.Context, e *ent.Publisher) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []PublisherAction
+}
+
+// PublisherAction is a custom action on Publisher entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type PublisherAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.Publisher) (bool, error)
+	Run func(ctx context.Context, e *ent.Publisher) (vent.ActionResult, error)
+}
+
+func validatePublisherActions(actions []PublisherAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("PublisherAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("PublisherAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("PublisherAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
+}
+
+// DefaultPublisherAdmin is the generated default Publisher admin surface.
+// Embed it to keep defaults while overriding individual methods.
+// Client is required for default field implementations.
+type DefaultPublisherAdmin struct {
+	Client *ent.Client
+}
+
+// NewDefaultPublisherAdmin returns a default Publisher admin using client.
+func NewDefaultPublisherAdmin(client *ent.Client) DefaultPublisherAdmin {
+	return DefaultPublisherAdmin{Client: client}
+}
+
+func (DefaultPublisherAdmin) Name(e *ent.Publisher) string {
+	return fmt.Sprintf("%v", e.Name)
+}
+
+func (DefaultPublisherAdmin) EagerLoadQuery(q *ent.PublisherQuery) *ent.PublisherQuery {
+	q = q.WithBooks()
+	return q
+}
+
+func (a DefaultPublisherAdmin) FieldID() PublisherField {
+	return NewPublisherIdField(a.Client)
+}
+
+func (a DefaultPublisherAdmin) FieldName() PublisherField {
+	return NewPublisherNameField(a.Client)
+}
+
+func (a DefaultPublisherAdmin) FieldCatalog() PublisherField {
+	return NewPublisherCatalogField(a.Client)
+}
+
+func (a DefaultPublisherAdmin) FieldBooks() PublisherField {
+	return NewPublisherBooksField(a.Client)
+}
+
+func (DefaultPublisherAdmin) Actions() []PublisherAction {
+	return nil
+}
+
+func (DefaultPublisherAdmin) ValidateCreate(context.Context, PublisherCreateInput) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) ValidateUpdate(ctx context.Context, id uuid.UUID, input PublisherUpdateInput) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) ValidateDelete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) BeforeCreate(context.Context, *ent.PublisherCreate) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) BeforeUpdate(context.Context, *ent.Publisher, *ent.PublisherUpdateOne) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) BeforeDelete(context.Context, *ent.Publisher) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) AfterCreate(context.Context, *ent.Publisher) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) AfterUpdate(context.Context, *ent.Publisher, *ent.Publisher) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) AfterDelete(context.Context, *ent.Publisher) error {
+	return nil
+}
+
+func (DefaultPublisherAdmin) CanRead(ctx context.Context, _ *ent.Publisher) (bool, error) {
+	return defaultCan(ctx, "read_publisher")
+}
+
+func (DefaultPublisherAdmin) CanCreate(ctx context.Context) (bool, error) {
+	return defaultCan(ctx, "create_publisher")
+}
+
+func (DefaultPublisherAdmin) CanUpdate(ctx context.Context, e *ent.Publisher) (bool, error) {
+	ok, err := defaultCan(ctx, "update_publisher")
+	if err != nil || !ok {
+		return ok, err
+	}
+	return true, nil
+}
+
+func (DefaultPublisherAdmin) CanDelete(ctx context.Context, e *ent.Publisher) (bool, error) {
+	ok, err := defaultCan(ctx, "delete_publisher")
+	if err != nil || !ok {
+		return ok, err
+	}
+	return true, nil
+}
+
 // ReviewAdmin is the customizable admin surface for Review.
 // Embed DefaultReviewAdmin and override only the methods you need.
 //
 // Field* methods supply field implementations. EagerLoadQuery controls which
 // edges are loaded for lists, detail pages, and FK option labels. Validate*
-// methods own mutation policy. CanRead/CanUpdate/CanDelete take the target
-// entity. CanCreate and schema CRUD permissions (read_/create_/...) own
-// schema-level access for routes, menu visibility, and create.
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
 type ReviewAdmin interface {
 	FieldUser() ReviewField
 	FieldRating() ReviewField
@@ -465,6 +1205,57 @@ type ReviewAdmin interface {
 	CanCreate(ctx context.Context) (bool, error)
 	CanUpdate(ctx context.Context, e *ent.Review) (bool, error)
 	CanDelete(ctx context.Context, e *ent.Review) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.ReviewCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.Review, builder *ent.ReviewUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.Review) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.Review) error
+	AfterUpdate(ctx context.Context, prev, e *ent.Review) error
+	AfterDelete(ctx context.Context, e *ent.Review) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []ReviewAction
+}
+
+// ReviewAction is a custom action on Review entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type ReviewAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.Review) (bool, error)
+	Run func(ctx context.Context, e *ent.Review) (vent.ActionResult, error)
+}
+
+func validateReviewActions(actions []ReviewAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("ReviewAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("ReviewAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("ReviewAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
 }
 
 // DefaultReviewAdmin is the generated default Review admin surface.
@@ -505,6 +1296,10 @@ func (a DefaultReviewAdmin) FieldBook() ReviewField {
 	return NewReviewBookField(a.Client)
 }
 
+func (DefaultReviewAdmin) Actions() []ReviewAction {
+	return nil
+}
+
 func (DefaultReviewAdmin) ValidateCreate(context.Context, ReviewCreateInput) error {
 	return nil
 }
@@ -517,6 +1312,30 @@ func (DefaultReviewAdmin) ValidateDelete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (DefaultReviewAdmin) BeforeCreate(context.Context, *ent.ReviewCreate) error {
+	return nil
+}
+
+func (DefaultReviewAdmin) BeforeUpdate(context.Context, *ent.Review, *ent.ReviewUpdateOne) error {
+	return nil
+}
+
+func (DefaultReviewAdmin) BeforeDelete(context.Context, *ent.Review) error {
+	return nil
+}
+
+func (DefaultReviewAdmin) AfterCreate(context.Context, *ent.Review) error {
+	return nil
+}
+
+func (DefaultReviewAdmin) AfterUpdate(context.Context, *ent.Review, *ent.Review) error {
+	return nil
+}
+
+func (DefaultReviewAdmin) AfterDelete(context.Context, *ent.Review) error {
+	return nil
+}
+
 func (DefaultReviewAdmin) CanRead(ctx context.Context, _ *ent.Review) (bool, error) {
 	return defaultCan(ctx, "read_review")
 }
@@ -542,18 +1361,20 @@ func (DefaultReviewAdmin) CanDelete(ctx context.Context, e *ent.Review) (bool, e
 //
 // Field* methods supply field implementations. EagerLoadQuery controls which
 // edges are loaded for lists, detail pages, and FK option labels. Validate*
-// methods own mutation policy. CanRead/CanUpdate/CanDelete take the target
-// entity. CanCreate and schema CRUD permissions (read_/create_/...) own
-// schema-level access for routes, menu visibility, and create.
+// methods own mutation policy; ValidateCreate and ValidateUpdate run in the
+// save's transaction (see ent.TxFromContext). CanRead/CanUpdate/CanDelete
+// take the target entity. CanCreate and schema CRUD permissions
+// (read_/create_/...) own schema-level access for routes, menu visibility,
+// and create. Before* and After* hooks surround the admin's own mutations.
 type UserAdmin interface {
 	FieldID() UserField
 	FieldEmail() UserField
 	FieldPassword() UserField
+	FieldLastLogin() UserField
 	FieldIsStaff() UserField
 	FieldIsSuperuser() UserField
 	FieldIsActive() UserField
 	FieldGroups() UserField
-	FieldLastLogin() UserField
 	Name(e *ent.User) string
 	EagerLoadQuery(q *ent.UserQuery) *ent.UserQuery
 	ValidateCreate(ctx context.Context, input UserCreateInput) error
@@ -563,6 +1384,57 @@ type UserAdmin interface {
 	CanCreate(ctx context.Context) (bool, error)
 	CanUpdate(ctx context.Context, e *ent.User) (bool, error)
 	CanDelete(ctx context.Context, e *ent.User) (bool, error)
+	// Before* hooks run after validation, just before the save, and may set
+	// more values on the builder the form was applied to; an error aborts
+	// the mutation and is shown to the user. Update hooks also get prev, the
+	// entity as it was before the change.
+	BeforeCreate(ctx context.Context, builder *ent.UserCreate) error
+	BeforeUpdate(ctx context.Context, prev *ent.User, builder *ent.UserUpdateOne) error
+	BeforeDelete(ctx context.Context, e *ent.User) error
+	// After* hooks run once the change is saved, with the saved entity (or,
+	// for deletes, the deleted one), e.g. to send notifications or enqueue
+	// jobs. The change stands, so their errors are only logged.
+	AfterCreate(ctx context.Context, e *ent.User) error
+	AfterUpdate(ctx context.Context, prev, e *ent.User) error
+	AfterDelete(ctx context.Context, e *ent.User) error
+	// Actions lists the schema's custom actions. Each is offered as a button
+	// on the change page, in the list row menu, and as a bulk action after
+	// the built-in delete.
+	Actions() []UserAction
+}
+
+// UserAction is a custom action on User entities. Bulk runs call
+// Run once per row; rows that fail are reported without undoing the others.
+type UserAction struct {
+	// Name identifies the action in URLs. It must be unique per schema and
+	// may not be "delete".
+	Name  string
+	Label string
+	// Confirm, when set, is asked before the action runs.
+	Confirm string
+	// Permission is required to see and run the action. It must be declared
+	// in a schema's VentSchemaAnnotation.Permissions (or be a generated CRUD
+	// permission).
+	Permission string
+	// Can reports whether the action may run on e. Nil uses CanUpdate.
+	Can func(ctx context.Context, e *ent.User) (bool, error)
+	Run func(ctx context.Context, e *ent.User) (vent.ActionResult, error)
+}
+
+func validateUserActions(actions []UserAction) error {
+	seen := map[string]bool{vent.BulkActionDelete: true}
+	for _, action := range actions {
+		switch {
+		case action.Name == "" || action.Run == nil:
+			return fmt.Errorf("UserAdmin.Actions(): every action needs a Name and Run")
+		case seen[action.Name]:
+			return fmt.Errorf("UserAdmin.Actions(): action name %q is already used", action.Name)
+		case !isDeclaredPermission(action.Permission):
+			return fmt.Errorf("UserAdmin.Actions(): action %q needs a Permission declared in VentSchemaAnnotation.Permissions, got %q", action.Name, action.Permission)
+		}
+		seen[action.Name] = true
+	}
+	return nil
 }
 
 // DefaultUserAdmin is the generated default User admin surface.
@@ -598,6 +1470,10 @@ func (a DefaultUserAdmin) FieldPassword() UserField {
 	return NewUserPasswordField(a.Client)
 }
 
+func (a DefaultUserAdmin) FieldLastLogin() UserField {
+	return NewUserLastLoginField(a.Client)
+}
+
 func (a DefaultUserAdmin) FieldIsStaff() UserField {
 	return NewUserIsStaffField(a.Client)
 }
@@ -614,8 +1490,8 @@ func (a DefaultUserAdmin) FieldGroups() UserField {
 	return NewUserGroupsField(a.Client)
 }
 
-func (a DefaultUserAdmin) FieldLastLogin() UserField {
-	return NewUserLastLoginField(a.Client)
+func (DefaultUserAdmin) Actions() []UserAction {
+	return nil
 }
 
 func (DefaultUserAdmin) ValidateCreate(context.Context, UserCreateInput) error {
@@ -646,6 +1522,30 @@ func (DefaultUserAdmin) ValidateDelete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (DefaultUserAdmin) BeforeCreate(context.Context, *ent.UserCreate) error {
+	return nil
+}
+
+func (DefaultUserAdmin) BeforeUpdate(context.Context, *ent.User, *ent.UserUpdateOne) error {
+	return nil
+}
+
+func (DefaultUserAdmin) BeforeDelete(context.Context, *ent.User) error {
+	return nil
+}
+
+func (DefaultUserAdmin) AfterCreate(context.Context, *ent.User) error {
+	return nil
+}
+
+func (DefaultUserAdmin) AfterUpdate(context.Context, *ent.User, *ent.User) error {
+	return nil
+}
+
+func (DefaultUserAdmin) AfterDelete(context.Context, *ent.User) error {
+	return nil
+}
+
 func (DefaultUserAdmin) CanRead(ctx context.Context, _ *ent.User) (bool, error) {
 	return defaultCan(ctx, "read_user")
 }
@@ -685,6 +1585,17 @@ func defaultCan(ctx context.Context, permission string) (bool, error) {
 	return UserHasPermission(ctx, user, permission)
 }
 
+// isDeclaredPermission reports whether name is a generated or annotated
+// permission, so actions cannot be bound to a permission no group can hold.
+func isDeclaredPermission(name string) bool {
+	for _, permission := range permissions {
+		if permission.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func denyIfCannot(ok bool, err error) error {
 	if err != nil {
 		return err
@@ -3,14 +3,17 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 )
 
 // Book is the model entity for the Book schema.
@@ -20,21 +23,36 @@ type Book struct {
 	ID int `json:"id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
 	// Pages holds the value of the "pages" field.
 	Pages int `json:"pages,omitempty"`
 	// Published holds the value of the "published" field.
 	Published bool `json:"published,omitempty"`
+	// Format holds the value of the "format" field.
+	Format book.Format `json:"format,omitempty"`
 	// PublishedAt holds the value of the "published_at" field.
 	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Editions holds the value of the "editions" field.
+	Editions []int `json:"editions,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Cover holds the value of the "cover" field.
+	Cover string `json:"cover,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// InternalNotes holds the value of the "internal_notes" field.
 	InternalNotes *string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BookQuery when eager-loading is set.
-	Edges        BookEdges `json:"edges"`
-	book_author  *int
-	selectValues sql.SelectValues
+	Edges           BookEdges `json:"edges"`
+	book_author     *int
+	publisher_books *uuid.UUID
+	selectValues    sql.SelectValues
 }
 
 // BookEdges holds the relations/edges for other nodes in the graph.
@@ -43,9 +61,11 @@ type BookEdges struct {
 	Author *Author `json:"author,omitempty"`
 	// Reviews holds the value of the reviews edge.
 	Reviews []*Review `json:"reviews,omitempty"`
+	// Publisher holds the value of the publisher edge.
+	Publisher *Publisher `json:"publisher,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
@@ -68,21 +88,36 @@ func (e BookEdges) ReviewsOrErr() ([]*Review, error) {
 	return nil, &NotLoadedError{edge: "reviews"}
 }
 
+// PublisherOrErr returns the Publisher value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookEdges) PublisherOrErr() (*Publisher, error) {
+	if e.Publisher != nil {
+		return e.Publisher, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: publisher.Label}
+	}
+	return nil, &NotLoadedError{edge: "publisher"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Book) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case book.FieldTags, book.FieldEditions, book.FieldMetadata:
+			values[i] = new([]byte)
 		case book.FieldPublished:
 			values[i] = new(sql.NullBool)
-		case book.FieldID, book.FieldPages:
+		case book.FieldID, book.FieldPages, book.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case book.FieldTitle, book.FieldInternalNotes:
+		case book.FieldTitle, book.FieldSlug, book.FieldFormat, book.FieldCover, book.FieldInternalNotes:
 			values[i] = new(sql.NullString)
 		case book.FieldPublishedAt, book.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case book.ForeignKeys[0]: // book_author
 			values[i] = new(sql.NullInt64)
+		case book.ForeignKeys[1]: // publisher_books
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -110,6 +145,12 @@ func (_m *Book) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case book.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
 		case book.FieldPages:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field pages", values[i])
@@ -122,6 +163,12 @@ func (_m *Book) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Published = value.Bool
 			}
+		case book.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = book.Format(value.String)
+			}
 		case book.FieldPublishedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field published_at", values[i])
@@ -129,12 +176,48 @@ func (_m *Book) assignValues(columns []string, values []any) error {
 				_m.PublishedAt = new(time.Time)
 				*_m.PublishedAt = value.Time
 			}
+		case book.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case book.FieldEditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field editions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Editions); err != nil {
+					return fmt.Errorf("unmarshal field editions: %w", err)
+				}
+			}
+		case book.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case book.FieldCover:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cover", values[i])
+			} else if value.Valid {
+				_m.Cover = value.String
+			}
 		case book.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case book.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case book.FieldInternalNotes:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field internal_notes", values[i])
@@ -149,6 +232,13 @@ func (_m *Book) assignValues(columns []string, values []any) error {
 				_m.book_author = new(int)
 				*_m.book_author = int(value.Int64)
 			}
+		case book.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher_books", values[i])
+			} else if value.Valid {
+				_m.publisher_books = new(uuid.UUID)
+				*_m.publisher_books = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -172,6 +262,11 @@ func (_m *Book) QueryReviews() *ReviewQuery {
 	return NewBookClient(_m.config).QueryReviews(_m)
 }
 
+// QueryPublisher queries the "publisher" edge of the Book entity.
+func (_m *Book) QueryPublisher() *PublisherQuery {
+	return NewBookClient(_m.config).QueryPublisher(_m)
+}
+
 // Update returns a builder for updating this Book.
 // Note that you need to call Book.Unwrap() before calling this method if this Book
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,20 +293,41 @@ func (_m *Book) String() string {
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
 	builder.WriteString("pages=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Pages))
 	builder.WriteString(", ")
 	builder.WriteString("published=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Published))
 	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
 	if v := _m.PublishedAt; v != nil {
 		builder.WriteString("published_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("editions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Editions))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("cover=")
+	builder.WriteString(_m.Cover)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("internal_notes=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
@@ -3,6 +3,7 @@
 package book
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -16,20 +17,36 @@ const (
 	FieldID = "id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
 	// FieldPages holds the string denoting the pages field in the database.
 	FieldPages = "pages"
 	// FieldPublished holds the string denoting the published field in the database.
 	FieldPublished = "published"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
 	// FieldPublishedAt holds the string denoting the published_at field in the database.
 	FieldPublishedAt = "published_at"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldEditions holds the string denoting the editions field in the database.
+	FieldEditions = "editions"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCover holds the string denoting the cover field in the database.
+	FieldCover = "cover"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldInternalNotes holds the string denoting the internal_notes field in the database.
 	FieldInternalNotes = "internal_notes"
 	// EdgeAuthor holds the string denoting the author edge name in mutations.
 	EdgeAuthor = "author"
 	// EdgeReviews holds the string denoting the reviews edge name in mutations.
 	EdgeReviews = "reviews"
+	// EdgePublisher holds the string denoting the publisher edge name in mutations.
+	EdgePublisher = "publisher"
 	// Table holds the table name of the book in the database.
 	Table = "books"
 	// AuthorTable is the table that holds the author relation/edge.
@@ -46,16 +63,30 @@ const (
 	ReviewsInverseTable = "reviews"
 	// ReviewsColumn is the table column denoting the reviews relation/edge.
 	ReviewsColumn = "book_reviews"
+	// PublisherTable is the table that holds the publisher relation/edge.
+	PublisherTable = "books"
+	// PublisherInverseTable is the table name for the Publisher entity.
+	// It exists in this package in order to avoid circular dependency with the "publisher" package.
+	PublisherInverseTable = "publishers"
+	// PublisherColumn is the table column denoting the publisher relation/edge.
+	PublisherColumn = "publisher_books"
 )
 
 // Columns holds all SQL columns for book fields.
 var Columns = []string{
 	FieldID,
 	FieldTitle,
+	FieldSlug,
 	FieldPages,
 	FieldPublished,
+	FieldFormat,
 	FieldPublishedAt,
+	FieldTags,
+	FieldEditions,
+	FieldMetadata,
+	FieldCover,
 	FieldCreatedAt,
+	FieldVersion,
 	FieldInternalNotes,
 }
 
@@ -63,6 +94,7 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"book_author",
+	"publisher_books",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -91,8 +123,38 @@ var (
 	DefaultPublished bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
+// Format defines the type for the "format" enum field.
+type Format string
+
+// FormatPaperback is the default value of the Format enum.
+const DefaultFormat = FormatPaperback
+
+// Format values.
+const (
+	FormatHardcover Format = "hardcover"
+	FormatPaperback Format = "paperback"
+	FormatEbook     Format = "ebook"
+	FormatAudiobook Format = "audiobook"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatHardcover, FormatPaperback, FormatEbook, FormatAudiobook:
+		return nil
+	default:
+		return fmt.Errorf("book: invalid enum value for format field: %q", f)
+	}
+}
+
 // OrderOption defines the ordering options for the Book queries.
 type OrderOption func(*sql.Selector)
 
@@ -106,6 +168,11 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
 // ByPages orders the results by the pages field.
 func ByPages(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPages, opts...).ToFunc()
@@ -116,16 +183,31 @@ func ByPublished(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublished, opts...).ToFunc()
 }
 
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
 // ByPublishedAt orders the results by the published_at field.
 func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
 }
 
+// ByCover orders the results by the cover field.
+func ByCover(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCover, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByInternalNotes orders the results by the internal_notes field.
 func ByInternalNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInternalNotes, opts...).ToFunc()
@@ -151,6 +233,13 @@ func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPublisherField orders the results by publisher field.
+func ByPublisherField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPublisherStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newAuthorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -165,3 +254,10 @@ func newReviewsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
 	)
 }
+func newPublisherStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PublisherInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PublisherTable, PublisherColumn),
+	)
+}
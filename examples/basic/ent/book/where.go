@@ -60,6 +60,11 @@ func Title(v string) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldTitle, v))
 }
 
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldSlug, v))
+}
+
 // Pages applies equality check predicate on the "pages" field. It's identical to PagesEQ.
 func Pages(v int) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldPages, v))
@@ -75,11 +80,21 @@ func PublishedAt(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldPublishedAt, v))
 }
 
+// Cover applies equality check predicate on the "cover" field. It's identical to CoverEQ.
+func Cover(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCover, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldVersion, v))
+}
+
 // InternalNotes applies equality check predicate on the "internal_notes" field. It's identical to InternalNotesEQ.
 func InternalNotes(v string) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldInternalNotes, v))
@@ -150,6 +165,81 @@ func TitleContainsFold(v string) predicate.Book {
 	return predicate.Book(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugIsNil applies the IsNil predicate on the "slug" field.
+func SlugIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldSlug))
+}
+
+// SlugNotNil applies the NotNil predicate on the "slug" field.
+func SlugNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldSlug))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldSlug, v))
+}
+
 // PagesEQ applies the EQ predicate on the "pages" field.
 func PagesEQ(v int) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldPages, v))
@@ -200,6 +290,26 @@ func PublishedNEQ(v bool) predicate.Book {
 	return predicate.Book(sql.FieldNEQ(FieldPublished, v))
 }
 
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldFormat, vs...))
+}
+
 // PublishedAtEQ applies the EQ predicate on the "published_at" field.
 func PublishedAtEQ(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldPublishedAt, v))
@@ -250,6 +360,111 @@ func PublishedAtNotNil() predicate.Book {
 	return predicate.Book(sql.FieldNotNull(FieldPublishedAt))
 }
 
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldTags))
+}
+
+// EditionsIsNil applies the IsNil predicate on the "editions" field.
+func EditionsIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldEditions))
+}
+
+// EditionsNotNil applies the NotNil predicate on the "editions" field.
+func EditionsNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldEditions))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldMetadata))
+}
+
+// CoverEQ applies the EQ predicate on the "cover" field.
+func CoverEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCover, v))
+}
+
+// CoverNEQ applies the NEQ predicate on the "cover" field.
+func CoverNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldCover, v))
+}
+
+// CoverIn applies the In predicate on the "cover" field.
+func CoverIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldCover, vs...))
+}
+
+// CoverNotIn applies the NotIn predicate on the "cover" field.
+func CoverNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldCover, vs...))
+}
+
+// CoverGT applies the GT predicate on the "cover" field.
+func CoverGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldCover, v))
+}
+
+// CoverGTE applies the GTE predicate on the "cover" field.
+func CoverGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldCover, v))
+}
+
+// CoverLT applies the LT predicate on the "cover" field.
+func CoverLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldCover, v))
+}
+
+// CoverLTE applies the LTE predicate on the "cover" field.
+func CoverLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldCover, v))
+}
+
+// CoverContains applies the Contains predicate on the "cover" field.
+func CoverContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldCover, v))
+}
+
+// CoverHasPrefix applies the HasPrefix predicate on the "cover" field.
+func CoverHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldCover, v))
+}
+
+// CoverHasSuffix applies the HasSuffix predicate on the "cover" field.
+func CoverHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldCover, v))
+}
+
+// CoverIsNil applies the IsNil predicate on the "cover" field.
+func CoverIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldCover))
+}
+
+// CoverNotNil applies the NotNil predicate on the "cover" field.
+func CoverNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldCover))
+}
+
+// CoverEqualFold applies the EqualFold predicate on the "cover" field.
+func CoverEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldCover, v))
+}
+
+// CoverContainsFold applies the ContainsFold predicate on the "cover" field.
+func CoverContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldCover, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldCreatedAt, v))
@@ -290,6 +505,46 @@ func CreatedAtLTE(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldVersion, v))
+}
+
 // InternalNotesEQ applies the EQ predicate on the "internal_notes" field.
 func InternalNotesEQ(v string) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldInternalNotes, v))
@@ -411,6 +666,29 @@ func HasReviewsWith(preds ...predicate.Review) predicate.Book {
 	})
 }
 
+// HasPublisher applies the HasEdge predicate on the "publisher" edge.
+func HasPublisher() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PublisherTable, PublisherColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPublisherWith applies the HasEdge predicate on the "publisher" edge with a given conditions (other predicates).
+func HasPublisherWith(preds ...predicate.Publisher) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newPublisherStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Book) predicate.Book {
 	return predicate.Book(sql.AndPredicates(predicates...))
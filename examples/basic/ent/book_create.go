@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
 )
 
@@ -30,6 +32,20 @@ func (_c *BookCreate) SetTitle(v string) *BookCreate {
 	return _c
 }
 
+// SetSlug sets the "slug" field.
+func (_c *BookCreate) SetSlug(v string) *BookCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_c *BookCreate) SetNillableSlug(v *string) *BookCreate {
+	if v != nil {
+		_c.SetSlug(*v)
+	}
+	return _c
+}
+
 // SetPages sets the "pages" field.
 func (_c *BookCreate) SetPages(v int) *BookCreate {
 	_c.mutation.SetPages(v)
@@ -58,6 +74,20 @@ func (_c *BookCreate) SetNillablePublished(v *bool) *BookCreate {
 	return _c
 }
 
+// SetFormat sets the "format" field.
+func (_c *BookCreate) SetFormat(v book.Format) *BookCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *BookCreate) SetNillableFormat(v *book.Format) *BookCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (_c *BookCreate) SetPublishedAt(v time.Time) *BookCreate {
 	_c.mutation.SetPublishedAt(v)
@@ -72,6 +102,38 @@ func (_c *BookCreate) SetNillablePublishedAt(v *time.Time) *BookCreate {
 	return _c
 }
 
+// SetTags sets the "tags" field.
+func (_c *BookCreate) SetTags(v []string) *BookCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetEditions sets the "editions" field.
+func (_c *BookCreate) SetEditions(v []int) *BookCreate {
+	_c.mutation.SetEditions(v)
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *BookCreate) SetMetadata(v map[string]interface{}) *BookCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCover sets the "cover" field.
+func (_c *BookCreate) SetCover(v string) *BookCreate {
+	_c.mutation.SetCover(v)
+	return _c
+}
+
+// SetNillableCover sets the "cover" field if the given value is not nil.
+func (_c *BookCreate) SetNillableCover(v *string) *BookCreate {
+	if v != nil {
+		_c.SetCover(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *BookCreate) SetCreatedAt(v time.Time) *BookCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -86,6 +148,20 @@ func (_c *BookCreate) SetNillableCreatedAt(v *time.Time) *BookCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *BookCreate) SetVersion(v int) *BookCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *BookCreate) SetNillableVersion(v *int) *BookCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetInternalNotes sets the "internal_notes" field.
 func (_c *BookCreate) SetInternalNotes(v string) *BookCreate {
 	_c.mutation.SetInternalNotes(v)
@@ -126,6 +202,25 @@ func (_c *BookCreate) AddReviews(v ...*Review) *BookCreate {
 	return _c.AddReviewIDs(ids...)
 }
 
+// SetPublisherID sets the "publisher" edge to the Publisher entity by ID.
+func (_c *BookCreate) SetPublisherID(id uuid.UUID) *BookCreate {
+	_c.mutation.SetPublisherID(id)
+	return _c
+}
+
+// SetNillablePublisherID sets the "publisher" edge to the Publisher entity by ID if the given value is not nil.
+func (_c *BookCreate) SetNillablePublisherID(id *uuid.UUID) *BookCreate {
+	if id != nil {
+		_c = _c.SetPublisherID(*id)
+	}
+	return _c
+}
+
+// SetPublisher sets the "publisher" edge to the Publisher entity.
+func (_c *BookCreate) SetPublisher(v *Publisher) *BookCreate {
+	return _c.SetPublisherID(v.ID)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (_c *BookCreate) Mutation() *BookMutation {
 	return _c.mutation
@@ -169,10 +264,18 @@ func (_c *BookCreate) defaults() {
 		v := book.DefaultPublished
 		_c.mutation.SetPublished(v)
 	}
+	if _, ok := _c.mutation.Format(); !ok {
+		v := book.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := book.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := book.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -196,9 +299,20 @@ func (_c *BookCreate) check() error {
 	if _, ok := _c.mutation.Published(); !ok {
 		return &ValidationError{Name: "published", err: errors.New(`ent: missing required field "Book.published"`)}
 	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Book.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := book.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Book.format": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Book.created_at"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Book.version"`)}
+	}
 	if len(_c.mutation.AuthorIDs()) == 0 {
 		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "Book.author"`)}
 	}
@@ -233,6 +347,10 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_spec.SetField(book.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(book.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
 	if value, ok := _c.mutation.Pages(); ok {
 		_spec.SetField(book.FieldPages, field.TypeInt, value)
 		_node.Pages = value
@@ -241,14 +359,38 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_spec.SetField(book.FieldPublished, field.TypeBool, value)
 		_node.Published = value
 	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(book.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
 	if value, ok := _c.mutation.PublishedAt(); ok {
 		_spec.SetField(book.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = &value
 	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(book.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Editions(); ok {
+		_spec.SetField(book.FieldEditions, field.TypeJSON, value)
+		_node.Editions = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(book.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.Cover(); ok {
+		_spec.SetField(book.FieldCover, field.TypeString, value)
+		_node.Cover = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(book.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.InternalNotes(); ok {
 		_spec.SetField(book.FieldInternalNotes, field.TypeString, value)
 		_node.InternalNotes = &value
@@ -286,6 +428,23 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PublisherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.PublisherTable,
+			Columns: []string{book.PublisherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.publisher_books = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -350,6 +509,24 @@ func (u *BookUpsert) UpdateTitle() *BookUpsert {
 	return u
 }
 
+// SetSlug sets the "slug" field.
+func (u *BookUpsert) SetSlug(v string) *BookUpsert {
+	u.Set(book.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *BookUpsert) UpdateSlug() *BookUpsert {
+	u.SetExcluded(book.FieldSlug)
+	return u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *BookUpsert) ClearSlug() *BookUpsert {
+	u.SetNull(book.FieldSlug)
+	return u
+}
+
 // SetPages sets the "pages" field.
 func (u *BookUpsert) SetPages(v int) *BookUpsert {
 	u.Set(book.FieldPages, v)
@@ -380,6 +557,18 @@ func (u *BookUpsert) UpdatePublished() *BookUpsert {
 	return u
 }
 
+// SetFormat sets the "format" field.
+func (u *BookUpsert) SetFormat(v book.Format) *BookUpsert {
+	u.Set(book.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *BookUpsert) UpdateFormat() *BookUpsert {
+	u.SetExcluded(book.FieldFormat)
+	return u
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (u *BookUpsert) SetPublishedAt(v time.Time) *BookUpsert {
 	u.Set(book.FieldPublishedAt, v)
@@ -398,6 +587,96 @@ func (u *BookUpsert) ClearPublishedAt() *BookUpsert {
 	return u
 }
 
+// SetTags sets the "tags" field.
+func (u *BookUpsert) SetTags(v []string) *BookUpsert {
+	u.Set(book.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *BookUpsert) UpdateTags() *BookUpsert {
+	u.SetExcluded(book.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *BookUpsert) ClearTags() *BookUpsert {
+	u.SetNull(book.FieldTags)
+	return u
+}
+
+// SetEditions sets the "editions" field.
+func (u *BookUpsert) SetEditions(v []int) *BookUpsert {
+	u.Set(book.FieldEditions, v)
+	return u
+}
+
+// UpdateEditions sets the "editions" field to the value that was provided on create.
+func (u *BookUpsert) UpdateEditions() *BookUpsert {
+	u.SetExcluded(book.FieldEditions)
+	return u
+}
+
+// ClearEditions clears the value of the "editions" field.
+func (u *BookUpsert) ClearEditions() *BookUpsert {
+	u.SetNull(book.FieldEditions)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BookUpsert) SetMetadata(v map[string]interface{}) *BookUpsert {
+	u.Set(book.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BookUpsert) UpdateMetadata() *BookUpsert {
+	u.SetExcluded(book.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *BookUpsert) ClearMetadata() *BookUpsert {
+	u.SetNull(book.FieldMetadata)
+	return u
+}
+
+// SetCover sets the "cover" field.
+func (u *BookUpsert) SetCover(v string) *BookUpsert {
+	u.Set(book.FieldCover, v)
+	return u
+}
+
+// UpdateCover sets the "cover" field to the value that was provided on create.
+func (u *BookUpsert) UpdateCover() *BookUpsert {
+	u.SetExcluded(book.FieldCover)
+	return u
+}
+
+// ClearCover clears the value of the "cover" field.
+func (u *BookUpsert) ClearCover() *BookUpsert {
+	u.SetNull(book.FieldCover)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *BookUpsert) SetVersion(v int) *BookUpsert {
+	u.Set(book.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *BookUpsert) UpdateVersion() *BookUpsert {
+	u.SetExcluded(book.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *BookUpsert) AddVersion(v int) *BookUpsert {
+	u.Add(book.FieldVersion, v)
+	return u
+}
+
 // SetInternalNotes sets the "internal_notes" field.
 func (u *BookUpsert) SetInternalNotes(v string) *BookUpsert {
 	u.Set(book.FieldInternalNotes, v)
@@ -475,6 +754,27 @@ func (u *BookUpsertOne) UpdateTitle() *BookUpsertOne {
 	})
 }
 
+// SetSlug sets the "slug" field.
+func (u *BookUpsertOne) SetSlug(v string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateSlug() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *BookUpsertOne) ClearSlug() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearSlug()
+	})
+}
+
 // SetPages sets the "pages" field.
 func (u *BookUpsertOne) SetPages(v int) *BookUpsertOne {
 	return u.Update(func(s *BookUpsert) {
@@ -510,6 +810,20 @@ func (u *BookUpsertOne) UpdatePublished() *BookUpsertOne {
 	})
 }
 
+// SetFormat sets the "format" field.
+func (u *BookUpsertOne) SetFormat(v book.Format) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateFormat() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateFormat()
+	})
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (u *BookUpsertOne) SetPublishedAt(v time.Time) *BookUpsertOne {
 	return u.Update(func(s *BookUpsert) {
@@ -531,6 +845,111 @@ func (u *BookUpsertOne) ClearPublishedAt() *BookUpsertOne {
 	})
 }
 
+// SetTags sets the "tags" field.
+func (u *BookUpsertOne) SetTags(v []string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateTags() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *BookUpsertOne) ClearTags() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetEditions sets the "editions" field.
+func (u *BookUpsertOne) SetEditions(v []int) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetEditions(v)
+	})
+}
+
+// UpdateEditions sets the "editions" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateEditions() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateEditions()
+	})
+}
+
+// ClearEditions clears the value of the "editions" field.
+func (u *BookUpsertOne) ClearEditions() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearEditions()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BookUpsertOne) SetMetadata(v map[string]interface{}) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateMetadata() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *BookUpsertOne) ClearMetadata() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetCover sets the "cover" field.
+func (u *BookUpsertOne) SetCover(v string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetCover(v)
+	})
+}
+
+// UpdateCover sets the "cover" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateCover() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateCover()
+	})
+}
+
+// ClearCover clears the value of the "cover" field.
+func (u *BookUpsertOne) ClearCover() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearCover()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *BookUpsertOne) SetVersion(v int) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *BookUpsertOne) AddVersion(v int) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateVersion() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // SetInternalNotes sets the "internal_notes" field.
 func (u *BookUpsertOne) SetInternalNotes(v string) *BookUpsertOne {
 	return u.Update(func(s *BookUpsert) {
@@ -777,6 +1196,27 @@ func (u *BookUpsertBulk) UpdateTitle() *BookUpsertBulk {
 	})
 }
 
+// SetSlug sets the "slug" field.
+func (u *BookUpsertBulk) SetSlug(v string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateSlug() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *BookUpsertBulk) ClearSlug() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearSlug()
+	})
+}
+
 // SetPages sets the "pages" field.
 func (u *BookUpsertBulk) SetPages(v int) *BookUpsertBulk {
 	return u.Update(func(s *BookUpsert) {
@@ -812,6 +1252,20 @@ func (u *BookUpsertBulk) UpdatePublished() *BookUpsertBulk {
 	})
 }
 
+// SetFormat sets the "format" field.
+func (u *BookUpsertBulk) SetFormat(v book.Format) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateFormat() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateFormat()
+	})
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (u *BookUpsertBulk) SetPublishedAt(v time.Time) *BookUpsertBulk {
 	return u.Update(func(s *BookUpsert) {
@@ -833,6 +1287,111 @@ func (u *BookUpsertBulk) ClearPublishedAt() *BookUpsertBulk {
 	})
 }
 
+// SetTags sets the "tags" field.
+func (u *BookUpsertBulk) SetTags(v []string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateTags() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *BookUpsertBulk) ClearTags() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetEditions sets the "editions" field.
+func (u *BookUpsertBulk) SetEditions(v []int) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetEditions(v)
+	})
+}
+
+// UpdateEditions sets the "editions" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateEditions() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateEditions()
+	})
+}
+
+// ClearEditions clears the value of the "editions" field.
+func (u *BookUpsertBulk) ClearEditions() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearEditions()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BookUpsertBulk) SetMetadata(v map[string]interface{}) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateMetadata() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *BookUpsertBulk) ClearMetadata() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetCover sets the "cover" field.
+func (u *BookUpsertBulk) SetCover(v string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetCover(v)
+	})
+}
+
+// UpdateCover sets the "cover" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateCover() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateCover()
+	})
+}
+
+// ClearCover clears the value of the "cover" field.
+func (u *BookUpsertBulk) ClearCover() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.ClearCover()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *BookUpsertBulk) SetVersion(v int) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *BookUpsertBulk) AddVersion(v int) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateVersion() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // SetInternalNotes sets the "internal_notes" field.
 func (u *BookUpsertBulk) SetInternalNotes(v string) *BookUpsertBulk {
 	return u.Update(func(s *BookUpsert) {
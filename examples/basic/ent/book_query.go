@@ -12,22 +12,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
 	"github.com/troygilman/vent/examples/basic/ent/predicate"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
 )
 
 // BookQuery is the builder for querying Book entities.
 type BookQuery struct {
 	config
-	ctx         *QueryContext
-	order       []book.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Book
-	withAuthor  *AuthorQuery
-	withReviews *ReviewQuery
-	withFKs     bool
+	ctx           *QueryContext
+	order         []book.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Book
+	withAuthor    *AuthorQuery
+	withReviews   *ReviewQuery
+	withPublisher *PublisherQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +111,28 @@ func (_q *BookQuery) QueryReviews() *ReviewQuery {
 	return query
 }
 
+// QueryPublisher chains the current query on the "publisher" edge.
+func (_q *BookQuery) QueryPublisher() *PublisherQuery {
+	query := (&PublisherClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(publisher.Table, publisher.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, book.PublisherTable, book.PublisherColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Book entity from the query.
 // Returns a *NotFoundError when no Book was found.
 func (_q *BookQuery) First(ctx context.Context) (*Book, error) {
@@ -295,13 +320,14 @@ func (_q *BookQuery) Clone() *BookQuery {
 		return nil
 	}
 	return &BookQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]book.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Book{}, _q.predicates...),
-		withAuthor:  _q.withAuthor.Clone(),
-		withReviews: _q.withReviews.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]book.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Book{}, _q.predicates...),
+		withAuthor:    _q.withAuthor.Clone(),
+		withReviews:   _q.withReviews.Clone(),
+		withPublisher: _q.withPublisher.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +356,17 @@ func (_q *BookQuery) WithReviews(opts ...func(*ReviewQuery)) *BookQuery {
 	return _q
 }
 
+// WithPublisher tells the query-builder to eager-load the nodes that are connected to
+// the "publisher" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithPublisher(opts ...func(*PublisherQuery)) *BookQuery {
+	query := (&PublisherClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPublisher = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +446,13 @@ func (_q *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 		nodes       = []*Book{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withAuthor != nil,
 			_q.withReviews != nil,
+			_q.withPublisher != nil,
 		}
 	)
-	if _q.withAuthor != nil {
+	if _q.withAuthor != nil || _q.withPublisher != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -451,6 +489,12 @@ func (_q *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 			return nil, err
 		}
 	}
+	if query := _q.withPublisher; query != nil {
+		if err := _q.loadPublisher(ctx, query, nodes, nil,
+			func(n *Book, e *Publisher) { n.Edges.Publisher = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +561,38 @@ func (_q *BookQuery) loadReviews(ctx context.Context, query *ReviewQuery, nodes
 	}
 	return nil
 }
+func (_q *BookQuery) loadPublisher(ctx context.Context, query *PublisherQuery, nodes []*Book, init func(*Book), assign func(*Book, *Publisher)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Book)
+	for i := range nodes {
+		if nodes[i].publisher_books == nil {
+			continue
+		}
+		fk := *nodes[i].publisher_books
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(publisher.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "publisher_books" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *BookQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,10 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
 	"github.com/troygilman/vent/examples/basic/ent/predicate"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
 )
 
@@ -44,6 +47,26 @@ func (_u *BookUpdate) SetNillableTitle(v *string) *BookUpdate {
 	return _u
 }
 
+// SetSlug sets the "slug" field.
+func (_u *BookUpdate) SetSlug(v string) *BookUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableSlug(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *BookUpdate) ClearSlug() *BookUpdate {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
 // SetPages sets the "pages" field.
 func (_u *BookUpdate) SetPages(v int) *BookUpdate {
 	_u.mutation.ResetPages()
@@ -79,6 +102,20 @@ func (_u *BookUpdate) SetNillablePublished(v *bool) *BookUpdate {
 	return _u
 }
 
+// SetFormat sets the "format" field.
+func (_u *BookUpdate) SetFormat(v book.Format) *BookUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableFormat(v *book.Format) *BookUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (_u *BookUpdate) SetPublishedAt(v time.Time) *BookUpdate {
 	_u.mutation.SetPublishedAt(v)
@@ -99,6 +136,95 @@ func (_u *BookUpdate) ClearPublishedAt() *BookUpdate {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *BookUpdate) SetTags(v []string) *BookUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *BookUpdate) AppendTags(v []string) *BookUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *BookUpdate) ClearTags() *BookUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetEditions sets the "editions" field.
+func (_u *BookUpdate) SetEditions(v []int) *BookUpdate {
+	_u.mutation.SetEditions(v)
+	return _u
+}
+
+// AppendEditions appends value to the "editions" field.
+func (_u *BookUpdate) AppendEditions(v []int) *BookUpdate {
+	_u.mutation.AppendEditions(v)
+	return _u
+}
+
+// ClearEditions clears the value of the "editions" field.
+func (_u *BookUpdate) ClearEditions() *BookUpdate {
+	_u.mutation.ClearEditions()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BookUpdate) SetMetadata(v map[string]interface{}) *BookUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *BookUpdate) ClearMetadata() *BookUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetCover sets the "cover" field.
+func (_u *BookUpdate) SetCover(v string) *BookUpdate {
+	_u.mutation.SetCover(v)
+	return _u
+}
+
+// SetNillableCover sets the "cover" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableCover(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetCover(*v)
+	}
+	return _u
+}
+
+// ClearCover clears the value of the "cover" field.
+func (_u *BookUpdate) ClearCover() *BookUpdate {
+	_u.mutation.ClearCover()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *BookUpdate) SetVersion(v int) *BookUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableVersion(v *int) *BookUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *BookUpdate) AddVersion(v int) *BookUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetInternalNotes sets the "internal_notes" field.
 func (_u *BookUpdate) SetInternalNotes(v string) *BookUpdate {
 	_u.mutation.SetInternalNotes(v)
@@ -145,6 +271,25 @@ func (_u *BookUpdate) AddReviews(v ...*Review) *BookUpdate {
 	return _u.AddReviewIDs(ids...)
 }
 
+// SetPublisherID sets the "publisher" edge to the Publisher entity by ID.
+func (_u *BookUpdate) SetPublisherID(id uuid.UUID) *BookUpdate {
+	_u.mutation.SetPublisherID(id)
+	return _u
+}
+
+// SetNillablePublisherID sets the "publisher" edge to the Publisher entity by ID if the given value is not nil.
+func (_u *BookUpdate) SetNillablePublisherID(id *uuid.UUID) *BookUpdate {
+	if id != nil {
+		_u = _u.SetPublisherID(*id)
+	}
+	return _u
+}
+
+// SetPublisher sets the "publisher" edge to the Publisher entity.
+func (_u *BookUpdate) SetPublisher(v *Publisher) *BookUpdate {
+	return _u.SetPublisherID(v.ID)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (_u *BookUpdate) Mutation() *BookMutation {
 	return _u.mutation
@@ -177,6 +322,12 @@ func (_u *BookUpdate) RemoveReviews(v ...*Review) *BookUpdate {
 	return _u.RemoveReviewIDs(ids...)
 }
 
+// ClearPublisher clears the "publisher" edge to the Publisher entity.
+func (_u *BookUpdate) ClearPublisher() *BookUpdate {
+	_u.mutation.ClearPublisher()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BookUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -216,6 +367,11 @@ func (_u *BookUpdate) check() error {
 			return &ValidationError{Name: "pages", err: fmt.Errorf(`ent: validator failed for field "Book.pages": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := book.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Book.format": %w`, err)}
+		}
+	}
 	if _u.mutation.AuthorCleared() && len(_u.mutation.AuthorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Book.author"`)
 	}
@@ -237,6 +393,12 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(book.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(book.FieldSlug, field.TypeString, value)
+	}
+	if _u.mutation.SlugCleared() {
+		_spec.ClearField(book.FieldSlug, field.TypeString)
+	}
 	if value, ok := _u.mutation.Pages(); ok {
 		_spec.SetField(book.FieldPages, field.TypeInt, value)
 	}
@@ -246,12 +408,55 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Published(); ok {
 		_spec.SetField(book.FieldPublished, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(book.FieldFormat, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.PublishedAt(); ok {
 		_spec.SetField(book.FieldPublishedAt, field.TypeTime, value)
 	}
 	if _u.mutation.PublishedAtCleared() {
 		_spec.ClearField(book.FieldPublishedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(book.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, book.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(book.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Editions(); ok {
+		_spec.SetField(book.FieldEditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEditions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, book.FieldEditions, value)
+		})
+	}
+	if _u.mutation.EditionsCleared() {
+		_spec.ClearField(book.FieldEditions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(book.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(book.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Cover(); ok {
+		_spec.SetField(book.FieldCover, field.TypeString, value)
+	}
+	if _u.mutation.CoverCleared() {
+		_spec.ClearField(book.FieldCover, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(book.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.InternalNotes(); ok {
 		_spec.SetField(book.FieldInternalNotes, field.TypeString, value)
 	}
@@ -332,6 +537,35 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PublisherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.PublisherTable,
+			Columns: []string{book.PublisherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublisherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.PublisherTable,
+			Columns: []string{book.PublisherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{book.Label}
@@ -366,6 +600,26 @@ func (_u *BookUpdateOne) SetNillableTitle(v *string) *BookUpdateOne {
 	return _u
 }
 
+// SetSlug sets the "slug" field.
+func (_u *BookUpdateOne) SetSlug(v string) *BookUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableSlug(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *BookUpdateOne) ClearSlug() *BookUpdateOne {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
 // SetPages sets the "pages" field.
 func (_u *BookUpdateOne) SetPages(v int) *BookUpdateOne {
 	_u.mutation.ResetPages()
@@ -401,6 +655,20 @@ func (_u *BookUpdateOne) SetNillablePublished(v *bool) *BookUpdateOne {
 	return _u
 }
 
+// SetFormat sets the "format" field.
+func (_u *BookUpdateOne) SetFormat(v book.Format) *BookUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableFormat(v *book.Format) *BookUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (_u *BookUpdateOne) SetPublishedAt(v time.Time) *BookUpdateOne {
 	_u.mutation.SetPublishedAt(v)
@@ -421,6 +689,95 @@ func (_u *BookUpdateOne) ClearPublishedAt() *BookUpdateOne {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *BookUpdateOne) SetTags(v []string) *BookUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *BookUpdateOne) AppendTags(v []string) *BookUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *BookUpdateOne) ClearTags() *BookUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetEditions sets the "editions" field.
+func (_u *BookUpdateOne) SetEditions(v []int) *BookUpdateOne {
+	_u.mutation.SetEditions(v)
+	return _u
+}
+
+// AppendEditions appends value to the "editions" field.
+func (_u *BookUpdateOne) AppendEditions(v []int) *BookUpdateOne {
+	_u.mutation.AppendEditions(v)
+	return _u
+}
+
+// ClearEditions clears the value of the "editions" field.
+func (_u *BookUpdateOne) ClearEditions() *BookUpdateOne {
+	_u.mutation.ClearEditions()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BookUpdateOne) SetMetadata(v map[string]interface{}) *BookUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *BookUpdateOne) ClearMetadata() *BookUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetCover sets the "cover" field.
+func (_u *BookUpdateOne) SetCover(v string) *BookUpdateOne {
+	_u.mutation.SetCover(v)
+	return _u
+}
+
+// SetNillableCover sets the "cover" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableCover(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetCover(*v)
+	}
+	return _u
+}
+
+// ClearCover clears the value of the "cover" field.
+func (_u *BookUpdateOne) ClearCover() *BookUpdateOne {
+	_u.mutation.ClearCover()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *BookUpdateOne) SetVersion(v int) *BookUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableVersion(v *int) *BookUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *BookUpdateOne) AddVersion(v int) *BookUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetInternalNotes sets the "internal_notes" field.
 func (_u *BookUpdateOne) SetInternalNotes(v string) *BookUpdateOne {
 	_u.mutation.SetInternalNotes(v)
@@ -467,6 +824,25 @@ func (_u *BookUpdateOne) AddReviews(v ...*Review) *BookUpdateOne {
 	return _u.AddReviewIDs(ids...)
 }
 
+// SetPublisherID sets the "publisher" edge to the Publisher entity by ID.
+func (_u *BookUpdateOne) SetPublisherID(id uuid.UUID) *BookUpdateOne {
+	_u.mutation.SetPublisherID(id)
+	return _u
+}
+
+// SetNillablePublisherID sets the "publisher" edge to the Publisher entity by ID if the given value is not nil.
+func (_u *BookUpdateOne) SetNillablePublisherID(id *uuid.UUID) *BookUpdateOne {
+	if id != nil {
+		_u = _u.SetPublisherID(*id)
+	}
+	return _u
+}
+
+// SetPublisher sets the "publisher" edge to the Publisher entity.
+func (_u *BookUpdateOne) SetPublisher(v *Publisher) *BookUpdateOne {
+	return _u.SetPublisherID(v.ID)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (_u *BookUpdateOne) Mutation() *BookMutation {
 	return _u.mutation
@@ -499,6 +875,12 @@ func (_u *BookUpdateOne) RemoveReviews(v ...*Review) *BookUpdateOne {
 	return _u.RemoveReviewIDs(ids...)
 }
 
+// ClearPublisher clears the "publisher" edge to the Publisher entity.
+func (_u *BookUpdateOne) ClearPublisher() *BookUpdateOne {
+	_u.mutation.ClearPublisher()
+	return _u
+}
+
 // Where appends a list predicates to the BookUpdate builder.
 func (_u *BookUpdateOne) Where(ps ...predicate.Book) *BookUpdateOne {
 	_u.mutation.Where(ps...)
@@ -551,6 +933,11 @@ func (_u *BookUpdateOne) check() error {
 			return &ValidationError{Name: "pages", err: fmt.Errorf(`ent: validator failed for field "Book.pages": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := book.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Book.format": %w`, err)}
+		}
+	}
 	if _u.mutation.AuthorCleared() && len(_u.mutation.AuthorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Book.author"`)
 	}
@@ -589,6 +976,12 @@ func (_u *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(book.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(book.FieldSlug, field.TypeString, value)
+	}
+	if _u.mutation.SlugCleared() {
+		_spec.ClearField(book.FieldSlug, field.TypeString)
+	}
 	if value, ok := _u.mutation.Pages(); ok {
 		_spec.SetField(book.FieldPages, field.TypeInt, value)
 	}
@@ -598,12 +991,55 @@ func (_u *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error) {
 	if value, ok := _u.mutation.Published(); ok {
 		_spec.SetField(book.FieldPublished, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(book.FieldFormat, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.PublishedAt(); ok {
 		_spec.SetField(book.FieldPublishedAt, field.TypeTime, value)
 	}
 	if _u.mutation.PublishedAtCleared() {
 		_spec.ClearField(book.FieldPublishedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(book.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, book.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(book.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Editions(); ok {
+		_spec.SetField(book.FieldEditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEditions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, book.FieldEditions, value)
+		})
+	}
+	if _u.mutation.EditionsCleared() {
+		_spec.ClearField(book.FieldEditions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(book.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(book.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Cover(); ok {
+		_spec.SetField(book.FieldCover, field.TypeString, value)
+	}
+	if _u.mutation.CoverCleared() {
+		_spec.ClearField(book.FieldCover, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(book.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.InternalNotes(); ok {
 		_spec.SetField(book.FieldInternalNotes, field.TypeString, value)
 	}
@@ -684,6 +1120,35 @@ func (_u *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PublisherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.PublisherTable,
+			Columns: []string{book.PublisherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublisherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.PublisherTable,
+			Columns: []string{book.PublisherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Book{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,11 +12,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/troygilman/vent/examples/basic/ent/auditlog"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
 	"github.com/troygilman/vent/examples/basic/ent/permission"
 	"github.com/troygilman/vent/examples/basic/ent/permissiongroup"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
 	"github.com/troygilman/vent/examples/basic/ent/user"
 )
 
@@ -78,11 +81,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			auditlog.Table:        auditlog.ValidColumn,
 			author.Table:          author.ValidColumn,
 			book.Table:            book.ValidColumn,
 			permission.Table:      permission.ValidColumn,
 			permissiongroup.Table: permissiongroup.ValidColumn,
+			publisher.Table:       publisher.ValidColumn,
 			review.Table:          review.ValidColumn,
+			revision.Table:        revision.ValidColumn,
 			user.Table:            user.ValidColumn,
 		})
 	})
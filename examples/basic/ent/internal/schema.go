@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = "{\"Schema\":\"github.com/troygilman/vent/examples/basic/ent/schema\",\"Package\":\"github.com/troygilman/vent/examples/basic/ent\",\"Schemas\":[{\"name\":\"Author\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"author\",\"unique\":true,\"inverse\":true,\"required\":true},{\"name\":\"books\",\"type\":\"Book\",\"ref_name\":\"author\",\"inverse\":true}],\"fields\":[{\"name\":\"user_id\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"active\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":true,\"default_kind\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"VentSchema\":{\"CustomFields\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSets\":[{\"Fields\":[\"user\",\"active\"],\"Label\":\"\"}],\"FilterableColumns\":[\"active\"],\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Authors\",\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SingularDisplayName\":\"Author\",\"TableColumns\":[\"user\",\"active\"]}}},{\"name\":\"Book\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"author\",\"type\":\"Author\",\"unique\":true,\"required\":true},{\"name\":\"reviews\",\"type\":\"Review\"}],\"fields\":[{\"name\":\"title\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"pages\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":2,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"published\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"published_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"internal_notes\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true}],\"annotations\":{\"VentSchema\":{\"CustomFields\":[{\"InputType\":\"string\",\"Name\":\"notes\",\"Sensitive\":false,\"Type\":\"string\"}],\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSets\":[{\"Fields\":[\"title\",\"author\",\"pages\",\"published\",\"published_at\",\"created_at\",\"notes\"],\"Label\":\"\"}],\"FilterableColumns\":[\"title\",\"published\",\"pages\"],\"PageSize\":0,\"Permissions\":[{\"Desc\":\"Publish a book\",\"Name\":\"publish\"}],\"PluralDisplayName\":\"Books\",\"ReadOnly\":false,\"ReadOnlyFields\":[\"created_at\"],\"RouteName\":\"books\",\"SingularDisplayName\":\"Book\",\"TableColumns\":[\"title\",\"author\",\"published\",\"pages\"]}}},{\"name\":\"Permission\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"groups\",\"type\":\"PermissionGroup\",\"ref_name\":\"permissions\",\"inverse\":true}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}}],\"annotations\":{\"VentAuthMixin\":{\"Role\":\"permission\"},\"VentSchema\":{\"CustomFields\":null,\"DisableAdmin\":false,\"DisableCreate\":true,\"DisableDelete\":true,\"FieldSets\":[{\"Fields\":[\"name\",\"groups\"],\"Label\":\"\"}],\"FilterableColumns\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Permissions\",\"ReadOnly\":false,\"ReadOnlyFields\":[\"name\"],\"RouteName\":\"\",\"SingularDisplayName\":\"Permission\",\"TableColumns\":[\"name\",\"groups\"]}}},{\"name\":\"PermissionGroup\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"permissions\",\"type\":\"Permission\"},{\"name\":\"users\",\"type\":\"User\",\"ref_name\":\"groups\",\"inverse\":true}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}}],\"annotations\":{\"VentAuthMixin\":{\"Role\":\"group\"},\"VentSchema\":{\"CustomFields\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSets\":[{\"Fields\":[\"name\",\"permissions\"],\"Label\":\"\"}],\"FilterableColumns\":[\"name\"],\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Permission Groups\",\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"permission-groups\",\"SingularDisplayName\":\"Permission Group\",\"TableColumns\":[\"name\"]}}},{\"name\":\"Review\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"book\",\"type\":\"Book\",\"ref_name\":\"reviews\",\"unique\":true,\"inverse\":true,\"required\":true},{\"name\":\"user\",\"type\":\"User\",\"ref_name\":\"reviews\",\"unique\":true,\"inverse\":true,\"required\":true}],\"fields\":[{\"name\":\"rating\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":2,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"body\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"VentSchema\":{\"CustomFields\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":true,\"FieldSets\":[{\"Fields\":[\"user\",\"rating\",\"body\",\"book\"],\"Label\":\"\"}],\"FilterableColumns\":[\"rating\"],\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Reviews\",\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SingularDisplayName\":\"Review\",\"TableColumns\":[\"user\",\"rating\",\"book\"]}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"groups\",\"type\":\"PermissionGroup\"},{\"name\":\"author\",\"type\":\"Author\",\"unique\":true},{\"name\":\"reviews\",\"type\":\"Review\"}],\"fields\":[{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"password_hash\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"is_staff\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"is_superuser\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"is_active\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":true,\"default_kind\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"last_login\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"VentAuthMixin\":{\"Role\":\"user\"},\"VentSchema\":{\"CustomFields\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSets\":[{\"Fields\":[\"id\",\"email\",\"password\",\"is_staff\",\"is_superuser\",\"is_active\",\"groups\",\"last_login\"],\"Label\":\"\"}],\"FilterableColumns\":[\"email\",\"is_staff\",\"is_active\"],\"PageSize\":0,\"Permissions\":[{\"Desc\":\"Act as another user\",\"Name\":\"impersonate\"}],\"PluralDisplayName\":\"Users\",\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SingularDisplayName\":\"User\",\"TableColumns\":[\"email\",\"is_staff\",\"is_superuser\",\"is_active\",\"last_login\"]}}}],\"Features\":[\"sql/versioned-migration\",\"sql/upsert\",\"schema/snapshot\"]}"
+const Schema = "{\"Schema\":\"github.com/troygilman/vent/examples/basic/ent/schema\",\"Package\":\"github.com/troygilman/vent/examples/basic/ent\",\"Schemas\":[{\"name\":\"AuditLog\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"actor_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"actor\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"action\",\"type\":{\"Type\":6,\"Ident\":\"auditlog.Action\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"enums\":[{\"N\":\"create\",\"V\":\"create\"},{\"N\":\"update\",\"V\":\"update\"},{\"N\":\"delete\",\"V\":\"delete\"},{\"N\":\"password\",\"V\":\"password\"},{\"N\":\"restore\",\"V\":\"restore\"},{\"N\":\"purge\",\"V\":\"purge\"}],\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"schema\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"entity_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"entity_name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"changes\",\"type\":{\"Type\":3,\"Ident\":\"[]vent.AuditChange\",\"PkgPath\":\"github.com/troygilman/vent\",\"PkgName\":\"vent\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]vent.AuditChange\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":true,\"MixinIndex\":0}}],\"indexes\":[{\"fields\":[\"schema\",\"entity_id\"]}],\"annotations\":{\"VentAuditLog\":{},\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":[\"-created_at\"],\"DisableAdmin\":false,\"DisableCreate\":true,\"DisableDelete\":true,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"created_at\",\"actor\",\"action\",\"schema\",\"entity_id\",\"entity_name\",\"changes\"],\"Label\":\"\"}],\"FileFields\":null,\"FilterableColumns\":[\"created_at\",\"action\",\"schema\",\"actor\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Audit log\",\"PrepopulatedFields\":null,\"ReadOnly\":true,\"ReadOnlyFields\":[\"created_at\",\"actor\",\"action\",\"schema\",\"entity_id\",\"entity_name\",\"changes\"],\"RouteName\":\"\",\"SearchFields\":[\"actor\",\"entity_name\",\"entity_id\"],\"SingularDisplayName\":\"Audit log entry\",\"TableColumns\":[\"created_at\",\"actor\",\"action\",\"schema\",\"entity_name\"],\"VersionField\":\"\"}}},{\"name\":\"Author\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"author\",\"unique\":true,\"inverse\":true,\"required\":true},{\"name\":\"books\",\"type\":\"Book\",\"ref_name\":\"author\",\"inverse\":true}],\"fields\":[{\"name\":\"user_id\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"active\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":true,\"default_kind\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"user\",\"active\"],\"Label\":\"\"}],\"FileFields\":null,\"FilterableColumns\":[\"active\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Authors\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SearchFields\":null,\"SingularDisplayName\":\"Author\",\"TableColumns\":[\"user\",\"active\"],\"VersionField\":\"\"}}},{\"name\":\"Book\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"author\",\"type\":\"Author\",\"unique\":true,\"required\":true},{\"name\":\"reviews\",\"type\":\"Review\"},{\"name\":\"publisher\",\"type\":\"Publisher\",\"ref_name\":\"books\",\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"title\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"VentField\":{\"ColumnHeader\":\"\",\"HelpText\":\"\",\"Label\":\"\",\"Placeholder\":\"e.g. The Left Hand of Darkness\",\"Widget\":\"\"}}},{\"name\":\"slug\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"VentField\":{\"ColumnHeader\":\"\",\"HelpText\":\"Filled in from the title on the add form.\",\"Label\":\"\",\"Placeholder\":\"\",\"Widget\":\"\"}}},{\"name\":\"pages\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":2,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"VentField\":{\"ColumnHeader\":\"Pp.\",\"HelpText\":\"\",\"Label\":\"\",\"Placeholder\":\"\",\"Widget\":\"\"}}},{\"name\":\"published\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"format\",\"type\":{\"Type\":6,\"Ident\":\"book.Format\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"enums\":[{\"N\":\"hardcover\",\"V\":\"hardcover\"},{\"N\":\"paperback\",\"V\":\"paperback\"},{\"N\":\"ebook\",\"V\":\"ebook\"},{\"N\":\"audiobook\",\"V\":\"audiobook\"}],\"default\":true,\"default_value\":\"paperback\",\"default_kind\":24,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"published_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"VentField\":{\"ColumnHeader\":\"\",\"HelpText\":\"Leave empty for unpublished books.\",\"Label\":\"Publication date\",\"Placeholder\":\"\",\"Widget\":\"date\"}}},{\"name\":\"tags\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"editions\",\"type\":{\"Type\":3,\"Ident\":\"[]int\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]int\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"metadata\",\"type\":{\"Type\":3,\"Ident\":\"map[string]interface {}\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]interface {}\",\"Kind\":21,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"cover\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"version\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":2,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"internal_notes\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true}],\"annotations\":{\"VentSchema\":{\"Clear\":null,\"CustomFields\":[{\"InputType\":\"string\",\"Name\":\"notes\",\"Sensitive\":false,\"Type\":\"string\"}],\"DefaultOrdering\":[\"-published_at\",\"title\"],\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"title\",\"slug\",\"cover\",\"author\",\"publisher\",\"pages\",\"format\"],\"Label\":\"Book\"},{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"Release status and catalog tags.\",\"Fields\":[\"published\",\"published_at\",\"tags\",\"editions\"],\"Label\":\"Publishing\"},{\"Collapsed\":true,\"Collapsible\":false,\"Description\":\"Free-form metadata and internal notes.\",\"Fields\":[\"metadata\",\"created_at\",\"version\",\"notes\"],\"Label\":\"Advanced\"}],\"FileFields\":null,\"FilterableColumns\":[\"title\",\"author\",\"format\",\"published\",\"pages\",\"published_at\"],\"ImageFields\":[\"cover\"],\"PageSize\":0,\"Permissions\":[{\"Desc\":\"Publish a book\",\"Name\":\"publish\"}],\"PluralDisplayName\":\"Books\",\"PrepopulatedFields\":{\"slug\":[\"title\"]},\"ReadOnly\":false,\"ReadOnlyFields\":[\"created_at\"],\"RouteName\":\"books\",\"SearchFields\":[\"title\",\"publisher.name\",\"author.user.email\"],\"SingularDisplayName\":\"Book\",\"TableColumns\":[\"cover\",\"title\",\"author\",\"format\",\"published\",\"pages\"],\"VersionField\":\"version\"}}},{\"name\":\"Permission\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"groups\",\"type\":\"PermissionGroup\",\"ref_name\":\"permissions\",\"inverse\":true}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}}],\"annotations\":{\"VentAuthMixin\":{\"Role\":\"permission\"},\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":false,\"DisableCreate\":true,\"DisableDelete\":true,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"name\",\"groups\"],\"Label\":\"\"}],\"FileFields\":null,\"FilterableColumns\":[\"name\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Permissions\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":[\"name\"],\"RouteName\":\"\",\"SearchFields\":[\"name\"],\"SingularDisplayName\":\"Permission\",\"TableColumns\":[\"name\",\"groups\"],\"VersionField\":\"\"}}},{\"name\":\"PermissionGroup\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"permissions\",\"type\":\"Permission\"},{\"name\":\"users\",\"type\":\"User\",\"ref_name\":\"groups\",\"inverse\":true}],\"fields\":[{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}}],\"annotations\":{\"VentAuthMixin\":{\"Role\":\"group\"},\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"name\",\"permissions\"],\"Label\":\"\"}],\"FileFields\":null,\"FilterableColumns\":[\"name\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Permission Groups\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"permission-groups\",\"SearchFields\":[\"name\"],\"SingularDisplayName\":\"Permission Group\",\"TableColumns\":[\"name\"],\"VersionField\":\"\"}}},{\"name\":\"Publisher\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"books\",\"type\":\"Book\"}],\"fields\":[{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"id\",\"type\":{\"Type\":4,\"Ident\":\"uuid.UUID\",\"PkgPath\":\"github.com/google/uuid\",\"PkgName\":\"uuid\",\"Nillable\":false,\"RType\":{\"Name\":\"UUID\",\"Ident\":\"uuid.UUID\",\"Kind\":17,\"PkgPath\":\"github.com/google/uuid\",\"Methods\":{\"ClockSequence\":{\"In\":[],\"Out\":[{\"Name\":\"int\",\"Ident\":\"int\",\"Kind\":2,\"PkgPath\":\"\",\"Methods\":null}]},\"Domain\":{\"In\":[],\"Out\":[{\"Name\":\"Domain\",\"Ident\":\"uuid.Domain\",\"Kind\":8,\"PkgPath\":\"github.com/google/uuid\",\"Methods\":null}]},\"ID\":{\"In\":[],\"Out\":[{\"Name\":\"uint32\",\"Ident\":\"uint32\",\"Kind\":10,\"PkgPath\":\"\",\"Methods\":null}]},\"MarshalBinary\":{\"In\":[],\"Out\":[{\"Name\":\"\",\"Ident\":\"[]uint8\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":null},{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]},\"MarshalText\":{\"In\":[],\"Out\":[{\"Name\":\"\",\"Ident\":\"[]uint8\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":null},{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]},\"NodeID\":{\"In\":[],\"Out\":[{\"Name\":\"\",\"Ident\":\"[]uint8\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":null}]},\"Scan\":{\"In\":[{\"Name\":\"\",\"Ident\":\"interface {}\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}],\"Out\":[{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]},\"String\":{\"In\":[],\"Out\":[{\"Name\":\"string\",\"Ident\":\"string\",\"Kind\":24,\"PkgPath\":\"\",\"Methods\":null}]},\"Time\":{\"In\":[],\"Out\":[{\"Name\":\"Time\",\"Ident\":\"uuid.Time\",\"Kind\":6,\"PkgPath\":\"github.com/google/uuid\",\"Methods\":null}]},\"URN\":{\"In\":[],\"Out\":[{\"Name\":\"string\",\"Ident\":\"string\",\"Kind\":24,\"PkgPath\":\"\",\"Methods\":null}]},\"UnmarshalBinary\":{\"In\":[{\"Name\":\"\",\"Ident\":\"[]uint8\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":null}],\"Out\":[{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]},\"UnmarshalText\":{\"In\":[{\"Name\":\"\",\"Ident\":\"[]uint8\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":null}],\"Out\":[{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]},\"Value\":{\"In\":[],\"Out\":[{\"Name\":\"Value\",\"Ident\":\"driver.Value\",\"Kind\":20,\"PkgPath\":\"database/sql/driver\",\"Methods\":null},{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]},\"Variant\":{\"In\":[],\"Out\":[{\"Name\":\"Variant\",\"Ident\":\"uuid.Variant\",\"Kind\":8,\"PkgPath\":\"github.com/google/uuid\",\"Methods\":null}]},\"Version\":{\"In\":[],\"Out\":[{\"Name\":\"Version\",\"Ident\":\"uuid.Version\",\"Kind\":8,\"PkgPath\":\"github.com/google/uuid\",\"Methods\":null}]}}}},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"catalog\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}],\"annotations\":{\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"id\",\"name\",\"catalog\",\"books\"],\"Label\":\"\"}],\"FileFields\":[\"catalog\"],\"FilterableColumns\":[\"name\",\"id\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Publishers\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SearchFields\":null,\"SingularDisplayName\":\"Publisher\",\"TableColumns\":[\"name\",\"id\"],\"VersionField\":\"\"},\"VentSoftDelete\":{}}},{\"name\":\"Review\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"book\",\"type\":\"Book\",\"ref_name\":\"reviews\",\"unique\":true,\"inverse\":true,\"required\":true},{\"name\":\"user\",\"type\":\"User\",\"ref_name\":\"reviews\",\"unique\":true,\"inverse\":true,\"required\":true}],\"fields\":[{\"name\":\"rating\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":2,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"body\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"VentField\":{\"ColumnHeader\":\"\",\"HelpText\":\"\",\"Label\":\"Review\",\"Placeholder\":\"\",\"Widget\":\"textarea\"}}}],\"annotations\":{\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":true,\"FieldSetLayout\":\"\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"user\",\"rating\",\"body\",\"book\"],\"Label\":\"\"}],\"FileFields\":null,\"FilterableColumns\":[\"rating\",\"book\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"Reviews\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SearchFields\":[\"body\",\"book.title\",\"user.email\"],\"SingularDisplayName\":\"Review\",\"TableColumns\":[\"user\",\"rating\",\"book\"],\"VersionField\":\"\"}}},{\"name\":\"Revision\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"actor_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"actor\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"action\",\"type\":{\"Type\":6,\"Ident\":\"revision.Action\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"enums\":[{\"N\":\"update\",\"V\":\"update\"},{\"N\":\"delete\",\"V\":\"delete\"}],\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"schema\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"entity_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"entity_name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"snapshot\",\"type\":{\"Type\":3,\"Ident\":\"vent.RevisionSnapshot\",\"PkgPath\":\"github.com/troygilman/vent\",\"PkgName\":\"vent\",\"Nillable\":true,\"RType\":{\"Name\":\"RevisionSnapshot\",\"Ident\":\"vent.RevisionSnapshot\",\"Kind\":21,\"PkgPath\":\"github.com/troygilman/vent\",\"Methods\":{\"Decode\":{\"In\":[{\"Name\":\"\",\"Ident\":\"interface {}\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}],\"Out\":[{\"Name\":\"error\",\"Ident\":\"error\",\"Kind\":20,\"PkgPath\":\"\",\"Methods\":null}]}}}},\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"restored_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":8,\"MixedIn\":true,\"MixinIndex\":0}}],\"indexes\":[{\"fields\":[\"schema\",\"entity_id\"]}],\"annotations\":{\"VentRevision\":{},\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":true,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSetLayout\":\"\",\"FieldSets\":null,\"FileFields\":null,\"FilterableColumns\":null,\"ImageFields\":null,\"PageSize\":0,\"Permissions\":null,\"PluralDisplayName\":\"\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SearchFields\":null,\"SingularDisplayName\":\"\",\"TableColumns\":null,\"VersionField\":\"\"}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"groups\",\"type\":\"PermissionGroup\"},{\"name\":\"author\",\"type\":\"Author\",\"unique\":true},{\"name\":\"reviews\",\"type\":\"Review\"}],\"fields\":[{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"password_hash\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"sensitive\":true},{\"name\":\"is_staff\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"is_superuser\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"is_active\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":true,\"default_kind\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0}},{\"name\":\"last_login\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}}],\"annotations\":{\"VentAuthMixin\":{\"Role\":\"user\"},\"VentSchema\":{\"Clear\":null,\"CustomFields\":null,\"DefaultOrdering\":null,\"DisableAdmin\":false,\"DisableCreate\":false,\"DisableDelete\":false,\"FieldSetLayout\":\"tabs\",\"FieldSets\":[{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"\",\"Fields\":[\"id\",\"email\",\"password\",\"last_login\"],\"Label\":\"Account\"},{\"Collapsed\":false,\"Collapsible\":false,\"Description\":\"Staff users can sign in to the admin; superusers bypass permission checks.\",\"Fields\":[\"is_staff\",\"is_superuser\",\"is_active\",\"groups\"],\"Label\":\"Access\"}],\"FileFields\":null,\"FilterableColumns\":[\"email\",\"is_staff\",\"is_active\"],\"ImageFields\":null,\"PageSize\":0,\"Permissions\":[{\"Desc\":\"Act as another user\",\"Name\":\"impersonate\"}],\"PluralDisplayName\":\"Users\",\"PrepopulatedFields\":null,\"ReadOnly\":false,\"ReadOnlyFields\":null,\"RouteName\":\"\",\"SearchFields\":[\"email\"],\"SingularDisplayName\":\"User\",\"TableColumns\":[\"email\",\"is_staff\",\"is_superuser\",\"is_active\",\"last_login\"],\"VersionField\":\"\"}}}],\"Features\":[\"sql/versioned-migration\",\"sql/upsert\",\"schema/snapshot\",\"sql/execquery\"]}"
@@ -0,0 +1,6 @@
+-- Add column "tags" to table: "books"
+ALTER TABLE `books` ADD COLUMN `tags` json NULL;
+-- Add column "editions" to table: "books"
+ALTER TABLE `books` ADD COLUMN `editions` json NULL;
+-- Add column "metadata" to table: "books"
+ALTER TABLE `books` ADD COLUMN `metadata` json NULL;
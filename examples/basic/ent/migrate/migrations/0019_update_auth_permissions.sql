@@ -0,0 +1,2 @@
+-- Added permissions
+INSERT INTO `permissions` (`name`) VALUES ('read_publisher'), ('create_publisher'), ('update_publisher'), ('delete_publisher');
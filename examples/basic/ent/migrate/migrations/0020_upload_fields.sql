@@ -0,0 +1,4 @@
+-- Add column "cover" to table: "books"
+ALTER TABLE `books` ADD COLUMN `cover` text NULL;
+-- Add column "catalog" to table: "publishers"
+ALTER TABLE `publishers` ADD COLUMN `catalog` text NULL;
@@ -0,0 +1,2 @@
+-- Added permissions
+INSERT INTO `permissions` (`name`) VALUES ('export_author'), ('export_book'), ('export_permission'), ('export_permission_group'), ('export_publisher'), ('export_review'), ('export_user');
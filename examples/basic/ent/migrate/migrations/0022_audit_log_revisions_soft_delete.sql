@@ -0,0 +1,10 @@
+-- Add column "deleted_at" to table: "publishers"
+ALTER TABLE `publishers` ADD COLUMN `deleted_at` datetime NULL;
+-- Create "audit_logs" table
+CREATE TABLE `audit_logs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `actor_id` text NULL, `actor` text NULL, `action` text NOT NULL, `schema` text NOT NULL, `entity_id` text NOT NULL, `entity_name` text NULL, `changes` json NULL);
+-- Create index "auditlog_schema_entity_id" to table: "audit_logs"
+CREATE INDEX `auditlog_schema_entity_id` ON `audit_logs` (`schema`, `entity_id`);
+-- Create "revisions" table
+CREATE TABLE `revisions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `actor_id` text NULL, `actor` text NULL, `action` text NOT NULL, `schema` text NOT NULL, `entity_id` text NOT NULL, `entity_name` text NULL, `snapshot` json NOT NULL, `restored_id` text NULL);
+-- Create index "revision_schema_entity_id" to table: "revisions"
+CREATE INDEX `revision_schema_entity_id` ON `revisions` (`schema`, `entity_id`);
@@ -0,0 +1,2 @@
+-- Added permissions
+INSERT INTO `permissions` (`name`) VALUES ('restore_publisher'), ('purge_publisher');
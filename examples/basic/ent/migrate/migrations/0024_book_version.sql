@@ -0,0 +1,12 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_books" table
+CREATE TABLE `new_books` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `title` text NOT NULL, `pages` integer NOT NULL DEFAULT (0), `published` bool NOT NULL DEFAULT (false), `format` text NOT NULL DEFAULT ('paperback'), `published_at` datetime NULL, `tags` json NULL, `editions` json NULL, `metadata` json NULL, `cover` text NULL, `created_at` datetime NOT NULL, `version` integer NOT NULL DEFAULT (1), `internal_notes` text NULL, `book_author` integer NOT NULL, `publisher_books` uuid NULL, CONSTRAINT `books_authors_author` FOREIGN KEY (`book_author`) REFERENCES `authors` (`id`) ON DELETE NO ACTION, CONSTRAINT `books_publishers_books` FOREIGN KEY (`publisher_books`) REFERENCES `publishers` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "books" to new temporary table "new_books"
+INSERT INTO `new_books` (`id`, `title`, `pages`, `published`, `format`, `published_at`, `tags`, `editions`, `metadata`, `cover`, `created_at`, `internal_notes`, `book_author`, `publisher_books`) SELECT `id`, `title`, `pages`, `published`, `format`, `published_at`, `tags`, `editions`, `metadata`, `cover`, `created_at`, `internal_notes`, `book_author`, `publisher_books` FROM `books`;
+-- Drop "books" table after copying rows
+DROP TABLE `books`;
+-- Rename temporary table "new_books" to "books"
+ALTER TABLE `new_books` RENAME TO `books`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- Add column "slug" to table: "books"
+ALTER TABLE `books` ADD COLUMN `slug` text NULL;
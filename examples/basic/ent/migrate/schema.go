@@ -8,6 +8,31 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "password", "restore", "purge"}},
+		{Name: "schema", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString},
+		{Name: "entity_name", Type: field.TypeString, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_schema_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[6]},
+			},
+		},
+	}
 	// AuthorsColumns holds the columns for the "authors" table.
 	AuthorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -32,12 +57,20 @@ var (
 	BooksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "title", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Nullable: true},
 		{Name: "pages", Type: field.TypeInt, Default: 0},
 		{Name: "published", Type: field.TypeBool, Default: false},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"hardcover", "paperback", "ebook", "audiobook"}, Default: "paperback"},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "editions", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "cover", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "internal_notes", Type: field.TypeString, Nullable: true},
 		{Name: "book_author", Type: field.TypeInt},
+		{Name: "publisher_books", Type: field.TypeUUID, Nullable: true},
 	}
 	// BooksTable holds the schema information for the "books" table.
 	BooksTable = &schema.Table{
@@ -47,10 +80,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "books_authors_author",
-				Columns:    []*schema.Column{BooksColumns[7]},
+				Columns:    []*schema.Column{BooksColumns[14]},
 				RefColumns: []*schema.Column{AuthorsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "books_publishers_books",
+				Columns:    []*schema.Column{BooksColumns[15]},
+				RefColumns: []*schema.Column{PublishersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// PermissionsColumns holds the columns for the "permissions" table.
@@ -75,6 +114,19 @@ var (
 		Columns:    PermissionGroupsColumns,
 		PrimaryKey: []*schema.Column{PermissionGroupsColumns[0]},
 	}
+	// PublishersColumns holds the columns for the "publishers" table.
+	PublishersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "catalog", Type: field.TypeString, Nullable: true},
+	}
+	// PublishersTable holds the schema information for the "publishers" table.
+	PublishersTable = &schema.Table{
+		Name:       "publishers",
+		Columns:    PublishersColumns,
+		PrimaryKey: []*schema.Column{PublishersColumns[0]},
+	}
 	// ReviewsColumns holds the columns for the "reviews" table.
 	ReviewsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -103,6 +155,32 @@ var (
 			},
 		},
 	}
+	// RevisionsColumns holds the columns for the "revisions" table.
+	RevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"update", "delete"}},
+		{Name: "schema", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString},
+		{Name: "entity_name", Type: field.TypeString, Nullable: true},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "restored_id", Type: field.TypeString, Nullable: true},
+	}
+	// RevisionsTable holds the schema information for the "revisions" table.
+	RevisionsTable = &schema.Table{
+		Name:       "revisions",
+		Columns:    RevisionsColumns,
+		PrimaryKey: []*schema.Column{RevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revision_schema_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{RevisionsColumns[5], RevisionsColumns[6]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -171,11 +249,14 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
 		AuthorsTable,
 		BooksTable,
 		PermissionsTable,
 		PermissionGroupsTable,
+		PublishersTable,
 		ReviewsTable,
+		RevisionsTable,
 		UsersTable,
 		PermissionGroupPermissionsTable,
 		UserGroupsTable,
@@ -185,6 +266,7 @@ var (
 func init() {
 	AuthorsTable.ForeignKeys[0].RefTable = UsersTable
 	BooksTable.ForeignKeys[0].RefTable = AuthorsTable
+	BooksTable.ForeignKeys[1].RefTable = PublishersTable
 	ReviewsTable.ForeignKeys[0].RefTable = BooksTable
 	ReviewsTable.ForeignKeys[1].RefTable = UsersTable
 	PermissionGroupPermissionsTable.ForeignKeys[0].RefTable = PermissionGroupsTable
@@ -11,12 +11,17 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/troygilman/vent"
+	"github.com/troygilman/vent/examples/basic/ent/auditlog"
 	"github.com/troygilman/vent/examples/basic/ent/author"
 	"github.com/troygilman/vent/examples/basic/ent/book"
 	"github.com/troygilman/vent/examples/basic/ent/permission"
 	"github.com/troygilman/vent/examples/basic/ent/permissiongroup"
 	"github.com/troygilman/vent/examples/basic/ent/predicate"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
 	"github.com/troygilman/vent/examples/basic/ent/review"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
 	"github.com/troygilman/vent/examples/basic/ent/user"
 )
 
@@ -29,43 +34,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAuditLog        = "AuditLog"
 	TypeAuthor          = "Author"
 	TypeBook            = "Book"
 	TypePermission      = "Permission"
 	TypePermissionGroup = "PermissionGroup"
+	TypePublisher       = "Publisher"
 	TypeReview          = "Review"
+	TypeRevision        = "Revision"
 	TypeUser            = "User"
 )
 
-// AuthorMutation represents an operation that mutates the Author nodes in the graph.
-type AuthorMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	active        *bool
+	created_at    *time.Time
+	actor_id      *string
+	actor         *string
+	action        *auditlog.Action
+	schema        *string
+	entity_id     *string
+	entity_name   *string
+	changes       *[]vent.AuditChange
+	appendchanges []vent.AuditChange
 	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	books         map[int]struct{}
-	removedbooks  map[int]struct{}
-	clearedbooks  bool
 	done          bool
-	oldValue      func(context.Context) (*Author, error)
-	predicates    []predicate.Author
+	oldValue      func(context.Context) (*AuditLog, error)
+	predicates    []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*AuthorMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// authorOption allows management of the mutation configuration using functional options.
-type authorOption func(*AuthorMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newAuthorMutation creates new mutation for the Author entity.
-func newAuthorMutation(c config, op Op, opts ...authorOption) *AuthorMutation {
-	m := &AuthorMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthor,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -74,20 +85,20 @@ func newAuthorMutation(c config, op Op, opts ...authorOption) *AuthorMutation {
 	return m
 }
 
-// withAuthorID sets the ID field of the mutation.
-func withAuthorID(id int) authorOption {
-	return func(m *AuthorMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Author
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*Author, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Author.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -96,10 +107,10 @@ func withAuthorID(id int) authorOption {
 	}
 }
 
-// withAuthor sets the old Author of the mutation.
-func withAuthor(node *Author) authorOption {
-	return func(m *AuthorMutation) {
-		m.oldValue = func(context.Context) (*Author, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -108,7 +119,7 @@ func withAuthor(node *Author) authorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthorMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -116,7 +127,7 @@ func (m AuthorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthorMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -127,7 +138,7 @@ func (m AuthorMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthorMutation) ID() (id int, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -138,7 +149,7 @@ func (m *AuthorMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthorMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -147,174 +158,377 @@ func (m *AuthorMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Author.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *AuthorMutation) SetUserID(i int) {
-	m.user = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *AuthorMutation) UserID() (r int, exists bool) {
-	v := m.user
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Author entity.
-// If the Author object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *AuthorMutation) ResetUserID() {
-	m.user = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetActive sets the "active" field.
-func (m *AuthorMutation) SetActive(b bool) {
-	m.active = &b
+// SetActorID sets the "actor_id" field.
+func (m *AuditLogMutation) SetActorID(s string) {
+	m.actor_id = &s
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *AuthorMutation) Active() (r bool, exists bool) {
-	v := m.active
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditLogMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the Author entity.
-// If the Author object wasn't provided to the builder, the object is fetched from the database.
+// OldActorID returns the old "actor_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *AuditLogMutation) OldActorID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldActorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.ActorID, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *AuthorMutation) ResetActive() {
-	m.active = nil
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditLogMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditlog.FieldActorID] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *AuthorMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[author.FieldUserID] = struct{}{}
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActorID]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *AuthorMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditLogMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditlog.FieldActorID)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *AuthorMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *AuthorMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
 }
 
-// AddBookIDs adds the "books" edge to the Book entity by ids.
-func (m *AuthorMutation) AddBookIDs(ids ...int) {
-	if m.books == nil {
-		m.books = make(map[int]struct{})
+// ClearActor clears the value of the "actor" field.
+func (m *AuditLogMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[auditlog.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, auditlog.FieldActor)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(a auditlog.Action) {
+	m.action = &a
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r auditlog.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.books[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v auditlog.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// ClearBooks clears the "books" edge to the Book entity.
-func (m *AuthorMutation) ClearBooks() {
-	m.clearedbooks = true
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// BooksCleared reports if the "books" edge to the Book entity was cleared.
-func (m *AuthorMutation) BooksCleared() bool {
-	return m.clearedbooks
+// SetSchema sets the "schema" field.
+func (m *AuditLogMutation) SetSchema(s string) {
+	m.schema = &s
 }
 
-// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
-func (m *AuthorMutation) RemoveBookIDs(ids ...int) {
-	if m.removedbooks == nil {
-		m.removedbooks = make(map[int]struct{})
+// Schema returns the value of the "schema" field in the mutation.
+func (m *AuditLogMutation) Schema() (r string, exists bool) {
+	v := m.schema
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.books, ids[i])
-		m.removedbooks[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldSchema returns the old "schema" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSchema(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchema: %w", err)
 	}
+	return oldValue.Schema, nil
 }
 
-// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
-func (m *AuthorMutation) RemovedBooksIDs() (ids []int) {
-	for id := range m.removedbooks {
-		ids = append(ids, id)
+// ResetSchema resets all changes to the "schema" field.
+func (m *AuditLogMutation) ResetSchema() {
+	m.schema = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *AuditLogMutation) SetEntityID(s string) {
+	m.entity_id = &s
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *AuditLogMutation) EntityID() (r string, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// BooksIDs returns the "books" edge IDs in the mutation.
-func (m *AuthorMutation) BooksIDs() (ids []int) {
-	for id := range m.books {
-		ids = append(ids, id)
+// OldEntityID returns the old "entity_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
 }
 
-// ResetBooks resets all changes to the "books" edge.
-func (m *AuthorMutation) ResetBooks() {
-	m.books = nil
-	m.clearedbooks = false
-	m.removedbooks = nil
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *AuditLogMutation) ResetEntityID() {
+	m.entity_id = nil
 }
 
-// Where appends a list predicates to the AuthorMutation builder.
-func (m *AuthorMutation) Where(ps ...predicate.Author) {
+// SetEntityName sets the "entity_name" field.
+func (m *AuditLogMutation) SetEntityName(s string) {
+	m.entity_name = &s
+}
+
+// EntityName returns the value of the "entity_name" field in the mutation.
+func (m *AuditLogMutation) EntityName() (r string, exists bool) {
+	v := m.entity_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityName returns the old "entity_name" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntityName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityName: %w", err)
+	}
+	return oldValue.EntityName, nil
+}
+
+// ClearEntityName clears the value of the "entity_name" field.
+func (m *AuditLogMutation) ClearEntityName() {
+	m.entity_name = nil
+	m.clearedFields[auditlog.FieldEntityName] = struct{}{}
+}
+
+// EntityNameCleared returns if the "entity_name" field was cleared in this mutation.
+func (m *AuditLogMutation) EntityNameCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldEntityName]
+	return ok
+}
+
+// ResetEntityName resets all changes to the "entity_name" field.
+func (m *AuditLogMutation) ResetEntityName() {
+	m.entity_name = nil
+	delete(m.clearedFields, auditlog.FieldEntityName)
+}
+
+// SetChanges sets the "changes" field.
+func (m *AuditLogMutation) SetChanges(vc []vent.AuditChange) {
+	m.changes = &vc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditLogMutation) Changes() (r []vent.AuditChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldChanges(ctx context.Context) (v []vent.AuditChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds vc to the "changes" field.
+func (m *AuditLogMutation) AppendChanges(vc []vent.AuditChange) {
+	m.appendchanges = append(m.appendchanges, vc...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *AuditLogMutation) AppendedChanges() ([]vent.AuditChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *AuditLogMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[auditlog.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *AuditLogMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditLogMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, auditlog.FieldChanges)
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Author, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -322,30 +536,48 @@ func (m *AuthorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthorMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthorMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Author).
-func (m *AuthorMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthorMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.user != nil {
-		fields = append(fields, author.FieldUserID)
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	if m.active != nil {
-		fields = append(fields, author.FieldActive)
+	if m.actor_id != nil {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
+	}
+	if m.schema != nil {
+		fields = append(fields, auditlog.FieldSchema)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, auditlog.FieldEntityID)
+	}
+	if m.entity_name != nil {
+		fields = append(fields, auditlog.FieldEntityName)
+	}
+	if m.changes != nil {
+		fields = append(fields, auditlog.FieldChanges)
 	}
 	return fields
 }
@@ -353,12 +585,24 @@ func (m *AuthorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthorMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case author.FieldUserID:
-		return m.UserID()
-	case author.FieldActive:
-		return m.Active()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditlog.FieldActorID:
+		return m.ActorID()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldSchema:
+		return m.Schema()
+	case auditlog.FieldEntityID:
+		return m.EntityID()
+	case auditlog.FieldEntityName:
+		return m.EntityName()
+	case auditlog.FieldChanges:
+		return m.Changes()
 	}
 	return nil, false
 }
@@ -366,234 +610,270 @@ func (m *AuthorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case author.FieldUserID:
-		return m.OldUserID(ctx)
-	case author.FieldActive:
-		return m.OldActive(ctx)
-	}
-	return nil, fmt.Errorf("unknown Author field %s", name)
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditlog.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldSchema:
+		return m.OldSchema(ctx)
+	case auditlog.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case auditlog.FieldEntityName:
+		return m.OldEntityName(ctx)
+	case auditlog.FieldChanges:
+		return m.OldChanges(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthorMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case author.FieldUserID:
-		v, ok := value.(int)
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case author.FieldActive:
-		v, ok := value.(bool)
+	case auditlog.FieldActorID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetActorID(v)
+		return nil
+	case auditlog.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditlog.FieldAction:
+		v, ok := value.(auditlog.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditlog.FieldSchema:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchema(v)
+		return nil
+	case auditlog.FieldEntityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case auditlog.FieldEntityName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityName(v)
+		return nil
+	case auditlog.FieldChanges:
+		v, ok := value.([]vent.AuditChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Author field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthorMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthorMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthorMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Author numeric field %s", name)
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthorMutation) ClearedFields() []string {
-	return nil
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldActorID) {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.FieldCleared(auditlog.FieldActor) {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	if m.FieldCleared(auditlog.FieldEntityName) {
+		fields = append(fields, auditlog.FieldEntityName)
+	}
+	if m.FieldCleared(auditlog.FieldChanges) {
+		fields = append(fields, auditlog.FieldChanges)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthorMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthorMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Author nullable field %s", name)
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case auditlog.FieldActor:
+		m.ClearActor()
+		return nil
+	case auditlog.FieldEntityName:
+		m.ClearEntityName()
+		return nil
+	case auditlog.FieldChanges:
+		m.ClearChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthorMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case author.FieldUserID:
-		m.ResetUserID()
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case author.FieldActive:
-		m.ResetActive()
+	case auditlog.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case auditlog.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldSchema:
+		m.ResetSchema()
+		return nil
+	case auditlog.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case auditlog.FieldEntityName:
+		m.ResetEntityName()
+		return nil
+	case auditlog.FieldChanges:
+		m.ResetChanges()
 		return nil
 	}
-	return fmt.Errorf("unknown Author field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, author.EdgeUser)
-	}
-	if m.books != nil {
-		edges = append(edges, author.EdgeBooks)
-	}
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthorMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case author.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case author.EdgeBooks:
-		ids := make([]ent.Value, 0, len(m.books))
-		for id := range m.books {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedbooks != nil {
-		edges = append(edges, author.EdgeBooks)
-	}
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthorMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case author.EdgeBooks:
-		ids := make([]ent.Value, 0, len(m.removedbooks))
-		for id := range m.removedbooks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, author.EdgeUser)
-	}
-	if m.clearedbooks {
-		edges = append(edges, author.EdgeBooks)
-	}
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthorMutation) EdgeCleared(name string) bool {
-	switch name {
-	case author.EdgeUser:
-		return m.cleareduser
-	case author.EdgeBooks:
-		return m.clearedbooks
-	}
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthorMutation) ClearEdge(name string) error {
-	switch name {
-	case author.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Author unique edge %s", name)
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthorMutation) ResetEdge(name string) error {
-	switch name {
-	case author.EdgeUser:
-		m.ResetUser()
-		return nil
-	case author.EdgeBooks:
-		m.ResetBooks()
-		return nil
-	}
-	return fmt.Errorf("unknown Author edge %s", name)
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// BookMutation represents an operation that mutates the Book nodes in the graph.
-type BookMutation struct {
+// AuthorMutation represents an operation that mutates the Author nodes in the graph.
+type AuthorMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	title          *string
-	pages          *int
-	addpages       *int
-	published      *bool
-	published_at   *time.Time
-	created_at     *time.Time
-	internal_notes *string
-	clearedFields  map[string]struct{}
-	author         *int
-	clearedauthor  bool
-	reviews        map[int]struct{}
-	removedreviews map[int]struct{}
-	clearedreviews bool
-	done           bool
-	oldValue       func(context.Context) (*Book, error)
-	predicates     []predicate.Book
+	op            Op
+	typ           string
+	id            *int
+	active        *bool
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	books         map[int]struct{}
+	removedbooks  map[int]struct{}
+	clearedbooks  bool
+	done          bool
+	oldValue      func(context.Context) (*Author, error)
+	predicates    []predicate.Author
 }
 
-var _ ent.Mutation = (*BookMutation)(nil)
+var _ ent.Mutation = (*AuthorMutation)(nil)
 
-// bookOption allows management of the mutation configuration using functional options.
-type bookOption func(*BookMutation)
+// authorOption allows management of the mutation configuration using functional options.
+type authorOption func(*AuthorMutation)
 
-// newBookMutation creates new mutation for the Book entity.
-func newBookMutation(c config, op Op, opts ...bookOption) *BookMutation {
-	m := &BookMutation{
+// newAuthorMutation creates new mutation for the Author entity.
+func newAuthorMutation(c config, op Op, opts ...authorOption) *AuthorMutation {
+	m := &AuthorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBook,
+		typ:           TypeAuthor,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -602,20 +882,20 @@ func newBookMutation(c config, op Op, opts ...bookOption) *BookMutation {
 	return m
 }
 
-// withBookID sets the ID field of the mutation.
-func withBookID(id int) bookOption {
-	return func(m *BookMutation) {
+// withAuthorID sets the ID field of the mutation.
+func withAuthorID(id int) authorOption {
+	return func(m *AuthorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Book
+			value *Author
 		)
-		m.oldValue = func(ctx context.Context) (*Book, error) {
+		m.oldValue = func(ctx context.Context) (*Author, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Book.Get(ctx, id)
+					value, err = m.Client().Author.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -624,10 +904,10 @@ func withBookID(id int) bookOption {
 	}
 }
 
-// withBook sets the old Book of the mutation.
-func withBook(node *Book) bookOption {
-	return func(m *BookMutation) {
-		m.oldValue = func(context.Context) (*Book, error) {
+// withAuthor sets the old Author of the mutation.
+func withAuthor(node *Author) authorOption {
+	return func(m *AuthorMutation) {
+		m.oldValue = func(context.Context) (*Author, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -636,7 +916,7 @@ func withBook(node *Book) bookOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BookMutation) Client() *Client {
+func (m AuthorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -644,7 +924,7 @@ func (m BookMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BookMutation) Tx() (*Tx, error) {
+func (m AuthorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -655,7 +935,7 @@ func (m BookMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BookMutation) ID() (id int, exists bool) {
+func (m *AuthorMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -666,7 +946,7 @@ func (m *BookMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BookMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuthorMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -675,376 +955,2531 @@ func (m *BookMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Book.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Author.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *BookMutation) SetTitle(s string) {
-	m.title = &s
+// SetUserID sets the "user_id" field.
+func (m *AuthorMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *BookMutation) Title() (r string, exists bool) {
-	v := m.title
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuthorMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Author entity.
+// If the Author object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AuthorMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.UserID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *BookMutation) ResetTitle() {
-	m.title = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuthorMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetPages sets the "pages" field.
-func (m *BookMutation) SetPages(i int) {
-	m.pages = &i
-	m.addpages = nil
+// SetActive sets the "active" field.
+func (m *AuthorMutation) SetActive(b bool) {
+	m.active = &b
 }
 
-// Pages returns the value of the "pages" field in the mutation.
-func (m *BookMutation) Pages() (r int, exists bool) {
-	v := m.pages
+// Active returns the value of the "active" field in the mutation.
+func (m *AuthorMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPages returns the old "pages" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the Author entity.
+// If the Author object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldPages(ctx context.Context) (v int, err error) {
+func (m *AuthorMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPages is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPages requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPages: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.Pages, nil
+	return oldValue.Active, nil
 }
 
-// AddPages adds i to the "pages" field.
-func (m *BookMutation) AddPages(i int) {
-	if m.addpages != nil {
-		*m.addpages += i
-	} else {
-		m.addpages = &i
-	}
+// ResetActive resets all changes to the "active" field.
+func (m *AuthorMutation) ResetActive() {
+	m.active = nil
 }
 
-// AddedPages returns the value that was added to the "pages" field in this mutation.
-func (m *BookMutation) AddedPages() (r int, exists bool) {
-	v := m.addpages
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearUser clears the "user" edge to the User entity.
+func (m *AuthorMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[author.FieldUserID] = struct{}{}
 }
 
-// ResetPages resets all changes to the "pages" field.
-func (m *BookMutation) ResetPages() {
-	m.pages = nil
-	m.addpages = nil
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AuthorMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// SetPublished sets the "published" field.
-func (m *BookMutation) SetPublished(b bool) {
-	m.published = &b
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AuthorMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Published returns the value of the "published" field in the mutation.
-func (m *BookMutation) Published() (r bool, exists bool) {
-	v := m.published
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUser resets all changes to the "user" edge.
+func (m *AuthorMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// OldPublished returns the old "published" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldPublished(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublished requires an ID field in the mutation")
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *AuthorMutation) AddBookIDs(ids ...int) {
+	if m.books == nil {
+		m.books = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
 	}
-	return oldValue.Published, nil
-}
-
-// ResetPublished resets all changes to the "published" field.
-func (m *BookMutation) ResetPublished() {
-	m.published = nil
 }
 
-// SetPublishedAt sets the "published_at" field.
-func (m *BookMutation) SetPublishedAt(t time.Time) {
-	m.published_at = &t
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *AuthorMutation) ClearBooks() {
+	m.clearedbooks = true
 }
 
-// PublishedAt returns the value of the "published_at" field in the mutation.
-func (m *BookMutation) PublishedAt() (r time.Time, exists bool) {
-	v := m.published_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *AuthorMutation) BooksCleared() bool {
+	return m.clearedbooks
 }
 
-// OldPublishedAt returns the old "published_at" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *AuthorMutation) RemoveBookIDs(ids ...int) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
 	}
-	return oldValue.PublishedAt, nil
 }
 
-// ClearPublishedAt clears the value of the "published_at" field.
-func (m *BookMutation) ClearPublishedAt() {
-	m.published_at = nil
-	m.clearedFields[book.FieldPublishedAt] = struct{}{}
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *AuthorMutation) RemovedBooksIDs() (ids []int) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
-func (m *BookMutation) PublishedAtCleared() bool {
-	_, ok := m.clearedFields[book.FieldPublishedAt]
-	return ok
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *AuthorMutation) BooksIDs() (ids []int) {
+	for id := range m.books {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetPublishedAt resets all changes to the "published_at" field.
-func (m *BookMutation) ResetPublishedAt() {
-	m.published_at = nil
-	delete(m.clearedFields, book.FieldPublishedAt)
+// ResetBooks resets all changes to the "books" edge.
+func (m *AuthorMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BookMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Where appends a list predicates to the AuthorMutation builder.
+func (m *AuthorMutation) Where(ps ...predicate.Author) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BookMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuthorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Author, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// Op returns the operation name.
+func (m *AuthorMutation) Op() Op {
+	return m.op
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BookMutation) ResetCreatedAt() {
-	m.created_at = nil
+// SetOp allows setting the mutation operation.
+func (m *AuthorMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetInternalNotes sets the "internal_notes" field.
-func (m *BookMutation) SetInternalNotes(s string) {
-	m.internal_notes = &s
+// Type returns the node type of this mutation (Author).
+func (m *AuthorMutation) Type() string {
+	return m.typ
 }
 
-// InternalNotes returns the value of the "internal_notes" field in the mutation.
-func (m *BookMutation) InternalNotes() (r string, exists bool) {
-	v := m.internal_notes
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthorMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.user != nil {
+		fields = append(fields, author.FieldUserID)
 	}
-	return *v, true
+	if m.active != nil {
+		fields = append(fields, author.FieldActive)
+	}
+	return fields
 }
 
-// OldInternalNotes returns the old "internal_notes" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldInternalNotes(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInternalNotes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInternalNotes requires an ID field in the mutation")
-	}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case author.FieldUserID:
+		return m.UserID()
+	case author.FieldActive:
+		return m.Active()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case author.FieldUserID:
+		return m.OldUserID(ctx)
+	case author.FieldActive:
+		return m.OldActive(ctx)
+	}
+	return nil, fmt.Errorf("unknown Author field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case author.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case author.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Author field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthorMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Author numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Author nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthorMutation) ResetField(name string) error {
+	switch name {
+	case author.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case author.FieldActive:
+		m.ResetActive()
+		return nil
+	}
+	return fmt.Errorf("unknown Author field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, author.EdgeUser)
+	}
+	if m.books != nil {
+		edges = append(edges, author.EdgeBooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case author.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case author.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbooks != nil {
+		edges = append(edges, author.EdgeBooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case author.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, author.EdgeUser)
+	}
+	if m.clearedbooks {
+		edges = append(edges, author.EdgeBooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case author.EdgeUser:
+		return m.cleareduser
+	case author.EdgeBooks:
+		return m.clearedbooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthorMutation) ClearEdge(name string) error {
+	switch name {
+	case author.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Author unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthorMutation) ResetEdge(name string) error {
+	switch name {
+	case author.EdgeUser:
+		m.ResetUser()
+		return nil
+	case author.EdgeBooks:
+		m.ResetBooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Author edge %s", name)
+}
+
+// BookMutation represents an operation that mutates the Book nodes in the graph.
+type BookMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	title            *string
+	slug             *string
+	pages            *int
+	addpages         *int
+	published        *bool
+	format           *book.Format
+	published_at     *time.Time
+	tags             *[]string
+	appendtags       []string
+	editions         *[]int
+	appendeditions   []int
+	metadata         *map[string]interface{}
+	cover            *string
+	created_at       *time.Time
+	version          *int
+	addversion       *int
+	internal_notes   *string
+	clearedFields    map[string]struct{}
+	author           *int
+	clearedauthor    bool
+	reviews          map[int]struct{}
+	removedreviews   map[int]struct{}
+	clearedreviews   bool
+	publisher        *uuid.UUID
+	clearedpublisher bool
+	done             bool
+	oldValue         func(context.Context) (*Book, error)
+	predicates       []predicate.Book
+}
+
+var _ ent.Mutation = (*BookMutation)(nil)
+
+// bookOption allows management of the mutation configuration using functional options.
+type bookOption func(*BookMutation)
+
+// newBookMutation creates new mutation for the Book entity.
+func newBookMutation(c config, op Op, opts ...bookOption) *BookMutation {
+	m := &BookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBookID sets the ID field of the mutation.
+func withBookID(id int) bookOption {
+	return func(m *BookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Book
+		)
+		m.oldValue = func(ctx context.Context) (*Book, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Book.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBook sets the old Book of the mutation.
+func withBook(node *Book) bookOption {
+	return func(m *BookMutation) {
+		m.oldValue = func(context.Context) (*Book, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Book.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *BookMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *BookMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *BookMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *BookMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *BookMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *BookMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[book.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *BookMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[book.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *BookMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, book.FieldSlug)
+}
+
+// SetPages sets the "pages" field.
+func (m *BookMutation) SetPages(i int) {
+	m.pages = &i
+	m.addpages = nil
+}
+
+// Pages returns the value of the "pages" field in the mutation.
+func (m *BookMutation) Pages() (r int, exists bool) {
+	v := m.pages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPages returns the old "pages" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPages(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPages: %w", err)
+	}
+	return oldValue.Pages, nil
+}
+
+// AddPages adds i to the "pages" field.
+func (m *BookMutation) AddPages(i int) {
+	if m.addpages != nil {
+		*m.addpages += i
+	} else {
+		m.addpages = &i
+	}
+}
+
+// AddedPages returns the value that was added to the "pages" field in this mutation.
+func (m *BookMutation) AddedPages() (r int, exists bool) {
+	v := m.addpages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPages resets all changes to the "pages" field.
+func (m *BookMutation) ResetPages() {
+	m.pages = nil
+	m.addpages = nil
+}
+
+// SetPublished sets the "published" field.
+func (m *BookMutation) SetPublished(b bool) {
+	m.published = &b
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *BookMutation) Published() (r bool, exists bool) {
+	v := m.published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublished returns the old "published" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPublished(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	}
+	return oldValue.Published, nil
+}
+
+// ResetPublished resets all changes to the "published" field.
+func (m *BookMutation) ResetPublished() {
+	m.published = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *BookMutation) SetFormat(b book.Format) {
+	m.format = &b
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *BookMutation) Format() (r book.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldFormat(ctx context.Context) (v book.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *BookMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *BookMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *BookMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *BookMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[book.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *BookMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[book.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *BookMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, book.FieldPublishedAt)
+}
+
+// SetTags sets the "tags" field.
+func (m *BookMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *BookMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *BookMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *BookMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *BookMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[book.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *BookMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[book.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *BookMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, book.FieldTags)
+}
+
+// SetEditions sets the "editions" field.
+func (m *BookMutation) SetEditions(i []int) {
+	m.editions = &i
+	m.appendeditions = nil
+}
+
+// Editions returns the value of the "editions" field in the mutation.
+func (m *BookMutation) Editions() (r []int, exists bool) {
+	v := m.editions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditions returns the old "editions" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldEditions(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditions: %w", err)
+	}
+	return oldValue.Editions, nil
+}
+
+// AppendEditions adds i to the "editions" field.
+func (m *BookMutation) AppendEditions(i []int) {
+	m.appendeditions = append(m.appendeditions, i...)
+}
+
+// AppendedEditions returns the list of values that were appended to the "editions" field in this mutation.
+func (m *BookMutation) AppendedEditions() ([]int, bool) {
+	if len(m.appendeditions) == 0 {
+		return nil, false
+	}
+	return m.appendeditions, true
+}
+
+// ClearEditions clears the value of the "editions" field.
+func (m *BookMutation) ClearEditions() {
+	m.editions = nil
+	m.appendeditions = nil
+	m.clearedFields[book.FieldEditions] = struct{}{}
+}
+
+// EditionsCleared returns if the "editions" field was cleared in this mutation.
+func (m *BookMutation) EditionsCleared() bool {
+	_, ok := m.clearedFields[book.FieldEditions]
+	return ok
+}
+
+// ResetEditions resets all changes to the "editions" field.
+func (m *BookMutation) ResetEditions() {
+	m.editions = nil
+	m.appendeditions = nil
+	delete(m.clearedFields, book.FieldEditions)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *BookMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *BookMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *BookMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[book.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *BookMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[book.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *BookMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, book.FieldMetadata)
+}
+
+// SetCover sets the "cover" field.
+func (m *BookMutation) SetCover(s string) {
+	m.cover = &s
+}
+
+// Cover returns the value of the "cover" field in the mutation.
+func (m *BookMutation) Cover() (r string, exists bool) {
+	v := m.cover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCover returns the old "cover" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldCover(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCover is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCover requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCover: %w", err)
+	}
+	return oldValue.Cover, nil
+}
+
+// ClearCover clears the value of the "cover" field.
+func (m *BookMutation) ClearCover() {
+	m.cover = nil
+	m.clearedFields[book.FieldCover] = struct{}{}
+}
+
+// CoverCleared returns if the "cover" field was cleared in this mutation.
+func (m *BookMutation) CoverCleared() bool {
+	_, ok := m.clearedFields[book.FieldCover]
+	return ok
+}
+
+// ResetCover resets all changes to the "cover" field.
+func (m *BookMutation) ResetCover() {
+	m.cover = nil
+	delete(m.clearedFields, book.FieldCover)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *BookMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *BookMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *BookMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *BookMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *BookMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetInternalNotes sets the "internal_notes" field.
+func (m *BookMutation) SetInternalNotes(s string) {
+	m.internal_notes = &s
+}
+
+// InternalNotes returns the value of the "internal_notes" field in the mutation.
+func (m *BookMutation) InternalNotes() (r string, exists bool) {
+	v := m.internal_notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternalNotes returns the old "internal_notes" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldInternalNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalNotes: %w", err)
+	}
+	return oldValue.InternalNotes, nil
+}
+
+// ClearInternalNotes clears the value of the "internal_notes" field.
+func (m *BookMutation) ClearInternalNotes() {
+	m.internal_notes = nil
+	m.clearedFields[book.FieldInternalNotes] = struct{}{}
+}
+
+// InternalNotesCleared returns if the "internal_notes" field was cleared in this mutation.
+func (m *BookMutation) InternalNotesCleared() bool {
+	_, ok := m.clearedFields[book.FieldInternalNotes]
+	return ok
+}
+
+// ResetInternalNotes resets all changes to the "internal_notes" field.
+func (m *BookMutation) ResetInternalNotes() {
+	m.internal_notes = nil
+	delete(m.clearedFields, book.FieldInternalNotes)
+}
+
+// SetAuthorID sets the "author" edge to the Author entity by id.
+func (m *BookMutation) SetAuthorID(id int) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the Author entity.
+func (m *BookMutation) ClearAuthor() {
+	m.clearedauthor = true
+}
+
+// AuthorCleared reports if the "author" edge to the Author entity was cleared.
+func (m *BookMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *BookMutation) AuthorID() (id int, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) AuthorIDs() (ids []int) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *BookMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
+func (m *BookMutation) AddReviewIDs(ids ...int) {
+	if m.reviews == nil {
+		m.reviews = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviews clears the "reviews" edge to the Review entity.
+func (m *BookMutation) ClearReviews() {
+	m.clearedreviews = true
+}
+
+// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
+func (m *BookMutation) ReviewsCleared() bool {
+	return m.clearedreviews
+}
+
+// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
+func (m *BookMutation) RemoveReviewIDs(ids ...int) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
+func (m *BookMutation) RemovedReviewsIDs() (ids []int) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *BookMutation) ReviewsIDs() (ids []int) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *BookMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
+}
+
+// SetPublisherID sets the "publisher" edge to the Publisher entity by id.
+func (m *BookMutation) SetPublisherID(id uuid.UUID) {
+	m.publisher = &id
+}
+
+// ClearPublisher clears the "publisher" edge to the Publisher entity.
+func (m *BookMutation) ClearPublisher() {
+	m.clearedpublisher = true
+}
+
+// PublisherCleared reports if the "publisher" edge to the Publisher entity was cleared.
+func (m *BookMutation) PublisherCleared() bool {
+	return m.clearedpublisher
+}
+
+// PublisherID returns the "publisher" edge ID in the mutation.
+func (m *BookMutation) PublisherID() (id uuid.UUID, exists bool) {
+	if m.publisher != nil {
+		return *m.publisher, true
+	}
+	return
+}
+
+// PublisherIDs returns the "publisher" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PublisherID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) PublisherIDs() (ids []uuid.UUID) {
+	if id := m.publisher; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPublisher resets all changes to the "publisher" edge.
+func (m *BookMutation) ResetPublisher() {
+	m.publisher = nil
+	m.clearedpublisher = false
+}
+
+// Where appends a list predicates to the BookMutation builder.
+func (m *BookMutation) Where(ps ...predicate.Book) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Book, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Book).
+func (m *BookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.title != nil {
+		fields = append(fields, book.FieldTitle)
+	}
+	if m.slug != nil {
+		fields = append(fields, book.FieldSlug)
+	}
+	if m.pages != nil {
+		fields = append(fields, book.FieldPages)
+	}
+	if m.published != nil {
+		fields = append(fields, book.FieldPublished)
+	}
+	if m.format != nil {
+		fields = append(fields, book.FieldFormat)
+	}
+	if m.published_at != nil {
+		fields = append(fields, book.FieldPublishedAt)
+	}
+	if m.tags != nil {
+		fields = append(fields, book.FieldTags)
+	}
+	if m.editions != nil {
+		fields = append(fields, book.FieldEditions)
+	}
+	if m.metadata != nil {
+		fields = append(fields, book.FieldMetadata)
+	}
+	if m.cover != nil {
+		fields = append(fields, book.FieldCover)
+	}
+	if m.created_at != nil {
+		fields = append(fields, book.FieldCreatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, book.FieldVersion)
+	}
+	if m.internal_notes != nil {
+		fields = append(fields, book.FieldInternalNotes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case book.FieldTitle:
+		return m.Title()
+	case book.FieldSlug:
+		return m.Slug()
+	case book.FieldPages:
+		return m.Pages()
+	case book.FieldPublished:
+		return m.Published()
+	case book.FieldFormat:
+		return m.Format()
+	case book.FieldPublishedAt:
+		return m.PublishedAt()
+	case book.FieldTags:
+		return m.Tags()
+	case book.FieldEditions:
+		return m.Editions()
+	case book.FieldMetadata:
+		return m.Metadata()
+	case book.FieldCover:
+		return m.Cover()
+	case book.FieldCreatedAt:
+		return m.CreatedAt()
+	case book.FieldVersion:
+		return m.Version()
+	case book.FieldInternalNotes:
+		return m.InternalNotes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case book.FieldTitle:
+		return m.OldTitle(ctx)
+	case book.FieldSlug:
+		return m.OldSlug(ctx)
+	case book.FieldPages:
+		return m.OldPages(ctx)
+	case book.FieldPublished:
+		return m.OldPublished(ctx)
+	case book.FieldFormat:
+		return m.OldFormat(ctx)
+	case book.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case book.FieldTags:
+		return m.OldTags(ctx)
+	case book.FieldEditions:
+		return m.OldEditions(ctx)
+	case book.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case book.FieldCover:
+		return m.OldCover(ctx)
+	case book.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case book.FieldVersion:
+		return m.OldVersion(ctx)
+	case book.FieldInternalNotes:
+		return m.OldInternalNotes(ctx)
+	}
+	return nil, fmt.Errorf("unknown Book field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case book.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case book.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case book.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPages(v)
+		return nil
+	case book.FieldPublished:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublished(v)
+		return nil
+	case book.FieldFormat:
+		v, ok := value.(book.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case book.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case book.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case book.FieldEditions:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditions(v)
+		return nil
+	case book.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case book.FieldCover:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCover(v)
+		return nil
+	case book.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case book.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case book.FieldInternalNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternalNotes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Book field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookMutation) AddedFields() []string {
+	var fields []string
+	if m.addpages != nil {
+		fields = append(fields, book.FieldPages)
+	}
+	if m.addversion != nil {
+		fields = append(fields, book.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case book.FieldPages:
+		return m.AddedPages()
+	case book.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case book.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPages(v)
+		return nil
+	case book.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Book numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(book.FieldSlug) {
+		fields = append(fields, book.FieldSlug)
+	}
+	if m.FieldCleared(book.FieldPublishedAt) {
+		fields = append(fields, book.FieldPublishedAt)
+	}
+	if m.FieldCleared(book.FieldTags) {
+		fields = append(fields, book.FieldTags)
+	}
+	if m.FieldCleared(book.FieldEditions) {
+		fields = append(fields, book.FieldEditions)
+	}
+	if m.FieldCleared(book.FieldMetadata) {
+		fields = append(fields, book.FieldMetadata)
+	}
+	if m.FieldCleared(book.FieldCover) {
+		fields = append(fields, book.FieldCover)
+	}
+	if m.FieldCleared(book.FieldInternalNotes) {
+		fields = append(fields, book.FieldInternalNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookMutation) ClearField(name string) error {
+	switch name {
+	case book.FieldSlug:
+		m.ClearSlug()
+		return nil
+	case book.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case book.FieldTags:
+		m.ClearTags()
+		return nil
+	case book.FieldEditions:
+		m.ClearEditions()
+		return nil
+	case book.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case book.FieldCover:
+		m.ClearCover()
+		return nil
+	case book.FieldInternalNotes:
+		m.ClearInternalNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Book nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookMutation) ResetField(name string) error {
+	switch name {
+	case book.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case book.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case book.FieldPages:
+		m.ResetPages()
+		return nil
+	case book.FieldPublished:
+		m.ResetPublished()
+		return nil
+	case book.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case book.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case book.FieldTags:
+		m.ResetTags()
+		return nil
+	case book.FieldEditions:
+		m.ResetEditions()
+		return nil
+	case book.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case book.FieldCover:
+		m.ResetCover()
+		return nil
+	case book.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case book.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case book.FieldInternalNotes:
+		m.ResetInternalNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Book field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.author != nil {
+		edges = append(edges, book.EdgeAuthor)
+	}
+	if m.reviews != nil {
+		edges = append(edges, book.EdgeReviews)
+	}
+	if m.publisher != nil {
+		edges = append(edges, book.EdgePublisher)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	case book.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgePublisher:
+		if id := m.publisher; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedreviews != nil {
+		edges = append(edges, book.EdgeReviews)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedauthor {
+		edges = append(edges, book.EdgeAuthor)
+	}
+	if m.clearedreviews {
+		edges = append(edges, book.EdgeReviews)
+	}
+	if m.clearedpublisher {
+		edges = append(edges, book.EdgePublisher)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case book.EdgeAuthor:
+		return m.clearedauthor
+	case book.EdgeReviews:
+		return m.clearedreviews
+	case book.EdgePublisher:
+		return m.clearedpublisher
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookMutation) ClearEdge(name string) error {
+	switch name {
+	case book.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	case book.EdgePublisher:
+		m.ClearPublisher()
+		return nil
+	}
+	return fmt.Errorf("unknown Book unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookMutation) ResetEdge(name string) error {
+	switch name {
+	case book.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	case book.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	case book.EdgePublisher:
+		m.ResetPublisher()
+		return nil
+	}
+	return fmt.Errorf("unknown Book edge %s", name)
+}
+
+// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
+type PermissionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	groups        map[int]struct{}
+	removedgroups map[int]struct{}
+	clearedgroups bool
+	done          bool
+	oldValue      func(context.Context) (*Permission, error)
+	predicates    []predicate.Permission
+}
+
+var _ ent.Mutation = (*PermissionMutation)(nil)
+
+// permissionOption allows management of the mutation configuration using functional options.
+type permissionOption func(*PermissionMutation)
+
+// newPermissionMutation creates new mutation for the Permission entity.
+func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
+	m := &PermissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePermission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPermissionID sets the ID field of the mutation.
+func withPermissionID(id int) permissionOption {
+	return func(m *PermissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Permission
+		)
+		m.oldValue = func(ctx context.Context) (*Permission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Permission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPermission sets the old Permission of the mutation.
+func withPermission(node *Permission) permissionOption {
+	return func(m *PermissionMutation) {
+		m.oldValue = func(context.Context) (*Permission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PermissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PermissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PermissionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PermissionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PermissionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInternalNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PermissionMutation) ResetName() {
+	m.name = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the PermissionGroup entity by ids.
+func (m *PermissionMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the PermissionGroup entity.
+func (m *PermissionMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the PermissionGroup entity was cleared.
+func (m *PermissionMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the PermissionGroup entity by IDs.
+func (m *PermissionMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the PermissionGroup entity.
+func (m *PermissionMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *PermissionMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *PermissionMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// Where appends a list predicates to the PermissionMutation builder.
+func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Permission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PermissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PermissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Permission).
+func (m *PermissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PermissionMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, permission.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case permission.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case permission.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Permission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case permission.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PermissionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PermissionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PermissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PermissionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Permission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PermissionMutation) ResetField(name string) error {
+	switch name {
+	case permission.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.groups != nil {
+		edges = append(edges, permission.EdgeGroups)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedgroups != nil {
+		edges = append(edges, permission.EdgeGroups)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.InternalNotes, nil
+	return nil
 }
 
-// ClearInternalNotes clears the value of the "internal_notes" field.
-func (m *BookMutation) ClearInternalNotes() {
-	m.internal_notes = nil
-	m.clearedFields[book.FieldInternalNotes] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroups {
+		edges = append(edges, permission.EdgeGroups)
+	}
+	return edges
 }
 
-// InternalNotesCleared returns if the "internal_notes" field was cleared in this mutation.
-func (m *BookMutation) InternalNotesCleared() bool {
-	_, ok := m.clearedFields[book.FieldInternalNotes]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case permission.EdgeGroups:
+		return m.clearedgroups
+	}
+	return false
 }
 
-// ResetInternalNotes resets all changes to the "internal_notes" field.
-func (m *BookMutation) ResetInternalNotes() {
-	m.internal_notes = nil
-	delete(m.clearedFields, book.FieldInternalNotes)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PermissionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permission unique edge %s", name)
 }
 
-// SetAuthorID sets the "author" edge to the Author entity by id.
-func (m *BookMutation) SetAuthorID(id int) {
-	m.author = &id
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case permission.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission edge %s", name)
 }
 
-// ClearAuthor clears the "author" edge to the Author entity.
-func (m *BookMutation) ClearAuthor() {
-	m.clearedauthor = true
+// PermissionGroupMutation represents an operation that mutates the PermissionGroup nodes in the graph.
+type PermissionGroupMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	clearedFields      map[string]struct{}
+	permissions        map[int]struct{}
+	removedpermissions map[int]struct{}
+	clearedpermissions bool
+	users              map[int]struct{}
+	removedusers       map[int]struct{}
+	clearedusers       bool
+	done               bool
+	oldValue           func(context.Context) (*PermissionGroup, error)
+	predicates         []predicate.PermissionGroup
 }
 
-// AuthorCleared reports if the "author" edge to the Author entity was cleared.
-func (m *BookMutation) AuthorCleared() bool {
-	return m.clearedauthor
+var _ ent.Mutation = (*PermissionGroupMutation)(nil)
+
+// permissiongroupOption allows management of the mutation configuration using functional options.
+type permissiongroupOption func(*PermissionGroupMutation)
+
+// newPermissionGroupMutation creates new mutation for the PermissionGroup entity.
+func newPermissionGroupMutation(c config, op Op, opts ...permissiongroupOption) *PermissionGroupMutation {
+	m := &PermissionGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePermissionGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// AuthorID returns the "author" edge ID in the mutation.
-func (m *BookMutation) AuthorID() (id int, exists bool) {
-	if m.author != nil {
-		return *m.author, true
+// withPermissionGroupID sets the ID field of the mutation.
+func withPermissionGroupID(id int) permissiongroupOption {
+	return func(m *PermissionGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PermissionGroup
+		)
+		m.oldValue = func(ctx context.Context) (*PermissionGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PermissionGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPermissionGroup sets the old PermissionGroup of the mutation.
+func withPermissionGroup(node *PermissionGroup) permissiongroupOption {
+	return func(m *PermissionGroupMutation) {
+		m.oldValue = func(context.Context) (*PermissionGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PermissionGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PermissionGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PermissionGroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PermissionGroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PermissionGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PermissionGroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PermissionGroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PermissionGroup entity.
+// If the PermissionGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionGroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PermissionGroupMutation) ResetName() {
+	m.name = nil
+}
+
+// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
+func (m *PermissionGroupMutation) AddPermissionIDs(ids ...int) {
+	if m.permissions == nil {
+		m.permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPermissions clears the "permissions" edge to the Permission entity.
+func (m *PermissionGroupMutation) ClearPermissions() {
+	m.clearedpermissions = true
+}
+
+// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
+func (m *PermissionGroupMutation) PermissionsCleared() bool {
+	return m.clearedpermissions
+}
+
+// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
+func (m *PermissionGroupMutation) RemovePermissionIDs(ids ...int) {
+	if m.removedpermissions == nil {
+		m.removedpermissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.permissions, ids[i])
+		m.removedpermissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
+func (m *PermissionGroupMutation) RemovedPermissionsIDs() (ids []int) {
+	for id := range m.removedpermissions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// AuthorIDs returns the "author" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AuthorID instead. It exists only for internal usage by the builders.
-func (m *BookMutation) AuthorIDs() (ids []int) {
-	if id := m.author; id != nil {
-		ids = append(ids, *id)
+// PermissionsIDs returns the "permissions" edge IDs in the mutation.
+func (m *PermissionGroupMutation) PermissionsIDs() (ids []int) {
+	for id := range m.permissions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAuthor resets all changes to the "author" edge.
-func (m *BookMutation) ResetAuthor() {
-	m.author = nil
-	m.clearedauthor = false
+// ResetPermissions resets all changes to the "permissions" edge.
+func (m *PermissionGroupMutation) ResetPermissions() {
+	m.permissions = nil
+	m.clearedpermissions = false
+	m.removedpermissions = nil
 }
 
-// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
-func (m *BookMutation) AddReviewIDs(ids ...int) {
-	if m.reviews == nil {
-		m.reviews = make(map[int]struct{})
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *PermissionGroupMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.reviews[ids[i]] = struct{}{}
+		m.users[ids[i]] = struct{}{}
 	}
 }
 
-// ClearReviews clears the "reviews" edge to the Review entity.
-func (m *BookMutation) ClearReviews() {
-	m.clearedreviews = true
+// ClearUsers clears the "users" edge to the User entity.
+func (m *PermissionGroupMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
-func (m *BookMutation) ReviewsCleared() bool {
-	return m.clearedreviews
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *PermissionGroupMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
-func (m *BookMutation) RemoveReviewIDs(ids ...int) {
-	if m.removedreviews == nil {
-		m.removedreviews = make(map[int]struct{})
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *PermissionGroupMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.reviews, ids[i])
-		m.removedreviews[ids[i]] = struct{}{}
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
-func (m *BookMutation) RemovedReviewsIDs() (ids []int) {
-	for id := range m.removedreviews {
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *PermissionGroupMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ReviewsIDs returns the "reviews" edge IDs in the mutation.
-func (m *BookMutation) ReviewsIDs() (ids []int) {
-	for id := range m.reviews {
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *PermissionGroupMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetReviews resets all changes to the "reviews" edge.
-func (m *BookMutation) ResetReviews() {
-	m.reviews = nil
-	m.clearedreviews = false
-	m.removedreviews = nil
+// ResetUsers resets all changes to the "users" edge.
+func (m *PermissionGroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// Where appends a list predicates to the BookMutation builder.
-func (m *BookMutation) Where(ps ...predicate.Book) {
+// Where appends a list predicates to the PermissionGroupMutation builder.
+func (m *PermissionGroupMutation) Where(ps ...predicate.PermissionGroup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BookMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PermissionGroupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Book, len(ps))
+func (m *PermissionGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PermissionGroup, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1052,42 +3487,27 @@ func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BookMutation) Op() Op {
+func (m *PermissionGroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BookMutation) SetOp(op Op) {
+func (m *PermissionGroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Book).
-func (m *BookMutation) Type() string {
+// Type returns the node type of this mutation (PermissionGroup).
+func (m *PermissionGroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BookMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.title != nil {
-		fields = append(fields, book.FieldTitle)
-	}
-	if m.pages != nil {
-		fields = append(fields, book.FieldPages)
-	}
-	if m.published != nil {
-		fields = append(fields, book.FieldPublished)
-	}
-	if m.published_at != nil {
-		fields = append(fields, book.FieldPublishedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, book.FieldCreatedAt)
-	}
-	if m.internal_notes != nil {
-		fields = append(fields, book.FieldInternalNotes)
+func (m *PermissionGroupMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, permissiongroup.FieldName)
 	}
 	return fields
 }
@@ -1095,20 +3515,10 @@ func (m *BookMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BookMutation) Field(name string) (ent.Value, bool) {
+func (m *PermissionGroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case book.FieldTitle:
-		return m.Title()
-	case book.FieldPages:
-		return m.Pages()
-	case book.FieldPublished:
-		return m.Published()
-	case book.FieldPublishedAt:
-		return m.PublishedAt()
-	case book.FieldCreatedAt:
-		return m.CreatedAt()
-	case book.FieldInternalNotes:
-		return m.InternalNotes()
+	case permissiongroup.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -1116,195 +3526,107 @@ func (m *BookMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PermissionGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case book.FieldTitle:
-		return m.OldTitle(ctx)
-	case book.FieldPages:
-		return m.OldPages(ctx)
-	case book.FieldPublished:
-		return m.OldPublished(ctx)
-	case book.FieldPublishedAt:
-		return m.OldPublishedAt(ctx)
-	case book.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case book.FieldInternalNotes:
-		return m.OldInternalNotes(ctx)
+	case permissiongroup.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown Book field %s", name)
+	return nil, fmt.Errorf("unknown PermissionGroup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BookMutation) SetField(name string, value ent.Value) error {
+func (m *PermissionGroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case book.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case book.FieldPages:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPages(v)
-		return nil
-	case book.FieldPublished:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublished(v)
-		return nil
-	case book.FieldPublishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublishedAt(v)
-		return nil
-	case book.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case book.FieldInternalNotes:
+	case permissiongroup.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInternalNotes(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Book field %s", name)
+	return fmt.Errorf("unknown PermissionGroup field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BookMutation) AddedFields() []string {
-	var fields []string
-	if m.addpages != nil {
-		fields = append(fields, book.FieldPages)
-	}
-	return fields
+func (m *PermissionGroupMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case book.FieldPages:
-		return m.AddedPages()
-	}
+func (m *PermissionGroupMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BookMutation) AddField(name string, value ent.Value) error {
+func (m *PermissionGroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case book.FieldPages:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPages(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Book numeric field %s", name)
+	return fmt.Errorf("unknown PermissionGroup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BookMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(book.FieldPublishedAt) {
-		fields = append(fields, book.FieldPublishedAt)
-	}
-	if m.FieldCleared(book.FieldInternalNotes) {
-		fields = append(fields, book.FieldInternalNotes)
-	}
-	return fields
+func (m *PermissionGroupMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BookMutation) FieldCleared(name string) bool {
+func (m *PermissionGroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BookMutation) ClearField(name string) error {
-	switch name {
-	case book.FieldPublishedAt:
-		m.ClearPublishedAt()
-		return nil
-	case book.FieldInternalNotes:
-		m.ClearInternalNotes()
-		return nil
-	}
-	return fmt.Errorf("unknown Book nullable field %s", name)
+func (m *PermissionGroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PermissionGroup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BookMutation) ResetField(name string) error {
-	switch name {
-	case book.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case book.FieldPages:
-		m.ResetPages()
-		return nil
-	case book.FieldPublished:
-		m.ResetPublished()
-		return nil
-	case book.FieldPublishedAt:
-		m.ResetPublishedAt()
-		return nil
-	case book.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case book.FieldInternalNotes:
-		m.ResetInternalNotes()
+func (m *PermissionGroupMutation) ResetField(name string) error {
+	switch name {
+	case permissiongroup.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Book field %s", name)
+	return fmt.Errorf("unknown PermissionGroup field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BookMutation) AddedEdges() []string {
+func (m *PermissionGroupMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.author != nil {
-		edges = append(edges, book.EdgeAuthor)
+	if m.permissions != nil {
+		edges = append(edges, permissiongroup.EdgePermissions)
 	}
-	if m.reviews != nil {
-		edges = append(edges, book.EdgeReviews)
+	if m.users != nil {
+		edges = append(edges, permissiongroup.EdgeUsers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BookMutation) AddedIDs(name string) []ent.Value {
+func (m *PermissionGroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case book.EdgeAuthor:
-		if id := m.author; id != nil {
-			return []ent.Value{*id}
+	case permissiongroup.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.permissions))
+		for id := range m.permissions {
+			ids = append(ids, id)
 		}
-	case book.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.reviews))
-		for id := range m.reviews {
+		return ids
+	case permissiongroup.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1313,21 +3635,30 @@ func (m *BookMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BookMutation) RemovedEdges() []string {
+func (m *PermissionGroupMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedreviews != nil {
-		edges = append(edges, book.EdgeReviews)
+	if m.removedpermissions != nil {
+		edges = append(edges, permissiongroup.EdgePermissions)
+	}
+	if m.removedusers != nil {
+		edges = append(edges, permissiongroup.EdgeUsers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+func (m *PermissionGroupMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case book.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.removedreviews))
-		for id := range m.removedreviews {
+	case permissiongroup.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.removedpermissions))
+		for id := range m.removedpermissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case permissiongroup.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1336,81 +3667,80 @@ func (m *BookMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BookMutation) ClearedEdges() []string {
+func (m *PermissionGroupMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedauthor {
-		edges = append(edges, book.EdgeAuthor)
+	if m.clearedpermissions {
+		edges = append(edges, permissiongroup.EdgePermissions)
 	}
-	if m.clearedreviews {
-		edges = append(edges, book.EdgeReviews)
+	if m.clearedusers {
+		edges = append(edges, permissiongroup.EdgeUsers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BookMutation) EdgeCleared(name string) bool {
+func (m *PermissionGroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case book.EdgeAuthor:
-		return m.clearedauthor
-	case book.EdgeReviews:
-		return m.clearedreviews
+	case permissiongroup.EdgePermissions:
+		return m.clearedpermissions
+	case permissiongroup.EdgeUsers:
+		return m.clearedusers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BookMutation) ClearEdge(name string) error {
+func (m *PermissionGroupMutation) ClearEdge(name string) error {
 	switch name {
-	case book.EdgeAuthor:
-		m.ClearAuthor()
-		return nil
 	}
-	return fmt.Errorf("unknown Book unique edge %s", name)
+	return fmt.Errorf("unknown PermissionGroup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BookMutation) ResetEdge(name string) error {
+func (m *PermissionGroupMutation) ResetEdge(name string) error {
 	switch name {
-	case book.EdgeAuthor:
-		m.ResetAuthor()
+	case permissiongroup.EdgePermissions:
+		m.ResetPermissions()
 		return nil
-	case book.EdgeReviews:
-		m.ResetReviews()
+	case permissiongroup.EdgeUsers:
+		m.ResetUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown Book edge %s", name)
+	return fmt.Errorf("unknown PermissionGroup edge %s", name)
 }
 
-// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
-type PermissionMutation struct {
+// PublisherMutation represents an operation that mutates the Publisher nodes in the graph.
+type PublisherMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
+	id            *uuid.UUID
+	deleted_at    *time.Time
 	name          *string
+	catalog       *string
 	clearedFields map[string]struct{}
-	groups        map[int]struct{}
-	removedgroups map[int]struct{}
-	clearedgroups bool
+	books         map[int]struct{}
+	removedbooks  map[int]struct{}
+	clearedbooks  bool
 	done          bool
-	oldValue      func(context.Context) (*Permission, error)
-	predicates    []predicate.Permission
+	oldValue      func(context.Context) (*Publisher, error)
+	predicates    []predicate.Publisher
 }
 
-var _ ent.Mutation = (*PermissionMutation)(nil)
+var _ ent.Mutation = (*PublisherMutation)(nil)
 
-// permissionOption allows management of the mutation configuration using functional options.
-type permissionOption func(*PermissionMutation)
+// publisherOption allows management of the mutation configuration using functional options.
+type publisherOption func(*PublisherMutation)
 
-// newPermissionMutation creates new mutation for the Permission entity.
-func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
-	m := &PermissionMutation{
+// newPublisherMutation creates new mutation for the Publisher entity.
+func newPublisherMutation(c config, op Op, opts ...publisherOption) *PublisherMutation {
+	m := &PublisherMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermission,
+		typ:           TypePublisher,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1419,20 +3749,20 @@ func newPermissionMutation(c config, op Op, opts ...permissionOption) *Permissio
 	return m
 }
 
-// withPermissionID sets the ID field of the mutation.
-func withPermissionID(id int) permissionOption {
-	return func(m *PermissionMutation) {
+// withPublisherID sets the ID field of the mutation.
+func withPublisherID(id uuid.UUID) publisherOption {
+	return func(m *PublisherMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Permission
+			value *Publisher
 		)
-		m.oldValue = func(ctx context.Context) (*Permission, error) {
+		m.oldValue = func(ctx context.Context) (*Publisher, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Permission.Get(ctx, id)
+					value, err = m.Client().Publisher.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1441,10 +3771,10 @@ func withPermissionID(id int) permissionOption {
 	}
 }
 
-// withPermission sets the old Permission of the mutation.
-func withPermission(node *Permission) permissionOption {
-	return func(m *PermissionMutation) {
-		m.oldValue = func(context.Context) (*Permission, error) {
+// withPublisher sets the old Publisher of the mutation.
+func withPublisher(node *Publisher) publisherOption {
+	return func(m *PublisherMutation) {
+		m.oldValue = func(context.Context) (*Publisher, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1453,7 +3783,7 @@ func withPermission(node *Permission) permissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionMutation) Client() *Client {
+func (m PublisherMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1461,7 +3791,7 @@ func (m PermissionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PermissionMutation) Tx() (*Tx, error) {
+func (m PublisherMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1470,9 +3800,15 @@ func (m PermissionMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Publisher entities.
+func (m *PublisherMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PermissionMutation) ID() (id int, exists bool) {
+func (m *PublisherMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1483,28 +3819,77 @@ func (m *PermissionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PublisherMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Publisher.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PublisherMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PublisherMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Publisher entity.
+// If the Publisher object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublisherMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PublisherMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[publisher.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PublisherMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[publisher.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PublisherMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, publisher.FieldDeletedAt)
+}
+
 // SetName sets the "name" field.
-func (m *PermissionMutation) SetName(s string) {
+func (m *PublisherMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *PermissionMutation) Name() (r string, exists bool) {
+func (m *PublisherMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1512,10 +3897,10 @@ func (m *PermissionMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Publisher entity.
+// If the Publisher object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PublisherMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1530,73 +3915,122 @@ func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *PermissionMutation) ResetName() {
+func (m *PublisherMutation) ResetName() {
 	m.name = nil
 }
 
-// AddGroupIDs adds the "groups" edge to the PermissionGroup entity by ids.
-func (m *PermissionMutation) AddGroupIDs(ids ...int) {
-	if m.groups == nil {
-		m.groups = make(map[int]struct{})
+// SetCatalog sets the "catalog" field.
+func (m *PublisherMutation) SetCatalog(s string) {
+	m.catalog = &s
+}
+
+// Catalog returns the value of the "catalog" field in the mutation.
+func (m *PublisherMutation) Catalog() (r string, exists bool) {
+	v := m.catalog
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCatalog returns the old "catalog" field's value of the Publisher entity.
+// If the Publisher object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublisherMutation) OldCatalog(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCatalog is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCatalog requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatalog: %w", err)
+	}
+	return oldValue.Catalog, nil
+}
+
+// ClearCatalog clears the value of the "catalog" field.
+func (m *PublisherMutation) ClearCatalog() {
+	m.catalog = nil
+	m.clearedFields[publisher.FieldCatalog] = struct{}{}
+}
+
+// CatalogCleared returns if the "catalog" field was cleared in this mutation.
+func (m *PublisherMutation) CatalogCleared() bool {
+	_, ok := m.clearedFields[publisher.FieldCatalog]
+	return ok
+}
+
+// ResetCatalog resets all changes to the "catalog" field.
+func (m *PublisherMutation) ResetCatalog() {
+	m.catalog = nil
+	delete(m.clearedFields, publisher.FieldCatalog)
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *PublisherMutation) AddBookIDs(ids ...int) {
+	if m.books == nil {
+		m.books = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
+		m.books[ids[i]] = struct{}{}
 	}
 }
 
-// ClearGroups clears the "groups" edge to the PermissionGroup entity.
-func (m *PermissionMutation) ClearGroups() {
-	m.clearedgroups = true
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *PublisherMutation) ClearBooks() {
+	m.clearedbooks = true
 }
 
-// GroupsCleared reports if the "groups" edge to the PermissionGroup entity was cleared.
-func (m *PermissionMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *PublisherMutation) BooksCleared() bool {
+	return m.clearedbooks
 }
 
-// RemoveGroupIDs removes the "groups" edge to the PermissionGroup entity by IDs.
-func (m *PermissionMutation) RemoveGroupIDs(ids ...int) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[int]struct{})
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *PublisherMutation) RemoveBookIDs(ids ...int) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedGroups returns the removed IDs of the "groups" edge to the PermissionGroup entity.
-func (m *PermissionMutation) RemovedGroupsIDs() (ids []int) {
-	for id := range m.removedgroups {
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *PublisherMutation) RemovedBooksIDs() (ids []int) {
+	for id := range m.removedbooks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *PermissionMutation) GroupsIDs() (ids []int) {
-	for id := range m.groups {
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *PublisherMutation) BooksIDs() (ids []int) {
+	for id := range m.books {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroups resets all changes to the "groups" edge.
-func (m *PermissionMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+// ResetBooks resets all changes to the "books" edge.
+func (m *PublisherMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
 }
 
-// Where appends a list predicates to the PermissionMutation builder.
-func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+// Where appends a list predicates to the PublisherMutation builder.
+func (m *PublisherMutation) Where(ps ...predicate.Publisher) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PublisherMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Permission, len(ps))
+func (m *PublisherMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Publisher, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1604,27 +4038,33 @@ func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PermissionMutation) Op() Op {
+func (m *PublisherMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PermissionMutation) SetOp(op Op) {
+func (m *PublisherMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Permission).
-func (m *PermissionMutation) Type() string {
+// Type returns the node type of this mutation (Publisher).
+func (m *PublisherMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PermissionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *PublisherMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.deleted_at != nil {
+		fields = append(fields, publisher.FieldDeletedAt)
+	}
 	if m.name != nil {
-		fields = append(fields, permission.FieldName)
+		fields = append(fields, publisher.FieldName)
+	}
+	if m.catalog != nil {
+		fields = append(fields, publisher.FieldCatalog)
 	}
 	return fields
 }
@@ -1632,10 +4072,14 @@ func (m *PermissionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+func (m *PublisherMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case permission.FieldName:
+	case publisher.FieldDeletedAt:
+		return m.DeletedAt()
+	case publisher.FieldName:
 		return m.Name()
+	case publisher.FieldCatalog:
+		return m.Catalog()
 	}
 	return nil, false
 }
@@ -1643,98 +4087,137 @@ func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PublisherMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case permission.FieldName:
+	case publisher.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case publisher.FieldName:
 		return m.OldName(ctx)
+	case publisher.FieldCatalog:
+		return m.OldCatalog(ctx)
 	}
-	return nil, fmt.Errorf("unknown Permission field %s", name)
+	return nil, fmt.Errorf("unknown Publisher field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+func (m *PublisherMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case permission.FieldName:
+	case publisher.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case publisher.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case publisher.FieldCatalog:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatalog(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown Publisher field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PermissionMutation) AddedFields() []string {
+func (m *PublisherMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PublisherMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+func (m *PublisherMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Permission numeric field %s", name)
+	return fmt.Errorf("unknown Publisher numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PermissionMutation) ClearedFields() []string {
-	return nil
+func (m *PublisherMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(publisher.FieldDeletedAt) {
+		fields = append(fields, publisher.FieldDeletedAt)
+	}
+	if m.FieldCleared(publisher.FieldCatalog) {
+		fields = append(fields, publisher.FieldCatalog)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PermissionMutation) FieldCleared(name string) bool {
+func (m *PublisherMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PermissionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Permission nullable field %s", name)
+func (m *PublisherMutation) ClearField(name string) error {
+	switch name {
+	case publisher.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case publisher.FieldCatalog:
+		m.ClearCatalog()
+		return nil
+	}
+	return fmt.Errorf("unknown Publisher nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PermissionMutation) ResetField(name string) error {
+func (m *PublisherMutation) ResetField(name string) error {
 	switch name {
-	case permission.FieldName:
+	case publisher.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case publisher.FieldName:
 		m.ResetName()
 		return nil
+	case publisher.FieldCatalog:
+		m.ResetCatalog()
+		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown Publisher field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionMutation) AddedEdges() []string {
+func (m *PublisherMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.groups != nil {
-		edges = append(edges, permission.EdgeGroups)
+	if m.books != nil {
+		edges = append(edges, publisher.EdgeBooks)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+func (m *PublisherMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case permission.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
+	case publisher.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1743,21 +4226,21 @@ func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionMutation) RemovedEdges() []string {
+func (m *PublisherMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedgroups != nil {
-		edges = append(edges, permission.EdgeGroups)
+	if m.removedbooks != nil {
+		edges = append(edges, publisher.EdgeBooks)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+func (m *PublisherMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case permission.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
+	case publisher.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1766,73 +4249,73 @@ func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionMutation) ClearedEdges() []string {
+func (m *PublisherMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedgroups {
-		edges = append(edges, permission.EdgeGroups)
+	if m.clearedbooks {
+		edges = append(edges, publisher.EdgeBooks)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PermissionMutation) EdgeCleared(name string) bool {
+func (m *PublisherMutation) EdgeCleared(name string) bool {
 	switch name {
-	case permission.EdgeGroups:
-		return m.clearedgroups
+	case publisher.EdgeBooks:
+		return m.clearedbooks
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PermissionMutation) ClearEdge(name string) error {
+func (m *PublisherMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Permission unique edge %s", name)
+	return fmt.Errorf("unknown Publisher unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PermissionMutation) ResetEdge(name string) error {
+func (m *PublisherMutation) ResetEdge(name string) error {
 	switch name {
-	case permission.EdgeGroups:
-		m.ResetGroups()
+	case publisher.EdgeBooks:
+		m.ResetBooks()
 		return nil
 	}
-	return fmt.Errorf("unknown Permission edge %s", name)
+	return fmt.Errorf("unknown Publisher edge %s", name)
 }
 
-// PermissionGroupMutation represents an operation that mutates the PermissionGroup nodes in the graph.
-type PermissionGroupMutation struct {
+// ReviewMutation represents an operation that mutates the Review nodes in the graph.
+type ReviewMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	clearedFields      map[string]struct{}
-	permissions        map[int]struct{}
-	removedpermissions map[int]struct{}
-	clearedpermissions bool
-	users              map[int]struct{}
-	removedusers       map[int]struct{}
-	clearedusers       bool
-	done               bool
-	oldValue           func(context.Context) (*PermissionGroup, error)
-	predicates         []predicate.PermissionGroup
+	op            Op
+	typ           string
+	id            *int
+	rating        *int
+	addrating     *int
+	body          *string
+	clearedFields map[string]struct{}
+	book          *int
+	clearedbook   bool
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Review, error)
+	predicates    []predicate.Review
 }
 
-var _ ent.Mutation = (*PermissionGroupMutation)(nil)
+var _ ent.Mutation = (*ReviewMutation)(nil)
 
-// permissiongroupOption allows management of the mutation configuration using functional options.
-type permissiongroupOption func(*PermissionGroupMutation)
+// reviewOption allows management of the mutation configuration using functional options.
+type reviewOption func(*ReviewMutation)
 
-// newPermissionGroupMutation creates new mutation for the PermissionGroup entity.
-func newPermissionGroupMutation(c config, op Op, opts ...permissiongroupOption) *PermissionGroupMutation {
-	m := &PermissionGroupMutation{
+// newReviewMutation creates new mutation for the Review entity.
+func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
+	m := &ReviewMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermissionGroup,
+		typ:           TypeReview,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1841,20 +4324,20 @@ func newPermissionGroupMutation(c config, op Op, opts ...permissiongroupOption)
 	return m
 }
 
-// withPermissionGroupID sets the ID field of the mutation.
-func withPermissionGroupID(id int) permissiongroupOption {
-	return func(m *PermissionGroupMutation) {
+// withReviewID sets the ID field of the mutation.
+func withReviewID(id int) reviewOption {
+	return func(m *ReviewMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PermissionGroup
+			value *Review
 		)
-		m.oldValue = func(ctx context.Context) (*PermissionGroup, error) {
+		m.oldValue = func(ctx context.Context) (*Review, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PermissionGroup.Get(ctx, id)
+					value, err = m.Client().Review.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1863,10 +4346,10 @@ func withPermissionGroupID(id int) permissiongroupOption {
 	}
 }
 
-// withPermissionGroup sets the old PermissionGroup of the mutation.
-func withPermissionGroup(node *PermissionGroup) permissiongroupOption {
-	return func(m *PermissionGroupMutation) {
-		m.oldValue = func(context.Context) (*PermissionGroup, error) {
+// withReview sets the old Review of the mutation.
+func withReview(node *Review) reviewOption {
+	return func(m *ReviewMutation) {
+		m.oldValue = func(context.Context) (*Review, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1875,7 +4358,7 @@ func withPermissionGroup(node *PermissionGroup) permissiongroupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionGroupMutation) Client() *Client {
+func (m ReviewMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1883,7 +4366,7 @@ func (m PermissionGroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PermissionGroupMutation) Tx() (*Tx, error) {
+func (m ReviewMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1894,7 +4377,7 @@ func (m PermissionGroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PermissionGroupMutation) ID() (id int, exists bool) {
+func (m *ReviewMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1905,7 +4388,7 @@ func (m *PermissionGroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PermissionGroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ReviewMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1914,165 +4397,204 @@ func (m *PermissionGroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PermissionGroup.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PermissionGroupMutation) SetName(s string) {
-	m.name = &s
+// SetRating sets the "rating" field.
+func (m *ReviewMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PermissionGroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// Rating returns the value of the "rating" field in the mutation.
+func (m *ReviewMutation) Rating() (r int, exists bool) {
+	v := m.rating
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PermissionGroup entity.
-// If the PermissionGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldRating returns the old "rating" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionGroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ReviewMutation) OldRating(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRating requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Rating, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PermissionGroupMutation) ResetName() {
-	m.name = nil
+// AddRating adds i to the "rating" field.
+func (m *ReviewMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
+	}
 }
 
-// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
-func (m *PermissionGroupMutation) AddPermissionIDs(ids ...int) {
-	if m.permissions == nil {
-		m.permissions = make(map[int]struct{})
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *ReviewMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.permissions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *ReviewMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetBody sets the "body" field.
+func (m *ReviewMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *ReviewMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearPermissions clears the "permissions" edge to the Permission entity.
-func (m *PermissionGroupMutation) ClearPermissions() {
-	m.clearedpermissions = true
+// OldBody returns the old "body" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldBody(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
 }
 
-// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
-func (m *PermissionGroupMutation) PermissionsCleared() bool {
-	return m.clearedpermissions
+// ClearBody clears the value of the "body" field.
+func (m *ReviewMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[review.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *ReviewMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[review.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *ReviewMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, review.FieldBody)
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *ReviewMutation) SetBookID(id int) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *ReviewMutation) ClearBook() {
+	m.clearedbook = true
 }
 
-// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
-func (m *PermissionGroupMutation) RemovePermissionIDs(ids ...int) {
-	if m.removedpermissions == nil {
-		m.removedpermissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.permissions, ids[i])
-		m.removedpermissions[ids[i]] = struct{}{}
-	}
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *ReviewMutation) BookCleared() bool {
+	return m.clearedbook
 }
 
-// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
-func (m *PermissionGroupMutation) RemovedPermissionsIDs() (ids []int) {
-	for id := range m.removedpermissions {
-		ids = append(ids, id)
+// BookID returns the "book" edge ID in the mutation.
+func (m *ReviewMutation) BookID() (id int, exists bool) {
+	if m.book != nil {
+		return *m.book, true
 	}
 	return
 }
 
-// PermissionsIDs returns the "permissions" edge IDs in the mutation.
-func (m *PermissionGroupMutation) PermissionsIDs() (ids []int) {
-	for id := range m.permissions {
-		ids = append(ids, id)
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *ReviewMutation) BookIDs() (ids []int) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPermissions resets all changes to the "permissions" edge.
-func (m *PermissionGroupMutation) ResetPermissions() {
-	m.permissions = nil
-	m.clearedpermissions = false
-	m.removedpermissions = nil
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *PermissionGroupMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ResetBook resets all changes to the "book" edge.
+func (m *ReviewMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *PermissionGroupMutation) ClearUsers() {
-	m.clearedusers = true
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ReviewMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *PermissionGroupMutation) UsersCleared() bool {
-	return m.clearedusers
+// ClearUser clears the "user" edge to the User entity.
+func (m *ReviewMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *PermissionGroupMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ReviewMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *PermissionGroupMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// UserID returns the "user" edge ID in the mutation.
+func (m *ReviewMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *PermissionGroupMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ReviewMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *PermissionGroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *ReviewMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the PermissionGroupMutation builder.
-func (m *PermissionGroupMutation) Where(ps ...predicate.PermissionGroup) {
+// Where appends a list predicates to the ReviewMutation builder.
+func (m *ReviewMutation) Where(ps ...predicate.Review) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PermissionGroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReviewMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionGroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PermissionGroup, len(ps))
+func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Review, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2080,27 +4602,30 @@ func (m *PermissionGroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PermissionGroupMutation) Op() Op {
+func (m *ReviewMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PermissionGroupMutation) SetOp(op Op) {
+func (m *ReviewMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PermissionGroup).
-func (m *PermissionGroupMutation) Type() string {
+// Type returns the node type of this mutation (Review).
+func (m *ReviewMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PermissionGroupMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, permissiongroup.FieldName)
+func (m *ReviewMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.rating != nil {
+		fields = append(fields, review.FieldRating)
+	}
+	if m.body != nil {
+		fields = append(fields, review.FieldBody)
 	}
 	return fields
 }
@@ -2108,10 +4633,12 @@ func (m *PermissionGroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PermissionGroupMutation) Field(name string) (ent.Value, bool) {
+func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case permissiongroup.FieldName:
-		return m.Name()
+	case review.FieldRating:
+		return m.Rating()
+	case review.FieldBody:
+		return m.Body()
 	}
 	return nil, false
 }
@@ -2119,222 +4646,242 @@ func (m *PermissionGroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PermissionGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case permissiongroup.FieldName:
-		return m.OldName(ctx)
+	case review.FieldRating:
+		return m.OldRating(ctx)
+	case review.FieldBody:
+		return m.OldBody(ctx)
 	}
-	return nil, fmt.Errorf("unknown PermissionGroup field %s", name)
+	return nil, fmt.Errorf("unknown Review field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionGroupMutation) SetField(name string, value ent.Value) error {
+func (m *ReviewMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case permissiongroup.FieldName:
+	case review.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case review.FieldBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetBody(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PermissionGroup field %s", name)
+	return fmt.Errorf("unknown Review field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PermissionGroupMutation) AddedFields() []string {
-	return nil
+func (m *ReviewMutation) AddedFields() []string {
+	var fields []string
+	if m.addrating != nil {
+		fields = append(fields, review.FieldRating)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PermissionGroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case review.FieldRating:
+		return m.AddedRating()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionGroupMutation) AddField(name string, value ent.Value) error {
+func (m *ReviewMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case review.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PermissionGroup numeric field %s", name)
+	return fmt.Errorf("unknown Review numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PermissionGroupMutation) ClearedFields() []string {
-	return nil
+func (m *ReviewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(review.FieldBody) {
+		fields = append(fields, review.FieldBody)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PermissionGroupMutation) FieldCleared(name string) bool {
+func (m *ReviewMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PermissionGroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PermissionGroup nullable field %s", name)
+func (m *ReviewMutation) ClearField(name string) error {
+	switch name {
+	case review.FieldBody:
+		m.ClearBody()
+		return nil
+	}
+	return fmt.Errorf("unknown Review nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PermissionGroupMutation) ResetField(name string) error {
+func (m *ReviewMutation) ResetField(name string) error {
 	switch name {
-	case permissiongroup.FieldName:
-		m.ResetName()
+	case review.FieldRating:
+		m.ResetRating()
+		return nil
+	case review.FieldBody:
+		m.ResetBody()
 		return nil
 	}
-	return fmt.Errorf("unknown PermissionGroup field %s", name)
+	return fmt.Errorf("unknown Review field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionGroupMutation) AddedEdges() []string {
+func (m *ReviewMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.permissions != nil {
-		edges = append(edges, permissiongroup.EdgePermissions)
+	if m.book != nil {
+		edges = append(edges, review.EdgeBook)
 	}
-	if m.users != nil {
-		edges = append(edges, permissiongroup.EdgeUsers)
+	if m.user != nil {
+		edges = append(edges, review.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PermissionGroupMutation) AddedIDs(name string) []ent.Value {
+func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case permissiongroup.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.permissions))
-		for id := range m.permissions {
-			ids = append(ids, id)
+	case review.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case permissiongroup.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
+	case review.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionGroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedpermissions != nil {
-		edges = append(edges, permissiongroup.EdgePermissions)
-	}
-	if m.removedusers != nil {
-		edges = append(edges, permissiongroup.EdgeUsers)
-	}
+func (m *ReviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PermissionGroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case permissiongroup.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.removedpermissions))
-		for id := range m.removedpermissions {
-			ids = append(ids, id)
-		}
-		return ids
-	case permissiongroup.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionGroupMutation) ClearedEdges() []string {
+func (m *ReviewMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedpermissions {
-		edges = append(edges, permissiongroup.EdgePermissions)
+	if m.clearedbook {
+		edges = append(edges, review.EdgeBook)
 	}
-	if m.clearedusers {
-		edges = append(edges, permissiongroup.EdgeUsers)
+	if m.cleareduser {
+		edges = append(edges, review.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PermissionGroupMutation) EdgeCleared(name string) bool {
+func (m *ReviewMutation) EdgeCleared(name string) bool {
 	switch name {
-	case permissiongroup.EdgePermissions:
-		return m.clearedpermissions
-	case permissiongroup.EdgeUsers:
-		return m.clearedusers
+	case review.EdgeBook:
+		return m.clearedbook
+	case review.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PermissionGroupMutation) ClearEdge(name string) error {
+func (m *ReviewMutation) ClearEdge(name string) error {
 	switch name {
+	case review.EdgeBook:
+		m.ClearBook()
+		return nil
+	case review.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown PermissionGroup unique edge %s", name)
+	return fmt.Errorf("unknown Review unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PermissionGroupMutation) ResetEdge(name string) error {
+func (m *ReviewMutation) ResetEdge(name string) error {
 	switch name {
-	case permissiongroup.EdgePermissions:
-		m.ResetPermissions()
+	case review.EdgeBook:
+		m.ResetBook()
 		return nil
-	case permissiongroup.EdgeUsers:
-		m.ResetUsers()
+	case review.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown PermissionGroup edge %s", name)
+	return fmt.Errorf("unknown Review edge %s", name)
 }
 
-// ReviewMutation represents an operation that mutates the Review nodes in the graph.
-type ReviewMutation struct {
+// RevisionMutation represents an operation that mutates the Revision nodes in the graph.
+type RevisionMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	rating        *int
-	addrating     *int
-	body          *string
+	created_at    *time.Time
+	actor_id      *string
+	actor         *string
+	action        *revision.Action
+	schema        *string
+	entity_id     *string
+	entity_name   *string
+	snapshot      *vent.RevisionSnapshot
+	restored_id   *string
 	clearedFields map[string]struct{}
-	book          *int
-	clearedbook   bool
-	user          *int
-	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Review, error)
-	predicates    []predicate.Review
+	oldValue      func(context.Context) (*Revision, error)
+	predicates    []predicate.Revision
 }
 
-var _ ent.Mutation = (*ReviewMutation)(nil)
+var _ ent.Mutation = (*RevisionMutation)(nil)
 
-// reviewOption allows management of the mutation configuration using functional options.
-type reviewOption func(*ReviewMutation)
+// revisionOption allows management of the mutation configuration using functional options.
+type revisionOption func(*RevisionMutation)
 
-// newReviewMutation creates new mutation for the Review entity.
-func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
-	m := &ReviewMutation{
+// newRevisionMutation creates new mutation for the Revision entity.
+func newRevisionMutation(c config, op Op, opts ...revisionOption) *RevisionMutation {
+	m := &RevisionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReview,
+		typ:           TypeRevision,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2343,20 +4890,20 @@ func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
 	return m
 }
 
-// withReviewID sets the ID field of the mutation.
-func withReviewID(id int) reviewOption {
-	return func(m *ReviewMutation) {
+// withRevisionID sets the ID field of the mutation.
+func withRevisionID(id int) revisionOption {
+	return func(m *RevisionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Review
+			value *Revision
 		)
-		m.oldValue = func(ctx context.Context) (*Review, error) {
+		m.oldValue = func(ctx context.Context) (*Revision, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Review.Get(ctx, id)
+					value, err = m.Client().Revision.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2365,10 +4912,10 @@ func withReviewID(id int) reviewOption {
 	}
 }
 
-// withReview sets the old Review of the mutation.
-func withReview(node *Review) reviewOption {
-	return func(m *ReviewMutation) {
-		m.oldValue = func(context.Context) (*Review, error) {
+// withRevision sets the old Revision of the mutation.
+func withRevision(node *Revision) revisionOption {
+	return func(m *RevisionMutation) {
+		m.oldValue = func(context.Context) (*Revision, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2377,7 +4924,7 @@ func withReview(node *Review) reviewOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReviewMutation) Client() *Client {
+func (m RevisionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2385,7 +4932,7 @@ func (m ReviewMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReviewMutation) Tx() (*Tx, error) {
+func (m RevisionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2396,7 +4943,7 @@ func (m ReviewMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReviewMutation) ID() (id int, exists bool) {
+func (m *RevisionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2407,7 +4954,7 @@ func (m *ReviewMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReviewMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RevisionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2416,204 +4963,397 @@ func (m *ReviewMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Revision.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRating sets the "rating" field.
-func (m *ReviewMutation) SetRating(i int) {
-	m.rating = &i
-	m.addrating = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *RevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Rating returns the value of the "rating" field in the mutation.
-func (m *ReviewMutation) Rating() (r int, exists bool) {
-	v := m.rating
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRating returns the old "rating" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldRating(ctx context.Context) (v int, err error) {
+func (m *RevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRating requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Rating, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddRating adds i to the "rating" field.
-func (m *ReviewMutation) AddRating(i int) {
-	if m.addrating != nil {
-		*m.addrating += i
-	} else {
-		m.addrating = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedRating returns the value that was added to the "rating" field in this mutation.
-func (m *ReviewMutation) AddedRating() (r int, exists bool) {
-	v := m.addrating
+// SetActorID sets the "actor_id" field.
+func (m *RevisionMutation) SetActorID(s string) {
+	m.actor_id = &s
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *RevisionMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRating resets all changes to the "rating" field.
-func (m *ReviewMutation) ResetRating() {
-	m.rating = nil
-	m.addrating = nil
+// OldActorID returns the old "actor_id" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldActorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
 }
 
-// SetBody sets the "body" field.
-func (m *ReviewMutation) SetBody(s string) {
-	m.body = &s
+// ClearActorID clears the value of the "actor_id" field.
+func (m *RevisionMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[revision.FieldActorID] = struct{}{}
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *ReviewMutation) Body() (r string, exists bool) {
-	v := m.body
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *RevisionMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[revision.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *RevisionMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, revision.FieldActorID)
+}
+
+// SetActor sets the "actor" field.
+func (m *RevisionMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *RevisionMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldBody(ctx context.Context) (v *string, err error) {
+func (m *RevisionMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.Actor, nil
 }
 
-// ClearBody clears the value of the "body" field.
-func (m *ReviewMutation) ClearBody() {
-	m.body = nil
-	m.clearedFields[review.FieldBody] = struct{}{}
+// ClearActor clears the value of the "actor" field.
+func (m *RevisionMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[revision.FieldActor] = struct{}{}
 }
 
-// BodyCleared returns if the "body" field was cleared in this mutation.
-func (m *ReviewMutation) BodyCleared() bool {
-	_, ok := m.clearedFields[review.FieldBody]
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *RevisionMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[revision.FieldActor]
 	return ok
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *ReviewMutation) ResetBody() {
-	m.body = nil
-	delete(m.clearedFields, review.FieldBody)
+// ResetActor resets all changes to the "actor" field.
+func (m *RevisionMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, revision.FieldActor)
 }
 
-// SetBookID sets the "book" edge to the Book entity by id.
-func (m *ReviewMutation) SetBookID(id int) {
-	m.book = &id
+// SetAction sets the "action" field.
+func (m *RevisionMutation) SetAction(r revision.Action) {
+	m.action = &r
 }
 
-// ClearBook clears the "book" edge to the Book entity.
-func (m *ReviewMutation) ClearBook() {
-	m.clearedbook = true
+// Action returns the value of the "action" field in the mutation.
+func (m *RevisionMutation) Action() (r revision.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldAction(ctx context.Context) (v revision.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *RevisionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetSchema sets the "schema" field.
+func (m *RevisionMutation) SetSchema(s string) {
+	m.schema = &s
+}
+
+// Schema returns the value of the "schema" field in the mutation.
+func (m *RevisionMutation) Schema() (r string, exists bool) {
+	v := m.schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchema returns the old "schema" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldSchema(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchema: %w", err)
+	}
+	return oldValue.Schema, nil
+}
+
+// ResetSchema resets all changes to the "schema" field.
+func (m *RevisionMutation) ResetSchema() {
+	m.schema = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *RevisionMutation) SetEntityID(s string) {
+	m.entity_id = &s
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *RevisionMutation) EntityID() (r string, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldEntityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *RevisionMutation) ResetEntityID() {
+	m.entity_id = nil
+}
+
+// SetEntityName sets the "entity_name" field.
+func (m *RevisionMutation) SetEntityName(s string) {
+	m.entity_name = &s
+}
+
+// EntityName returns the value of the "entity_name" field in the mutation.
+func (m *RevisionMutation) EntityName() (r string, exists bool) {
+	v := m.entity_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityName returns the old "entity_name" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldEntityName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityName: %w", err)
+	}
+	return oldValue.EntityName, nil
+}
+
+// ClearEntityName clears the value of the "entity_name" field.
+func (m *RevisionMutation) ClearEntityName() {
+	m.entity_name = nil
+	m.clearedFields[revision.FieldEntityName] = struct{}{}
+}
+
+// EntityNameCleared returns if the "entity_name" field was cleared in this mutation.
+func (m *RevisionMutation) EntityNameCleared() bool {
+	_, ok := m.clearedFields[revision.FieldEntityName]
+	return ok
 }
 
-// BookCleared reports if the "book" edge to the Book entity was cleared.
-func (m *ReviewMutation) BookCleared() bool {
-	return m.clearedbook
+// ResetEntityName resets all changes to the "entity_name" field.
+func (m *RevisionMutation) ResetEntityName() {
+	m.entity_name = nil
+	delete(m.clearedFields, revision.FieldEntityName)
 }
 
-// BookID returns the "book" edge ID in the mutation.
-func (m *ReviewMutation) BookID() (id int, exists bool) {
-	if m.book != nil {
-		return *m.book, true
-	}
-	return
+// SetSnapshot sets the "snapshot" field.
+func (m *RevisionMutation) SetSnapshot(vs vent.RevisionSnapshot) {
+	m.snapshot = &vs
 }
 
-// BookIDs returns the "book" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BookID instead. It exists only for internal usage by the builders.
-func (m *ReviewMutation) BookIDs() (ids []int) {
-	if id := m.book; id != nil {
-		ids = append(ids, *id)
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *RevisionMutation) Snapshot() (r vent.RevisionSnapshot, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetBook resets all changes to the "book" edge.
-func (m *ReviewMutation) ResetBook() {
-	m.book = nil
-	m.clearedbook = false
+// OldSnapshot returns the old "snapshot" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldSnapshot(ctx context.Context) (v vent.RevisionSnapshot, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ReviewMutation) SetUserID(id int) {
-	m.user = &id
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *RevisionMutation) ResetSnapshot() {
+	m.snapshot = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *ReviewMutation) ClearUser() {
-	m.cleareduser = true
+// SetRestoredID sets the "restored_id" field.
+func (m *RevisionMutation) SetRestoredID(s string) {
+	m.restored_id = &s
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ReviewMutation) UserCleared() bool {
-	return m.cleareduser
+// RestoredID returns the value of the "restored_id" field in the mutation.
+func (m *RevisionMutation) RestoredID() (r string, exists bool) {
+	v := m.restored_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ReviewMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// OldRestoredID returns the old "restored_id" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldRestoredID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestoredID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestoredID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestoredID: %w", err)
+	}
+	return oldValue.RestoredID, nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ReviewMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearRestoredID clears the value of the "restored_id" field.
+func (m *RevisionMutation) ClearRestoredID() {
+	m.restored_id = nil
+	m.clearedFields[revision.FieldRestoredID] = struct{}{}
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ReviewMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// RestoredIDCleared returns if the "restored_id" field was cleared in this mutation.
+func (m *RevisionMutation) RestoredIDCleared() bool {
+	_, ok := m.clearedFields[revision.FieldRestoredID]
+	return ok
 }
 
-// Where appends a list predicates to the ReviewMutation builder.
-func (m *ReviewMutation) Where(ps ...predicate.Review) {
+// ResetRestoredID resets all changes to the "restored_id" field.
+func (m *RevisionMutation) ResetRestoredID() {
+	m.restored_id = nil
+	delete(m.clearedFields, revision.FieldRestoredID)
+}
+
+// Where appends a list predicates to the RevisionMutation builder.
+func (m *RevisionMutation) Where(ps ...predicate.Revision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReviewMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RevisionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Review, len(ps))
+func (m *RevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Revision, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2621,30 +5361,51 @@ func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReviewMutation) Op() Op {
+func (m *RevisionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReviewMutation) SetOp(op Op) {
+func (m *RevisionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Review).
-func (m *ReviewMutation) Type() string {
+// Type returns the node type of this mutation (Revision).
+func (m *RevisionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReviewMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.rating != nil {
-		fields = append(fields, review.FieldRating)
+func (m *RevisionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, revision.FieldCreatedAt)
 	}
-	if m.body != nil {
-		fields = append(fields, review.FieldBody)
+	if m.actor_id != nil {
+		fields = append(fields, revision.FieldActorID)
+	}
+	if m.actor != nil {
+		fields = append(fields, revision.FieldActor)
+	}
+	if m.action != nil {
+		fields = append(fields, revision.FieldAction)
+	}
+	if m.schema != nil {
+		fields = append(fields, revision.FieldSchema)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, revision.FieldEntityID)
+	}
+	if m.entity_name != nil {
+		fields = append(fields, revision.FieldEntityName)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, revision.FieldSnapshot)
+	}
+	if m.restored_id != nil {
+		fields = append(fields, revision.FieldRestoredID)
 	}
 	return fields
 }
@@ -2652,12 +5413,26 @@ func (m *ReviewMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
+func (m *RevisionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case review.FieldRating:
-		return m.Rating()
-	case review.FieldBody:
-		return m.Body()
+	case revision.FieldCreatedAt:
+		return m.CreatedAt()
+	case revision.FieldActorID:
+		return m.ActorID()
+	case revision.FieldActor:
+		return m.Actor()
+	case revision.FieldAction:
+		return m.Action()
+	case revision.FieldSchema:
+		return m.Schema()
+	case revision.FieldEntityID:
+		return m.EntityID()
+	case revision.FieldEntityName:
+		return m.EntityName()
+	case revision.FieldSnapshot:
+		return m.Snapshot()
+	case revision.FieldRestoredID:
+		return m.RestoredID()
 	}
 	return nil, false
 }
@@ -2665,208 +5440,251 @@ func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case review.FieldRating:
-		return m.OldRating(ctx)
-	case review.FieldBody:
-		return m.OldBody(ctx)
-	}
-	return nil, fmt.Errorf("unknown Review field %s", name)
+	case revision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case revision.FieldActorID:
+		return m.OldActorID(ctx)
+	case revision.FieldActor:
+		return m.OldActor(ctx)
+	case revision.FieldAction:
+		return m.OldAction(ctx)
+	case revision.FieldSchema:
+		return m.OldSchema(ctx)
+	case revision.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case revision.FieldEntityName:
+		return m.OldEntityName(ctx)
+	case revision.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case revision.FieldRestoredID:
+		return m.OldRestoredID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Revision field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReviewMutation) SetField(name string, value ent.Value) error {
+func (m *RevisionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case review.FieldRating:
-		v, ok := value.(int)
+	case revision.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRating(v)
+		m.SetCreatedAt(v)
 		return nil
-	case review.FieldBody:
+	case revision.FieldActorID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetActorID(v)
+		return nil
+	case revision.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case revision.FieldAction:
+		v, ok := value.(revision.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case revision.FieldSchema:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchema(v)
+		return nil
+	case revision.FieldEntityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case revision.FieldEntityName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityName(v)
+		return nil
+	case revision.FieldSnapshot:
+		v, ok := value.(vent.RevisionSnapshot)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case revision.FieldRestoredID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestoredID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Review field %s", name)
+	return fmt.Errorf("unknown Revision field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReviewMutation) AddedFields() []string {
-	var fields []string
-	if m.addrating != nil {
-		fields = append(fields, review.FieldRating)
-	}
-	return fields
+func (m *RevisionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case review.FieldRating:
-		return m.AddedRating()
-	}
+func (m *RevisionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReviewMutation) AddField(name string, value ent.Value) error {
+func (m *RevisionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case review.FieldRating:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRating(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Review numeric field %s", name)
+	return fmt.Errorf("unknown Revision numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReviewMutation) ClearedFields() []string {
+func (m *RevisionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(review.FieldBody) {
-		fields = append(fields, review.FieldBody)
+	if m.FieldCleared(revision.FieldActorID) {
+		fields = append(fields, revision.FieldActorID)
+	}
+	if m.FieldCleared(revision.FieldActor) {
+		fields = append(fields, revision.FieldActor)
+	}
+	if m.FieldCleared(revision.FieldEntityName) {
+		fields = append(fields, revision.FieldEntityName)
+	}
+	if m.FieldCleared(revision.FieldRestoredID) {
+		fields = append(fields, revision.FieldRestoredID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReviewMutation) FieldCleared(name string) bool {
+func (m *RevisionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReviewMutation) ClearField(name string) error {
+func (m *RevisionMutation) ClearField(name string) error {
 	switch name {
-	case review.FieldBody:
-		m.ClearBody()
+	case revision.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case revision.FieldActor:
+		m.ClearActor()
+		return nil
+	case revision.FieldEntityName:
+		m.ClearEntityName()
+		return nil
+	case revision.FieldRestoredID:
+		m.ClearRestoredID()
 		return nil
 	}
-	return fmt.Errorf("unknown Review nullable field %s", name)
+	return fmt.Errorf("unknown Revision nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReviewMutation) ResetField(name string) error {
+func (m *RevisionMutation) ResetField(name string) error {
 	switch name {
-	case review.FieldRating:
-		m.ResetRating()
+	case revision.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case review.FieldBody:
-		m.ResetBody()
+	case revision.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case revision.FieldActor:
+		m.ResetActor()
+		return nil
+	case revision.FieldAction:
+		m.ResetAction()
+		return nil
+	case revision.FieldSchema:
+		m.ResetSchema()
+		return nil
+	case revision.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case revision.FieldEntityName:
+		m.ResetEntityName()
+		return nil
+	case revision.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case revision.FieldRestoredID:
+		m.ResetRestoredID()
 		return nil
 	}
-	return fmt.Errorf("unknown Review field %s", name)
+	return fmt.Errorf("unknown Revision field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReviewMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.book != nil {
-		edges = append(edges, review.EdgeBook)
-	}
-	if m.user != nil {
-		edges = append(edges, review.EdgeUser)
-	}
+func (m *RevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case review.EdgeBook:
-		if id := m.book; id != nil {
-			return []ent.Value{*id}
-		}
-	case review.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *RevisionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReviewMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
+func (m *RevisionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReviewMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedbook {
-		edges = append(edges, review.EdgeBook)
-	}
-	if m.cleareduser {
-		edges = append(edges, review.EdgeUser)
-	}
+func (m *RevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ReviewMutation) EdgeCleared(name string) bool {
-	switch name {
-	case review.EdgeBook:
-		return m.clearedbook
-	case review.EdgeUser:
-		return m.cleareduser
-	}
+func (m *RevisionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ReviewMutation) ClearEdge(name string) error {
-	switch name {
-	case review.EdgeBook:
-		m.ClearBook()
-		return nil
-	case review.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Review unique edge %s", name)
+func (m *RevisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Revision unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ReviewMutation) ResetEdge(name string) error {
-	switch name {
-	case review.EdgeBook:
-		m.ResetBook()
-		return nil
-	case review.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Review edge %s", name)
+func (m *RevisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Revision edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // Author is the predicate function for author builders.
 type Author func(*sql.Selector)
 
@@ -18,8 +21,14 @@ type Permission func(*sql.Selector)
 // PermissionGroup is the predicate function for permissiongroup builders.
 type PermissionGroup func(*sql.Selector)
 
+// Publisher is the predicate function for publisher builders.
+type Publisher func(*sql.Selector)
+
 // Review is the predicate function for review builders.
 type Review func(*sql.Selector)
 
+// Revision is the predicate function for revision builders.
+type Revision func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
+)
+
+// Publisher is the model entity for the Publisher schema.
+type Publisher struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Catalog holds the value of the "catalog" field.
+	Catalog string `json:"catalog,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PublisherQuery when eager-loading is set.
+	Edges        PublisherEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PublisherEdges holds the relations/edges for other nodes in the graph.
+type PublisherEdges struct {
+	// Books holds the value of the books edge.
+	Books []*Book `json:"books,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BooksOrErr returns the Books value or an error if the edge
+// was not loaded in eager-loading.
+func (e PublisherEdges) BooksOrErr() ([]*Book, error) {
+	if e.loadedTypes[0] {
+		return e.Books, nil
+	}
+	return nil, &NotLoadedError{edge: "books"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Publisher) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case publisher.FieldName, publisher.FieldCatalog:
+			values[i] = new(sql.NullString)
+		case publisher.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case publisher.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Publisher fields.
+func (_m *Publisher) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case publisher.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case publisher.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case publisher.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case publisher.FieldCatalog:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field catalog", values[i])
+			} else if value.Valid {
+				_m.Catalog = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Publisher.
+// This includes values selected through modifiers, order, etc.
+func (_m *Publisher) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBooks queries the "books" edge of the Publisher entity.
+func (_m *Publisher) QueryBooks() *BookQuery {
+	return NewPublisherClient(_m.config).QueryBooks(_m)
+}
+
+// Update returns a builder for updating this Publisher.
+// Note that you need to call Publisher.Unwrap() before calling this method if this Publisher
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Publisher) Update() *PublisherUpdateOne {
+	return NewPublisherClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Publisher entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Publisher) Unwrap() *Publisher {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Publisher is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Publisher) String() string {
+	var builder strings.Builder
+	builder.WriteString("Publisher(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("catalog=")
+	builder.WriteString(_m.Catalog)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Publishers is a parsable slice of Publisher.
+type Publishers []*Publisher
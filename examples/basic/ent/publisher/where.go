@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package publisher
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/troygilman/vent/examples/basic/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLTE(FieldID, id))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldName, v))
+}
+
+// Catalog applies equality check predicate on the "catalog" field. It's identical to CatalogEQ.
+func Catalog(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldCatalog, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Publisher {
+	return predicate.Publisher(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Publisher {
+	return predicate.Publisher(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldContainsFold(FieldName, v))
+}
+
+// CatalogEQ applies the EQ predicate on the "catalog" field.
+func CatalogEQ(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEQ(FieldCatalog, v))
+}
+
+// CatalogNEQ applies the NEQ predicate on the "catalog" field.
+func CatalogNEQ(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNEQ(FieldCatalog, v))
+}
+
+// CatalogIn applies the In predicate on the "catalog" field.
+func CatalogIn(vs ...string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldIn(FieldCatalog, vs...))
+}
+
+// CatalogNotIn applies the NotIn predicate on the "catalog" field.
+func CatalogNotIn(vs ...string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldNotIn(FieldCatalog, vs...))
+}
+
+// CatalogGT applies the GT predicate on the "catalog" field.
+func CatalogGT(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGT(FieldCatalog, v))
+}
+
+// CatalogGTE applies the GTE predicate on the "catalog" field.
+func CatalogGTE(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldGTE(FieldCatalog, v))
+}
+
+// CatalogLT applies the LT predicate on the "catalog" field.
+func CatalogLT(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLT(FieldCatalog, v))
+}
+
+// CatalogLTE applies the LTE predicate on the "catalog" field.
+func CatalogLTE(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldLTE(FieldCatalog, v))
+}
+
+// CatalogContains applies the Contains predicate on the "catalog" field.
+func CatalogContains(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldContains(FieldCatalog, v))
+}
+
+// CatalogHasPrefix applies the HasPrefix predicate on the "catalog" field.
+func CatalogHasPrefix(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldHasPrefix(FieldCatalog, v))
+}
+
+// CatalogHasSuffix applies the HasSuffix predicate on the "catalog" field.
+func CatalogHasSuffix(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldHasSuffix(FieldCatalog, v))
+}
+
+// CatalogIsNil applies the IsNil predicate on the "catalog" field.
+func CatalogIsNil() predicate.Publisher {
+	return predicate.Publisher(sql.FieldIsNull(FieldCatalog))
+}
+
+// CatalogNotNil applies the NotNil predicate on the "catalog" field.
+func CatalogNotNil() predicate.Publisher {
+	return predicate.Publisher(sql.FieldNotNull(FieldCatalog))
+}
+
+// CatalogEqualFold applies the EqualFold predicate on the "catalog" field.
+func CatalogEqualFold(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldEqualFold(FieldCatalog, v))
+}
+
+// CatalogContainsFold applies the ContainsFold predicate on the "catalog" field.
+func CatalogContainsFold(v string) predicate.Publisher {
+	return predicate.Publisher(sql.FieldContainsFold(FieldCatalog, v))
+}
+
+// HasBooks applies the HasEdge predicate on the "books" edge.
+func HasBooks() predicate.Publisher {
+	return predicate.Publisher(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BooksTable, BooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBooksWith applies the HasEdge predicate on the "books" edge with a given conditions (other predicates).
+func HasBooksWith(preds ...predicate.Book) predicate.Publisher {
+	return predicate.Publisher(func(s *sql.Selector) {
+		step := newBooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Publisher) predicate.Publisher {
+	return predicate.Publisher(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Publisher) predicate.Publisher {
+	return predicate.Publisher(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Publisher) predicate.Publisher {
+	return predicate.Publisher(sql.NotPredicates(p))
+}
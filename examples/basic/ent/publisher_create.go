@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/troygilman/vent/examples/basic/ent/book"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
+)
+
+// PublisherCreate is the builder for creating a Publisher entity.
+type PublisherCreate struct {
+	config
+	mutation *PublisherMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PublisherCreate) SetDeletedAt(v time.Time) *PublisherCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PublisherCreate) SetNillableDeletedAt(v *time.Time) *PublisherCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PublisherCreate) SetName(v string) *PublisherCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCatalog sets the "catalog" field.
+func (_c *PublisherCreate) SetCatalog(v string) *PublisherCreate {
+	_c.mutation.SetCatalog(v)
+	return _c
+}
+
+// SetNillableCatalog sets the "catalog" field if the given value is not nil.
+func (_c *PublisherCreate) SetNillableCatalog(v *string) *PublisherCreate {
+	if v != nil {
+		_c.SetCatalog(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PublisherCreate) SetID(v uuid.UUID) *PublisherCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PublisherCreate) SetNillableID(v *uuid.UUID) *PublisherCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by IDs.
+func (_c *PublisherCreate) AddBookIDs(ids ...int) *PublisherCreate {
+	_c.mutation.AddBookIDs(ids...)
+	return _c
+}
+
+// AddBooks adds the "books" edges to the Book entity.
+func (_c *PublisherCreate) AddBooks(v ...*Book) *PublisherCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBookIDs(ids...)
+}
+
+// Mutation returns the PublisherMutation object of the builder.
+func (_c *PublisherCreate) Mutation() *PublisherMutation {
+	return _c.mutation
+}
+
+// Save creates the Publisher in the database.
+func (_c *PublisherCreate) Save(ctx context.Context) (*Publisher, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PublisherCreate) SaveX(ctx context.Context) *Publisher {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublisherCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublisherCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PublisherCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := publisher.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PublisherCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Publisher.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := publisher.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Publisher.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PublisherCreate) sqlSave(ctx context.Context) (*Publisher, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PublisherCreate) createSpec() (*Publisher, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Publisher{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(publisher.Table, sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(publisher.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(publisher.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Catalog(); ok {
+		_spec.SetField(publisher.FieldCatalog, field.TypeString, value)
+		_node.Catalog = value
+	}
+	if nodes := _c.mutation.BooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Publisher.Create().
+//		SetDeletedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublisherUpsert) {
+//			SetDeletedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PublisherCreate) OnConflict(opts ...sql.ConflictOption) *PublisherUpsertOne {
+	_c.conflict = opts
+	return &PublisherUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Publisher.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PublisherCreate) OnConflictColumns(columns ...string) *PublisherUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PublisherUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PublisherUpsertOne is the builder for "upsert"-ing
+	//  one Publisher node.
+	PublisherUpsertOne struct {
+		create *PublisherCreate
+	}
+
+	// PublisherUpsert is the "OnConflict" setter.
+	PublisherUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PublisherUpsert) SetDeletedAt(v time.Time) *PublisherUpsert {
+	u.Set(publisher.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PublisherUpsert) UpdateDeletedAt() *PublisherUpsert {
+	u.SetExcluded(publisher.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PublisherUpsert) ClearDeletedAt() *PublisherUpsert {
+	u.SetNull(publisher.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PublisherUpsert) SetName(v string) *PublisherUpsert {
+	u.Set(publisher.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PublisherUpsert) UpdateName() *PublisherUpsert {
+	u.SetExcluded(publisher.FieldName)
+	return u
+}
+
+// SetCatalog sets the "catalog" field.
+func (u *PublisherUpsert) SetCatalog(v string) *PublisherUpsert {
+	u.Set(publisher.FieldCatalog, v)
+	return u
+}
+
+// UpdateCatalog sets the "catalog" field to the value that was provided on create.
+func (u *PublisherUpsert) UpdateCatalog() *PublisherUpsert {
+	u.SetExcluded(publisher.FieldCatalog)
+	return u
+}
+
+// ClearCatalog clears the value of the "catalog" field.
+func (u *PublisherUpsert) ClearCatalog() *PublisherUpsert {
+	u.SetNull(publisher.FieldCatalog)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Publisher.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(publisher.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PublisherUpsertOne) UpdateNewValues() *PublisherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(publisher.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Publisher.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PublisherUpsertOne) Ignore() *PublisherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublisherUpsertOne) DoNothing() *PublisherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublisherCreate.OnConflict
+// documentation for more info.
+func (u *PublisherUpsertOne) Update(set func(*PublisherUpsert)) *PublisherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublisherUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PublisherUpsertOne) SetDeletedAt(v time.Time) *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PublisherUpsertOne) UpdateDeletedAt() *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PublisherUpsertOne) ClearDeletedAt() *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PublisherUpsertOne) SetName(v string) *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PublisherUpsertOne) UpdateName() *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCatalog sets the "catalog" field.
+func (u *PublisherUpsertOne) SetCatalog(v string) *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.SetCatalog(v)
+	})
+}
+
+// UpdateCatalog sets the "catalog" field to the value that was provided on create.
+func (u *PublisherUpsertOne) UpdateCatalog() *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.UpdateCatalog()
+	})
+}
+
+// ClearCatalog clears the value of the "catalog" field.
+func (u *PublisherUpsertOne) ClearCatalog() *PublisherUpsertOne {
+	return u.Update(func(s *PublisherUpsert) {
+		s.ClearCatalog()
+	})
+}
+
+// Exec executes the query.
+func (u *PublisherUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublisherCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublisherUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PublisherUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PublisherUpsertOne.ID is not supported by MySQL driver. Use PublisherUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PublisherUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PublisherCreateBulk is the builder for creating many Publisher entities in bulk.
+type PublisherCreateBulk struct {
+	config
+	err      error
+	builders []*PublisherCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Publisher entities in the database.
+func (_c *PublisherCreateBulk) Save(ctx context.Context) ([]*Publisher, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Publisher, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PublisherMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PublisherCreateBulk) SaveX(ctx context.Context) []*Publisher {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublisherCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublisherCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Publisher.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublisherUpsert) {
+//			SetDeletedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PublisherCreateBulk) OnConflict(opts ...sql.ConflictOption) *PublisherUpsertBulk {
+	_c.conflict = opts
+	return &PublisherUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Publisher.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PublisherCreateBulk) OnConflictColumns(columns ...string) *PublisherUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PublisherUpsertBulk{
+		create: _c,
+	}
+}
+
+// PublisherUpsertBulk is the builder for "upsert"-ing
+// a bulk of Publisher nodes.
+type PublisherUpsertBulk struct {
+	create *PublisherCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Publisher.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(publisher.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PublisherUpsertBulk) UpdateNewValues() *PublisherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(publisher.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Publisher.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PublisherUpsertBulk) Ignore() *PublisherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublisherUpsertBulk) DoNothing() *PublisherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublisherCreateBulk.OnConflict
+// documentation for more info.
+func (u *PublisherUpsertBulk) Update(set func(*PublisherUpsert)) *PublisherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublisherUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PublisherUpsertBulk) SetDeletedAt(v time.Time) *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PublisherUpsertBulk) UpdateDeletedAt() *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PublisherUpsertBulk) ClearDeletedAt() *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PublisherUpsertBulk) SetName(v string) *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PublisherUpsertBulk) UpdateName() *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCatalog sets the "catalog" field.
+func (u *PublisherUpsertBulk) SetCatalog(v string) *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.SetCatalog(v)
+	})
+}
+
+// UpdateCatalog sets the "catalog" field to the value that was provided on create.
+func (u *PublisherUpsertBulk) UpdateCatalog() *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.UpdateCatalog()
+	})
+}
+
+// ClearCatalog clears the value of the "catalog" field.
+func (u *PublisherUpsertBulk) ClearCatalog() *PublisherUpsertBulk {
+	return u.Update(func(s *PublisherUpsert) {
+		s.ClearCatalog()
+	})
+}
+
+// Exec executes the query.
+func (u *PublisherUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PublisherCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublisherCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublisherUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
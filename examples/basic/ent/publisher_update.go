@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/troygilman/vent/examples/basic/ent/book"
+	"github.com/troygilman/vent/examples/basic/ent/predicate"
+	"github.com/troygilman/vent/examples/basic/ent/publisher"
+)
+
+// PublisherUpdate is the builder for updating Publisher entities.
+type PublisherUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PublisherMutation
+}
+
+// Where appends a list predicates to the PublisherUpdate builder.
+func (_u *PublisherUpdate) Where(ps ...predicate.Publisher) *PublisherUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PublisherUpdate) SetDeletedAt(v time.Time) *PublisherUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PublisherUpdate) SetNillableDeletedAt(v *time.Time) *PublisherUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PublisherUpdate) ClearDeletedAt() *PublisherUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PublisherUpdate) SetName(v string) *PublisherUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PublisherUpdate) SetNillableName(v *string) *PublisherUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCatalog sets the "catalog" field.
+func (_u *PublisherUpdate) SetCatalog(v string) *PublisherUpdate {
+	_u.mutation.SetCatalog(v)
+	return _u
+}
+
+// SetNillableCatalog sets the "catalog" field if the given value is not nil.
+func (_u *PublisherUpdate) SetNillableCatalog(v *string) *PublisherUpdate {
+	if v != nil {
+		_u.SetCatalog(*v)
+	}
+	return _u
+}
+
+// ClearCatalog clears the value of the "catalog" field.
+func (_u *PublisherUpdate) ClearCatalog() *PublisherUpdate {
+	_u.mutation.ClearCatalog()
+	return _u
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by IDs.
+func (_u *PublisherUpdate) AddBookIDs(ids ...int) *PublisherUpdate {
+	_u.mutation.AddBookIDs(ids...)
+	return _u
+}
+
+// AddBooks adds the "books" edges to the Book entity.
+func (_u *PublisherUpdate) AddBooks(v ...*Book) *PublisherUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookIDs(ids...)
+}
+
+// Mutation returns the PublisherMutation object of the builder.
+func (_u *PublisherUpdate) Mutation() *PublisherMutation {
+	return _u.mutation
+}
+
+// ClearBooks clears all "books" edges to the Book entity.
+func (_u *PublisherUpdate) ClearBooks() *PublisherUpdate {
+	_u.mutation.ClearBooks()
+	return _u
+}
+
+// RemoveBookIDs removes the "books" edge to Book entities by IDs.
+func (_u *PublisherUpdate) RemoveBookIDs(ids ...int) *PublisherUpdate {
+	_u.mutation.RemoveBookIDs(ids...)
+	return _u
+}
+
+// RemoveBooks removes "books" edges to Book entities.
+func (_u *PublisherUpdate) RemoveBooks(v ...*Book) *PublisherUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PublisherUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PublisherUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PublisherUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PublisherUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PublisherUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := publisher.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Publisher.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PublisherUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publisher.Table, publisher.Columns, sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(publisher.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(publisher.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(publisher.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Catalog(); ok {
+		_spec.SetField(publisher.FieldCatalog, field.TypeString, value)
+	}
+	if _u.mutation.CatalogCleared() {
+		_spec.ClearField(publisher.FieldCatalog, field.TypeString)
+	}
+	if _u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBooksIDs(); len(nodes) > 0 && !_u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publisher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PublisherUpdateOne is the builder for updating a single Publisher entity.
+type PublisherUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PublisherMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PublisherUpdateOne) SetDeletedAt(v time.Time) *PublisherUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PublisherUpdateOne) SetNillableDeletedAt(v *time.Time) *PublisherUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PublisherUpdateOne) ClearDeletedAt() *PublisherUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PublisherUpdateOne) SetName(v string) *PublisherUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PublisherUpdateOne) SetNillableName(v *string) *PublisherUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCatalog sets the "catalog" field.
+func (_u *PublisherUpdateOne) SetCatalog(v string) *PublisherUpdateOne {
+	_u.mutation.SetCatalog(v)
+	return _u
+}
+
+// SetNillableCatalog sets the "catalog" field if the given value is not nil.
+func (_u *PublisherUpdateOne) SetNillableCatalog(v *string) *PublisherUpdateOne {
+	if v != nil {
+		_u.SetCatalog(*v)
+	}
+	return _u
+}
+
+// ClearCatalog clears the value of the "catalog" field.
+func (_u *PublisherUpdateOne) ClearCatalog() *PublisherUpdateOne {
+	_u.mutation.ClearCatalog()
+	return _u
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by IDs.
+func (_u *PublisherUpdateOne) AddBookIDs(ids ...int) *PublisherUpdateOne {
+	_u.mutation.AddBookIDs(ids...)
+	return _u
+}
+
+// AddBooks adds the "books" edges to the Book entity.
+func (_u *PublisherUpdateOne) AddBooks(v ...*Book) *PublisherUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookIDs(ids...)
+}
+
+// Mutation returns the PublisherMutation object of the builder.
+func (_u *PublisherUpdateOne) Mutation() *PublisherMutation {
+	return _u.mutation
+}
+
+// ClearBooks clears all "books" edges to the Book entity.
+func (_u *PublisherUpdateOne) ClearBooks() *PublisherUpdateOne {
+	_u.mutation.ClearBooks()
+	return _u
+}
+
+// RemoveBookIDs removes the "books" edge to Book entities by IDs.
+func (_u *PublisherUpdateOne) RemoveBookIDs(ids ...int) *PublisherUpdateOne {
+	_u.mutation.RemoveBookIDs(ids...)
+	return _u
+}
+
+// RemoveBooks removes "books" edges to Book entities.
+func (_u *PublisherUpdateOne) RemoveBooks(v ...*Book) *PublisherUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookIDs(ids...)
+}
+
+// Where appends a list predicates to the PublisherUpdate builder.
+func (_u *PublisherUpdateOne) Where(ps ...predicate.Publisher) *PublisherUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PublisherUpdateOne) Select(field string, fields ...string) *PublisherUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Publisher entity.
+func (_u *PublisherUpdateOne) Save(ctx context.Context) (*Publisher, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PublisherUpdateOne) SaveX(ctx context.Context) *Publisher {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PublisherUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PublisherUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PublisherUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := publisher.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Publisher.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PublisherUpdateOne) sqlSave(ctx context.Context) (_node *Publisher, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publisher.Table, publisher.Columns, sqlgraph.NewFieldSpec(publisher.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Publisher.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, publisher.FieldID)
+		for _, f := range fields {
+			if !publisher.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != publisher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(publisher.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(publisher.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(publisher.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Catalog(); ok {
+		_spec.SetField(publisher.FieldCatalog, field.TypeString, value)
+	}
+	if _u.mutation.CatalogCleared() {
+		_spec.ClearField(publisher.FieldCatalog, field.TypeString)
+	}
+	if _u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBooksIDs(); len(nodes) > 0 && !_u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   publisher.BooksTable,
+			Columns: []string{publisher.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Publisher{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publisher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/troygilman/vent"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
+)
+
+// Revision is the model entity for the Revision schema.
+type Revision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID string `json:"actor_id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Action holds the value of the "action" field.
+	Action revision.Action `json:"action,omitempty"`
+	// Schema holds the value of the "schema" field.
+	Schema string `json:"schema,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID string `json:"entity_id,omitempty"`
+	// EntityName holds the value of the "entity_name" field.
+	EntityName string `json:"entity_name,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot vent.RevisionSnapshot `json:"snapshot,omitempty"`
+	// RestoredID holds the value of the "restored_id" field.
+	RestoredID   string `json:"restored_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Revision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case revision.FieldSnapshot:
+			values[i] = new([]byte)
+		case revision.FieldID:
+			values[i] = new(sql.NullInt64)
+		case revision.FieldActorID, revision.FieldActor, revision.FieldAction, revision.FieldSchema, revision.FieldEntityID, revision.FieldEntityName, revision.FieldRestoredID:
+			values[i] = new(sql.NullString)
+		case revision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Revision fields.
+func (_m *Revision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case revision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case revision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case revision.FieldActorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = value.String
+			}
+		case revision.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case revision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = revision.Action(value.String)
+			}
+		case revision.FieldSchema:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schema", values[i])
+			} else if value.Valid {
+				_m.Schema = value.String
+			}
+		case revision.FieldEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = value.String
+			}
+		case revision.FieldEntityName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_name", values[i])
+			} else if value.Valid {
+				_m.EntityName = value.String
+			}
+		case revision.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case revision.FieldRestoredID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_id", values[i])
+			} else if value.Valid {
+				_m.RestoredID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Revision.
+// This includes values selected through modifiers, order, etc.
+func (_m *Revision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Revision.
+// Note that you need to call Revision.Unwrap() before calling this method if this Revision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Revision) Update() *RevisionUpdateOne {
+	return NewRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Revision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Revision) Unwrap() *Revision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Revision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Revision) String() string {
+	var builder strings.Builder
+	builder.WriteString("Revision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(_m.ActorID)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("schema=")
+	builder.WriteString(_m.Schema)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(_m.EntityID)
+	builder.WriteString(", ")
+	builder.WriteString("entity_name=")
+	builder.WriteString(_m.EntityName)
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("restored_id=")
+	builder.WriteString(_m.RestoredID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Revisions is a parsable slice of Revision.
+type Revisions []*Revision
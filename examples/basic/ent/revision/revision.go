@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the revision type in the database.
+	Label = "revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldSchema holds the string denoting the schema field in the database.
+	FieldSchema = "schema"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldEntityName holds the string denoting the entity_name field in the database.
+	FieldEntityName = "entity_name"
+	// FieldSnapshot holds the string denoting the snapshot field in the database.
+	FieldSnapshot = "snapshot"
+	// FieldRestoredID holds the string denoting the restored_id field in the database.
+	FieldRestoredID = "restored_id"
+	// Table holds the table name of the revision in the database.
+	Table = "revisions"
+)
+
+// Columns holds all SQL columns for revision fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldActorID,
+	FieldActor,
+	FieldAction,
+	FieldSchema,
+	FieldEntityID,
+	FieldEntityName,
+	FieldSnapshot,
+	FieldRestoredID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionUpdate, ActionDelete:
+		return nil
+	default:
+		return fmt.Errorf("revision: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the Revision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// BySchema orders the results by the schema field.
+func BySchema(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchema, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByEntityName orders the results by the entity_name field.
+func ByEntityName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityName, opts...).ToFunc()
+}
+
+// ByRestoredID orders the results by the restored_id field.
+func ByRestoredID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredID, opts...).ToFunc()
+}
@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/troygilman/vent/examples/basic/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldActorID, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldActor, v))
+}
+
+// Schema applies equality check predicate on the "schema" field. It's identical to SchemaEQ.
+func Schema(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldSchema, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityName applies equality check predicate on the "entity_name" field. It's identical to EntityNameEQ.
+func EntityName(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldEntityName, v))
+}
+
+// RestoredID applies equality check predicate on the "restored_id" field. It's identical to RestoredIDEQ.
+func RestoredID(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldRestoredID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDContains applies the Contains predicate on the "actor_id" field.
+func ActorIDContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldActorID, v))
+}
+
+// ActorIDHasPrefix applies the HasPrefix predicate on the "actor_id" field.
+func ActorIDHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldActorID, v))
+}
+
+// ActorIDHasSuffix applies the HasSuffix predicate on the "actor_id" field.
+func ActorIDHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.Revision {
+	return predicate.Revision(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.Revision {
+	return predicate.Revision(sql.FieldNotNull(FieldActorID))
+}
+
+// ActorIDEqualFold applies the EqualFold predicate on the "actor_id" field.
+func ActorIDEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldActorID, v))
+}
+
+// ActorIDContainsFold applies the ContainsFold predicate on the "actor_id" field.
+func ActorIDContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldActorID, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.Revision {
+	return predicate.Revision(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.Revision {
+	return predicate.Revision(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldActor, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// SchemaEQ applies the EQ predicate on the "schema" field.
+func SchemaEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldSchema, v))
+}
+
+// SchemaNEQ applies the NEQ predicate on the "schema" field.
+func SchemaNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldSchema, v))
+}
+
+// SchemaIn applies the In predicate on the "schema" field.
+func SchemaIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldSchema, vs...))
+}
+
+// SchemaNotIn applies the NotIn predicate on the "schema" field.
+func SchemaNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldSchema, vs...))
+}
+
+// SchemaGT applies the GT predicate on the "schema" field.
+func SchemaGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldSchema, v))
+}
+
+// SchemaGTE applies the GTE predicate on the "schema" field.
+func SchemaGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldSchema, v))
+}
+
+// SchemaLT applies the LT predicate on the "schema" field.
+func SchemaLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldSchema, v))
+}
+
+// SchemaLTE applies the LTE predicate on the "schema" field.
+func SchemaLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldSchema, v))
+}
+
+// SchemaContains applies the Contains predicate on the "schema" field.
+func SchemaContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldSchema, v))
+}
+
+// SchemaHasPrefix applies the HasPrefix predicate on the "schema" field.
+func SchemaHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldSchema, v))
+}
+
+// SchemaHasSuffix applies the HasSuffix predicate on the "schema" field.
+func SchemaHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldSchema, v))
+}
+
+// SchemaEqualFold applies the EqualFold predicate on the "schema" field.
+func SchemaEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldSchema, v))
+}
+
+// SchemaContainsFold applies the ContainsFold predicate on the "schema" field.
+func SchemaContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldSchema, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldEntityID, v))
+}
+
+// EntityIDContains applies the Contains predicate on the "entity_id" field.
+func EntityIDContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldEntityID, v))
+}
+
+// EntityIDHasPrefix applies the HasPrefix predicate on the "entity_id" field.
+func EntityIDHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldEntityID, v))
+}
+
+// EntityIDHasSuffix applies the HasSuffix predicate on the "entity_id" field.
+func EntityIDHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldEntityID, v))
+}
+
+// EntityIDEqualFold applies the EqualFold predicate on the "entity_id" field.
+func EntityIDEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldEntityID, v))
+}
+
+// EntityIDContainsFold applies the ContainsFold predicate on the "entity_id" field.
+func EntityIDContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldEntityID, v))
+}
+
+// EntityNameEQ applies the EQ predicate on the "entity_name" field.
+func EntityNameEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldEntityName, v))
+}
+
+// EntityNameNEQ applies the NEQ predicate on the "entity_name" field.
+func EntityNameNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldEntityName, v))
+}
+
+// EntityNameIn applies the In predicate on the "entity_name" field.
+func EntityNameIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldEntityName, vs...))
+}
+
+// EntityNameNotIn applies the NotIn predicate on the "entity_name" field.
+func EntityNameNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldEntityName, vs...))
+}
+
+// EntityNameGT applies the GT predicate on the "entity_name" field.
+func EntityNameGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldEntityName, v))
+}
+
+// EntityNameGTE applies the GTE predicate on the "entity_name" field.
+func EntityNameGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldEntityName, v))
+}
+
+// EntityNameLT applies the LT predicate on the "entity_name" field.
+func EntityNameLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldEntityName, v))
+}
+
+// EntityNameLTE applies the LTE predicate on the "entity_name" field.
+func EntityNameLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldEntityName, v))
+}
+
+// EntityNameContains applies the Contains predicate on the "entity_name" field.
+func EntityNameContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldEntityName, v))
+}
+
+// EntityNameHasPrefix applies the HasPrefix predicate on the "entity_name" field.
+func EntityNameHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldEntityName, v))
+}
+
+// EntityNameHasSuffix applies the HasSuffix predicate on the "entity_name" field.
+func EntityNameHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldEntityName, v))
+}
+
+// EntityNameIsNil applies the IsNil predicate on the "entity_name" field.
+func EntityNameIsNil() predicate.Revision {
+	return predicate.Revision(sql.FieldIsNull(FieldEntityName))
+}
+
+// EntityNameNotNil applies the NotNil predicate on the "entity_name" field.
+func EntityNameNotNil() predicate.Revision {
+	return predicate.Revision(sql.FieldNotNull(FieldEntityName))
+}
+
+// EntityNameEqualFold applies the EqualFold predicate on the "entity_name" field.
+func EntityNameEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldEntityName, v))
+}
+
+// EntityNameContainsFold applies the ContainsFold predicate on the "entity_name" field.
+func EntityNameContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldEntityName, v))
+}
+
+// RestoredIDEQ applies the EQ predicate on the "restored_id" field.
+func RestoredIDEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldRestoredID, v))
+}
+
+// RestoredIDNEQ applies the NEQ predicate on the "restored_id" field.
+func RestoredIDNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldRestoredID, v))
+}
+
+// RestoredIDIn applies the In predicate on the "restored_id" field.
+func RestoredIDIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldRestoredID, vs...))
+}
+
+// RestoredIDNotIn applies the NotIn predicate on the "restored_id" field.
+func RestoredIDNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldRestoredID, vs...))
+}
+
+// RestoredIDGT applies the GT predicate on the "restored_id" field.
+func RestoredIDGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldRestoredID, v))
+}
+
+// RestoredIDGTE applies the GTE predicate on the "restored_id" field.
+func RestoredIDGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldRestoredID, v))
+}
+
+// RestoredIDLT applies the LT predicate on the "restored_id" field.
+func RestoredIDLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldRestoredID, v))
+}
+
+// RestoredIDLTE applies the LTE predicate on the "restored_id" field.
+func RestoredIDLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldRestoredID, v))
+}
+
+// RestoredIDContains applies the Contains predicate on the "restored_id" field.
+func RestoredIDContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldRestoredID, v))
+}
+
+// RestoredIDHasPrefix applies the HasPrefix predicate on the "restored_id" field.
+func RestoredIDHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldRestoredID, v))
+}
+
+// RestoredIDHasSuffix applies the HasSuffix predicate on the "restored_id" field.
+func RestoredIDHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldRestoredID, v))
+}
+
+// RestoredIDIsNil applies the IsNil predicate on the "restored_id" field.
+func RestoredIDIsNil() predicate.Revision {
+	return predicate.Revision(sql.FieldIsNull(FieldRestoredID))
+}
+
+// RestoredIDNotNil applies the NotNil predicate on the "restored_id" field.
+func RestoredIDNotNil() predicate.Revision {
+	return predicate.Revision(sql.FieldNotNull(FieldRestoredID))
+}
+
+// RestoredIDEqualFold applies the EqualFold predicate on the "restored_id" field.
+func RestoredIDEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldRestoredID, v))
+}
+
+// RestoredIDContainsFold applies the ContainsFold predicate on the "restored_id" field.
+func RestoredIDContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldRestoredID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Revision) predicate.Revision {
+	return predicate.Revision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Revision) predicate.Revision {
+	return predicate.Revision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Revision) predicate.Revision {
+	return predicate.Revision(sql.NotPredicates(p))
+}
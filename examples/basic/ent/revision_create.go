@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/troygilman/vent"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
+)
+
+// RevisionCreate is the builder for creating a Revision entity.
+type RevisionCreate struct {
+	config
+	mutation *RevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RevisionCreate) SetCreatedAt(v time.Time) *RevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableCreatedAt(v *time.Time) *RevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *RevisionCreate) SetActorID(v string) *RevisionCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableActorID(v *string) *RevisionCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *RevisionCreate) SetActor(v string) *RevisionCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableActor(v *string) *RevisionCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *RevisionCreate) SetAction(v revision.Action) *RevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetSchema sets the "schema" field.
+func (_c *RevisionCreate) SetSchema(v string) *RevisionCreate {
+	_c.mutation.SetSchema(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *RevisionCreate) SetEntityID(v string) *RevisionCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetEntityName sets the "entity_name" field.
+func (_c *RevisionCreate) SetEntityName(v string) *RevisionCreate {
+	_c.mutation.SetEntityName(v)
+	return _c
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableEntityName(v *string) *RevisionCreate {
+	if v != nil {
+		_c.SetEntityName(*v)
+	}
+	return _c
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_c *RevisionCreate) SetSnapshot(v vent.RevisionSnapshot) *RevisionCreate {
+	_c.mutation.SetSnapshot(v)
+	return _c
+}
+
+// SetRestoredID sets the "restored_id" field.
+func (_c *RevisionCreate) SetRestoredID(v string) *RevisionCreate {
+	_c.mutation.SetRestoredID(v)
+	return _c
+}
+
+// SetNillableRestoredID sets the "restored_id" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableRestoredID(v *string) *RevisionCreate {
+	if v != nil {
+		_c.SetRestoredID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RevisionMutation object of the builder.
+func (_c *RevisionCreate) Mutation() *RevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the Revision in the database.
+func (_c *RevisionCreate) Save(ctx context.Context) (*Revision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RevisionCreate) SaveX(ctx context.Context) *Revision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := revision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Revision.created_at"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Revision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := revision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Revision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Schema(); !ok {
+		return &ValidationError{Name: "schema", err: errors.New(`ent: missing required field "Revision.schema"`)}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "Revision.entity_id"`)}
+	}
+	if _, ok := _c.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "Revision.snapshot"`)}
+	}
+	return nil
+}
+
+func (_c *RevisionCreate) sqlSave(ctx context.Context) (*Revision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RevisionCreate) createSpec() (*Revision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Revision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(revision.Table, sqlgraph.NewFieldSpec(revision.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(revision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(revision.FieldActorID, field.TypeString, value)
+		_node.ActorID = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(revision.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(revision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Schema(); ok {
+		_spec.SetField(revision.FieldSchema, field.TypeString, value)
+		_node.Schema = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(revision.FieldEntityID, field.TypeString, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.EntityName(); ok {
+		_spec.SetField(revision.FieldEntityName, field.TypeString, value)
+		_node.EntityName = value
+	}
+	if value, ok := _c.mutation.Snapshot(); ok {
+		_spec.SetField(revision.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := _c.mutation.RestoredID(); ok {
+		_spec.SetField(revision.FieldRestoredID, field.TypeString, value)
+		_node.RestoredID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Revision.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RevisionCreate) OnConflict(opts ...sql.ConflictOption) *RevisionUpsertOne {
+	_c.conflict = opts
+	return &RevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Revision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RevisionCreate) OnConflictColumns(columns ...string) *RevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RevisionUpsertOne is the builder for "upsert"-ing
+	//  one Revision node.
+	RevisionUpsertOne struct {
+		create *RevisionCreate
+	}
+
+	// RevisionUpsert is the "OnConflict" setter.
+	RevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRestoredID sets the "restored_id" field.
+func (u *RevisionUpsert) SetRestoredID(v string) *RevisionUpsert {
+	u.Set(revision.FieldRestoredID, v)
+	return u
+}
+
+// UpdateRestoredID sets the "restored_id" field to the value that was provided on create.
+func (u *RevisionUpsert) UpdateRestoredID() *RevisionUpsert {
+	u.SetExcluded(revision.FieldRestoredID)
+	return u
+}
+
+// ClearRestoredID clears the value of the "restored_id" field.
+func (u *RevisionUpsert) ClearRestoredID() *RevisionUpsert {
+	u.SetNull(revision.FieldRestoredID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Revision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RevisionUpsertOne) UpdateNewValues() *RevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(revision.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(revision.FieldActorID)
+		}
+		if _, exists := u.create.mutation.Actor(); exists {
+			s.SetIgnore(revision.FieldActor)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(revision.FieldAction)
+		}
+		if _, exists := u.create.mutation.Schema(); exists {
+			s.SetIgnore(revision.FieldSchema)
+		}
+		if _, exists := u.create.mutation.EntityID(); exists {
+			s.SetIgnore(revision.FieldEntityID)
+		}
+		if _, exists := u.create.mutation.EntityName(); exists {
+			s.SetIgnore(revision.FieldEntityName)
+		}
+		if _, exists := u.create.mutation.Snapshot(); exists {
+			s.SetIgnore(revision.FieldSnapshot)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Revision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RevisionUpsertOne) Ignore() *RevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RevisionUpsertOne) DoNothing() *RevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RevisionCreate.OnConflict
+// documentation for more info.
+func (u *RevisionUpsertOne) Update(set func(*RevisionUpsert)) *RevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRestoredID sets the "restored_id" field.
+func (u *RevisionUpsertOne) SetRestoredID(v string) *RevisionUpsertOne {
+	return u.Update(func(s *RevisionUpsert) {
+		s.SetRestoredID(v)
+	})
+}
+
+// UpdateRestoredID sets the "restored_id" field to the value that was provided on create.
+func (u *RevisionUpsertOne) UpdateRestoredID() *RevisionUpsertOne {
+	return u.Update(func(s *RevisionUpsert) {
+		s.UpdateRestoredID()
+	})
+}
+
+// ClearRestoredID clears the value of the "restored_id" field.
+func (u *RevisionUpsertOne) ClearRestoredID() *RevisionUpsertOne {
+	return u.Update(func(s *RevisionUpsert) {
+		s.ClearRestoredID()
+	})
+}
+
+// Exec executes the query.
+func (u *RevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RevisionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RevisionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RevisionCreateBulk is the builder for creating many Revision entities in bulk.
+type RevisionCreateBulk struct {
+	config
+	err      error
+	builders []*RevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Revision entities in the database.
+func (_c *RevisionCreateBulk) Save(ctx context.Context) ([]*Revision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Revision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RevisionCreateBulk) SaveX(ctx context.Context) []*Revision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Revision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *RevisionUpsertBulk {
+	_c.conflict = opts
+	return &RevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Revision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RevisionCreateBulk) OnConflictColumns(columns ...string) *RevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// RevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Revision nodes.
+type RevisionUpsertBulk struct {
+	create *RevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Revision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RevisionUpsertBulk) UpdateNewValues() *RevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(revision.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(revision.FieldActorID)
+			}
+			if _, exists := b.mutation.Actor(); exists {
+				s.SetIgnore(revision.FieldActor)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(revision.FieldAction)
+			}
+			if _, exists := b.mutation.Schema(); exists {
+				s.SetIgnore(revision.FieldSchema)
+			}
+			if _, exists := b.mutation.EntityID(); exists {
+				s.SetIgnore(revision.FieldEntityID)
+			}
+			if _, exists := b.mutation.EntityName(); exists {
+				s.SetIgnore(revision.FieldEntityName)
+			}
+			if _, exists := b.mutation.Snapshot(); exists {
+				s.SetIgnore(revision.FieldSnapshot)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Revision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RevisionUpsertBulk) Ignore() *RevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RevisionUpsertBulk) DoNothing() *RevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *RevisionUpsertBulk) Update(set func(*RevisionUpsert)) *RevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRestoredID sets the "restored_id" field.
+func (u *RevisionUpsertBulk) SetRestoredID(v string) *RevisionUpsertBulk {
+	return u.Update(func(s *RevisionUpsert) {
+		s.SetRestoredID(v)
+	})
+}
+
+// UpdateRestoredID sets the "restored_id" field to the value that was provided on create.
+func (u *RevisionUpsertBulk) UpdateRestoredID() *RevisionUpsertBulk {
+	return u.Update(func(s *RevisionUpsert) {
+		s.UpdateRestoredID()
+	})
+}
+
+// ClearRestoredID clears the value of the "restored_id" field.
+func (u *RevisionUpsertBulk) ClearRestoredID() *RevisionUpsertBulk {
+	return u.Update(func(s *RevisionUpsert) {
+		s.ClearRestoredID()
+	})
+}
+
+// Exec executes the query.
+func (u *RevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
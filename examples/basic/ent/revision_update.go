@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/troygilman/vent/examples/basic/ent/predicate"
+	"github.com/troygilman/vent/examples/basic/ent/revision"
+)
+
+// RevisionUpdate is the builder for updating Revision entities.
+type RevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RevisionMutation
+}
+
+// Where appends a list predicates to the RevisionUpdate builder.
+func (_u *RevisionUpdate) Where(ps ...predicate.Revision) *RevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRestoredID sets the "restored_id" field.
+func (_u *RevisionUpdate) SetRestoredID(v string) *RevisionUpdate {
+	_u.mutation.SetRestoredID(v)
+	return _u
+}
+
+// SetNillableRestoredID sets the "restored_id" field if the given value is not nil.
+func (_u *RevisionUpdate) SetNillableRestoredID(v *string) *RevisionUpdate {
+	if v != nil {
+		_u.SetRestoredID(*v)
+	}
+	return _u
+}
+
+// ClearRestoredID clears the value of the "restored_id" field.
+func (_u *RevisionUpdate) ClearRestoredID() *RevisionUpdate {
+	_u.mutation.ClearRestoredID()
+	return _u
+}
+
+// Mutation returns the RevisionMutation object of the builder.
+func (_u *RevisionUpdate) Mutation() *RevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(revision.Table, revision.Columns, sqlgraph.NewFieldSpec(revision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(revision.FieldActorID, field.TypeString)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(revision.FieldActor, field.TypeString)
+	}
+	if _u.mutation.EntityNameCleared() {
+		_spec.ClearField(revision.FieldEntityName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredID(); ok {
+		_spec.SetField(revision.FieldRestoredID, field.TypeString, value)
+	}
+	if _u.mutation.RestoredIDCleared() {
+		_spec.ClearField(revision.FieldRestoredID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RevisionUpdateOne is the builder for updating a single Revision entity.
+type RevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RevisionMutation
+}
+
+// SetRestoredID sets the "restored_id" field.
+func (_u *RevisionUpdateOne) SetRestoredID(v string) *RevisionUpdateOne {
+	_u.mutation.SetRestoredID(v)
+	return _u
+}
+
+// SetNillableRestoredID sets the "restored_id" field if the given value is not nil.
+func (_u *RevisionUpdateOne) SetNillableRestoredID(v *string) *RevisionUpdateOne {
+	if v != nil {
+		_u.SetRestoredID(*v)
+	}
+	return _u
+}
+
+// ClearRestoredID clears the value of the "restored_id" field.
+func (_u *RevisionUpdateOne) ClearRestoredID() *RevisionUpdateOne {
+	_u.mutation.ClearRestoredID()
+	return _u
+}
+
+// Mutation returns the RevisionMutation object of the builder.
+func (_u *RevisionUpdateOne) Mutation() *RevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RevisionUpdate builder.
+func (_u *RevisionUpdateOne) Where(ps ...predicate.Revision) *RevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RevisionUpdateOne) Select(field string, fields ...string) *RevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Revision entity.
+func (_u *RevisionUpdateOne) Save(ctx context.Context) (*Revision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevisionUpdateOne) SaveX(ctx context.Context) *Revision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RevisionUpdateOne) sqlSave(ctx context.Context) (_node *Revision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(revision.Table, revision.Columns, sqlgraph.NewFieldSpec(revision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Revision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revision.FieldID)
+		for _, f := range fields {
+			if !revision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != revision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(revision.FieldActorID, field.TypeString)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(revision.FieldActor, field.TypeString)
+	}
+	if _u.mutation.EntityNameCleared() {
+		_spec.ClearField(revision.FieldEntityName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredID(); ok {
+		_spec.SetField(revision.FieldRestoredID, field.TypeString, value)
+	}
+	if _u.mutation.RestoredIDCleared() {
+		_spec.ClearField(revision.FieldRestoredID, field.TypeString)
+	}
+	_node = &Revision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
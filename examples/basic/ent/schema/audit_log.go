@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"github.com/troygilman/vent"
+)
+
+// AuditLog turns on the admin audit log: every admin create, update, delete,
+// and password change is recorded here, and change pages get a History tab.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		vent.VentSchemaAnnotation{
+			SingularDisplayName: "Audit log entry",
+			PluralDisplayName:   "Audit log",
+		},
+	}
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		vent.AuditLogMixin{},
+	}
+}
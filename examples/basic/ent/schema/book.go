@@ -10,19 +10,35 @@ import (
 	"github.com/troygilman/vent"
 )
 
-// Book is the main showcase: mixed field kinds, a unique FK, list filters,
-// read-only fields, a custom virtual field, and an extra permission.
+// Book is the main showcase: mixed field kinds, an enum, JSON and slice fields, an image upload, a unique FK, list filters,
+// read-only fields, field label and widget overrides, a custom virtual field, an extra permission, a
+// version field that catches concurrent edits, and a slug prepopulated from the title.
 type Book struct {
 	ent.Schema
 }
 
 func (Book) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("title").NotEmpty(),
-		field.Int("pages").NonNegative().Default(0),
+		field.String("title").NotEmpty().
+			Annotations(vent.VentFieldAnnotation{Placeholder: "e.g. The Left Hand of Darkness"}),
+		field.String("slug").Optional().
+			Annotations(vent.VentFieldAnnotation{HelpText: "Filled in from the title on the add form."}),
+		field.Int("pages").NonNegative().Default(0).
+			Annotations(vent.VentFieldAnnotation{ColumnHeader: "Pp."}),
 		field.Bool("published").Default(false),
-		field.Time("published_at").Optional().Nillable(),
+		field.Enum("format").Values("hardcover", "paperback", "ebook", "audiobook").Default("paperback"),
+		field.Time("published_at").Optional().Nillable().
+			Annotations(vent.VentFieldAnnotation{
+				Label:    "Publication date",
+				HelpText: "Leave empty for unpublished books.",
+				Widget:   vent.WidgetDate,
+			}),
+		field.Strings("tags").Optional(),
+		field.Ints("editions").Optional(),
+		field.JSON("metadata", map[string]any{}).Optional(),
+		field.String("cover").Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Int("version").Default(1),
 		// Sensitive fields are omitted from the default admin surface; the
 		// custom "notes" field below reads/writes this value instead.
 		field.String("internal_notes").Optional().Nillable().Sensitive(),
@@ -33,6 +49,7 @@ func (Book) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("author", Author.Type).Unique().Required(),
 		edge.To("reviews", Review.Type),
+		edge.From("publisher", Publisher.Type).Ref("books").Unique(),
 	}
 }
 
@@ -42,20 +59,34 @@ func (Book) Annotations() []schema.Annotation {
 			RouteName:           "books",
 			SingularDisplayName: "Book",
 			PluralDisplayName:   "Books",
-			TableColumns:        []string{"title", "author", "published", "pages"},
-			FilterableColumns:   []string{"title", "published", "pages"},
-			FieldSets: []vent.FieldSet{{
-				Fields: []string{
-					"title",
-					"author",
-					"pages",
-					"published",
-					"published_at",
-					"created_at",
-					"notes",
+			TableColumns:        []string{"cover", "title", "author", "format", "published", "pages"},
+			FilterableColumns:   []string{"title", "author", "format", "published", "pages", "published_at"},
+			SearchFields:        []string{"title", "publisher.name", "author.user.email"},
+			DefaultOrdering:     []string{"-published_at", "title"},
+			ImageFields:         []string{"cover"},
+			FieldSets: []vent.FieldSet{
+				{
+					Label:  "Book",
+					Fields: []string{"title", "slug", "cover", "author", "publisher", "pages", "format"},
 				},
-			}},
+				{
+					Label:       "Publishing",
+					Description: "Release status and catalog tags.",
+					Fields:      []string{"published", "published_at", "tags", "editions"},
+				},
+				{
+					Label:       "Advanced",
+					Description: "Free-form metadata and internal notes.",
+					Fields:      []string{"metadata", "created_at", "version", "notes"},
+					Collapsed:   true,
+				},
+			},
 			ReadOnlyFields: []string{"created_at"},
+			// Saves of a change form loaded before someone else's edit are
+			// refused with a diff instead of overwriting it.
+			VersionField: "version",
+			// The add form fills the slug from the title until it is edited.
+			PrepopulatedFields: map[string][]string{"slug": {"title"}},
 			CustomFields: []vent.Field{
 				{Name: "notes", Type: "string", InputType: "string"},
 			},
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/troygilman/vent"
+)
+
+// Publisher uses a UUID primary key to exercise non-int IDs in routes, filters and FK selects,
+// keeps an optional catalog file upload, and is soft deleted: deletes move publishers to the trash.
+type Publisher struct {
+	ent.Schema
+}
+
+func (Publisher) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		vent.SoftDeleteMixin{},
+	}
+}
+
+func (Publisher) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("catalog").Optional(),
+	}
+}
+
+func (Publisher) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("books", Book.Type),
+	}
+}
+
+func (Publisher) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		vent.VentSchemaAnnotation{
+			SingularDisplayName: "Publisher",
+			PluralDisplayName:   "Publishers",
+			TableColumns:        []string{"name", "id"},
+			FilterableColumns:   []string{"name", "id"},
+			FileFields:          []string{"catalog"},
+			FieldSets: []vent.FieldSet{{
+				Fields: []string{"id", "name", "catalog", "books"},
+			}},
+		},
+	}
+}
@@ -16,7 +16,8 @@ type Review struct {
 func (Review) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("rating").Min(1).Max(5),
-		field.String("body").Optional().Nillable(),
+		field.String("body").Optional().Nillable().
+			Annotations(vent.VentFieldAnnotation{Label: "Review", Widget: vent.WidgetTextarea}),
 	}
 }
 
@@ -40,7 +41,8 @@ func (Review) Annotations() []schema.Annotation {
 			PluralDisplayName:   "Reviews",
 			DisableDelete:       true,
 			TableColumns:        []string{"user", "rating", "book"},
-			FilterableColumns:   []string{"rating"},
+			FilterableColumns:   []string{"rating", "book"},
+			SearchFields:        []string{"body", "book.title", "user.email"},
 			FieldSets: []vent.FieldSet{{
 				Fields: []string{"user", "rating", "body", "book"},
 			}},
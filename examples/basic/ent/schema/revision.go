@@ -0,0 +1,18 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"github.com/troygilman/vent"
+)
+
+// Revision turns on entity revisions: every admin update and delete stores
+// the entity's previous values here, and change pages get a Revisions tab.
+type Revision struct {
+	ent.Schema
+}
+
+func (Revision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		vent.RevisionMixin{},
+	}
+}
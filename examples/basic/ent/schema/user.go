@@ -10,6 +10,7 @@ import (
 
 // User extends the Vent auth user mixin with an extra field and schema-level
 // overrides: custom table columns, fieldsets, and an extra permission name.
+// List filters are inherited from the mixin.
 type User struct {
 	ent.Schema
 }
@@ -47,28 +48,21 @@ func (User) Annotations() []schema.Annotation {
 				"is_active",
 				"last_login",
 			},
-			FilterableColumns: []string{
-				"email",
-				"is_staff",
-				"is_active",
-			},
 			Permissions: []vent.Permission{
 				{Name: "impersonate", Desc: "Act as another user"},
 			},
 			FieldSets: []vent.FieldSet{
 				{
-					Fields: []string{
-						"id",
-						"email",
-						"password",
-						"is_staff",
-						"is_superuser",
-						"is_active",
-						"groups",
-						"last_login",
-					},
+					Label:  "Account",
+					Fields: []string{"id", "email", "password", "last_login"},
+				},
+				{
+					Label:       "Access",
+					Description: "Staff users can sign in to the admin; superusers bypass permission checks.",
+					Fields:      []string{"is_staff", "is_superuser", "is_active", "groups"},
 				},
 			},
+			FieldSetLayout: vent.FieldSetLayoutTabs,
 		},
 	}
 }
@@ -0,0 +1,296 @@
+package vent
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ExportBatchSize is how many rows a list export loads per query.
+const ExportBatchSize = 500
+
+// ExportFormat is a list export file format.
+type ExportFormat string
+
+const (
+	ExportFormatCSV   ExportFormat = "csv"
+	ExportFormatJSONL ExportFormat = "jsonl"
+	ExportFormatXLSX  ExportFormat = "xlsx"
+)
+
+// ExportFormats lists the supported export formats in menu order.
+var ExportFormats = []ExportFormat{ExportFormatCSV, ExportFormatJSONL, ExportFormatXLSX}
+
+// ParseExportFormat parses the export format query parameter.
+// Empty values default to CSV.
+func ParseExportFormat(raw string) (ExportFormat, error) {
+	switch format := ExportFormat(strings.ToLower(strings.TrimSpace(raw))); format {
+	case "":
+		return ExportFormatCSV, nil
+	case ExportFormatCSV, ExportFormatJSONL, ExportFormatXLSX:
+		return format, nil
+	default:
+		return "", BadRequest(fmt.Sprintf("unsupported export format %q", raw))
+	}
+}
+
+// Label is the format's menu label.
+func (f ExportFormat) Label() string {
+	switch f {
+	case ExportFormatJSONL:
+		return "JSON Lines"
+	case ExportFormatXLSX:
+		return "Excel"
+	default:
+		return "CSV"
+	}
+}
+
+// ContentType is the format's HTTP Content-Type.
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportFormatJSONL:
+		return "application/jsonl; charset=utf-8"
+	case ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Filename is the download filename for an export named base.
+func (f ExportFormat) Filename(base string) string {
+	return base + "." + string(f)
+}
+
+// ExportWriter streams list rows in one export format. Every row has one
+// value per column, in column order.
+type ExportWriter interface {
+	WriteRow(values []string) error
+	// Flush writes buffered rows to the underlying writer.
+	Flush() error
+	// Close finishes the file. It does not close the underlying writer.
+	Close() error
+}
+
+// NewExportWriter returns a writer for format whose header is columns.
+// CSV and XLSX write a header row; JSON Lines uses columns as object keys.
+func NewExportWriter(w io.Writer, format ExportFormat, columns []string) (ExportWriter, error) {
+	switch format {
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, err
+		}
+		return &csvExportWriter{w: cw}, nil
+	case ExportFormatJSONL:
+		return newJSONLExportWriter(w, columns)
+	case ExportFormatXLSX:
+		return newXLSXExportWriter(w, columns)
+	default:
+		return nil, fmt.Errorf("vent: unsupported export format %q", format)
+	}
+}
+
+// ExportBatch loads up to limit export rows starting at offset, each formatted
+// as one value per column.
+type ExportBatch func(ctx context.Context, offset, limit int) ([][]string, error)
+
+// ServeExport streams an export download named name, loading rows
+// ExportBatchSize at a time until a batch comes back short. Errors before the
+// first batch is written get a normal error response; later errors abort the
+// response so the client sees a failed download rather than a truncated file.
+func ServeExport(w http.ResponseWriter, r *http.Request, format ExportFormat, name string, columns []string, next ExportBatch) {
+	rows, err := next(r.Context(), 0, ExportBatchSize)
+	if err != nil {
+		HandleError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", format.Filename(name)))
+	exporter, err := NewExportWriter(w, format, columns)
+	if err != nil {
+		abortExport(r, err)
+	}
+	rc := http.NewResponseController(w)
+	for offset := 0; ; offset += ExportBatchSize {
+		if offset > 0 {
+			if rows, err = next(r.Context(), offset, ExportBatchSize); err != nil {
+				abortExport(r, err)
+			}
+		}
+		for _, row := range rows {
+			if err := exporter.WriteRow(row); err != nil {
+				abortExport(r, err)
+			}
+		}
+		if len(rows) < ExportBatchSize {
+			break
+		}
+		if err := exporter.Flush(); err != nil {
+			abortExport(r, err)
+		}
+		_ = rc.Flush()
+	}
+	if err := exporter.Close(); err != nil {
+		abortExport(r, err)
+	}
+}
+
+func abortExport(r *http.Request, err error) {
+	logError(r, err)
+	panic(http.ErrAbortHandler)
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (e *csvExportWriter) WriteRow(values []string) error {
+	return e.w.Write(values)
+}
+
+func (e *csvExportWriter) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExportWriter) Close() error {
+	return e.Flush()
+}
+
+type jsonlExportWriter struct {
+	w    *bufio.Writer
+	keys [][]byte
+}
+
+func newJSONLExportWriter(w io.Writer, columns []string) (*jsonlExportWriter, error) {
+	keys := make([][]byte, len(columns))
+	for i, column := range columns {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return &jsonlExportWriter{w: bufio.NewWriter(w), keys: keys}, nil
+}
+
+// WriteRow writes one JSON object with keys in column order, which
+// encoding/json would sort if given a map.
+func (e *jsonlExportWriter) WriteRow(values []string) error {
+	e.w.WriteByte('{')
+	for i, key := range e.keys {
+		if i > 0 {
+			e.w.WriteByte(',')
+		}
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		e.w.Write(key)
+		e.w.WriteByte(':')
+		e.w.Write(value)
+	}
+	_, err := e.w.WriteString("}\n")
+	return err
+}
+
+func (e *jsonlExportWriter) Flush() error {
+	return e.w.Flush()
+}
+
+func (e *jsonlExportWriter) Close() error {
+	return e.Flush()
+}
+
+// xlsxExportWriter writes a single-sheet workbook with inline strings, so
+// rows stream straight into the sheet entry without a shared string table.
+type xlsxExportWriter struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	row   int
+}
+
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Export" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+func newXLSXExportWriter(w io.Writer, columns []string) (*xlsxExportWriter, error) {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxStaticParts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+	// The sheet is the last entry, so it can stay open while rows stream in.
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	e := &xlsxExportWriter{zip: zw, sheet: bufio.NewWriter(f)}
+	e.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	if err := e.WriteRow(columns); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *xlsxExportWriter) WriteRow(values []string) error {
+	e.row++
+	e.sheet.WriteString(`<row r="` + strconv.Itoa(e.row) + `">`)
+	for _, value := range values {
+		e.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(e.sheet, []byte(value)); err != nil {
+			return err
+		}
+		e.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := e.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (e *xlsxExportWriter) Flush() error {
+	if err := e.sheet.Flush(); err != nil {
+		return err
+	}
+	return e.zip.Flush()
+}
+
+func (e *xlsxExportWriter) Close() error {
+	e.sheet.WriteString(`</sheetData></worksheet>`)
+	if err := e.sheet.Flush(); err != nil {
+		return err
+	}
+	return e.zip.Close()
+}
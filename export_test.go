@@ -0,0 +1,132 @@
+package vent
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseExportFormat(t *testing.T) {
+	for raw, want := range map[string]ExportFormat{"": ExportFormatCSV, "JSONL": ExportFormatJSONL, " xlsx ": ExportFormatXLSX} {
+		if got, err := ParseExportFormat(raw); err != nil || got != want {
+			t.Fatalf("ParseExportFormat(%q) = %q, %v, want %q", raw, got, err, want)
+		}
+	}
+	_, err := ParseExportFormat("pdf")
+	if he, ok := AsHttpError(err); !ok || he.Status != http.StatusBadRequest {
+		t.Fatalf("ParseExportFormat(pdf) error = %v, want bad request", err)
+	}
+}
+
+func writeExport(t *testing.T, format ExportFormat, columns []string, rows ...[]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	exporter, err := NewExportWriter(&buf, format, columns)
+	if err != nil {
+		t.Fatalf("NewExportWriter() error = %v", err)
+	}
+	for _, row := range rows {
+		if err := exporter.WriteRow(row); err != nil {
+			t.Fatalf("WriteRow() error = %v", err)
+		}
+	}
+	if err := exporter.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestExportWriterCSV(t *testing.T) {
+	got := string(writeExport(t, ExportFormatCSV, []string{"Title", "Pages"}, []string{"Dune, Part 1", "412"}))
+	want := "Title,Pages\n\"Dune, Part 1\",412\n"
+	if got != want {
+		t.Fatalf("csv = %q, want %q", got, want)
+	}
+}
+
+func TestExportWriterJSONLKeepsColumnOrder(t *testing.T) {
+	got := string(writeExport(t, ExportFormatJSONL, []string{"Title", "Author"},
+		[]string{"Dune", "Herbert"},
+		[]string{`Say "hi"`, ""},
+	))
+	want := `{"Title":"Dune","Author":"Herbert"}` + "\n" + `{"Title":"Say \"hi\"","Author":""}` + "\n"
+	if got != want {
+		t.Fatalf("jsonl = %q, want %q", got, want)
+	}
+}
+
+func TestExportWriterXLSX(t *testing.T) {
+	data := writeExport(t, ExportFormatXLSX, []string{"Title"}, []string{"Tom & Jerry <3"})
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	parts := map[string]string{}
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open(%s) error = %v", f.Name, err)
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[f.Name] = string(b)
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels"} {
+		if _, ok := parts[name]; !ok {
+			t.Fatalf("workbook is missing %s", name)
+		}
+	}
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	for _, want := range []string{`<row r="1">`, `>Title</t>`, `<row r="2">`, `>Tom &amp; Jerry &lt;3</t>`, `</sheetData></worksheet>`} {
+		if !strings.Contains(sheet, want) {
+			t.Fatalf("sheet missing %q:\n%s", want, sheet)
+		}
+	}
+}
+
+func TestServeExportStreamsBatches(t *testing.T) {
+	total := ExportBatchSize + 2
+	var offsets []int
+	next := func(ctx context.Context, offset, limit int) ([][]string, error) {
+		offsets = append(offsets, offset)
+		rows := [][]string{}
+		for i := offset; i < total && len(rows) < limit; i++ {
+			rows = append(rows, []string{"row"})
+		}
+		return rows, nil
+	}
+
+	recorder := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/admin/books/export/?format=csv", nil)
+	ServeExport(recorder, r, ExportFormatCSV, "books", []string{"Title"}, next)
+
+	if len(offsets) != 2 || offsets[1] != ExportBatchSize {
+		t.Fatalf("batch offsets = %v, want [0 %d]", offsets, ExportBatchSize)
+	}
+	if got := strings.Count(recorder.Body.String(), "\n"); got != total+1 {
+		t.Fatalf("lines = %d, want header plus %d rows", got, total)
+	}
+	if got := recorder.Header().Get("Content-Disposition"); got != `attachment; filename="books.csv"` {
+		t.Fatalf("Content-Disposition = %q", got)
+	}
+}
+
+func TestServeExportFirstBatchError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/admin/books/export/", nil)
+	ServeExport(recorder, r, ExportFormatCSV, "books", []string{"Title"}, func(context.Context, int, int) ([][]string, error) {
+		return nil, errors.New("db down")
+	})
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", recorder.Code)
+	}
+	if recorder.Header().Get("Content-Disposition") != "" {
+		t.Fatal("failed exports should not be sent as a download")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"text/template"
 	"unicode"
@@ -74,12 +75,33 @@ func (ext *AdminExtension) Hooks() []gen.Hook {
 	}
 }
 
+// Options enables the Ent features the generated admin needs: ExecContext on
+// transactions, for the savepoint each import row runs in.
+func (ext *AdminExtension) Options() []entc.Option {
+	return []entc.Option{
+		func(cfg *gen.Config) error {
+			if enabled, _ := cfg.FeatureEnabled(gen.FeatureExecQuery.Name); !enabled {
+				cfg.Features = append(cfg.Features, gen.FeatureExecQuery)
+			}
+			return nil
+		},
+	}
+}
+
 func adminTemplateFuncs() template.FuncMap {
 	return template.FuncMap{
 		"fieldComponentRenderFunc": fieldComponentRenderFunc,
 		"fieldComponentPropsType":  fieldComponentPropsType,
 		"isFieldKindPassword":      isFieldKindPassword,
 		"isFieldKindTime":          isFieldKindTime,
+		"isFieldKindEnum":          isFieldKindEnum,
+		"isFieldKindJSON":          isFieldKindJSON,
+		"isFieldKindList":          isFieldKindList,
+		"isFieldKindInts":          isFieldKindInts,
+		"isFieldKindUpload":        isUploadFieldKind,
+		"isFieldKindImage":         isFieldKindImage,
+		"fieldKindHasPlaceholder":  fieldKindHasPlaceholder,
+		"formValueFunc":            formValueFunc,
 		"isMemberKindCustom":       isMemberKindCustom,
 		"isMemberKindEdge":         isMemberKindEdge,
 		"isMemberKindEntField":     isMemberKindEntField,
@@ -87,6 +109,8 @@ func adminTemplateFuncs() template.FuncMap {
 		"hasGeneratedFieldDefault": hasGeneratedFieldDefault,
 		"fieldsVarName":            fieldsVarName,
 		"resourceName":             resourceName,
+		"idPackages":               idPackages,
+		"searchPackages":           searchPackages,
 	}
 }
 
@@ -107,6 +131,54 @@ func (e *AdminExtension) Templates() []*gen.Template {
 	}
 }
 
+// idPackages returns the distinct imports needed to name the ID types of configs.
+func idPackages(configs []NodeRenderConfig) []IDPackage {
+	var packages []IDPackage
+	seen := make(map[string]struct{})
+	for _, config := range configs {
+		pkg := config.RC.IDPackage
+		if pkg.Path == "" {
+			continue
+		}
+		if _, ok := seen[pkg.Path]; ok {
+			continue
+		}
+		seen[pkg.Path] = struct{}{}
+		packages = append(packages, pkg)
+	}
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].Path < packages[j].Path
+	})
+	return packages
+}
+
+// searchPackages returns the Ent packages search predicates reach through
+// edges that are not already imported as admin schemas.
+func searchPackages(configs []NodeRenderConfig) []string {
+	seen := make(map[string]struct{})
+	for _, config := range configs {
+		seen[config.Node.Package()] = struct{}{}
+	}
+	var packages []string
+	add := func(pkg string) {
+		if _, ok := seen[pkg]; ok {
+			return
+		}
+		seen[pkg] = struct{}{}
+		packages = append(packages, pkg)
+	}
+	for _, config := range configs {
+		for _, search := range config.RC.SearchFields {
+			for _, hop := range search.Hops {
+				add(hop.Package)
+			}
+			add(search.Package)
+		}
+	}
+	sort.Strings(packages)
+	return packages
+}
+
 func isMemberKindCustom(member SurfaceMember) bool {
 	return member.MemberKind == MemberCustom
 }
@@ -151,6 +223,14 @@ func fieldComponentRenderFunc(member SurfaceMember) string {
 		return "RenderTimeFieldHTML"
 	case FieldKindPassword:
 		return "RenderPasswordFieldHTML"
+	case FieldKindEnum:
+		return "RenderEnumFieldHTML"
+	case FieldKindJSON:
+		return "RenderJSONFieldHTML"
+	case FieldKindStrings, FieldKindInts:
+		return "RenderTagsFieldHTML"
+	case FieldKindFile, FieldKindImage:
+		return "RenderFileFieldHTML"
 	default:
 		return "RenderTextFieldHTML"
 	}
@@ -174,6 +254,14 @@ func fieldComponentPropsType(member SurfaceMember) string {
 		return "SchemaEntityTimeFieldProps"
 	case FieldKindPassword:
 		return "SchemaEntityPasswordFieldProps"
+	case FieldKindEnum:
+		return "SchemaEntityEnumFieldProps"
+	case FieldKindJSON:
+		return "SchemaEntityJSONFieldProps"
+	case FieldKindStrings, FieldKindInts:
+		return "SchemaEntityTagsFieldProps"
+	case FieldKindFile, FieldKindImage:
+		return "SchemaEntityFileFieldProps"
 	default:
 		return "SchemaEntityTextFieldProps"
 	}
@@ -186,9 +274,35 @@ func setVentConfigAnnotation(graph *gen.Graph, config VentExtensionConfig, confi
 	graph.Annotations[VentConfigAnnotation{}.Name()] = VentConfigAnnotation{
 		VentExtensionConfig: config,
 		Configs:             configs,
+		AuditLog:            auditLogConfig(configs),
+		Revisions:           revisionConfig(graph.Nodes),
 	}
 }
 
+// auditLogConfig returns the audit log schema among configs, if any.
+func auditLogConfig(configs []NodeRenderConfig) AuditLogConfig {
+	for _, config := range configs {
+		if config.RC.IsAuditLogSchema {
+			return AuditLogConfig{
+				SchemaName: config.Node.Name,
+				RouteName:  config.RC.RouteName,
+			}
+		}
+	}
+	return AuditLogConfig{}
+}
+
+// revisionConfig returns the revision schema among nodes, if any. It has no
+// admin, so it is not among the render configs.
+func revisionConfig(nodes []*gen.Type) RevisionConfig {
+	for _, node := range nodes {
+		if isRevisionNode(node) {
+			return RevisionConfig{SchemaName: node.Name, IDType: idType(node)}
+		}
+	}
+	return RevisionConfig{}
+}
+
 func formInputTypeForField(field *gen.Field) FieldKind {
 	kind, ok := fieldKindForEntField(field)
 	if !ok {
@@ -210,11 +324,33 @@ func fieldKindForEntField(field *gen.Field) (FieldKind, bool) {
 		return FieldKindInt, true
 	case schemafield.TypeFloat32, schemafield.TypeFloat64:
 		return FieldKindFloat, true
+	case schemafield.TypeEnum:
+		// Enums backed by a custom GoType have no generated constants to
+		// convert form input into, so only Ent-generated enums are supported.
+		if field.HasGoType() {
+			return "", false
+		}
+		return FieldKindEnum, true
+	case schemafield.TypeJSON:
+		return jsonFieldKind(field), true
 	default:
 		return "", false
 	}
 }
 
+// jsonFieldKind picks the tag-style editor for plain string and int slices
+// (field.Strings, field.Ints) and the JSON editor for every other JSON type.
+func jsonFieldKind(field *gen.Field) FieldKind {
+	switch field.Type.String() {
+	case "[]string":
+		return FieldKindStrings
+	case "[]int":
+		return FieldKindInts
+	default:
+		return FieldKindJSON
+	}
+}
+
 func customFieldKind(field Field) FieldKind {
 	kind, ok := FieldKindFromString(customFieldKindValue(field))
 	if !ok {
@@ -238,6 +374,37 @@ func isFieldKindTime(kind FieldKind) bool {
 	return kind == FieldKindTime
 }
 
+func isFieldKindEnum(kind FieldKind) bool {
+	return kind == FieldKindEnum
+}
+
+func isFieldKindJSON(kind FieldKind) bool {
+	return kind == FieldKindJSON
+}
+
+func isFieldKindList(kind FieldKind) bool {
+	return kind == FieldKindStrings || kind == FieldKindInts
+}
+
+func isFieldKindInts(kind FieldKind) bool {
+	return kind == FieldKindInts
+}
+
+func isFieldKindImage(kind FieldKind) bool {
+	return kind == FieldKindImage
+}
+
+// formValueFunc is the vent helper that formats a member value for its form control.
+func formValueFunc(member SurfaceMember) string {
+	switch member.FieldKind {
+	case FieldKindJSON:
+		return "vent.FormatJSONFormValue"
+	case FieldKindStrings, FieldKindInts:
+		return "vent.FormatListFormValue"
+	}
+	return "vent.FormatFormValue"
+}
+
 func isSupportedInputField(field *gen.Field) bool {
 	_, ok := fieldKindForEntField(field)
 	return ok
@@ -267,7 +434,7 @@ func createInputTypeForEntField(field *gen.Field) string {
 }
 
 func baseInputTypeForEntField(field *gen.Field) string {
-	if field.IsTime() {
+	if field.IsTime() || field.IsEnum() || field.IsJSON() {
 		return "string"
 	}
 	return field.Type.Type.String()
@@ -446,8 +613,36 @@ func validateVentGraph(graph *gen.Graph, config VentExtensionConfig) error {
 		errs = append(errs, validateAuthMixinRole(permissionNode, AuthRolePermission)...)
 	}
 
+	var auditLogNodes, revisionNodes []string
 	for _, node := range graph.Nodes {
 		errs = append(errs, validateVentSchemaAnnotation(node)...)
+		if isAuditLogNode(node) {
+			auditLogNodes = append(auditLogNodes, node.Name)
+			var annotation VentSchemaAnnotation
+			if annotation.parse(node) == nil && annotation.DisableAdmin {
+				errs = append(errs, fmt.Sprintf("audit log schema %q cannot disable the admin", node.Name))
+			}
+		}
+		if isRevisionNode(node) {
+			revisionNodes = append(revisionNodes, node.Name)
+			if idPackage(node).Path != "" {
+				errs = append(errs, fmt.Sprintf("revision schema %q must use a built-in ID type", node.Name))
+			}
+		}
+		if isSoftDeleteNode(node) {
+			if isAuditLogNode(node) || isRevisionNode(node) {
+				errs = append(errs, fmt.Sprintf("schema %q cannot combine the soft delete mixin with the audit log or revision mixin", node.Name))
+			}
+			if graph.Config != nil && graph.Storage != nil && graph.Storage.Name != "sql" {
+				errs = append(errs, fmt.Sprintf("schema %q uses the soft delete mixin, which needs Ent's SQL storage", node.Name))
+			}
+		}
+	}
+	if len(auditLogNodes) > 1 {
+		errs = append(errs, fmt.Sprintf("only one schema may use the audit log mixin, found %s", strings.Join(auditLogNodes, ", ")))
+	}
+	if len(revisionNodes) > 1 {
+		errs = append(errs, fmt.Sprintf("only one schema may use the revision mixin, found %s", strings.Join(revisionNodes, ", ")))
 	}
 
 	if len(errs) > 0 {
@@ -539,6 +734,12 @@ func validateVentSchemaAnnotation(node *gen.Type) []string {
 		errs = append(errs, fmt.Sprintf("schema %q page size must be positive", node.Name))
 	}
 
+	for _, name := range annotation.Clear {
+		if !isClearableAnnotationField(name) {
+			errs = append(errs, fmt.Sprintf("schema %q clears unknown annotation field %q", node.Name, name))
+		}
+	}
+
 	seenFilters := make(map[string]struct{}, len(annotation.FilterableColumns))
 	for _, column := range annotation.FilterableColumns {
 		if _, dup := seenFilters[column]; dup {
@@ -559,6 +760,12 @@ func validateVentSchemaAnnotation(node *gen.Type) []string {
 		}
 	}
 
+	if annotation.VersionField != "" {
+		if msg := versionFieldError(node, annotation.VersionField); msg != "" {
+			errs = append(errs, msg)
+		}
+	}
+
 	for _, fieldSet := range annotation.FieldSets {
 		for _, fieldName := range fieldSet.Fields {
 			if fieldName != "id" {
@@ -596,19 +803,46 @@ func filterableColumnError(node *gen.Type, name string) string {
 		}
 		kind, ok := fieldKindForEntField(field)
 		if !ok {
-			return fmt.Sprintf("schema %q filterable column %q has unsupported type; only string, bool, and int fields are supported", node.Name, name)
+			return fmt.Sprintf("schema %q filterable column %q has unsupported type; only string, bool, int, float, time, and enum fields are supported", node.Name, name)
 		}
-		if _, ok := filterTypeForFieldKind(kind); !ok {
-			return fmt.Sprintf("schema %q filterable column %q has unsupported type; only string, bool, and int fields are supported", node.Name, name)
+		if filterType, ok := filterTypeForFieldKind(kind); !ok || !filterSupportsField(filterType, field) {
+			return fmt.Sprintf("schema %q filterable column %q has unsupported type; only string, bool, int, float, time, and enum fields are supported", node.Name, name)
 		}
 		return ""
 	}
-	if hasEdge(node, name) {
-		return fmt.Sprintf("schema %q filterable column %q is an edge; only scalar fields are supported", node.Name, name)
+	if edge, ok := findEdge(node, name); ok {
+		if edge.Type != nil && !resolveSchemaMeta(edge.Type).AdminEnabled {
+			return fmt.Sprintf("schema %q filterable column %q is an edge to %s, which has no admin", node.Name, name, edge.Type.Name)
+		}
+		return ""
 	}
 	return fmt.Sprintf("schema %q filterable column %q does not exist", node.Name, name)
 }
 
+// versionFieldError checks that a VersionField names a required, mutable
+// int or time field with a default, which the admin can compare and advance.
+func versionFieldError(node *gen.Type, name string) string {
+	field, ok := findField(node, name)
+	if !ok {
+		return fmt.Sprintf("schema %q version field %q does not exist", node.Name, name)
+	}
+	switch field.Type.Type {
+	case schemafield.TypeInt, schemafield.TypeInt8, schemafield.TypeInt16, schemafield.TypeInt32, schemafield.TypeInt64, schemafield.TypeTime:
+	default:
+		return fmt.Sprintf("schema %q version field %q must be an int or time field", node.Name, name)
+	}
+	if field.HasGoType() {
+		return fmt.Sprintf("schema %q version field %q must not have a custom GoType", node.Name, name)
+	}
+	if field.Optional || field.Nillable || field.Immutable {
+		return fmt.Sprintf("schema %q version field %q must be required and mutable", node.Name, name)
+	}
+	if !field.Default {
+		return fmt.Sprintf("schema %q version field %q needs a default for new entities", node.Name, name)
+	}
+	return ""
+}
+
 func hasField(node *gen.Type, name string) bool {
 	_, ok := findField(node, name)
 	return ok
@@ -624,12 +858,17 @@ func findField(node *gen.Type, name string) (*gen.Field, bool) {
 }
 
 func hasEdge(node *gen.Type, name string) bool {
+	_, ok := findEdge(node, name)
+	return ok
+}
+
+func findEdge(node *gen.Type, name string) (*gen.Edge, bool) {
 	for _, edge := range node.Edges {
 		if edge.Name == name {
-			return true
+			return edge, true
 		}
 	}
-	return false
+	return nil, false
 }
 
 // AuthSchemas maps Vent's required auth roles to Ent schema type references.
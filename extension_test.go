@@ -30,6 +30,13 @@ func TestFieldKindFromString(t *testing.T) {
 		"foreign_key_unique": FieldKindForeignKeyUnique,
 		"time":               FieldKindTime,
 		"time.Time":          FieldKindTime,
+		"enum":               FieldKindEnum,
+		"json":               FieldKindJSON,
+		"json.RawMessage":    FieldKindJSON,
+		"strings":            FieldKindStrings,
+		"[]string":           FieldKindStrings,
+		"ints":               FieldKindInts,
+		"[]int":              FieldKindInts,
 	}
 
 	for input, want := range tests {
@@ -42,7 +49,7 @@ func TestFieldKindFromString(t *testing.T) {
 		}
 	}
 
-	for _, input := range []string{"", "json", "uuid", "bytes", "enum", "unsupported"} {
+	for _, input := range []string{"", "uuid", "bytes", "unsupported"} {
 		if got, ok := FieldKindFromString(input); ok {
 			t.Fatalf("FieldKindFromString(%q) = %q, true; want unsupported", input, got)
 		}
@@ -66,6 +73,8 @@ func TestFieldKindForEntField(t *testing.T) {
 		schemafield.TypeUint64:  FieldKindInt,
 		schemafield.TypeFloat32: FieldKindFloat,
 		schemafield.TypeFloat64: FieldKindFloat,
+		schemafield.TypeEnum:    FieldKindEnum,
+		schemafield.TypeJSON:    FieldKindJSON,
 	}
 
 	for fieldType, want := range tests {
@@ -85,7 +94,7 @@ func TestFieldKindForEntField(t *testing.T) {
 		}
 	}
 
-	for _, fieldType := range []schemafield.Type{schemafield.TypeJSON, schemafield.TypeUUID, schemafield.TypeBytes, schemafield.TypeEnum, schemafield.TypeOther, schemafield.TypeInvalid} {
+	for _, fieldType := range []schemafield.Type{schemafield.TypeUUID, schemafield.TypeBytes, schemafield.TypeOther, schemafield.TypeInvalid} {
 		field := testField(fieldType)
 		if got, ok := fieldKindForEntField(field); ok {
 			t.Fatalf("fieldKindForEntField(%s) = %q, true; want unsupported", fieldType, got)
@@ -94,6 +103,18 @@ func TestFieldKindForEntField(t *testing.T) {
 			t.Fatalf("isSupportedInputField(%s) = true, want false", fieldType)
 		}
 	}
+
+	for ident, want := range map[string]FieldKind{"[]string": FieldKindStrings, "[]int": FieldKindInts, "map[string]interface {}": FieldKindJSON} {
+		field := &gen.Field{Type: &schemafield.TypeInfo{Type: schemafield.TypeJSON, Ident: ident}}
+		if got, ok := fieldKindForEntField(field); !ok || got != want {
+			t.Fatalf("fieldKindForEntField(JSON %s) = %q, %v; want %q", ident, got, ok, want)
+		}
+	}
+
+	goTypeEnum := &gen.Field{Type: &schemafield.TypeInfo{Type: schemafield.TypeEnum, RType: &schemafield.RType{Name: "Status"}}}
+	if got, ok := fieldKindForEntField(goTypeEnum); ok {
+		t.Fatalf("fieldKindForEntField(GoType enum) = %q, true; want unsupported", got)
+	}
 }
 
 func TestBuildRenderConfigDefaultInputSemantics(t *testing.T) {
@@ -120,9 +141,8 @@ func TestBuildRenderConfigDefaultInputSemantics(t *testing.T) {
 	assertInputField(t, rc.UpdateInputFields, "author", "author", "*string", false, false)
 	assertInputField(t, rc.UpdateInputFields, "tags", "tags", "*[]string", false, false)
 
-	if field := findInputField(rc.CreateInputFields, "settings"); field != nil {
-		t.Fatalf("unsupported JSON field was included: %+v", *field)
-	}
+	assertInputField(t, rc.CreateInputFields, "settings", "settings", "string", false, false)
+	assertInputField(t, rc.UpdateInputFields, "settings", "settings", "*string", false, false)
 }
 
 func TestBuildRenderConfigSurfaceMemberKinds(t *testing.T) {
@@ -301,31 +321,37 @@ func TestFilterableColumnValidation(t *testing.T) {
 			},
 		},
 	}
+	if errs = validateVentSchemaAnnotation(edgeNode); len(errs) != 0 {
+		t.Fatalf("validateVentSchemaAnnotation(edge filter) = %v, want edges to admin schemas accepted", errs)
+	}
+	edgeNode.Edges[0].Type.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{DisableAdmin: true},
+	}
 	errs = validateVentSchemaAnnotation(edgeNode)
 	if len(errs) == 0 {
-		t.Fatal("validateVentSchemaAnnotation(edge filter) returned no errors")
+		t.Fatal("validateVentSchemaAnnotation(edge filter without admin) returned no errors")
 	}
-	if !strings.Contains(errs[0], `filterable column "author" is an edge`) {
-		t.Fatalf("validateVentSchemaAnnotation(edge filter) = %v", errs)
+	if !strings.Contains(errs[0], `filterable column "author" is an edge to User, which has no admin`) {
+		t.Fatalf("validateVentSchemaAnnotation(edge filter without admin) = %v", errs)
 	}
 
-	timeNode := &gen.Type{
+	jsonNode := &gen.Type{
 		Name: "Article",
 		Fields: []*gen.Field{
-			{Name: "starts_at", Type: &schemafield.TypeInfo{Type: schemafield.TypeTime}},
+			{Name: "settings", Type: &schemafield.TypeInfo{Type: schemafield.TypeJSON}},
 		},
 		Annotations: gen.Annotations{
 			VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
-				FilterableColumns: []string{"starts_at"},
+				FilterableColumns: []string{"settings"},
 			},
 		},
 	}
-	errs = validateVentSchemaAnnotation(timeNode)
+	errs = validateVentSchemaAnnotation(jsonNode)
 	if len(errs) == 0 {
-		t.Fatal("validateVentSchemaAnnotation(time filter) returned no errors")
+		t.Fatal("validateVentSchemaAnnotation(json filter) returned no errors")
 	}
-	if !strings.Contains(errs[0], `filterable column "starts_at" has unsupported type`) {
-		t.Fatalf("validateVentSchemaAnnotation(time filter) = %v", errs)
+	if !strings.Contains(errs[0], `filterable column "settings" has unsupported type`) {
+		t.Fatalf("validateVentSchemaAnnotation(json filter) = %v", errs)
 	}
 
 	dupNode := &gen.Type{
@@ -388,6 +414,74 @@ func TestPageSizeValidation(t *testing.T) {
 	}
 }
 
+func TestClearValidation(t *testing.T) {
+	node := &gen.Type{
+		Name: "Article",
+		Annotations: gen.Annotations{
+			VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+				Clear: []string{"TableColumns", "Colums", "Clear"},
+			},
+		},
+	}
+	errs := validateVentSchemaAnnotation(node)
+	if len(errs) != 2 {
+		t.Fatalf("validateVentSchemaAnnotation() = %v, want 2 errors", errs)
+	}
+	if !strings.Contains(errs[0], `clears unknown annotation field "Colums"`) {
+		t.Fatalf("validateVentSchemaAnnotation() = %v", errs)
+	}
+}
+
+func TestClearValidationAfterMerge(t *testing.T) {
+	merged := VentSchemaAnnotation{ReadOnly: true}.Merge(&VentSchemaAnnotation{
+		Clear: []string{"TableColumn"},
+	})
+	node := &gen.Type{
+		Name:        "Article",
+		Annotations: gen.Annotations{VentSchemaAnnotation{}.Name(): merged},
+	}
+	errs := validateVentSchemaAnnotation(node)
+	if len(errs) != 1 || !strings.Contains(errs[0], `clears unknown annotation field "TableColumn"`) {
+		t.Fatalf("validateVentSchemaAnnotation(merged) = %v, want the misspelled Clear name reported", errs)
+	}
+}
+
+func TestVersionFieldValidation(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"revision", ""},
+		{"updated_at", ""},
+		{"missing", `version field "missing" does not exist`},
+		{"title", `version field "title" must be an int or time field`},
+		{"ends_at", `version field "ends_at" must be required and mutable`},
+		{"starts_at", `version field "starts_at" needs a default for new entities`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			node := testInputNode()
+			node.Fields = append(node.Fields,
+				&gen.Field{Name: "revision", Type: &schemafield.TypeInfo{Type: schemafield.TypeInt64}, Default: true},
+				&gen.Field{Name: "updated_at", Type: &schemafield.TypeInfo{Type: schemafield.TypeTime}, Default: true},
+			)
+			node.Annotations = gen.Annotations{
+				VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{VersionField: tt.field},
+			}
+			errs := validateVentSchemaAnnotation(node)
+			if tt.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("validateVentSchemaAnnotation() = %v, want none", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0], tt.want) {
+				t.Fatalf("validateVentSchemaAnnotation() = %v, want %q", errs, tt.want)
+			}
+		})
+	}
+}
+
 func TestCustomFieldKindValidation(t *testing.T) {
 	validNode := &gen.Type{
 		Name: "Article",
@@ -405,7 +499,7 @@ func TestCustomFieldKindValidation(t *testing.T) {
 		Name: "Article",
 		Annotations: gen.Annotations{
 			VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
-				CustomFields: []Field{{Name: "settings", Type: "json"}},
+				CustomFields: []Field{{Name: "settings", Type: "uuid"}},
 			},
 		},
 	}
@@ -413,7 +507,7 @@ func TestCustomFieldKindValidation(t *testing.T) {
 	if len(errs) == 0 {
 		t.Fatalf("validateVentSchemaAnnotation(invalid custom field) returned no errors")
 	}
-	if !strings.Contains(errs[0], `custom field "settings" has unsupported input type "json"`) {
+	if !strings.Contains(errs[0], `custom field "settings" has unsupported input type "uuid"`) {
 		t.Fatalf("validateVentSchemaAnnotation(invalid custom field) = %v", errs)
 	}
 }
@@ -443,6 +537,33 @@ func TestValidateRouteNames(t *testing.T) {
 	}
 }
 
+func TestAuditLogConfig(t *testing.T) {
+	configs := []NodeRenderConfig{
+		{Node: &gen.Type{Name: "Book"}, RC: RenderConfig{SchemaMeta: SchemaMeta{RouteName: "books"}}},
+		{Node: &gen.Type{Name: "AuditLog"}, RC: RenderConfig{SchemaMeta: SchemaMeta{RouteName: "audit_logs", IsAuditLogSchema: true}}},
+	}
+	want := AuditLogConfig{SchemaName: "AuditLog", RouteName: "audit_logs"}
+	if got := auditLogConfig(configs); got != want {
+		t.Fatalf("auditLogConfig() = %+v, want %+v", got, want)
+	}
+	if got := auditLogConfig(configs[:1]); got != (AuditLogConfig{}) {
+		t.Fatalf("auditLogConfig(no audit schema) = %+v, want zero", got)
+	}
+}
+
+func TestRevisionConfig(t *testing.T) {
+	nodes := []*gen.Type{
+		{Name: "Book"},
+		{Name: "Revision", Annotations: gen.Annotations{VentRevisionAnnotation{}.Name(): VentRevisionAnnotation{}}},
+	}
+	if got := revisionConfig(nodes); got != (RevisionConfig{SchemaName: "Revision", IDType: "int"}) {
+		t.Fatalf("revisionConfig() = %+v, want Revision", got)
+	}
+	if got := revisionConfig(nodes[:1]); got != (RevisionConfig{}) {
+		t.Fatalf("revisionConfig(no revision schema) = %+v, want zero", got)
+	}
+}
+
 func TestNormalizeAdminPath(t *testing.T) {
 	tests := map[string]string{
 		"":           "/admin/",
@@ -12,6 +12,12 @@ const (
 	FieldKindForeignKey       FieldKind = "foreign_key"
 	FieldKindForeignKeyUnique FieldKind = "foreign_key_unique"
 	FieldKindTime             FieldKind = "time"
+	FieldKindEnum             FieldKind = "enum"
+	FieldKindJSON             FieldKind = "json"
+	FieldKindStrings          FieldKind = "strings"
+	FieldKindInts             FieldKind = "ints"
+	FieldKindFile             FieldKind = "file"
+	FieldKindImage            FieldKind = "image"
 )
 
 // FieldKindFromString normalizes a string into a supported FieldKind.
@@ -33,6 +39,18 @@ func FieldKindFromString(value string) (FieldKind, bool) {
 		return FieldKindForeignKeyUnique, true
 	case string(FieldKindTime), "time.Time":
 		return FieldKindTime, true
+	case string(FieldKindEnum):
+		return FieldKindEnum, true
+	case string(FieldKindJSON), "json.RawMessage":
+		return FieldKindJSON, true
+	case string(FieldKindStrings), "[]string":
+		return FieldKindStrings, true
+	case string(FieldKindInts), "[]int":
+		return FieldKindInts, true
+	case string(FieldKindFile):
+		return FieldKindFile, true
+	case string(FieldKindImage):
+		return FieldKindImage, true
 	default:
 		return "", false
 	}
@@ -1,5 +1,13 @@
 package vent
 
+import (
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // BoolFilter is a list-filter selector for boolean fields.
 type BoolFilter string
 
@@ -34,3 +42,172 @@ func (f BoolFilter) Normalize() BoolFilter {
 func (f BoolFilter) String() string {
 	return string(f)
 }
+
+// FilterParam returns a list-filter query parameter, joining repeated values
+// (e.g. from a multi-select) with commas so they round-trip as one value.
+func FilterParam(q url.Values, key string) string {
+	return strings.Join(q[key], ",")
+}
+
+// FilterValues splits a multi-value list filter ("a, b") into its trimmed,
+// non-empty values.
+func FilterValues(raw string) []string {
+	var values []string
+	for _, value := range strings.Split(raw, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Integer is the set of Go types backing Ent integer fields.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Float is the set of Go types backing Ent float fields.
+type Float interface {
+	~float32 | ~float64
+}
+
+// ParseIntFilter parses one integer filter value; ok is false when raw is
+// empty or not a valid T.
+func ParseIntFilter[T Integer](raw string) (v T, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, false
+	}
+	if T(0)-1 > 0 {
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil || uint64(T(n)) != n {
+			return 0, false
+		}
+		return T(n), true
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || int64(T(n)) != n {
+		return 0, false
+	}
+	return T(n), true
+}
+
+// ParseIntFilterValues parses a comma-separated integer filter, dropping
+// invalid entries.
+func ParseIntFilterValues[T Integer](raw string) []T {
+	var values []T
+	for _, value := range FilterValues(raw) {
+		if n, ok := ParseIntFilter[T](value); ok {
+			values = append(values, n)
+		}
+	}
+	return values
+}
+
+// ParseFloatFilter parses one float filter value; ok is false when raw is
+// empty or not a finite number.
+func ParseFloatFilter[T Float](raw string) (v T, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+		return 0, false
+	}
+	return T(n), true
+}
+
+// ParseFloatFilterValues parses a comma-separated float filter, dropping
+// invalid entries.
+func ParseFloatFilterValues[T Float](raw string) []T {
+	var values []T
+	for _, value := range FilterValues(raw) {
+		if n, ok := ParseFloatFilter[T](value); ok {
+			values = append(values, n)
+		}
+	}
+	return values
+}
+
+// TimeFilterPreset names a relative date range for time list filters.
+type TimeFilterPreset string
+
+const (
+	TimeFilterToday      TimeFilterPreset = "today"
+	TimeFilterLast7Days  TimeFilterPreset = "last_7_days"
+	TimeFilterLast30Days TimeFilterPreset = "last_30_days"
+	TimeFilterThisMonth  TimeFilterPreset = "this_month"
+	TimeFilterThisYear   TimeFilterPreset = "this_year"
+)
+
+// TimeFilterPresets lists the presets offered by time filters, in display order.
+var TimeFilterPresets = []TimeFilterPreset{
+	TimeFilterToday,
+	TimeFilterLast7Days,
+	TimeFilterLast30Days,
+	TimeFilterThisMonth,
+	TimeFilterThisYear,
+}
+
+// Label returns the preset's display text, or "" for unknown presets.
+func (p TimeFilterPreset) Label() string {
+	switch p {
+	case TimeFilterToday:
+		return "Today"
+	case TimeFilterLast7Days:
+		return "Last 7 days"
+	case TimeFilterLast30Days:
+		return "Last 30 days"
+	case TimeFilterThisMonth:
+		return "This month"
+	case TimeFilterThisYear:
+		return "This year"
+	default:
+		return ""
+	}
+}
+
+// Range returns the half-open [from, to) range the preset covers relative
+// to now, in now's location. ok is false for unknown presets.
+func (p TimeFilterPreset) Range(now time.Time) (from, to time.Time, ok bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	tomorrow := today.AddDate(0, 0, 1)
+	switch p {
+	case TimeFilterToday:
+		return today, tomorrow, true
+	case TimeFilterLast7Days:
+		return today.AddDate(0, 0, -6), tomorrow, true
+	case TimeFilterLast30Days:
+		return today.AddDate(0, 0, -29), tomorrow, true
+	case TimeFilterThisMonth:
+		month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return month, month.AddDate(0, 1, 0), true
+	case TimeFilterThisYear:
+		year := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+		return year, year.AddDate(1, 0, 0), true
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+}
+
+// TimeFilterRange resolves a time filter into a half-open [from, to) range.
+// min and max are inclusive dates (YYYY-MM-DD); a preset narrows the range
+// further. Zero bounds are unbounded.
+func TimeFilterRange(preset, min, max string, now time.Time) (from, to time.Time) {
+	if day, err := time.ParseInLocation(time.DateOnly, strings.TrimSpace(min), now.Location()); err == nil {
+		from = day
+	}
+	if day, err := time.ParseInLocation(time.DateOnly, strings.TrimSpace(max), now.Location()); err == nil {
+		to = day.AddDate(0, 0, 1)
+	}
+	if presetFrom, presetTo, ok := TimeFilterPreset(preset).Range(now); ok {
+		if from.IsZero() || presetFrom.After(from) {
+			from = presetFrom
+		}
+		if to.IsZero() || presetTo.Before(to) {
+			to = presetTo
+		}
+	}
+	return from, to
+}
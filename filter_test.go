@@ -3,7 +3,10 @@ package vent
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestIsDatastarRequest(t *testing.T) {
@@ -72,3 +75,85 @@ func TestBoolFilterJSON(t *testing.T) {
 		t.Fatalf("Bool() = %v, %v; want false, true", v, ok)
 	}
 }
+
+func TestFilterParamJoinsRepeatedValues(t *testing.T) {
+	q := url.Values{"filter.format": {"ebook", "hardcover"}}
+	if got := FilterParam(q, "filter.format"); got != "ebook,hardcover" {
+		t.Fatalf("FilterParam() = %q, want ebook,hardcover", got)
+	}
+	if got := FilterValues(" ebook, ,hardcover "); !reflect.DeepEqual(got, []string{"ebook", "hardcover"}) {
+		t.Fatalf("FilterValues() = %q", got)
+	}
+}
+
+func TestParseIntFilter(t *testing.T) {
+	if v, ok := ParseIntFilter[int](" 42 "); !ok || v != 42 {
+		t.Fatalf("ParseIntFilter[int](42) = %v, %v", v, ok)
+	}
+	if _, ok := ParseIntFilter[int8]("300"); ok {
+		t.Fatal("ParseIntFilter[int8](300) should overflow")
+	}
+	if _, ok := ParseIntFilter[uint]("-1"); ok {
+		t.Fatal("ParseIntFilter[uint](-1) should fail")
+	}
+	if _, ok := ParseIntFilter[int](""); ok {
+		t.Fatal("ParseIntFilter[int](\"\") should be unset")
+	}
+	if got := ParseIntFilterValues[int64]("1, x, 3"); !reflect.DeepEqual(got, []int64{1, 3}) {
+		t.Fatalf("ParseIntFilterValues() = %v, want [1 3]", got)
+	}
+}
+
+func TestParseFloatFilter(t *testing.T) {
+	if v, ok := ParseFloatFilter[float64]("2.5"); !ok || v != 2.5 {
+		t.Fatalf("ParseFloatFilter(2.5) = %v, %v", v, ok)
+	}
+	for _, raw := range []string{"", "NaN", "Inf", "abc"} {
+		if _, ok := ParseFloatFilter[float32](raw); ok {
+			t.Fatalf("ParseFloatFilter(%q) should fail", raw)
+		}
+	}
+	if got := ParseFloatFilterValues[float64]("1.5,2"); !reflect.DeepEqual(got, []float64{1.5, 2}) {
+		t.Fatalf("ParseFloatFilterValues() = %v", got)
+	}
+}
+
+func TestTimeFilterPresetRange(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 13, 30, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time { return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC) }
+	cases := map[TimeFilterPreset][2]time.Time{
+		TimeFilterToday:      {day(time.March, 15), day(time.March, 16)},
+		TimeFilterLast7Days:  {day(time.March, 9), day(time.March, 16)},
+		TimeFilterLast30Days: {day(time.February, 15), day(time.March, 16)},
+		TimeFilterThisMonth:  {day(time.March, 1), day(time.April, 1)},
+		TimeFilterThisYear:   {day(time.January, 1), time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for preset, want := range cases {
+		from, to, ok := preset.Range(now)
+		if !ok || !from.Equal(want[0]) || !to.Equal(want[1]) {
+			t.Fatalf("%s.Range() = %v, %v, %v; want %v, %v", preset, from, to, ok, want[0], want[1])
+		}
+		if preset.Label() == "" {
+			t.Fatalf("%s has no label", preset)
+		}
+	}
+	if _, _, ok := TimeFilterPreset("someday").Range(now); ok {
+		t.Fatal("unknown preset should not resolve")
+	}
+}
+
+func TestTimeFilterRange(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 13, 30, 0, 0, time.UTC)
+	from, to := TimeFilterRange("", "2024-01-10", "2024-01-20", now)
+	if !from.Equal(time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2024, time.January, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("date range = %v, %v; want Jan 10 to end of Jan 20", from, to)
+	}
+	from, to = TimeFilterRange("", "", "bogus", now)
+	if !from.IsZero() || !to.IsZero() {
+		t.Fatalf("invalid dates = %v, %v; want unbounded", from, to)
+	}
+	from, to = TimeFilterRange(string(TimeFilterThisMonth), "2024-03-10", "", now)
+	if !from.Equal(time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("preset with min = %v, %v; want the intersection", from, to)
+	}
+}
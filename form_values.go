@@ -1,7 +1,10 @@
 package vent
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -13,23 +16,149 @@ func FormatFormValue(value any) string {
 			return ""
 		}
 		return v.Format("2006-01-02T15:04")
+	case []string:
+		return strings.Join(v, ", ")
+	case []int:
+		parts := make([]string, len(v))
+		for i, n := range v {
+			parts[i] = strconv.Itoa(n)
+		}
+		return strings.Join(parts, ", ")
 	default:
 		return fmt.Sprintf("%v", value)
 	}
 }
 
-// ParseDateTimeLocal parses a datetime-local or RFC3339 string.
+// FormatListFormValue formats a field.Strings or field.Ints value for the tag
+// editor: a JSON array of strings, so values may contain commas. Empty lists
+// format as an empty string.
+func FormatListFormValue(value any) string {
+	var values []string
+	switch v := value.(type) {
+	case []string:
+		values = v
+	case []int:
+		values = make([]string, len(v))
+		for i, n := range v {
+			values[i] = strconv.Itoa(n)
+		}
+	default:
+		return FormatFormValue(value)
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// FormatJSONValue formats a JSON field value as compact JSON for list cells.
+// Null values format as an empty string.
+func FormatJSONValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+// FormatJSONFormValue formats a JSON field value as indented JSON for the editor.
+// Null values format as an empty string.
+func FormatJSONFormValue(value any) string {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+// SetJSONValue decodes raw JSON into the argument type of set and calls it.
+// An empty raw value decodes to the zero value.
+func SetJSONValue[T any, R any](set func(T) R, raw string) error {
+	var value T
+	if strings.TrimSpace(raw) != "" {
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return err
+		}
+	}
+	set(value)
+	return nil
+}
+
+// ParseStringList reads a tag editor value, a JSON array as written by
+// FormatListFormValue, into trimmed, non-empty values. Anything else, such as
+// an import cell or query parameter, is split on commas.
+func ParseStringList(value string) []string {
+	var values []string
+	if elements, ok := parseJSONList(value); ok {
+		for _, element := range elements {
+			if element = strings.TrimSpace(element); element != "" {
+				values = append(values, element)
+			}
+		}
+		return values
+	}
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// parseJSONList decodes value as a JSON array of strings or numbers.
+func parseJSONList(value string) ([]string, bool) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "[") {
+		return nil, false
+	}
+	var elements []any
+	if err := json.Unmarshal([]byte(value), &elements); err != nil {
+		return nil, false
+	}
+	values := make([]string, 0, len(elements))
+	for _, element := range elements {
+		switch v := element.(type) {
+		case string:
+			values = append(values, v)
+		case float64:
+			values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// ParseIntList splits a comma-separated tag input into integers.
+func ParseIntList(value string) ([]int, error) {
+	parts := ParseStringList(value)
+	values := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", part)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// ParseDateTimeLocal parses a datetime-local, date, or RFC3339 string.
 func ParseDateTimeLocal(value string) (time.Time, error) {
 	if value == "" {
 		return time.Time{}, nil
 	}
-	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", time.RFC3339} {
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", time.DateOnly, time.RFC3339} {
 		parsed, err := time.Parse(layout, value)
 		if err == nil {
 			return parsed, nil
 		}
 	}
-	return time.Time{}, fmt.Errorf("must use datetime-local or RFC3339 format")
+	return time.Time{}, fmt.Errorf("must use datetime-local, date, or RFC3339 format")
 }
 
 // PasswordHashIsSet reports whether a password hash pointer is populated.
@@ -13,3 +13,107 @@ func mustParseTime(t *testing.T, value string) time.Time {
 	}
 	return parsed
 }
+
+func TestFormatFormValueSlices(t *testing.T) {
+	if got := FormatFormValue([]string{"sci-fi", "classic"}); got != "sci-fi, classic" {
+		t.Fatalf("FormatFormValue([]string) = %q", got)
+	}
+	if got := FormatFormValue([]int{1, 2}); got != "1, 2" {
+		t.Fatalf("FormatFormValue([]int) = %q", got)
+	}
+}
+
+func TestFormatJSONValue(t *testing.T) {
+	value := map[string]any{"isbn": "123"}
+	if got := FormatJSONValue(value); got != `{"isbn":"123"}` {
+		t.Fatalf("FormatJSONValue() = %q", got)
+	}
+	if got := FormatJSONFormValue(value); got != "{\n  \"isbn\": \"123\"\n}" {
+		t.Fatalf("FormatJSONFormValue() = %q", got)
+	}
+	var empty map[string]any
+	if got := FormatJSONValue(empty); got != "" {
+		t.Fatalf("FormatJSONValue(nil) = %q, want empty", got)
+	}
+}
+
+func TestSetJSONValue(t *testing.T) {
+	var got map[string]any
+	set := func(v map[string]any) bool {
+		got = v
+		return true
+	}
+	if err := SetJSONValue(set, `{"isbn": "123"}`); err != nil {
+		t.Fatalf("SetJSONValue() error = %v", err)
+	}
+	if got["isbn"] != "123" {
+		t.Fatalf("SetJSONValue() set %v", got)
+	}
+	if err := SetJSONValue(set, ""); err != nil || got != nil {
+		t.Fatalf("SetJSONValue(empty) = %v, set %v; want zero value", err, got)
+	}
+	if err := SetJSONValue(set, `{"isbn":`); err == nil {
+		t.Fatal("SetJSONValue(invalid) error = nil")
+	}
+}
+
+func TestParseStringList(t *testing.T) {
+	got := ParseStringList(" sci-fi, ,classic ")
+	if len(got) != 2 || got[0] != "sci-fi" || got[1] != "classic" {
+		t.Fatalf("ParseStringList() = %q", got)
+	}
+	if got := ParseStringList(""); len(got) != 0 {
+		t.Fatalf("ParseStringList(empty) = %q", got)
+	}
+	got = ParseStringList(`["Smith, John", " ", "Le Guin"]`)
+	if len(got) != 2 || got[0] != "Smith, John" || got[1] != "Le Guin" {
+		t.Fatalf("ParseStringList(JSON) = %q", got)
+	}
+}
+
+func TestFormatListFormValue(t *testing.T) {
+	value := FormatListFormValue([]string{"Smith, John", "Le Guin"})
+	if value != `["Smith, John","Le Guin"]` {
+		t.Fatalf("FormatListFormValue(strings) = %s", value)
+	}
+	if got := ParseStringList(value); len(got) != 2 || got[0] != "Smith, John" {
+		t.Fatalf("ParseStringList(FormatListFormValue()) = %q", got)
+	}
+	if got := FormatListFormValue([]int{1, 2}); got != `["1","2"]` {
+		t.Fatalf("FormatListFormValue(ints) = %s", got)
+	}
+	if ints, err := ParseIntList(`[1, "2"]`); err != nil || len(ints) != 2 || ints[1] != 2 {
+		t.Fatalf("ParseIntList(JSON) = %v, %v", ints, err)
+	}
+	if got := FormatListFormValue([]string(nil)); got != "" {
+		t.Fatalf("FormatListFormValue(nil) = %q, want empty", got)
+	}
+}
+
+func TestParseIntList(t *testing.T) {
+	got, err := ParseIntList("1, 2,3")
+	if err != nil {
+		t.Fatalf("ParseIntList() error = %v", err)
+	}
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Fatalf("ParseIntList() = %v", got)
+	}
+	if _, err := ParseIntList("1, two"); err == nil {
+		t.Fatal("ParseIntList(invalid) error = nil")
+	}
+}
+
+func TestParseDateTimeLocal(t *testing.T) {
+	for _, value := range []string{"2024-03-01T09:30", "2024-03-01"} {
+		got, err := ParseDateTimeLocal(value)
+		if err != nil {
+			t.Fatalf("ParseDateTimeLocal(%q) error = %v", value, err)
+		}
+		if got.Year() != 2024 || got.Month() != 3 || got.Day() != 1 {
+			t.Fatalf("ParseDateTimeLocal(%q) = %v", value, got)
+		}
+	}
+	if _, err := ParseDateTimeLocal("03/01/2024"); err == nil {
+		t.Fatal("ParseDateTimeLocal(invalid) error = nil")
+	}
+}
@@ -0,0 +1,59 @@
+package vent
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+)
+
+// ParseID parses a URL path or form value into an Ent ID of type T.
+// Types implementing encoding.TextUnmarshaler (e.g. uuid.UUID) decode through
+// UnmarshalText; string and integer kinds, including named types, are parsed
+// directly.
+func ParseID[T any](value string) (T, error) {
+	var id T
+	if u, ok := any(&id).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(value)); err != nil {
+			var zero T
+			return zero, err
+		}
+		return id, nil
+	}
+	rv := reflect.ValueOf(&id).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, rv.Type().Bits())
+		if err != nil {
+			return id, err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, rv.Type().Bits())
+		if err != nil {
+			return id, err
+		}
+		rv.SetUint(n)
+	default:
+		return id, fmt.Errorf("unsupported id type %T", id)
+	}
+	return id, nil
+}
+
+// FormatID formats an Ent ID as the string accepted by ParseID.
+func FormatID(id any) string {
+	if m, ok := id.(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	return fmt.Sprint(id)
+}
+
+// FormatIDPath formats an Ent ID as an escaped URL path segment.
+func FormatIDPath(id any) string {
+	return url.PathEscape(FormatID(id))
+}
@@ -0,0 +1,65 @@
+package vent
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type testTextID string
+
+func (id testTextID) MarshalText() ([]byte, error) {
+	return []byte("id-" + string(id)), nil
+}
+
+func (id *testTextID) UnmarshalText(text []byte) error {
+	value, ok := strings.CutPrefix(string(text), "id-")
+	if !ok {
+		return errors.New("missing id- prefix")
+	}
+	*id = testTextID(value)
+	return nil
+}
+
+func TestParseID(t *testing.T) {
+	if id, err := ParseID[int]("42"); err != nil || id != 42 {
+		t.Fatalf("ParseID[int] = %v, %v, want 42", id, err)
+	}
+	if id, err := ParseID[int64]("-7"); err != nil || id != -7 {
+		t.Fatalf("ParseID[int64] = %v, %v, want -7", id, err)
+	}
+	if id, err := ParseID[uint8]("255"); err != nil || id != 255 {
+		t.Fatalf("ParseID[uint8] = %v, %v, want 255", id, err)
+	}
+	if id, err := ParseID[string]("abc"); err != nil || id != "abc" {
+		t.Fatalf("ParseID[string] = %q, %v, want abc", id, err)
+	}
+	if id, err := ParseID[testTextID]("id-x1"); err != nil || id != "x1" {
+		t.Fatalf("ParseID[testTextID] = %q, %v, want x1", id, err)
+	}
+
+	if _, err := ParseID[int]("abc"); err == nil {
+		t.Fatal("ParseID[int](abc) error = nil, want error")
+	}
+	if _, err := ParseID[uint8]("256"); err == nil {
+		t.Fatal("ParseID[uint8](256) error = nil, want range error")
+	}
+	if _, err := ParseID[testTextID]("x1"); err == nil {
+		t.Fatal("ParseID[testTextID](x1) error = nil, want error")
+	}
+	if _, err := ParseID[float64]("1.5"); err == nil {
+		t.Fatal("ParseID[float64] error = nil, want unsupported type error")
+	}
+}
+
+func TestFormatID(t *testing.T) {
+	if got := FormatID(42); got != "42" {
+		t.Fatalf("FormatID(42) = %q, want 42", got)
+	}
+	if got := FormatID(testTextID("x1")); got != "id-x1" {
+		t.Fatalf("FormatID(testTextID) = %q, want id-x1", got)
+	}
+	if got := FormatIDPath("a/b c"); got != "a%2Fb%20c" {
+		t.Fatalf("FormatIDPath = %q, want a%%2Fb%%20c", got)
+	}
+}
@@ -0,0 +1,495 @@
+package vent
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/troygilman/vent/auth"
+)
+
+// MaxImportRows caps how many data rows one import file may hold.
+const MaxImportRows = 10000
+
+// MaxImportPreviewRows caps how many successful rows an import result keeps
+// for the preview table. Failed rows are always kept.
+const MaxImportPreviewRows = 50
+
+// ImportFile is a parsed import file: its column headers and data rows.
+type ImportFile struct {
+	Headers []string
+	Rows    []ImportRow
+}
+
+// ImportRow is one data row, with one value per header.
+type ImportRow struct {
+	// Line is the row's 1-based position in the file: its line for CSV and
+	// JSON Lines, its array index plus one for JSON arrays.
+	Line   int
+	Values []string
+}
+
+// ParseImportFile parses a CSV (.csv) or JSON (.json, .jsonl) import file.
+// JSON files hold an array of objects or one object per line; their keys
+// become the headers in first-seen order.
+func ParseImportFile(filename string, r io.Reader) (ImportFile, error) {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".csv":
+		return parseCSVImport(r)
+	case ".json", ".jsonl":
+		return parseJSONImport(r)
+	default:
+		return ImportFile{}, BadRequest("import files must be .csv, .json, or .jsonl")
+	}
+}
+
+// ReadImportUpload parses the import file uploaded as form field field of a
+// multipart request.
+func ReadImportUpload(r *http.Request, field string) (ImportFile, error) {
+	if !auth.IsMultipartRequest(r) {
+		return ImportFile{}, BadRequest("import files must be uploaded as multipart/form-data")
+	}
+	if err := auth.ParseMultipartForm(r); err != nil {
+		return ImportFile{}, BadRequest("invalid form data").WithCause(err)
+	}
+	if len(r.MultipartForm.File[field]) == 0 || r.MultipartForm.File[field][0].Filename == "" {
+		return ImportFile{}, BadRequest("choose a file to import")
+	}
+	header := r.MultipartForm.File[field][0]
+	f, err := header.Open()
+	if err != nil {
+		return ImportFile{}, err
+	}
+	defer f.Close()
+	return ParseImportFile(header.Filename, f)
+}
+
+func parseCSVImport(r io.Reader) (ImportFile, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	headers, err := reader.Read()
+	if err == io.EOF {
+		return ImportFile{}, BadRequest("import file is empty")
+	}
+	if err != nil {
+		return ImportFile{}, BadRequest("invalid CSV").WithCause(err)
+	}
+	file := ImportFile{Headers: trimHeaders(headers)}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return file, nil
+		}
+		if err != nil {
+			return ImportFile{}, BadRequest("invalid CSV").WithCause(err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(file.Rows) == MaxImportRows {
+			return ImportFile{}, BadRequest(fmt.Sprintf("import files may hold at most %d rows", MaxImportRows))
+		}
+		values := make([]string, len(file.Headers))
+		copy(values, record)
+		file.Rows = append(file.Rows, ImportRow{Line: line, Values: values})
+	}
+}
+
+func trimHeaders(headers []string) []string {
+	trimmed := make([]string, len(headers))
+	for i, header := range headers {
+		trimmed[i] = strings.TrimSpace(strings.TrimPrefix(header, "\uFEFF"))
+	}
+	return trimmed
+}
+
+func parseJSONImport(r io.Reader) (ImportFile, error) {
+	reader := bufio.NewReader(r)
+	first, err := peekNonSpace(reader)
+	if err == io.EOF {
+		return ImportFile{}, BadRequest("import file is empty")
+	}
+	if err != nil {
+		return ImportFile{}, err
+	}
+
+	var file ImportFile
+	index := map[string]int{}
+	add := func(line int, object importObject) error {
+		if len(file.Rows) == MaxImportRows {
+			return BadRequest(fmt.Sprintf("import files may hold at most %d rows", MaxImportRows))
+		}
+		row := ImportRow{Line: line, Values: make([]string, len(file.Headers))}
+		for i, key := range object.keys {
+			value, err := importJSONValue(object.values[i])
+			if err != nil {
+				return BadRequest(fmt.Sprintf("row %d: invalid value for %q", line, key)).WithCause(err)
+			}
+			if _, ok := index[key]; !ok {
+				index[key] = len(file.Headers)
+				file.Headers = append(file.Headers, key)
+				row.Values = append(row.Values, "")
+			}
+			row.Values[index[key]] = value
+		}
+		file.Rows = append(file.Rows, row)
+		return nil
+	}
+
+	if first == '[' {
+		dec := json.NewDecoder(reader)
+		if _, err := dec.Token(); err != nil {
+			return ImportFile{}, BadRequest("invalid JSON").WithCause(err)
+		}
+		for i := 1; dec.More(); i++ {
+			object, err := decodeImportObject(dec)
+			if err != nil {
+				return ImportFile{}, BadRequest(fmt.Sprintf("invalid JSON in item %d", i)).WithCause(err)
+			}
+			if err := add(i, object); err != nil {
+				return ImportFile{}, err
+			}
+		}
+	} else {
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(nil, 1<<20)
+		for line := 1; scanner.Scan(); line++ {
+			text := bytes.TrimSpace(scanner.Bytes())
+			if len(text) == 0 {
+				continue
+			}
+			object, err := decodeImportObject(json.NewDecoder(bytes.NewReader(text)))
+			if err != nil {
+				return ImportFile{}, BadRequest(fmt.Sprintf("invalid JSON on line %d", line)).WithCause(err)
+			}
+			if err := add(line, object); err != nil {
+				return ImportFile{}, err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return ImportFile{}, BadRequest("invalid JSON Lines").WithCause(err)
+		}
+	}
+	// Rows read before a later row introduced a key are short; pad them.
+	for i := range file.Rows {
+		for len(file.Rows[i].Values) < len(file.Headers) {
+			file.Rows[i].Values = append(file.Rows[i].Values, "")
+		}
+	}
+	return file, nil
+}
+
+// importObject is a JSON object with its keys in file order, which
+// encoding/json maps do not keep.
+type importObject struct {
+	keys   []string
+	values []json.RawMessage
+}
+
+func decodeImportObject(dec *json.Decoder) (importObject, error) {
+	var object importObject
+	if tok, err := dec.Token(); err != nil {
+		return object, err
+	} else if tok != json.Delim('{') {
+		return object, fmt.Errorf("expected an object, got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return object, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return object, err
+		}
+		object.keys = append(object.keys, tok.(string))
+		object.values = append(object.values, value)
+	}
+	_, err := dec.Token()
+	return object, err
+}
+
+func peekNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		b, err := reader.Peek(1)
+		if err != nil {
+			return 0, err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			reader.ReadByte()
+		case 0xEF:
+			// Skip a UTF-8 byte order mark.
+			reader.Discard(3)
+		default:
+			return b[0], nil
+		}
+	}
+}
+
+// importJSONValue flattens a JSON value into the string form a CSV cell
+// would hold: strings as-is, null as empty, arrays of scalars
+// comma-separated, and anything else as its JSON text.
+func importJSONValue(raw json.RawMessage) (string, error) {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			switch item.(type) {
+			case map[string]any, []any:
+				return string(raw), nil
+			}
+			part, err := importJSONValue(mustMarshal(item))
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return strings.Join(parts, ","), nil
+	default:
+		return string(bytes.TrimSpace(raw)), nil
+	}
+}
+
+func mustMarshal(v any) json.RawMessage {
+	b, _ := json.Marshal(v)
+	return b
+}
+
+// ImportColumn is an admin surface member an import file column can fill.
+type ImportColumn struct {
+	Name  string
+	Label string
+}
+
+// MapImportColumns matches file headers to columns by name or label,
+// ignoring case, spaces, and underscores. The result holds the matched
+// column name per header, or "" for headers that match nothing or repeat an
+// earlier match.
+func MapImportColumns(headers []string, columns []ImportColumn) []string {
+	mapping := make([]string, len(headers))
+	used := map[string]bool{}
+	for i, header := range headers {
+		key := importColumnKey(header)
+		for _, column := range columns {
+			if used[column.Name] || (key != importColumnKey(column.Name) && key != importColumnKey(column.Label)) {
+				continue
+			}
+			mapping[i] = column.Name
+			used[column.Name] = true
+			break
+		}
+	}
+	return mapping
+}
+
+func importColumnKey(name string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// Fields returns the row's values keyed by mapped column name.
+func (r ImportRow) Fields(mapping []string) map[string]string {
+	fields := make(map[string]string, len(mapping))
+	for i, name := range mapping {
+		if name != "" && i < len(r.Values) {
+			fields[name] = r.Values[i]
+		}
+	}
+	return fields
+}
+
+// DecodeImportValues sets the fields of the struct dst points to from values
+// keyed by JSON tag. Empty values leave pointer fields nil. String, bool,
+// int, uint, and float fields (or pointers to them) are supported, as are
+// []string fields, which take a comma-separated list.
+func DecodeImportValues(values map[string]string, dst any) error {
+	target := reflect.ValueOf(dst)
+	if target.Kind() != reflect.Pointer || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("vent: DecodeImportValues needs a struct pointer, got %T", dst)
+	}
+	target = target.Elem()
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		value, ok := values[name]
+		if !ok || name == "" || name == "-" {
+			continue
+		}
+		if err := setImportValue(target.Field(i), strings.TrimSpace(value)); err != nil {
+			return BadRequest(fmt.Sprintf("invalid %s: %v", name, err))
+		}
+	}
+	return nil
+}
+
+// ImportUpdateInput fills the update input dst points to from create, the
+// decoded create input of an import row, keeping only the columns in values.
+// A row matching an existing record then saves through the update path and
+// leaves the fields the file does not have as they are.
+func ImportUpdateInput(values map[string]string, create, dst any) error {
+	data, err := json.Marshal(create)
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name := range fields {
+		if _, ok := values[name]; !ok {
+			delete(fields, name)
+		}
+	}
+	if data, err = json.Marshal(fields); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+func setImportValue(field reflect.Value, value string) error {
+	if field.Kind() == reflect.Pointer {
+		if value == "" {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		ptr := reflect.New(field.Type().Elem())
+		if err := setImportValue(ptr.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		if value == "" {
+			field.SetBool(false)
+			return nil
+		}
+		v, err := strconv.ParseBool(strings.ToLower(value))
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a non-negative integer", value)
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		field.SetFloat(v)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported list type %s", field.Type())
+		}
+		field.Set(reflect.ValueOf(ParseStringList(value)).Convert(field.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
+
+// ImportAction is what importing a row did, or would do in a dry run.
+type ImportAction string
+
+const (
+	ImportActionCreate ImportAction = "create"
+	ImportActionUpdate ImportAction = "update"
+)
+
+// ImportResult summarizes an import run for the preview and result pages.
+type ImportResult struct {
+	DryRun bool
+	// Committed is true once a non-dry run's transaction commits.
+	Committed bool
+	// Columns are the labels of the mapped columns, in file order, and
+	// Ignored are headers that matched no column.
+	Columns []string
+	Ignored []string
+	// Rows holds every failed row and the first MaxImportPreviewRows
+	// successful ones.
+	Rows    []ImportRowResult
+	Total   int
+	Created int
+	Updated int
+	Failed  int
+}
+
+// ImportRowResult is the outcome of one import row.
+type ImportRowResult struct {
+	Line   int
+	Values []string
+	Action ImportAction
+	Error  string
+}
+
+// NewImportResult starts a result for file with the given header mapping
+// onto columns.
+func NewImportResult(file ImportFile, mapping []string, columns []ImportColumn, dryRun bool) ImportResult {
+	result := ImportResult{DryRun: dryRun}
+	for i, name := range mapping {
+		if name == "" {
+			if file.Headers[i] != "" {
+				result.Ignored = append(result.Ignored, file.Headers[i])
+			}
+			continue
+		}
+		for _, column := range columns {
+			if column.Name == name {
+				result.Columns = append(result.Columns, column.Label)
+			}
+		}
+	}
+	return result
+}
+
+// Record adds the outcome of row to the result. message is the row's error,
+// or "" when action succeeded.
+func (r *ImportResult) Record(row ImportRow, mapping []string, action ImportAction, message string) {
+	r.Total++
+	switch {
+	case message != "":
+		r.Failed++
+	case action == ImportActionUpdate:
+		r.Updated++
+	default:
+		r.Created++
+	}
+	if message == "" && r.Total-r.Failed > MaxImportPreviewRows {
+		return
+	}
+	values := make([]string, 0, len(r.Columns))
+	for i, name := range mapping {
+		if name != "" && i < len(row.Values) {
+			values = append(values, row.Values[i])
+		}
+	}
+	r.Rows = append(r.Rows, ImportRowResult{Line: row.Line, Values: values, Action: action, Error: message})
+}
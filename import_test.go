@@ -0,0 +1,152 @@
+package vent
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImportFileCSV(t *testing.T) {
+	file, err := ParseImportFile("books.CSV", strings.NewReader("\uFEFFTitle, Pages\n\"Dune, Part 1\",412\nEmma\n"))
+	if err != nil {
+		t.Fatalf("ParseImportFile() error = %v", err)
+	}
+	if want := []string{"Title", "Pages"}; !reflect.DeepEqual(file.Headers, want) {
+		t.Fatalf("headers = %q, want %q", file.Headers, want)
+	}
+	want := []ImportRow{
+		{Line: 2, Values: []string{"Dune, Part 1", "412"}},
+		{Line: 3, Values: []string{"Emma", ""}},
+	}
+	if !reflect.DeepEqual(file.Rows, want) {
+		t.Fatalf("rows = %+v, want %+v", file.Rows, want)
+	}
+}
+
+func TestParseImportFileJSONKeepsKeyOrder(t *testing.T) {
+	for name, input := range map[string]string{
+		"books.json":  `[{"title": "Dune", "pages": 412, "tags": ["sf", 1]}, {"title": null, "isbn": "x"}]`,
+		"books.jsonl": "{\"title\": \"Dune\", \"pages\": 412, \"tags\": [\"sf\", 1]}\n\n{\"title\": null, \"isbn\": \"x\"}\n",
+	} {
+		file, err := ParseImportFile(name, strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("ParseImportFile(%s) error = %v", name, err)
+		}
+		if want := []string{"title", "pages", "tags", "isbn"}; !reflect.DeepEqual(file.Headers, want) {
+			t.Fatalf("%s headers = %q, want %q", name, file.Headers, want)
+		}
+		if want := []string{"Dune", "412", "sf,1", ""}; !reflect.DeepEqual(file.Rows[0].Values, want) {
+			t.Fatalf("%s first row = %q, want %q", name, file.Rows[0].Values, want)
+		}
+		if want := []string{"", "", "", "x"}; !reflect.DeepEqual(file.Rows[1].Values, want) {
+			t.Fatalf("%s second row = %q, want %q", name, file.Rows[1].Values, want)
+		}
+	}
+}
+
+func TestParseImportFileErrors(t *testing.T) {
+	for name, input := range map[string]string{
+		"books.xml":   "<books/>",
+		"books.csv":   "",
+		"books.json":  `[{"title": "Dune"}, 3]`,
+		"books.jsonl": "{\"title\": \"Dune\"}\n{oops}\n",
+	} {
+		_, err := ParseImportFile(name, strings.NewReader(input))
+		if he, ok := AsHttpError(err); !ok || he.Status != http.StatusBadRequest {
+			t.Fatalf("ParseImportFile(%s) error = %v, want bad request", name, err)
+		}
+	}
+}
+
+func TestMapImportColumns(t *testing.T) {
+	columns := []ImportColumn{
+		{Name: "title", Label: "Title"},
+		{Name: "published_at", Label: "Publication date"},
+	}
+	got := MapImportColumns([]string{"Publication Date", "TITLE", "title", "notes"}, columns)
+	if want := []string{"published_at", "title", "", ""}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("MapImportColumns() = %q, want %q", got, want)
+	}
+}
+
+func TestDecodeImportValues(t *testing.T) {
+	var input struct {
+		Title  string   `json:"title"`
+		Pages  *int     `json:"pages,omitempty"`
+		Price  float64  `json:"price"`
+		Active bool     `json:"active"`
+		Tags   []string `json:"tags"`
+		Rating *uint8   `json:"rating"`
+	}
+	err := DecodeImportValues(map[string]string{
+		"title":  "Dune",
+		"pages":  " 412 ",
+		"price":  "9.5",
+		"active": "TRUE",
+		"tags":   "sf, classic",
+		"rating": "",
+	}, &input)
+	if err != nil {
+		t.Fatalf("DecodeImportValues() error = %v", err)
+	}
+	if input.Title != "Dune" || input.Pages == nil || *input.Pages != 412 || input.Price != 9.5 || !input.Active || input.Rating != nil {
+		t.Fatalf("decoded = %+v", input)
+	}
+	if want := []string{"sf", "classic"}; !reflect.DeepEqual(input.Tags, want) {
+		t.Fatalf("tags = %q, want %q", input.Tags, want)
+	}
+
+	err = DecodeImportValues(map[string]string{"pages": "many"}, &input)
+	if he, ok := AsHttpError(err); !ok || he.Message != `invalid pages: "many" is not an integer` {
+		t.Fatalf("DecodeImportValues(pages=many) error = %v", err)
+	}
+}
+
+func TestImportUpdateInputKeepsFileColumns(t *testing.T) {
+	pages := 412
+	create := struct {
+		Title string `json:"title"`
+		Pages *int   `json:"pages"`
+		Notes string `json:"notes"`
+	}{Title: "Dune", Pages: &pages}
+	var update struct {
+		Title *string `json:"title"`
+		Pages *int    `json:"pages"`
+		Notes *string `json:"notes"`
+	}
+	if err := ImportUpdateInput(map[string]string{"title": "Dune", "pages": "412"}, create, &update); err != nil {
+		t.Fatalf("ImportUpdateInput() error = %v", err)
+	}
+	if update.Title == nil || *update.Title != "Dune" || update.Pages == nil || *update.Pages != 412 {
+		t.Fatalf("update = %+v", update)
+	}
+	if update.Notes != nil {
+		t.Fatalf("notes = %q, want unset for a column the file lacks", *update.Notes)
+	}
+}
+
+func TestImportResultRecord(t *testing.T) {
+	file := ImportFile{Headers: []string{"Title", "Notes"}}
+	mapping := []string{"title", ""}
+	result := NewImportResult(file, mapping, []ImportColumn{{Name: "title", Label: "Title"}}, true)
+	if !reflect.DeepEqual(result.Columns, []string{"Title"}) || !reflect.DeepEqual(result.Ignored, []string{"Notes"}) {
+		t.Fatalf("columns = %q, ignored = %q", result.Columns, result.Ignored)
+	}
+
+	for i := 0; i < MaxImportPreviewRows+5; i++ {
+		result.Record(ImportRow{Line: i + 2, Values: []string{"Dune", "x"}}, mapping, ImportActionCreate, "")
+	}
+	result.Record(ImportRow{Line: 99, Values: []string{"", "x"}}, mapping, ImportActionUpdate, "title is required")
+
+	if result.Total != MaxImportPreviewRows+6 || result.Created != MaxImportPreviewRows+5 || result.Updated != 0 || result.Failed != 1 {
+		t.Fatalf("counts = %+v", result)
+	}
+	if len(result.Rows) != MaxImportPreviewRows+1 {
+		t.Fatalf("kept %d rows, want %d", len(result.Rows), MaxImportPreviewRows+1)
+	}
+	last := result.Rows[len(result.Rows)-1]
+	if last.Line != 99 || last.Error != "title is required" || !reflect.DeepEqual(last.Values, []string{""}) {
+		t.Fatalf("failed row = %+v", last)
+	}
+}
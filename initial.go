@@ -0,0 +1,154 @@
+package vent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// InitialField is an add form field a query string may pre-fill.
+type InitialField struct {
+	Name string
+	Kind FieldKind
+	// Options lists the values an enum field accepts.
+	Options []string
+	// CheckID rejects values that are not IDs of an edge's target; nil
+	// accepts any value.
+	CheckID func(string) error
+}
+
+// InitialValues are add form values taken from a query string, keyed by
+// field name, in the shape the field's form input takes.
+type InitialValues map[string]string
+
+type initialValuesKey struct{}
+
+// CheckID reports whether value parses as an Ent ID of type T.
+func CheckID[T any](value string) error {
+	_, err := ParseID[T](value)
+	return err
+}
+
+// ParseInitialValues reads add form values from query, as in
+// ?author=12&published=true. Parameters that do not name one of fields are
+// ignored, so links can carry unrelated ones; the rest must parse as their
+// field's kind, and empty ones are skipped. Many-edges and list fields take
+// repeated or comma-separated values.
+func ParseInitialValues(query url.Values, fields []InitialField) (InitialValues, error) {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make(InitialValues, len(query))
+	for _, name := range names {
+		i := slices.IndexFunc(fields, func(field InitialField) bool { return field.Name == name })
+		if i < 0 {
+			continue
+		}
+		value, err := parseInitialValue(fields[i], query[name])
+		if err != nil {
+			return nil, BadRequest(fmt.Sprintf("invalid %s: %v", name, err))
+		}
+		if value != "" {
+			values[name] = value
+		}
+	}
+	return values, nil
+}
+
+func parseInitialValue(field InitialField, raw []string) (string, error) {
+	switch field.Kind {
+	case FieldKindForeignKey, FieldKindStrings, FieldKindInts:
+		var parts []string
+		for _, value := range raw {
+			parts = append(parts, ParseStringList(value)...)
+		}
+		for _, part := range parts {
+			if err := checkInitialValue(field, part); err != nil {
+				return "", err
+			}
+		}
+		if field.Kind != FieldKindForeignKey {
+			return FormatListFormValue(parts), nil
+		}
+		return strings.Join(parts, ", "), nil
+	}
+	if len(raw) > 1 {
+		return "", fmt.Errorf("takes a single value")
+	}
+	value := strings.TrimSpace(raw[0])
+	if value == "" {
+		return "", nil
+	}
+	switch field.Kind {
+	case FieldKindBool:
+		v, err := strconv.ParseBool(strings.ToLower(value))
+		if err != nil {
+			return "", fmt.Errorf("%q is not true or false", value)
+		}
+		return strconv.FormatBool(v), nil
+	case FieldKindTime:
+		v, err := ParseDateTimeLocal(value)
+		if err != nil {
+			return "", err
+		}
+		return FormatFormValue(v), nil
+	}
+	if err := checkInitialValue(field, value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// checkInitialValue checks one value, or one element of a list, against the
+// field's kind.
+func checkInitialValue(field InitialField, value string) error {
+	switch field.Kind {
+	case FieldKindInt, FieldKindInts:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+	case FieldKindFloat:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	case FieldKindEnum:
+		if !slices.Contains(field.Options, value) {
+			return fmt.Errorf("%q is not one of %s", value, strings.Join(field.Options, ", "))
+		}
+	case FieldKindJSON:
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("not valid JSON")
+		}
+	case FieldKindForeignKey, FieldKindForeignKeyUnique:
+		if field.CheckID != nil {
+			if err := field.CheckID(value); err != nil {
+				return fmt.Errorf("%q is not an ID", value)
+			}
+		}
+	case FieldKindPassword, FieldKindFile, FieldKindImage:
+		return fmt.Errorf("cannot be pre-filled")
+	}
+	return nil
+}
+
+// WithInitialValues attaches the query string values an add form is
+// pre-filled with.
+func WithInitialValues(ctx context.Context, values InitialValues) context.Context {
+	return context.WithValue(ctx, initialValuesKey{}, values)
+}
+
+// InitialValue returns the value the query string gave the add form field
+// name, if any.
+func InitialValue(ctx context.Context, name string) (string, bool) {
+	values, _ := ctx.Value(initialValuesKey{}).(InitialValues)
+	value, ok := values[name]
+	return value, ok
+}
@@ -0,0 +1,110 @@
+package vent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testInitialFields = []InitialField{
+	{Name: "title", Kind: FieldKindString},
+	{Name: "pages", Kind: FieldKindInt},
+	{Name: "published", Kind: FieldKindBool},
+	{Name: "published_at", Kind: FieldKindTime},
+	{Name: "format", Kind: FieldKindEnum, Options: []string{"hardcover", "paperback"}},
+	{Name: "metadata", Kind: FieldKindJSON},
+	{Name: "tags", Kind: FieldKindStrings},
+	{Name: "author", Kind: FieldKindForeignKeyUnique, CheckID: CheckID[int]},
+	{Name: "reviews", Kind: FieldKindForeignKey, CheckID: CheckID[int]},
+}
+
+func TestParseInitialValues(t *testing.T) {
+	query, err := url.ParseQuery("title=Dune&pages=412&published=TRUE&published_at=1965-08-01&format=hardcover" +
+		"&metadata=%7B%22isbn%22%3A%221%22%7D&tags=sci-fi,classic&author=12&reviews=3&reviews=4,5")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ParseInitialValues(query, testInitialFields)
+	if err != nil {
+		t.Fatalf("ParseInitialValues() error = %v", err)
+	}
+	want := InitialValues{
+		"title":        "Dune",
+		"pages":        "412",
+		"published":    "true",
+		"published_at": "1965-08-01T00:00",
+		"format":       "hardcover",
+		"metadata":     `{"isbn":"1"}`,
+		"tags":         `["sci-fi","classic"]`,
+		"author":       "12",
+		"reviews":      "3, 4, 5",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseInitialValues() = %v, want %v", got, want)
+	}
+}
+
+func TestParseInitialValuesSkipsEmpty(t *testing.T) {
+	got, err := ParseInitialValues(url.Values{"pages": {""}, "tags": {" , "}}, testInitialFields)
+	if err != nil {
+		t.Fatalf("ParseInitialValues() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("ParseInitialValues() = %v, want no values", got)
+	}
+}
+
+func TestParseInitialValuesIgnoresUnknownParams(t *testing.T) {
+	got, err := ParseInitialValues(url.Values{"utm_source": {"newsletter"}, "pages": {"12"}}, testInitialFields)
+	if err != nil {
+		t.Fatalf("ParseInitialValues() error = %v", err)
+	}
+	if want := (InitialValues{"pages": "12"}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseInitialValues() = %v, want %v", got, want)
+	}
+}
+
+func TestParseInitialValuesRejects(t *testing.T) {
+	for query, message := range map[string]string{
+		"pages=many":        `invalid pages: "many" is not an integer`,
+		"published=maybe":   `invalid published: "maybe" is not true or false`,
+		"published_at=soon": "invalid published_at",
+		"format=scroll":     `invalid format: "scroll" is not one of hardcover, paperback`,
+		"metadata=%7B":      "invalid metadata: not valid JSON",
+		"author=x":          `invalid author: "x" is not an ID`,
+		"reviews=3,x":       `invalid reviews: "x" is not an ID`,
+		"author=1&author=2": "invalid author: takes a single value",
+		"title=a&title=b":   "invalid title: takes a single value",
+	} {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ParseInitialValues(values, testInitialFields)
+		var httpErr *HttpError
+		if !errors.As(err, &httpErr) || httpErr.Status != http.StatusBadRequest {
+			t.Fatalf("%s: error = %v, want bad request", query, err)
+		}
+		if !strings.Contains(httpErr.PublicMessage(), message) {
+			t.Fatalf("%s: error = %q, want %q", query, httpErr.PublicMessage(), message)
+		}
+	}
+}
+
+func TestInitialValue(t *testing.T) {
+	if _, ok := InitialValue(context.Background(), "title"); ok {
+		t.Fatal("InitialValue() ok without initial values")
+	}
+	ctx := WithInitialValues(context.Background(), InitialValues{"title": "Dune"})
+	if got, ok := InitialValue(ctx, "title"); !ok || got != "Dune" {
+		t.Fatalf("InitialValue(title) = %q, %v", got, ok)
+	}
+	if _, ok := InitialValue(ctx, "pages"); ok {
+		t.Fatal("InitialValue(pages) ok, want missing")
+	}
+}
@@ -0,0 +1,91 @@
+package vent
+
+import (
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// MaxListSortKeys caps how many columns a list view sorts by at once.
+const MaxListSortKeys = 3
+
+// ListSort is one list-view sort key, in priority order.
+type ListSort struct {
+	Name string
+	Desc bool
+}
+
+// ParseListSort parses the comma-separated sort and dir query parameters
+// (e.g. sort=title,pages&dir=asc,desc). Unknown, duplicate, and excess keys
+// are dropped. When sortParam yields no keys, defaults is used instead; its
+// entries name a column, prefixed with "-" for descending.
+func ParseListSort(sortParam, dirParam string, defaults []string, allowed func(string) bool) []ListSort {
+	var sorts []ListSort
+	if sortParam != "" {
+		dirs := strings.Split(dirParam, ",")
+		for i, name := range strings.Split(sortParam, ",") {
+			desc := i < len(dirs) && strings.TrimSpace(dirs[i]) == "desc"
+			sorts = appendListSort(sorts, ListSort{Name: strings.TrimSpace(name), Desc: desc}, allowed)
+		}
+	}
+	if len(sorts) > 0 {
+		return sorts
+	}
+	for _, entry := range defaults {
+		name, desc := strings.CutPrefix(entry, "-")
+		sorts = appendListSort(sorts, ListSort{Name: name, Desc: desc}, allowed)
+	}
+	return sorts
+}
+
+func appendListSort(sorts []ListSort, sort ListSort, allowed func(string) bool) []ListSort {
+	if len(sorts) >= MaxListSortKeys || sort.Name == "" || !allowed(sort.Name) {
+		return sorts
+	}
+	for _, existing := range sorts {
+		if existing.Name == sort.Name {
+			return sorts
+		}
+	}
+	return append(sorts, sort)
+}
+
+// EncodeListSort formats sorts as sort and dir query parameter values.
+func EncodeListSort(sorts []ListSort) (sortParam, dirParam string) {
+	names := make([]string, len(sorts))
+	dirs := make([]string, len(sorts))
+	for i, sort := range sorts {
+		names[i] = sort.Name
+		dirs[i] = "asc"
+		if sort.Desc {
+			dirs[i] = "desc"
+		}
+	}
+	return strings.Join(names, ","), strings.Join(dirs, ",")
+}
+
+// ToggleListSort returns the sorts after clicking the header of column name:
+// the primary key flips direction; any other column becomes the ascending
+// primary key with the previous keys kept as tie-breakers.
+func ToggleListSort(sorts []ListSort, name string) []ListSort {
+	if len(sorts) > 0 && sorts[0].Name == name {
+		toggled := append([]ListSort(nil), sorts...)
+		toggled[0].Desc = !toggled[0].Desc
+		return toggled
+	}
+	toggled := []ListSort{{Name: name}}
+	for _, sort := range sorts {
+		if sort.Name != name && len(toggled) < MaxListSortKeys {
+			toggled = append(toggled, sort)
+		}
+	}
+	return toggled
+}
+
+// OrderTermOptions returns the Ent order options for the sort direction.
+func (s ListSort) OrderTermOptions() []sql.OrderTermOption {
+	if s.Desc {
+		return []sql.OrderTermOption{sql.OrderDesc()}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package vent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseListSort(t *testing.T) {
+	allowed := func(name string) bool { return name != "secret" }
+	defaults := []string{"-published_at", "title"}
+
+	cases := []struct {
+		name      string
+		sortParam string
+		dirParam  string
+		want      []ListSort
+	}{
+		{"defaults", "", "", []ListSort{{Name: "published_at", Desc: true}, {Name: "title"}}},
+		{"explicit", "pages,title", "desc,asc", []ListSort{{Name: "pages", Desc: true}, {Name: "title"}}},
+		{"missing dir is asc", "pages", "", []ListSort{{Name: "pages"}}},
+		{"drops unknown and duplicates", "secret,title,title", "asc,desc,asc", []ListSort{{Name: "title", Desc: true}}},
+		{"caps keys", "a,b,c,d", "", []ListSort{{Name: "a"}, {Name: "b"}, {Name: "c"}}},
+		{"only unknown falls back", "secret", "", []ListSort{{Name: "published_at", Desc: true}, {Name: "title"}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ParseListSort(tc.sortParam, tc.dirParam, defaults, allowed)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("ParseListSort() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEncodeListSortRoundTrip(t *testing.T) {
+	sorts := []ListSort{{Name: "title"}, {Name: "pages", Desc: true}}
+	sortParam, dirParam := EncodeListSort(sorts)
+	if sortParam != "title,pages" || dirParam != "asc,desc" {
+		t.Fatalf("EncodeListSort() = %q, %q", sortParam, dirParam)
+	}
+	got := ParseListSort(sortParam, dirParam, nil, func(string) bool { return true })
+	if !reflect.DeepEqual(got, sorts) {
+		t.Fatalf("round trip = %+v, want %+v", got, sorts)
+	}
+}
+
+func TestToggleListSort(t *testing.T) {
+	sorts := []ListSort{{Name: "title"}, {Name: "pages", Desc: true}, {Name: "format"}}
+
+	flipped := ToggleListSort(sorts, "title")
+	if !flipped[0].Desc || sorts[0].Desc {
+		t.Fatalf("toggling the primary key = %+v, want descending without mutating input", flipped)
+	}
+
+	promoted := ToggleListSort(sorts, "author")
+	want := []ListSort{{Name: "author"}, {Name: "title"}, {Name: "pages", Desc: true}}
+	if !reflect.DeepEqual(promoted, want) {
+		t.Fatalf("ToggleListSort(author) = %+v, want %+v", promoted, want)
+	}
+
+	secondary := ToggleListSort(sorts, "pages")
+	want = []ListSort{{Name: "pages"}, {Name: "title"}, {Name: "format"}}
+	if !reflect.DeepEqual(secondary, want) {
+		t.Fatalf("ToggleListSort(pages) = %+v, want %+v", secondary, want)
+	}
+}
@@ -1,10 +1,15 @@
 package vent
 
 import (
+	"context"
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 )
 
@@ -47,6 +52,9 @@ func (UserMixin) Annotations() []schema.Annotation {
 				"is_staff",
 				"is_active",
 			},
+			SearchFields: []string{
+				"email",
+			},
 			FieldSets: []FieldSet{
 				{
 					Fields: []string{
@@ -99,6 +107,9 @@ func (PermissionGroupMixin) Annotations() []schema.Annotation {
 			FilterableColumns: []string{
 				"name",
 			},
+			SearchFields: []string{
+				"name",
+			},
 			FieldSets: []FieldSet{
 				{
 					Fields: []string{
@@ -145,6 +156,9 @@ func (PermissionMixin) Annotations() []schema.Annotation {
 			FilterableColumns: []string{
 				"name",
 			},
+			SearchFields: []string{
+				"name",
+			},
 			FieldSets: []FieldSet{
 				{
 					Fields: []string{
@@ -156,3 +170,166 @@ func (PermissionMixin) Annotations() []schema.Annotation {
 		},
 	}
 }
+
+// AuditLogMixin makes a schema the admin audit log. When a schema uses it,
+// the generated handlers record every create, update, delete, and password
+// change made through the admin, and change pages get a History tab. The
+// audit list is read-only and visible to superusers only.
+type AuditLogMixin struct {
+	mixin.Schema
+}
+
+func (AuditLogMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.String("actor_id").Optional().Immutable(),
+		field.String("actor").Optional().Immutable(),
+		field.Enum("action").
+			Values(
+				string(AuditActionCreate),
+				string(AuditActionUpdate),
+				string(AuditActionDelete),
+				string(AuditActionPassword),
+				string(AuditActionRestore),
+				string(AuditActionPurge),
+			).
+			Immutable(),
+		field.String("schema").Immutable(),
+		field.String("entity_id").Immutable(),
+		field.String("entity_name").Optional().Immutable(),
+		field.JSON("changes", []AuditChange{}).Optional().Immutable(),
+	}
+}
+
+func (AuditLogMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schema", "entity_id"),
+	}
+}
+
+func (AuditLogMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		VentAuditLogAnnotation{},
+		VentSchemaAnnotation{
+			ReadOnly:      true,
+			DisableCreate: true,
+			DisableDelete: true,
+			TableColumns: []string{
+				"created_at",
+				"actor",
+				"action",
+				"schema",
+				"entity_name",
+			},
+			FilterableColumns: []string{
+				"created_at",
+				"action",
+				"schema",
+				"actor",
+			},
+			SearchFields: []string{
+				"actor",
+				"entity_name",
+				"entity_id",
+			},
+			DefaultOrdering: []string{"-created_at"},
+			ReadOnlyFields: []string{
+				"created_at",
+				"actor",
+				"action",
+				"schema",
+				"entity_id",
+				"entity_name",
+				"changes",
+			},
+			FieldSets: []FieldSet{
+				{
+					Fields: []string{
+						"created_at",
+						"actor",
+						"action",
+						"schema",
+						"entity_id",
+						"entity_name",
+						"changes",
+					},
+				},
+			},
+		},
+	}
+}
+
+// RevisionMixin makes a schema the store for entity revisions. When a schema
+// uses it, the generated handlers snapshot an entity's form values before
+// every update and delete made through the admin, and change pages get a
+// Revisions tab that compares and restores them. The schema has no admin of
+// its own.
+type RevisionMixin struct {
+	mixin.Schema
+}
+
+func (RevisionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.String("actor_id").Optional().Immutable(),
+		field.String("actor").Optional().Immutable(),
+		field.Enum("action").
+			Values(
+				string(AuditActionUpdate),
+				string(AuditActionDelete),
+			).
+			Immutable(),
+		field.String("schema").Immutable(),
+		field.String("entity_id").Immutable(),
+		field.String("entity_name").Optional().Immutable(),
+		field.JSON("snapshot", RevisionSnapshot{}).Immutable(),
+		// restored_id is the ID a deleted entity was recreated with.
+		field.String("restored_id").Optional(),
+	}
+}
+
+func (RevisionMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schema", "entity_id"),
+	}
+}
+
+func (RevisionMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		VentRevisionAnnotation{},
+		VentSchemaAnnotation{
+			DisableAdmin: true,
+		},
+	}
+}
+
+// SoftDeleteMixin adds a deleted_at field to a schema. Admin deletes set it
+// instead of removing the row, every query leaves such rows out unless its
+// context comes from SkipSoftDelete, and the list page gets a Trash view
+// where they can be restored or purged.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time(SoftDeleteField).Optional().Nillable(),
+	}
+}
+
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+			if softDeleteSkipped(ctx) {
+				return nil
+			}
+			return wherePredicate(q, sql.FieldIsNull(SoftDeleteField))
+		}),
+	}
+}
+
+func (SoftDeleteMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		VentSoftDeleteAnnotation{},
+	}
+}
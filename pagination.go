@@ -5,6 +5,14 @@ import "strconv"
 // DefaultListPageSize is the list page size when VentSchemaAnnotation.PageSize is unset.
 const DefaultListPageSize = 100
 
+// DetailRelationLimit is how many related entities the detail page lists per
+// reverse relation before linking to the full, filtered list.
+const DetailRelationLimit = 10
+
+// DeleteRelationLimit is how many related entities the delete confirmation
+// page lists per affected relation; the rest are counted.
+const DeleteRelationLimit = 10
+
 // ListPage is a 1-based offset page over a filtered list query.
 type ListPage struct {
 	Page     int
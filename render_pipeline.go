@@ -2,9 +2,12 @@ package vent
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/entc/gen"
+	schemafield "entgo.io/ent/schema/field"
 )
 
 // RenderConfig is the template-facing output of the render pipeline.
@@ -14,8 +17,47 @@ type RenderConfig struct {
 	AdminSurface      []SurfaceMember
 	TableColumns      []TableColumn
 	FilterableColumns []FilterableColumnConfig
+	// RelatedLists are the lists whose edge filters target this schema.
+	RelatedLists []RelatedListConfig
+	// ReverseRelations are edges to other admin schemas that are not on the
+	// admin surface, listed on the detail page.
+	ReverseRelations []ReverseRelationConfig
+	// DeleteRelations are the edges to other admin schemas whose entities a
+	// delete cascades to, unlinks, or is blocked by.
+	DeleteRelations []DeleteRelationConfig
+	// SearchFields are the string fields the list search box matches,
+	// possibly reached through edges.
+	SearchFields []SearchFieldConfig
+	// SortableColumns are the list sort keys: sortable table columns plus
+	// anything named in DefaultOrdering.
+	SortableColumns []SortableColumn
+	// DefaultOrdering is the annotation's list ordering ("-name" for descending).
+	DefaultOrdering   []string
 	CreateInputFields []InputFieldSpec
 	UpdateInputFields []InputFieldSpec
+	// ImportColumns are the create inputs an import file can fill, in form
+	// order; UpsertFields are the unique fields an import can match existing
+	// rows on.
+	ImportColumns []ImportColumnConfig
+	UpsertFields  []UpsertFieldConfig
+	// RevisionFields are the members a revision snapshots, in form order.
+	RevisionFields []RevisionFieldConfig
+	// FieldSets partitions the form members of AdminSurface into sections.
+	// Schemas without annotated field sets get a single unlabeled set.
+	FieldSets      []FieldSetConfig
+	FieldSetLayout FieldSetLayout
+	// HasUploadFields is true when a form member is a file or image field, so
+	// forms submit as multipart.
+	HasUploadFields bool
+	// UploadFields are the file and image fields, whose stored files are
+	// served to users who can read the entity holding their key.
+	UploadFields []UploadFieldConfig
+}
+
+// UploadFieldConfig is a file or image field holding a storage key.
+type UploadFieldConfig struct {
+	Name     string
+	Nillable bool
 }
 
 // FilterableColumnConfig describes a list-view filter control and its Ent predicate.
@@ -24,6 +66,143 @@ type FilterableColumnConfig struct {
 	Label         string
 	Type          string
 	PredicateName string
+	// Options lists the allowed values for enum filters.
+	Options []string
+	// EnumTypeName is the Ent-generated Go type for enum filters (e.g. "book.Format").
+	EnumTypeName string
+	// GoType is the field's Go type for int and float filters (e.g. "int64").
+	GoType string
+	// Range is true for int, float, and time filters, which take min/max
+	// bounds; time filters also take a preset.
+	Range bool
+	// Nullable is true for optional fields and edges, which can filter on
+	// NULL (or, for edges, on having no related entity).
+	Nullable bool
+	// EdgeTypeName and EdgePackage name the target of edge filters, which
+	// match related IDs with Has<Edge>With(<package>.IDIn(...)).
+	EdgeTypeName string
+	EdgePackage  string
+	EdgeIDType   string
+}
+
+// RelatedListConfig links a change page to another schema's list, filtered
+// by an edge filter that targets this schema.
+type RelatedListConfig struct {
+	SchemaName        string
+	RouteName         string
+	PluralDisplayName string
+	FilterName        string
+	FilterLabel       string
+	// Addable is true when the schema's add form takes the filter's edge
+	// from the query string, so the change page can link to an add form
+	// pre-filled with this entity.
+	Addable             bool
+	SingularDisplayName string
+}
+
+// ReverseRelationConfig is an edge listed on the detail page, such as an
+// author's books, with links to the related entities.
+type ReverseRelationConfig struct {
+	// Name is the edge name; QueryName is its Ent query method suffix.
+	Name      string
+	QueryName string
+	Label     string
+	// EdgeTypeName and RouteName name the target admin schema.
+	EdgeTypeName string
+	RouteName    string
+	// FilterName is the target list's edge filter back to this schema, for
+	// a "View all" link; empty when the target has none.
+	FilterName string
+}
+
+// DeleteRelationConfig is an edge whose related entities a delete affects,
+// listed on the delete confirmation page.
+type DeleteRelationConfig struct {
+	// QueryName is the edge's Ent query method suffix.
+	QueryName string
+	Label     string
+	// EdgeTypeName and RouteName name the target admin schema.
+	EdgeTypeName string
+	RouteName    string
+	Effect       DeleteEffect
+}
+
+// ImportColumnConfig maps an import file column onto a create input.
+type ImportColumnConfig struct {
+	Name  string
+	Label string
+	// RefTypeName is the admin schema an edge column references. Its values
+	// resolve by that schema's ID or NaturalKey; RefMany edges take a
+	// comma-separated list.
+	RefTypeName string
+	RefKey      string
+	RefMany     bool
+}
+
+// RevisionFieldConfig is a member a revision snapshots in its form value
+// shape. Format, when set, is the function that turns the entity value into
+// that shape; other values are stored as they are.
+type RevisionFieldConfig struct {
+	Name       string
+	Edge       bool
+	EdgeUnique bool
+	Nillable   bool
+	Format     string
+}
+
+// VersionFieldConfig is the field a schema's change form carries to detect
+// concurrent edits. Ints are incremented on every save; times are set to
+// the save time.
+type VersionFieldConfig struct {
+	Name   string
+	GoType string
+	Time   bool
+}
+
+// UpsertFieldConfig is a unique field an import can upsert on.
+type UpsertFieldConfig struct {
+	Name  string
+	Label string
+	// Column is the Ent column constant, e.g. "FieldEmail".
+	Column string
+	// Type is the field's Go type, e.g. "string".
+	Type string
+}
+
+// SearchFieldConfig describes one search path: a ContainsFold predicate on
+// the final field, wrapped in Has<Edge>With for each edge hop.
+type SearchFieldConfig struct {
+	Path string
+	Hops []SearchHop
+	// Package and PredicateName name the field predicate, e.g. "author" and
+	// "NameContainsFold".
+	Package       string
+	PredicateName string
+}
+
+// SearchHop is one edge traversal in a search path, e.g. book.HasAuthorWith.
+type SearchHop struct {
+	Package       string
+	PredicateName string
+}
+
+// FieldSetConfig describes one form section and the surface members it holds.
+type FieldSetConfig struct {
+	Label       string
+	Description string
+	Collapsible bool
+	Collapsed   bool
+	Members     []SurfaceMember
+}
+
+// SortableColumn describes how a list sort key maps onto an Ent order option.
+type SortableColumn struct {
+	Name string
+	// Kind is "field" (By<Field>), "edge_field" (By<Edge>Field), or
+	// "edge_count" (By<Edge>Count).
+	Kind string
+	// EdgeField is the target column passed to By<Edge>Field.
+	EdgeField string
 }
 
 // NodeRenderConfig pairs a node with its render config for iteration in templates.
@@ -40,39 +219,89 @@ type SchemaMeta struct {
 	PluralDisplayName   string
 	// DefaultNameField is the entity field used by generated Default*Admin.Name.
 	// Not an annotation; inferred as Name when that field exists, otherwise ID.
-	DefaultNameField  string
+	DefaultNameField string
+	// NaturalKey is the field imports may use instead of an ID to reference
+	// this schema. Not an annotation; inferred as the first unique,
+	// non-sensitive string field, if any.
+	NaturalKey        string
 	Permissions       []Permission
 	IsAuthUserSchema  bool
 	HasPasswordRoutes bool
-	ReadOnly          bool
-	DisableCreate     bool
-	DisableDelete     bool
-	ReadOnlyFields    []string
-	PageSize          int
+	// IsAuditLogSchema marks the schema using AuditLogMixin; its mutations
+	// are not themselves audited and it has no grantable permissions.
+	IsAuditLogSchema bool
+	// SoftDelete marks schemas using SoftDeleteMixin: deletes move entities
+	// to the trash, which has restore and purge permissions of its own.
+	SoftDelete     bool
+	ReadOnly       bool
+	DisableCreate  bool
+	DisableDelete  bool
+	ReadOnlyFields []string
+	// Version, when set, is checked on every change form save. The admin
+	// sets it itself, so it is read-only on forms.
+	Version  *VersionFieldConfig
+	PageSize int
 	// PackageDir is the Ent-generated schema package directory (e.g. "user").
 	PackageDir string
+	// IDType is the Go type of the Ent ID field (e.g. "int", "uuid.UUID").
+	IDType string
+	// IDPackage is the import for IDType; zero for builtin types.
+	IDPackage IDPackage
+}
+
+// IDPackage is an import required to name a schema's ID type.
+type IDPackage struct {
+	Name string
+	Path string
 }
 
 // SurfaceMember describes one member of the admin surface (form + bindable mutations).
 type SurfaceMember struct {
 	Name     string
 	SlotName string
-	Label    string
-	InForm   bool
+	// Label is the Go identifier suffix of the member's Field accessor
+	// (e.g. "PublishedAt" in FieldPublishedAt).
+	Label  string
+	InForm bool
+
+	// DisplayLabel is the human-facing form label; VentFieldAnnotation.Label
+	// or Label when unset.
+	DisplayLabel string
+	HelpText     string
+	Placeholder  string
+	Widget       FieldWidget
 
 	BindCreate bool
 	BindUpdate bool
+	// CopyOnDuplicate is true when duplicating an entity pre-fills the copy's
+	// add form with the member's value.
+	CopyOnDuplicate bool
+	// InitialFromQuery is true when the add form takes the member's initial
+	// value from a query parameter of the same name.
+	InitialFromQuery bool
+	// PrepopulateFrom names the fields whose values fill this one on the add
+	// form as the user types (PrepopulatedFields).
+	PrepopulateFrom []string
 
 	MemberKind MemberKind
 	FieldKind  FieldKind
 
 	EdgeTypeName string
-	EdgeUnique   bool
-	EdgeSingular string
-	EagerLoad    bool
+	// EdgeIDType is the Go type of the target's ID (e.g. "int").
+	EdgeIDType string
+	// EdgeRouteName is the target admin schema's route, for detail links.
+	EdgeRouteName string
+	EdgeUnique    bool
+	EdgeSingular  string
+	EagerLoad     bool
+	// EdgeSoftDelete marks edges to a SoftDeleteMixin schema, whose trashed
+	// entities the change form keeps selected.
+	EdgeSoftDelete bool
 
 	OptionalOnCreate bool
 	Nillable         bool
+	// Optional is true when the Ent field may be cleared on update.
+	Optional bool
 
 	// HasDefaultValue is true when the field has a constant Ent create default
 	// (not a DefaultFunc) that can be shown on add forms.
@@ -81,6 +310,11 @@ type SurfaceMember struct {
 	// Meaningful only when HasDefaultValue is true.
 	DefaultValueName string
 
+	// EnumValues lists the allowed values of an enum field, in schema order.
+	EnumValues []string
+	// EnumTypeName is the Ent-generated Go type of an enum field (e.g. "book.Format").
+	EnumTypeName string
+
 	// IsCustomField is true for virtual admin members (MemberCustom), including
 	// builtins like password and user-declared CustomFields entries.
 	IsCustomField bool
@@ -92,6 +326,7 @@ type TableColumn struct {
 	Label    string
 	Type     string
 	SlotName string
+	Sortable bool
 }
 
 // InputFieldSpec describes one field in generated CreateInput/UpdateInput structs.
@@ -128,6 +363,12 @@ type catalogMember struct {
 	label string
 	kind  MemberKind
 
+	displayLabel string
+	helpText     string
+	placeholder  string
+	widget       FieldWidget
+	columnHeader string
+
 	entField *gen.Field
 	edge     *gen.Edge
 
@@ -137,14 +378,34 @@ type catalogMember struct {
 	edgeSingular     string
 	optionalOnCreate bool
 	nillable         bool
+	optional         bool
 	listType         string
 	hasDefaultValue  bool
 	defaultValueName string
+	enumValues       []string
+	enumTypeName     string
+}
+
+// labelText is the member's human-facing label.
+func (m *catalogMember) labelText() string {
+	if m.displayLabel != "" {
+		return m.displayLabel
+	}
+	return m.label
+}
+
+// headerText is the member's list-view column header.
+func (m *catalogMember) headerText() string {
+	if m.columnHeader != "" {
+		return m.columnHeader
+	}
+	return m.labelText()
 }
 
 type layoutSpec struct {
 	adminSurface []string
 	tableColumns []string
+	fieldSets    []FieldSet
 }
 
 type resolvedMember struct {
@@ -159,6 +420,7 @@ type resolvedMember struct {
 type appliedLayout struct {
 	adminSurface []resolvedMember
 	tableColumns []resolvedMember
+	fieldSets    []FieldSet
 }
 
 // buildRenderConfig runs the catalog → layout → members → project pipeline.
@@ -176,7 +438,10 @@ func buildRenderConfig(node *gen.Type) (RenderConfig, error) {
 	var annotation VentSchemaAnnotation
 	hasAnnotation := annotation.parse(node) == nil
 
-	layout := resolveLayout(node, catalog, annotation, hasAnnotation)
+	layout, err := resolveLayout(node, catalog, annotation, hasAnnotation)
+	if err != nil {
+		return RenderConfig{}, err
+	}
 	applied, err := applyLayout(catalog, layout, meta)
 	if err != nil {
 		return RenderConfig{}, err
@@ -187,7 +452,25 @@ func buildRenderConfig(node *gen.Type) (RenderConfig, error) {
 		return RenderConfig{}, err
 	}
 
-	return projectRenderConfig(meta, applied, filterable), nil
+	search, err := projectSearchFields(node, annotation, hasAnnotation)
+	if err != nil {
+		return RenderConfig{}, err
+	}
+
+	rc := projectRenderConfig(meta, applied, filterable)
+	rc.SearchFields = search
+	if err := projectSortableColumns(&rc, node.Name, catalog, annotation, hasAnnotation); err != nil {
+		return RenderConfig{}, err
+	}
+	if hasAnnotation {
+		if err := projectPrepopulatedFields(&rc, node.Name, annotation.PrepopulatedFields); err != nil {
+			return RenderConfig{}, err
+		}
+	}
+	if hasAnnotation && annotation.FieldSetLayout != "" {
+		rc.FieldSetLayout = annotation.FieldSetLayout
+	}
+	return rc, nil
 }
 
 func buildRenderConfigs(nodes []*gen.Type) ([]NodeRenderConfig, error) {
@@ -204,9 +487,155 @@ func buildRenderConfigs(nodes []*gen.Type) ([]NodeRenderConfig, error) {
 			})
 		}
 	}
+	linkRelatedLists(configs)
+	linkEdgeRoutes(configs)
+	linkDeleteRelations(configs)
 	return configs, nil
 }
 
+// linkRelatedLists records, on each edge filter's target, a link to the
+// filtered list of the schema that declares the filter.
+func linkRelatedLists(configs []NodeRenderConfig) {
+	targets := make(map[string]*RenderConfig, len(configs))
+	for i := range configs {
+		targets[configs[i].Node.Name] = &configs[i].RC
+	}
+	for _, config := range configs {
+		for _, filter := range config.RC.FilterableColumns {
+			target, ok := targets[filter.EdgeTypeName]
+			if filter.Type != "edge" || !ok {
+				continue
+			}
+			addable := false
+			for _, member := range config.RC.AdminSurface {
+				if member.Name == filter.Name {
+					addable = member.InitialFromQuery && !config.RC.DisableCreate
+				}
+			}
+			target.RelatedLists = append(target.RelatedLists, RelatedListConfig{
+				SchemaName:          config.Node.Name,
+				RouteName:           config.RC.RouteName,
+				PluralDisplayName:   config.RC.PluralDisplayName,
+				FilterName:          filter.Name,
+				FilterLabel:         filter.Label,
+				Addable:             addable,
+				SingularDisplayName: config.RC.SingularDisplayName,
+			})
+		}
+	}
+}
+
+// linkEdgeRoutes records the target route of each surface edge and lists
+// the schema's other admin edges as reverse relations. It runs after
+// linkRelatedLists so reverse relations can link to the filtered list.
+func linkEdgeRoutes(configs []NodeRenderConfig) {
+	targets := make(map[string]*RenderConfig, len(configs))
+	for i := range configs {
+		targets[configs[i].Node.Name] = &configs[i].RC
+	}
+	for i := range configs {
+		rc := &configs[i].RC
+		onSurface := make(map[string]struct{}, len(rc.AdminSurface))
+		for j := range rc.AdminSurface {
+			member := &rc.AdminSurface[j]
+			onSurface[member.Name] = struct{}{}
+			if target, ok := targets[member.EdgeTypeName]; ok && member.MemberKind == MemberEdge {
+				member.EdgeRouteName = target.RouteName
+			}
+		}
+		for _, edge := range configs[i].Node.Edges {
+			target, ok := targets[edge.Type.Name]
+			if _, exists := onSurface[edge.Name]; exists || !ok {
+				continue
+			}
+			relation := ReverseRelationConfig{
+				Name:         edge.Name,
+				QueryName:    edge.StructField(),
+				Label:        pascalCase(edge.Name),
+				EdgeTypeName: edge.Type.Name,
+				RouteName:    target.RouteName,
+			}
+			if edge.Ref != nil {
+				for _, list := range rc.RelatedLists {
+					if list.SchemaName == edge.Type.Name && list.FilterName == edge.Ref.Name {
+						relation.FilterName = list.FilterName
+					}
+				}
+			}
+			rc.ReverseRelations = append(rc.ReverseRelations, relation)
+		}
+	}
+}
+
+// linkDeleteRelations lists, on each schema, the edges to other admin schemas
+// whose entities its deletes affect.
+func linkDeleteRelations(configs []NodeRenderConfig) {
+	targets := make(map[string]*RenderConfig, len(configs))
+	for i := range configs {
+		targets[configs[i].Node.Name] = &configs[i].RC
+	}
+	for i := range configs {
+		node := configs[i].Node
+		for _, edge := range node.Edges {
+			target, ok := targets[edge.Type.Name]
+			if !ok {
+				continue
+			}
+			effect, ok := deleteEffect(node, edge)
+			if !ok {
+				continue
+			}
+			configs[i].RC.DeleteRelations = append(configs[i].RC.DeleteRelations, DeleteRelationConfig{
+				QueryName:    edge.StructField(),
+				Label:        pascalCase(edge.Name),
+				EdgeTypeName: edge.Type.Name,
+				RouteName:    target.RouteName,
+				Effect:       effect,
+			})
+		}
+	}
+}
+
+// deleteEffect is what deleting a node entity does to the entities related
+// through edge, following the foreign key Ent's migrations create for it:
+// the edge's OnDelete annotation, else SET NULL for nullable keys and NO
+// ACTION for required ones. Join table rows are always deleted. Edges whose
+// key is on node's own table are not affected.
+func deleteEffect(node *gen.Type, edge *gen.Edge) (DeleteEffect, bool) {
+	var owner *gen.Edge
+	var nullable bool
+	switch {
+	case edge.M2M():
+		if edge.Through != nil || edge.Ref != nil && edge.Ref.Through != nil {
+			return "", false
+		}
+		return DeleteUnlink, true
+	case !edge.IsInverse() && (edge.O2M() || edge.O2O()):
+		owner = edge
+		nullable = edge.Ref == nil || edge.Ref.Optional
+	case edge.IsInverse() && edge.Ref != nil && edge.Ref.M2O():
+		owner = edge.Ref
+		nullable = edge.Ref.Optional
+	default:
+		return "", false
+	}
+	action := entsql.NoAction
+	if nullable || edge.Type == node {
+		action = entsql.SetNull
+	}
+	if annotation := owner.EntSQL(); annotation != nil && annotation.OnDelete != "" {
+		action = annotation.OnDelete
+	}
+	switch action {
+	case entsql.Cascade:
+		return DeleteCascade, true
+	case entsql.SetNull, entsql.SetDefault:
+		return DeleteUnlink, true
+	default:
+		return DeleteBlock, true
+	}
+}
+
 func resolveSchemaMeta(node *gen.Type) SchemaMeta {
 	var annotation VentSchemaAnnotation
 	hasAnnotation := annotation.parse(node) == nil
@@ -217,9 +646,14 @@ func resolveSchemaMeta(node *gen.Type) SchemaMeta {
 		SingularDisplayName: node.Name,
 		PluralDisplayName:   pluralDisplayName(node.Name),
 		DefaultNameField:    defaultNameField(node),
+		NaturalKey:          naturalKeyField(node),
 		IsAuthUserSchema:    isAuthUserNode(node),
 		HasPasswordRoutes:   isAuthUserNode(node),
+		IsAuditLogSchema:    isAuditLogNode(node),
+		SoftDelete:          isSoftDeleteNode(node),
 		PackageDir:          node.PackageDir(),
+		IDType:              idType(node),
+		IDPackage:           idPackage(node),
 	}
 
 	if hasAnnotation && annotation.DisableAdmin {
@@ -242,6 +676,16 @@ func resolveSchemaMeta(node *gen.Type) SchemaMeta {
 			meta.PluralDisplayName = annotation.PluralDisplayName
 		}
 		meta.Permissions = append([]Permission(nil), annotation.Permissions...)
+		if field, ok := findField(node, annotation.VersionField); ok {
+			meta.Version = &VersionFieldConfig{
+				Name:   field.Name,
+				GoType: field.Type.String(),
+				Time:   field.Type.Type == schemafield.TypeTime,
+			}
+			if !isReadOnlyField(meta, field.Name) {
+				meta.ReadOnlyFields = append(meta.ReadOnlyFields, field.Name)
+			}
+		}
 		if annotation.PageSize > 0 {
 			meta.PageSize = annotation.PageSize
 		}
@@ -254,11 +698,26 @@ func resolveSchemaMeta(node *gen.Type) SchemaMeta {
 	return meta
 }
 
+// isSoftDeleteField reports whether field is SoftDeleteMixin's deleted_at,
+// which the admin sets itself and never shows on forms or lists.
+func isSoftDeleteField(node *gen.Type, field *gen.Field) bool {
+	return field.Name == SoftDeleteField && isSoftDeleteNode(node)
+}
+
+func naturalKeyField(node *gen.Type) string {
+	for _, field := range node.Fields {
+		if field.Unique && !field.Sensitive() && field.Type.Type == schemafield.TypeString && !field.HasGoType() {
+			return field.Name
+		}
+	}
+	return ""
+}
+
 func buildMemberCatalog(node *gen.Type, meta SchemaMeta) (memberCatalog, error) {
 	catalog := make(memberCatalog)
 
 	for _, field := range node.Fields {
-		if field.Sensitive() {
+		if field.Sensitive() || isSoftDeleteField(node, field) {
 			continue
 		}
 		kind, ok := fieldKindForEntField(field)
@@ -266,7 +725,7 @@ func buildMemberCatalog(node *gen.Type, meta SchemaMeta) (memberCatalog, error)
 			continue
 		}
 		hasDefault, defaultName := constantCreateDefault(field)
-		catalog[field.Name] = &catalogMember{
+		member := &catalogMember{
 			name:             field.Name,
 			label:            pascalCase(field.Name),
 			kind:             MemberEntField,
@@ -274,10 +733,17 @@ func buildMemberCatalog(node *gen.Type, meta SchemaMeta) (memberCatalog, error)
 			fieldKind:        kind,
 			optionalOnCreate: optionalOnCreate(field),
 			nillable:         field.Nillable,
+			optional:         field.Optional,
 			listType:         field.Type.Type.String(),
 			hasDefaultValue:  hasDefault,
 			defaultValueName: defaultName,
 		}
+		if kind == FieldKindEnum {
+			member.listType = "enum"
+			member.enumValues = field.EnumValues()
+			member.enumTypeName = field.Type.String()
+		}
+		catalog[field.Name] = member
 	}
 
 	for _, edge := range node.Edges {
@@ -307,19 +773,134 @@ func buildMemberCatalog(node *gen.Type, meta SchemaMeta) (memberCatalog, error)
 			}
 			catalog[strings.ToLower(customField.Name)] = member
 		}
+		if err := applyUploadFieldKinds(node.Name, catalog, annotation.FileFields, FieldKindFile); err != nil {
+			return nil, err
+		}
+		if err := applyUploadFieldKinds(node.Name, catalog, annotation.ImageFields, FieldKindImage); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, field := range node.Fields {
+		if member, ok := catalog[field.Name]; ok {
+			if err := applyFieldAnnotation(node.Name, member, field.Annotations); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, edge := range node.Edges {
+		if err := applyFieldAnnotation(node.Name, catalog[edge.Name], edge.Annotations); err != nil {
+			return nil, err
+		}
 	}
 
 	catalog["id"] = &catalogMember{
 		name:      "id",
 		label:     "ID",
 		kind:      MemberEntField,
-		fieldKind: FieldKindInt,
-		listType:  "int",
+		fieldKind: idFieldKind(node),
+		listType:  idListType(node),
 	}
 
 	return catalog, nil
 }
 
+// applyFieldAnnotation copies a VentFieldAnnotation onto its catalog member and
+// checks that the widget suits the member's kind.
+func applyFieldAnnotation(schemaName string, member *catalogMember, annotations gen.Annotations) error {
+	var annotation VentFieldAnnotation
+	ok, err := annotation.parse(annotations)
+	if err != nil {
+		return fmt.Errorf("schema %q field %q: %w", schemaName, member.name, err)
+	}
+	if !ok {
+		return nil
+	}
+	if annotation.Label != "" {
+		member.displayLabel = annotation.Label
+	}
+	member.helpText = annotation.HelpText
+	member.placeholder = annotation.Placeholder
+	member.columnHeader = annotation.ColumnHeader
+	if annotation.Widget != "" {
+		if !widgetSupportsFieldKind(annotation.Widget, member.fieldKind) {
+			return fmt.Errorf("schema %q field %q widget %q does not support %s fields", schemaName, member.name, annotation.Widget, member.fieldKind)
+		}
+		member.widget = annotation.Widget
+	}
+	return nil
+}
+
+func widgetSupportsFieldKind(widget FieldWidget, kind FieldKind) bool {
+	switch widget {
+	case WidgetTextarea, WidgetEmail, WidgetURL, WidgetColor:
+		return kind == FieldKindString
+	case WidgetDate:
+		return kind == FieldKindTime
+	default:
+		return false
+	}
+}
+
+// fieldKindHasPlaceholder reports whether a kind's form input shows placeholders.
+func fieldKindHasPlaceholder(kind FieldKind) bool {
+	switch kind {
+	case FieldKindString, FieldKindInt, FieldKindFloat, FieldKindStrings, FieldKindInts:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyUploadFieldKinds re-kinds Ent string fields listed in FileFields or ImageFields.
+func applyUploadFieldKinds(schemaName string, catalog memberCatalog, names []string, kind FieldKind) error {
+	for _, name := range names {
+		member, ok := catalog[name]
+		if !ok || member.kind != MemberEntField || member.fieldKind != FieldKindString || name == "id" {
+			return fmt.Errorf("schema %q %s field %q must be an Ent string field", schemaName, kind, name)
+		}
+		if member.listType == string(FieldKindFile) || member.listType == string(FieldKindImage) {
+			return fmt.Errorf("schema %q upload field %q is declared more than once", schemaName, name)
+		}
+		member.fieldKind = kind
+		member.listType = string(kind)
+	}
+	return nil
+}
+
+// idType returns the Go type of the node's ID, defaulting to Ent's int.
+func idType(node *gen.Type) string {
+	if node.ID == nil || node.ID.Type == nil {
+		return "int"
+	}
+	return node.ID.Type.String()
+}
+
+func idPackage(node *gen.Type) IDPackage {
+	if node.ID == nil || node.ID.Type == nil || node.ID.Type.PkgPath == "" {
+		return IDPackage{}
+	}
+	return IDPackage{Name: node.ID.Type.PkgName, Path: node.ID.Type.PkgPath}
+}
+
+// idFieldKind renders integer IDs as numbers and every other ID type as text.
+func idFieldKind(node *gen.Type) FieldKind {
+	if node.ID == nil || node.ID.Type == nil || node.ID.Type.Type.Integer() {
+		return FieldKindInt
+	}
+	return FieldKindString
+}
+
+func idListType(node *gen.Type) string {
+	if node.ID == nil || node.ID.Type == nil {
+		return "int"
+	}
+	if !node.ID.Type.Type.Integer() {
+		return "id"
+	}
+	return node.ID.Type.Type.String()
+}
+
 func catalogCustomMember(schemaName string, meta SchemaMeta, field Field) (*catalogMember, error) {
 	name := strings.ToLower(field.Name)
 	if definition, ok := builtinCustomFields[name]; ok {
@@ -337,22 +918,50 @@ func catalogCustomMember(schemaName string, meta SchemaMeta, field Field) (*cata
 	}, nil
 }
 
-func resolveLayout(node *gen.Type, catalog memberCatalog, annotation VentSchemaAnnotation, hasAnnotation bool) layoutSpec {
-	if hasAnnotation && len(annotation.FieldSets) > 0 && len(annotation.FieldSets[0].Fields) > 0 {
+func resolveLayout(node *gen.Type, catalog memberCatalog, annotation VentSchemaAnnotation, hasAnnotation bool) (layoutSpec, error) {
+	if hasAnnotation && len(annotation.FieldSets) > 0 {
+		if err := validateFieldSetLayout(node.Name, annotation.FieldSetLayout); err != nil {
+			return layoutSpec{}, err
+		}
+		var adminSurface []string
+		seen := make(map[string]struct{})
+		for i, fieldSet := range annotation.FieldSets {
+			if len(fieldSet.Fields) == 0 {
+				return layoutSpec{}, fmt.Errorf("schema %q field set %d has no fields", node.Name, i)
+			}
+			for _, name := range fieldSet.Fields {
+				if _, dup := seen[name]; dup {
+					return layoutSpec{}, fmt.Errorf("schema %q field %q appears in more than one field set", node.Name, name)
+				}
+				seen[name] = struct{}{}
+				adminSurface = append(adminSurface, name)
+			}
+		}
 		tableColumns := resolveTableColumnNames(annotation, hasAnnotation)
 		if len(tableColumns) == 0 {
-			tableColumns = defaultTableColumnNames(annotation.FieldSets[0].Fields, catalog)
+			tableColumns = defaultTableColumnNames(adminSurface, catalog)
 		}
 		return layoutSpec{
-			adminSurface: append([]string(nil), annotation.FieldSets[0].Fields...),
+			adminSurface: adminSurface,
 			tableColumns: tableColumns,
-		}
+			fieldSets:    append([]FieldSet(nil), annotation.FieldSets...),
+		}, nil
 	}
 
 	defaultSurface := defaultAdminSurfaceNames(node, annotation, hasAnnotation)
 	return layoutSpec{
 		adminSurface: defaultSurface,
 		tableColumns: defaultTableColumnNames(defaultSurface, catalog),
+		fieldSets:    []FieldSet{{Fields: defaultSurface}},
+	}, nil
+}
+
+func validateFieldSetLayout(schemaName string, layout FieldSetLayout) error {
+	switch layout {
+	case "", FieldSetLayoutSections, FieldSetLayoutTabs:
+		return nil
+	default:
+		return fmt.Errorf("schema %q field set layout %q must be %q or %q", schemaName, layout, FieldSetLayoutSections, FieldSetLayoutTabs)
 	}
 }
 
@@ -360,7 +969,7 @@ func defaultAdminSurfaceNames(node *gen.Type, annotation VentSchemaAnnotation, h
 	names := []string{"id"}
 
 	for _, field := range node.Fields {
-		if field.Sensitive() || !isSupportedInputField(field) {
+		if field.Sensitive() || isSoftDeleteField(node, field) || !isSupportedInputField(field) {
 			continue
 		}
 		names = append(names, field.Name)
@@ -444,6 +1053,7 @@ func applyLayout(catalog memberCatalog, layout layoutSpec, meta SchemaMeta) (app
 	return appliedLayout{
 		adminSurface: adminSurface,
 		tableColumns: tableColumns,
+		fieldSets:    layout.fieldSets,
 	}, nil
 }
 
@@ -547,10 +1157,34 @@ func projectRenderConfig(meta SchemaMeta, applied appliedLayout, filterable []Fi
 	rc := RenderConfig{
 		SchemaMeta:        meta,
 		FilterableColumns: filterable,
+		FieldSetLayout:    FieldSetLayoutSections,
 	}
 
 	for _, member := range applied.adminSurface {
 		rc.AdminSurface = append(rc.AdminSurface, projectSurfaceMember(member))
+		if isUploadFieldKind(member.member.fieldKind) {
+			rc.UploadFields = append(rc.UploadFields, UploadFieldConfig{Name: member.member.name, Nillable: member.member.nillable})
+			if member.bindCreate || member.bindUpdate {
+				rc.HasUploadFields = true
+			}
+		}
+	}
+
+	for _, fieldSet := range applied.fieldSets {
+		config := FieldSetConfig{
+			Label:       fieldSet.Label,
+			Description: fieldSet.Description,
+			Collapsible: fieldSet.Collapsible || fieldSet.Collapsed,
+			Collapsed:   fieldSet.Collapsed,
+		}
+		for _, name := range fieldSet.Fields {
+			for _, member := range rc.AdminSurface {
+				if member.Name == name {
+					config.Members = append(config.Members, member)
+				}
+			}
+		}
+		rc.FieldSets = append(rc.FieldSets, config)
 	}
 
 	for _, member := range applied.tableColumns {
@@ -571,6 +1205,27 @@ func projectRenderConfig(meta SchemaMeta, applied appliedLayout, filterable []Fi
 		rc.UpdateInputFields = append(rc.UpdateInputFields, projectUpdateInputField(member.member))
 	}
 
+	for _, member := range applied.adminSurface {
+		if !member.bindCreate {
+			continue
+		}
+		if column, ok := projectImportColumn(member.member); ok {
+			rc.ImportColumns = append(rc.ImportColumns, column)
+		}
+		if field, ok := projectUpsertField(member.member); ok {
+			rc.UpsertFields = append(rc.UpsertFields, field)
+		}
+	}
+
+	for _, member := range applied.adminSurface {
+		if !member.bindCreate && !member.bindUpdate {
+			continue
+		}
+		if field, ok := projectRevisionField(member.member); ok {
+			rc.RevisionFields = append(rc.RevisionFields, field)
+		}
+	}
+
 	return rc
 }
 
@@ -591,25 +1246,184 @@ func projectFilterableColumns(schemaName string, catalog memberCatalog, annotati
 		if !ok {
 			return nil, fmt.Errorf("schema %q filterable column %q does not exist", schemaName, name)
 		}
+		if member.kind == MemberEdge {
+			column, err := projectEdgeFilter(schemaName, member)
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, column)
+			continue
+		}
 		filterType, ok := filterTypeForMember(member)
 		if !ok {
-			return nil, fmt.Errorf("schema %q filterable column %q has unsupported type; only string, bool, and int fields are supported", schemaName, name)
+			return nil, fmt.Errorf("schema %q filterable column %q has unsupported type; only string, bool, int, float, time, and enum fields are supported", schemaName, name)
 		}
-		columns = append(columns, FilterableColumnConfig{
+		column := FilterableColumnConfig{
 			Name:          member.name,
-			Label:         member.label,
+			Label:         member.labelText(),
 			Type:          filterType,
 			PredicateName: predicateNameForMember(member),
-		})
+			Options:       member.enumValues,
+			EnumTypeName:  member.enumTypeName,
+			Range:         filterType == "int" || filterType == "float" || filterType == "time",
+			Nullable:      member.optional && member.name != "id",
+		}
+		if filterType == "int" || filterType == "float" {
+			column.GoType = member.listType
+		}
+		columns = append(columns, column)
 	}
 	return columns, nil
 }
 
+// projectEdgeFilter filters by related entities of an edge whose target has
+// an admin to list them.
+func projectEdgeFilter(schemaName string, member *catalogMember) (FilterableColumnConfig, error) {
+	target := member.edge.Type
+	if target == nil || !resolveSchemaMeta(target).AdminEnabled {
+		return FilterableColumnConfig{}, fmt.Errorf("schema %q filterable column %q is an edge to %s, which has no admin", schemaName, member.name, member.edgeTypeName)
+	}
+	return FilterableColumnConfig{
+		Name:          member.name,
+		Label:         member.labelText(),
+		Type:          "edge",
+		PredicateName: member.edge.StructField(),
+		Nullable:      member.edge.Optional,
+		EdgeTypeName:  target.Name,
+		EdgePackage:   target.Package(),
+		EdgeIDType:    idType(target),
+	}, nil
+}
+
+// projectSearchFields resolves SearchFields paths ("title", "author.name")
+// against the schema graph. Every path must end at a string field.
+func projectSearchFields(node *gen.Type, annotation VentSchemaAnnotation, hasAnnotation bool) ([]SearchFieldConfig, error) {
+	if !hasAnnotation {
+		return nil, nil
+	}
+	var fields []SearchFieldConfig
+	for _, path := range annotation.SearchFields {
+		parts := strings.Split(path, ".")
+		current := node
+		var hops []SearchHop
+		for _, name := range parts[:len(parts)-1] {
+			edge, ok := findEdge(current, name)
+			if !ok || edge.Type == nil {
+				return nil, fmt.Errorf("schema %q search field %q: %q is not an edge of %s", node.Name, path, name, current.Name)
+			}
+			hops = append(hops, SearchHop{
+				Package:       current.Package(),
+				PredicateName: "Has" + edge.StructField() + "With",
+			})
+			current = edge.Type
+		}
+		field, ok := findField(current, parts[len(parts)-1])
+		if !ok || field.Type == nil || field.Type.Type != schemafield.TypeString || field.Sensitive() {
+			return nil, fmt.Errorf("schema %q search field %q must end at a string field of %s", node.Name, path, current.Name)
+		}
+		fields = append(fields, SearchFieldConfig{
+			Path:          path,
+			Hops:          hops,
+			Package:       current.Package(),
+			PredicateName: field.StructField() + "ContainsFold",
+		})
+	}
+	return fields, nil
+}
+
+func projectSortableColumns(rc *RenderConfig, schemaName string, catalog memberCatalog, annotation VentSchemaAnnotation, hasAnnotation bool) error {
+	seen := make(map[string]struct{})
+	for i, column := range rc.TableColumns {
+		sortable, ok := sortableColumnForMember(catalog[column.Name])
+		if !ok {
+			continue
+		}
+		rc.TableColumns[i].Sortable = true
+		if _, dup := seen[column.Name]; !dup {
+			seen[column.Name] = struct{}{}
+			rc.SortableColumns = append(rc.SortableColumns, sortable)
+		}
+	}
+	if !hasAnnotation {
+		return nil
+	}
+	for _, entry := range annotation.DefaultOrdering {
+		name := strings.TrimPrefix(entry, "-")
+		sortable, ok := sortableColumnForMember(catalog[name])
+		if !ok {
+			return fmt.Errorf("schema %q default ordering %q must name a sortable field or edge", schemaName, entry)
+		}
+		if _, dup := seen[name]; !dup {
+			seen[name] = struct{}{}
+			rc.SortableColumns = append(rc.SortableColumns, sortable)
+		}
+	}
+	rc.DefaultOrdering = append([]string(nil), annotation.DefaultOrdering...)
+	return nil
+}
+
+// sortableColumnForMember reports how a member sorts: scalar fields by value,
+// unique edges by the target's name (or ID), and other edges by count.
+func sortableColumnForMember(member *catalogMember) (SortableColumn, bool) {
+	if member == nil {
+		return SortableColumn{}, false
+	}
+	switch member.kind {
+	case MemberEntField:
+		switch member.fieldKind {
+		case FieldKindString, FieldKindInt, FieldKindFloat, FieldKindBool, FieldKindTime, FieldKindEnum, FieldKindFile, FieldKindImage:
+			return SortableColumn{Name: member.name, Kind: "field"}, true
+		}
+	case MemberEdge:
+		if !member.edgeUnique {
+			return SortableColumn{Name: member.name, Kind: "edge_count"}, true
+		}
+		return SortableColumn{Name: member.name, Kind: "edge_field", EdgeField: edgeOrderField(member.edge.Type)}, true
+	}
+	return SortableColumn{}, false
+}
+
+// edgeOrderField is the column unique edges sort by: the target's name field
+// when it has one (matching Default*Admin.Name), otherwise its ID.
+func edgeOrderField(target *gen.Type) string {
+	for _, field := range target.Fields {
+		if field.Name == "name" {
+			return field.StorageKey()
+		}
+	}
+	if target.ID != nil {
+		return target.ID.StorageKey()
+	}
+	return "id"
+}
+
 func filterTypeForMember(member *catalogMember) (string, bool) {
 	if member == nil || member.kind != MemberEntField {
 		return "", false
 	}
-	return filterTypeForFieldKind(member.fieldKind)
+	if member.name == "id" && member.listType != "int" {
+		return "id", true
+	}
+	filterType, ok := filterTypeForFieldKind(member.fieldKind)
+	if !ok || !filterSupportsField(filterType, member.entField) {
+		return "", false
+	}
+	return filterType, true
+}
+
+// filterSupportsField rejects numeric and time filters on fields backed by a
+// custom GoType: generated code cannot name the type to parse values into.
+func filterSupportsField(filterType string, field *gen.Field) bool {
+	switch filterType {
+	case "int", "float", "time":
+		return field == nil || !field.HasGoType()
+	default:
+		return true
+	}
+}
+
+func isUploadFieldKind(kind FieldKind) bool {
+	return kind == FieldKindFile || kind == FieldKindImage
 }
 
 func filterTypeForFieldKind(kind FieldKind) (string, bool) {
@@ -620,6 +1434,12 @@ func filterTypeForFieldKind(kind FieldKind) (string, bool) {
 		return "bool", true
 	case FieldKindInt:
 		return "int", true
+	case FieldKindFloat:
+		return "float", true
+	case FieldKindTime:
+		return "time", true
+	case FieldKindEnum:
+		return "enum", true
 	default:
 		return "", false
 	}
@@ -637,23 +1457,118 @@ func projectSurfaceMember(member resolvedMember) SurfaceMember {
 		Name:             member.member.name,
 		SlotName:         memberSlotName(member.member.name),
 		Label:            member.member.label,
+		DisplayLabel:     member.member.labelText(),
+		HelpText:         member.member.helpText,
+		Placeholder:      member.member.placeholder,
+		Widget:           member.member.widget,
 		InForm:           member.inForm,
 		BindCreate:       member.bindCreate,
 		BindUpdate:       member.bindUpdate,
+		CopyOnDuplicate:  copyOnDuplicate(member),
+		InitialFromQuery: initialFromQuery(member),
 		MemberKind:       member.member.kind,
 		FieldKind:        member.member.fieldKind,
 		EdgeTypeName:     member.member.edgeTypeName,
+		EdgeIDType:       edgeIDType(member.member),
 		EdgeUnique:       member.member.edgeUnique,
 		EdgeSingular:     member.member.edgeSingular,
 		EagerLoad:        member.member.kind == MemberEdge,
+		EdgeSoftDelete:   member.member.edge != nil && isSoftDeleteNode(member.member.edge.Type),
 		OptionalOnCreate: member.member.optionalOnCreate,
 		Nillable:         member.member.nillable,
+		Optional:         member.member.optional,
 		HasDefaultValue:  member.member.hasDefaultValue,
 		DefaultValueName: member.member.defaultValueName,
+		EnumValues:       member.member.enumValues,
+		EnumTypeName:     member.member.enumTypeName,
 		IsCustomField:    member.member.kind == MemberCustom,
 	}
 }
 
+// copyOnDuplicate reports whether a duplicate's add form copies member.
+// Unique and immutable fields identify the original and uploads would share
+// its file, so they are left out, as are one-to-one and one-to-many edges,
+// which would move the original's related entities to the copy.
+func copyOnDuplicate(member resolvedMember) bool {
+	if !member.bindCreate {
+		return false
+	}
+	switch m := member.member; m.kind {
+	case MemberEntField:
+		return m.entField != nil && !m.entField.Unique && !m.entField.Immutable && !m.entField.Sensitive() && !isUploadFieldKind(m.fieldKind)
+	case MemberEdge:
+		return m.edge != nil && (m.edge.M2O() || m.edge.M2M())
+	default:
+		return false
+	}
+}
+
+// initialFromQuery reports whether the add form takes member's initial value
+// from the query string: any input it binds on create except passwords and
+// uploads, which a link cannot carry.
+func initialFromQuery(member resolvedMember) bool {
+	switch member.member.fieldKind {
+	case FieldKindPassword, FieldKindFile, FieldKindImage:
+		return false
+	}
+	return member.inForm && member.bindCreate && member.member.name != "id"
+}
+
+func edgeIDType(member *catalogMember) string {
+	if member.kind != MemberEdge || member.edge == nil || member.edge.Type == nil {
+		return ""
+	}
+	return idType(member.edge.Type)
+}
+
+// projectPrepopulatedFields checks PrepopulatedFields against the admin
+// surface and records each target's sources. Targets must be text inputs on
+// the add form, and sources scalar fields that are also on it.
+func projectPrepopulatedFields(rc *RenderConfig, schemaName string, prepopulated map[string][]string) error {
+	targets := make([]string, 0, len(prepopulated))
+	for name := range prepopulated {
+		targets = append(targets, name)
+	}
+	sort.Strings(targets)
+
+	for _, name := range targets {
+		target := surfaceMemberByName(rc.AdminSurface, name)
+		if target == nil || target.MemberKind != MemberEntField || target.FieldKind != FieldKindString || target.Widget != "" || !target.BindCreate {
+			return fmt.Errorf("schema %q prepopulated field %q must be a string field with a text input on the add form", schemaName, name)
+		}
+		sources := prepopulated[name]
+		if len(sources) == 0 {
+			return fmt.Errorf("schema %q prepopulated field %q has no source fields", schemaName, name)
+		}
+		for _, sourceName := range sources {
+			source := surfaceMemberByName(rc.AdminSurface, sourceName)
+			if source == nil || sourceName == name || source.MemberKind != MemberEntField || !source.BindCreate || !prepopulatesFromKind(source.FieldKind) {
+				return fmt.Errorf("schema %q prepopulated field %q source %q must be another string, int, float, or enum field on the add form", schemaName, name, sourceName)
+			}
+		}
+		target.PrepopulateFrom = append([]string(nil), sources...)
+	}
+	return nil
+}
+
+func surfaceMemberByName(members []SurfaceMember, name string) *SurfaceMember {
+	for i := range members {
+		if members[i].Name == name {
+			return &members[i]
+		}
+	}
+	return nil
+}
+
+func prepopulatesFromKind(kind FieldKind) bool {
+	switch kind {
+	case FieldKindString, FieldKindInt, FieldKindFloat, FieldKindEnum:
+		return true
+	default:
+		return false
+	}
+}
+
 // constantCreateDefault reports whether a field has a constant Ent create default
 // and returns the generated default var name. Functional defaults (DefaultFunc)
 // are omitted — they are not safe to format into form controls.
@@ -667,7 +1582,7 @@ func constantCreateDefault(field *gen.Field) (hasDefault bool, name string) {
 func projectTableColumn(member resolvedMember) TableColumn {
 	return TableColumn{
 		Name:     member.member.name,
-		Label:    member.member.label,
+		Label:    member.member.headerText(),
 		Type:     member.member.listType,
 		SlotName: memberSlotName(member.member.name),
 	}
@@ -704,6 +1619,65 @@ func projectCreateInputField(member *catalogMember) InputFieldSpec {
 	}
 }
 
+// projectImportColumn maps a create input onto an import column. Passwords
+// and uploads cannot be imported from a file.
+func projectImportColumn(member *catalogMember) (ImportColumnConfig, bool) {
+	if member.fieldKind == FieldKindPassword || isUploadFieldKind(member.fieldKind) {
+		return ImportColumnConfig{}, false
+	}
+	column := ImportColumnConfig{Name: member.name, Label: member.labelText()}
+	if member.kind == MemberEdge && member.edge != nil && member.edge.Type != nil && resolveSchemaMeta(member.edge.Type).AdminEnabled {
+		column.RefTypeName = member.edge.Type.Name
+		column.RefKey = resolveSchemaMeta(member.edge.Type).NaturalKey
+		column.RefMany = !member.edgeUnique
+	}
+	return column, true
+}
+
+// projectUpsertField maps a unique string or numeric field onto an import
+// upsert key. Other types have no import cell syntax to match rows by.
+func projectUpsertField(member *catalogMember) (UpsertFieldConfig, bool) {
+	field := member.entField
+	if field == nil || !field.Unique || member.name == "id" || field.HasGoType() {
+		return UpsertFieldConfig{}, false
+	}
+	if field.Type.Type != schemafield.TypeString && !field.Type.Numeric() {
+		return UpsertFieldConfig{}, false
+	}
+	return UpsertFieldConfig{
+		Name:   member.name,
+		Label:  member.labelText(),
+		Column: field.Constant(),
+		Type:   field.Type.String(),
+	}, true
+}
+
+// projectRevisionField maps a bound member onto a revision snapshot value.
+// Custom fields have no stored value to snapshot, and password hashes and
+// uploaded files cannot be replayed through a form input.
+func projectRevisionField(member *catalogMember) (RevisionFieldConfig, bool) {
+	switch {
+	case member.kind == MemberEdge:
+		return RevisionFieldConfig{Name: member.name, Edge: true, EdgeUnique: member.edgeUnique}, true
+	case member.kind != MemberEntField || member.name == "id":
+		return RevisionFieldConfig{}, false
+	case member.fieldKind == FieldKindPassword || isUploadFieldKind(member.fieldKind):
+		return RevisionFieldConfig{}, false
+	}
+	field := RevisionFieldConfig{Name: member.name, Nillable: member.nillable}
+	switch member.fieldKind {
+	case FieldKindTime:
+		field.Format = "vent.FormatFormValue"
+	case FieldKindStrings, FieldKindInts:
+		field.Format = "vent.FormatListFormValue"
+	case FieldKindJSON:
+		field.Format = "vent.FormatJSONFormValue"
+	case FieldKindEnum:
+		field.Format = "string"
+	}
+	return field, true
+}
+
 func projectUpdateInputField(member *catalogMember) InputFieldSpec {
 	switch member.kind {
 	case MemberEntField:
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"unsafe"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/entc/load"
 	schemafield "entgo.io/ent/schema/field"
@@ -102,10 +103,10 @@ func TestBuildProjectedRenderConfigDefaultSurface(t *testing.T) {
 	}
 
 	assertSurfaceMemberNames(t, rc.AdminSurface, []string{
-		"id", "title", "published", "nickname", "starts_at", "ends_at", "author", "tags",
+		"id", "title", "published", "nickname", "starts_at", "ends_at", "settings", "author", "tags",
 	})
 	assertTableColumnNames(t, rc.TableColumns, []string{
-		"id", "title", "published", "nickname", "starts_at", "ends_at",
+		"id", "title", "published", "nickname", "starts_at", "ends_at", "settings",
 	})
 
 	title := findSurfaceMember(t, rc.AdminSurface, "title")
@@ -295,6 +296,501 @@ func TestBuildProjectedRenderConfigFilterableColumns(t *testing.T) {
 	}
 }
 
+func TestBuildProjectedRenderConfigRangeAndNullFilters(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields,
+		&gen.Field{Name: "price", Type: &schemafield.TypeInfo{Type: schemafield.TypeFloat64}},
+		&gen.Field{Name: "views", Type: &schemafield.TypeInfo{Type: schemafield.TypeInt64}, Optional: true},
+	)
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			FilterableColumns: []string{"title", "price", "views", "ends_at"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if title := findFilterableColumn(t, rc.FilterableColumns, "title"); title.Range || title.Nullable {
+		t.Fatalf("title filter = %+v, want plain string filter", title)
+	}
+	if price := findFilterableColumn(t, rc.FilterableColumns, "price"); price.Type != "float" || !price.Range || price.GoType != "float64" || price.Nullable {
+		t.Fatalf("price filter = %+v, want float range", price)
+	}
+	if views := findFilterableColumn(t, rc.FilterableColumns, "views"); views.Type != "int" || views.GoType != "int64" || !views.Nullable {
+		t.Fatalf("views filter = %+v, want nullable int64 range", views)
+	}
+	if endsAt := findFilterableColumn(t, rc.FilterableColumns, "ends_at"); endsAt.Type != "time" || !endsAt.Range || !endsAt.Nullable {
+		t.Fatalf("ends_at filter = %+v, want nullable time range", endsAt)
+	}
+}
+
+func TestBuildProjectedRenderConfigEdgeFilters(t *testing.T) {
+	node := testInputNode()
+	node.Edges[0].Optional = true
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			FilterableColumns: []string{"author", "tags"},
+		},
+	}
+
+	configs, err := buildRenderConfigs([]*gen.Type{node, node.Edges[0].Type})
+	if err != nil {
+		t.Fatalf("buildRenderConfigs() error = %v", err)
+	}
+	rc := configs[0].RC
+	author := findFilterableColumn(t, rc.FilterableColumns, "author")
+	if author.Type != "edge" || author.PredicateName != "Author" || author.EdgeTypeName != "User" || author.EdgePackage != "user" || author.EdgeIDType != "int" || !author.Nullable {
+		t.Fatalf("author filter = %+v, want nullable edge filter on User", author)
+	}
+	if tags := findFilterableColumn(t, rc.FilterableColumns, "tags"); tags.Nullable {
+		t.Fatalf("tags filter = %+v, want required edge", tags)
+	}
+
+	want := []RelatedListConfig{{SchemaName: "Article", RouteName: "articles", PluralDisplayName: "Articles", FilterName: "author", FilterLabel: "Author", Addable: true, SingularDisplayName: "Article"}}
+	if got := configs[1].RC.RelatedLists; !reflect.DeepEqual(got, want) {
+		t.Fatalf("User RelatedLists = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildProjectedRenderConfigReverseRelations(t *testing.T) {
+	article := testInputNode()
+	article.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{FilterableColumns: []string{"author"}},
+	}
+	user := article.Edges[0].Type
+	user.Edges = []*gen.Edge{{Name: "articles", Type: article, Ref: article.Edges[0]}}
+	user.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{FieldSets: []FieldSet{{Fields: []string{"id"}}}},
+	}
+
+	configs, err := buildRenderConfigs([]*gen.Type{article, user})
+	if err != nil {
+		t.Fatalf("buildRenderConfigs() error = %v", err)
+	}
+	if author := findSurfaceMember(t, configs[0].RC.AdminSurface, "author"); author.EdgeRouteName != "users" {
+		t.Fatalf("author EdgeRouteName = %q, want users", author.EdgeRouteName)
+	}
+	if len(configs[0].RC.ReverseRelations) != 0 {
+		t.Fatalf("Article ReverseRelations = %+v, want none: every edge is on the surface", configs[0].RC.ReverseRelations)
+	}
+	want := []ReverseRelationConfig{{
+		Name:         "articles",
+		QueryName:    "Articles",
+		Label:        "Articles",
+		EdgeTypeName: "Article",
+		RouteName:    "articles",
+		FilterName:   "author",
+	}}
+	if got := configs[1].RC.ReverseRelations; !reflect.DeepEqual(got, want) {
+		t.Fatalf("User ReverseRelations = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildRenderConfigDeleteRelations(t *testing.T) {
+	user := &gen.Type{Name: "User"}
+	book := &gen.Type{Name: "Book"}
+	comment := &gen.Type{Name: "Comment"}
+	tag := &gen.Type{Name: "Tag"}
+	bookAuthor := &gen.Edge{Name: "author", Type: user, Unique: true, Rel: gen.Relation{Type: gen.M2O}}
+	commentUser := &gen.Edge{Name: "user", Type: user, Unique: true, Optional: true, Inverse: "comments", Rel: gen.Relation{Type: gen.M2O}}
+	book.Edges = []*gen.Edge{bookAuthor}
+	comment.Edges = []*gen.Edge{commentUser}
+	user.Edges = []*gen.Edge{
+		{Name: "books", Type: book, Inverse: "author", Ref: bookAuthor, Rel: gen.Relation{Type: gen.O2M}},
+		{Name: "comments", Type: comment, Ref: commentUser, Rel: gen.Relation{Type: gen.O2M}},
+		{Name: "drafts", Type: book, Rel: gen.Relation{Type: gen.O2M}, Annotations: gen.Annotations{
+			entsql.Annotation{}.Name(): entsql.OnDelete(entsql.Cascade),
+		}},
+		{Name: "tags", Type: tag, Rel: gen.Relation{Type: gen.M2M}},
+	}
+	commentUser.Ref = user.Edges[1]
+
+	configs, err := buildRenderConfigs([]*gen.Type{user, book, comment, tag})
+	if err != nil {
+		t.Fatalf("buildRenderConfigs() error = %v", err)
+	}
+	want := []DeleteRelationConfig{
+		{QueryName: "Books", Label: "Books", EdgeTypeName: "Book", RouteName: "books", Effect: DeleteBlock},
+		{QueryName: "Comments", Label: "Comments", EdgeTypeName: "Comment", RouteName: "comments", Effect: DeleteUnlink},
+		{QueryName: "Drafts", Label: "Drafts", EdgeTypeName: "Book", RouteName: "books", Effect: DeleteCascade},
+		{QueryName: "Tags", Label: "Tags", EdgeTypeName: "Tag", RouteName: "tags", Effect: DeleteUnlink},
+	}
+	if got := configs[0].RC.DeleteRelations; !reflect.DeepEqual(got, want) {
+		t.Fatalf("User DeleteRelations = %+v, want %+v", got, want)
+	}
+	for _, config := range configs[1:3] {
+		if got := config.RC.DeleteRelations; len(got) != 0 {
+			t.Fatalf("%s DeleteRelations = %+v, want none: its keys are on its own table", config.Node.Name, got)
+		}
+	}
+}
+
+func TestBuildProjectedRenderConfigEdgeFilterRequiresTargetAdmin(t *testing.T) {
+	node := testInputNode()
+	node.Edges[0].Type.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{DisableAdmin: true},
+	}
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{FilterableColumns: []string{"author"}},
+	}
+	if _, err := buildRenderConfig(node); err == nil || !strings.Contains(err.Error(), "which has no admin") {
+		t.Fatalf("buildRenderConfig() error = %v, want target admin error", err)
+	}
+}
+
+func TestBuildProjectedRenderConfigEnumField(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields, &gen.Field{
+		Name:  "status",
+		Type:  &schemafield.TypeInfo{Type: schemafield.TypeEnum, Ident: "article.Status"},
+		Enums: []gen.Enum{{Name: "StatusDraft", Value: "draft"}, {Name: "StatusLive", Value: "live"}},
+	})
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			TableColumns:      []string{"title", "status"},
+			FilterableColumns: []string{"status"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+
+	member := findSurfaceMember(t, rc.AdminSurface, "status")
+	if member.FieldKind != FieldKindEnum || member.EnumTypeName != "article.Status" {
+		t.Fatalf("status member = %#v, want enum/article.Status", member)
+	}
+	if strings.Join(member.EnumValues, ",") != "draft,live" {
+		t.Fatalf("status EnumValues = %v, want [draft live]", member.EnumValues)
+	}
+	for _, column := range rc.TableColumns {
+		if column.Name == "status" && column.Type != "enum" {
+			t.Fatalf("status column Type = %q, want enum", column.Type)
+		}
+	}
+	assertInputField(t, rc.CreateInputFields, "status", "status", "string", false, false)
+	assertInputField(t, rc.UpdateInputFields, "status", "status", "*string", false, false)
+
+	status := findFilterableColumn(t, rc.FilterableColumns, "status")
+	if status.Type != "enum" || status.EnumTypeName != "article.Status" || strings.Join(status.Options, ",") != "draft,live" {
+		t.Fatalf("status filter = %#v, want enum/article.Status with options", status)
+	}
+}
+
+func TestBuildProjectedRenderConfigFieldSets(t *testing.T) {
+	node := testInputNode()
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			FieldSets: []FieldSet{
+				{Label: "Main", Fields: []string{"id", "title", "author"}},
+				{Label: "Schedule", Description: "When it runs.", Fields: []string{"starts_at", "ends_at"}, Collapsed: true},
+			},
+			FieldSetLayout: FieldSetLayoutTabs,
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	assertSurfaceMemberNames(t, rc.AdminSurface, []string{"id", "title", "author", "starts_at", "ends_at"})
+	if rc.FieldSetLayout != FieldSetLayoutTabs {
+		t.Fatalf("FieldSetLayout = %q, want tabs", rc.FieldSetLayout)
+	}
+	if len(rc.FieldSets) != 2 {
+		t.Fatalf("len(FieldSets) = %d, want 2", len(rc.FieldSets))
+	}
+	assertSurfaceMemberNames(t, rc.FieldSets[0].Members, []string{"id", "title", "author"})
+	assertSurfaceMemberNames(t, rc.FieldSets[1].Members, []string{"starts_at", "ends_at"})
+	schedule := rc.FieldSets[1]
+	if schedule.Label != "Schedule" || schedule.Description != "When it runs." || !schedule.Collapsible || !schedule.Collapsed {
+		t.Fatalf("schedule field set = %+v, want labeled, described, collapsed", schedule)
+	}
+	assertInputSpecNames(t, rc.CreateInputFields, []string{"title", "author", "starts_at", "ends_at"})
+}
+
+func TestBuildProjectedRenderConfigDefaultFieldSet(t *testing.T) {
+	rc, err := buildRenderConfig(testInputNode())
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if len(rc.FieldSets) != 1 || rc.FieldSets[0].Label != "" {
+		t.Fatalf("FieldSets = %+v, want one unlabeled set", rc.FieldSets)
+	}
+	if len(rc.FieldSets[0].Members) != len(rc.AdminSurface) {
+		t.Fatalf("default field set has %d members, want %d", len(rc.FieldSets[0].Members), len(rc.AdminSurface))
+	}
+	if rc.FieldSetLayout != FieldSetLayoutSections {
+		t.Fatalf("FieldSetLayout = %q, want sections", rc.FieldSetLayout)
+	}
+}
+
+func TestBuildProjectedRenderConfigFieldSetsInvalid(t *testing.T) {
+	for name, annotation := range map[string]VentSchemaAnnotation{
+		"duplicate": {FieldSets: []FieldSet{{Fields: []string{"title"}}, {Fields: []string{"title"}}}},
+		"empty":     {FieldSets: []FieldSet{{Fields: []string{"title"}}, {Label: "Empty"}}},
+		"layout":    {FieldSets: []FieldSet{{Fields: []string{"title"}}}, FieldSetLayout: "accordion"},
+	} {
+		node := testInputNode()
+		node.Annotations = gen.Annotations{VentSchemaAnnotation{}.Name(): annotation}
+		if _, err := buildRenderConfig(node); err == nil {
+			t.Fatalf("%s: buildRenderConfig() error = nil, want field set error", name)
+		}
+	}
+}
+
+func TestBuildProjectedRenderConfigFieldAnnotation(t *testing.T) {
+	node := testInputNode()
+	node.Fields[0].Annotations = gen.Annotations{
+		VentFieldAnnotation{}.Name(): VentFieldAnnotation{
+			Label:        "Headline",
+			HelpText:     "Shown on the front page.",
+			Placeholder:  "Untitled",
+			Widget:       WidgetTextarea,
+			ColumnHeader: "Head",
+		},
+	}
+	node.Fields[3].Annotations = gen.Annotations{
+		VentFieldAnnotation{}.Name(): VentFieldAnnotation{Label: "Start date", Widget: WidgetDate},
+	}
+	node.Edges[0].Annotations = gen.Annotations{
+		VentFieldAnnotation{}.Name(): VentFieldAnnotation{Label: "Written by"},
+	}
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			TableColumns:      []string{"title", "author", "starts_at"},
+			FilterableColumns: []string{"title"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	title := findSurfaceMember(t, rc.AdminSurface, "title")
+	if title.Label != "Title" {
+		t.Fatalf("title Label = %q, want Go identifier Title", title.Label)
+	}
+	if title.DisplayLabel != "Headline" || title.HelpText != "Shown on the front page." || title.Placeholder != "Untitled" || title.Widget != WidgetTextarea {
+		t.Fatalf("title member = %+v, want annotation values", title)
+	}
+	if startsAt := findSurfaceMember(t, rc.AdminSurface, "starts_at"); startsAt.DisplayLabel != "Start date" || startsAt.Widget != WidgetDate {
+		t.Fatalf("starts_at member = %+v, want date widget", startsAt)
+	}
+	if author := findSurfaceMember(t, rc.AdminSurface, "author"); author.DisplayLabel != "Written by" {
+		t.Fatalf("author DisplayLabel = %q, want Written by", author.DisplayLabel)
+	}
+	if nickname := findSurfaceMember(t, rc.AdminSurface, "nickname"); nickname.DisplayLabel != "Nickname" {
+		t.Fatalf("nickname DisplayLabel = %q, want default Nickname", nickname.DisplayLabel)
+	}
+	if column := findTableColumn(t, rc.TableColumns, "title"); column.Label != "Head" {
+		t.Fatalf("title column Label = %q, want Head", column.Label)
+	}
+	if column := findTableColumn(t, rc.TableColumns, "starts_at"); column.Label != "Start date" {
+		t.Fatalf("starts_at column Label = %q, want Start date", column.Label)
+	}
+	if filter := findFilterableColumn(t, rc.FilterableColumns, "title"); filter.Label != "Headline" {
+		t.Fatalf("title filter Label = %q, want Headline", filter.Label)
+	}
+}
+
+func TestBuildProjectedRenderConfigFieldAnnotationWidgetMismatch(t *testing.T) {
+	node := testInputNode()
+	node.Fields[1].Annotations = gen.Annotations{
+		VentFieldAnnotation{}.Name(): VentFieldAnnotation{Widget: WidgetEmail},
+	}
+	if _, err := buildRenderConfig(node); err == nil || !strings.Contains(err.Error(), `widget "email"`) {
+		t.Fatalf("buildRenderConfig() error = %v, want widget error", err)
+	}
+}
+
+func TestBuildProjectedRenderConfigSearchFields(t *testing.T) {
+	node := testInputNode()
+	node.Edges[0].Type = &gen.Type{
+		Name: "User",
+		Fields: []*gen.Field{
+			{Name: "email", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}},
+		},
+	}
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			SearchFields: []string{"title", "author.email"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	want := []SearchFieldConfig{
+		{Path: "title", Package: "article", PredicateName: "TitleContainsFold"},
+		{
+			Path:          "author.email",
+			Hops:          []SearchHop{{Package: "article", PredicateName: "HasAuthorWith"}},
+			Package:       "user",
+			PredicateName: "EmailContainsFold",
+		},
+	}
+	if !reflect.DeepEqual(rc.SearchFields, want) {
+		t.Fatalf("SearchFields = %+v, want %+v", rc.SearchFields, want)
+	}
+}
+
+func TestBuildProjectedRenderConfigSearchFieldsInvalid(t *testing.T) {
+	for _, path := range []string{"published", "missing", "title.name", "tags.name", "author"} {
+		node := testInputNode()
+		node.Annotations = gen.Annotations{
+			VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{SearchFields: []string{path}},
+		}
+		if _, err := buildRenderConfig(node); err == nil || !strings.Contains(err.Error(), "search field") {
+			t.Fatalf("buildRenderConfig(%q) error = %v, want search field error", path, err)
+		}
+	}
+}
+
+func TestBuildProjectedRenderConfigSortableColumns(t *testing.T) {
+	node := testInputNode()
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			FieldSets:       []FieldSet{{Fields: []string{"title", "starts_at", "author", "tags"}}},
+			TableColumns:    []string{"title", "settings", "author", "tags"},
+			DefaultOrdering: []string{"-starts_at", "title"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if column := findTableColumn(t, rc.TableColumns, "title"); !column.Sortable {
+		t.Fatal("title column should be sortable")
+	}
+	if column := findTableColumn(t, rc.TableColumns, "settings"); column.Sortable {
+		t.Fatal("JSON column should not be sortable")
+	}
+	want := []SortableColumn{
+		{Name: "title", Kind: "field"},
+		{Name: "author", Kind: "edge_field", EdgeField: "id"},
+		{Name: "tags", Kind: "edge_count"},
+		{Name: "starts_at", Kind: "field"},
+	}
+	if !reflect.DeepEqual(rc.SortableColumns, want) {
+		t.Fatalf("SortableColumns = %+v, want %+v", rc.SortableColumns, want)
+	}
+	if !reflect.DeepEqual(rc.DefaultOrdering, []string{"-starts_at", "title"}) {
+		t.Fatalf("DefaultOrdering = %v", rc.DefaultOrdering)
+	}
+}
+
+func TestBuildProjectedRenderConfigDefaultOrderingInvalid(t *testing.T) {
+	for _, ordering := range []string{"missing", "-settings"} {
+		node := testInputNode()
+		node.Annotations = gen.Annotations{
+			VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{DefaultOrdering: []string{ordering}},
+		}
+		if _, err := buildRenderConfig(node); err == nil || !strings.Contains(err.Error(), "default ordering") {
+			t.Fatalf("buildRenderConfig(%q) error = %v, want default ordering error", ordering, err)
+		}
+	}
+}
+
+func TestBuildProjectedRenderConfigUploadFields(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields,
+		&gen.Field{Name: "cover", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}, Optional: true},
+		&gen.Field{Name: "manuscript", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}},
+	)
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			TableColumns: []string{"title", "cover", "manuscript"},
+			ImageFields:  []string{"cover"},
+			FileFields:   []string{"manuscript"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if !rc.HasUploadFields {
+		t.Fatal("HasUploadFields = false, want true")
+	}
+	wantUploads := []UploadFieldConfig{{Name: "cover"}, {Name: "manuscript"}}
+	if !reflect.DeepEqual(rc.UploadFields, wantUploads) {
+		t.Fatalf("UploadFields = %+v, want %+v", rc.UploadFields, wantUploads)
+	}
+	if cover := findSurfaceMember(t, rc.AdminSurface, "cover"); cover.FieldKind != FieldKindImage {
+		t.Fatalf("cover FieldKind = %q, want image", cover.FieldKind)
+	}
+	if manuscript := findSurfaceMember(t, rc.AdminSurface, "manuscript"); manuscript.FieldKind != FieldKindFile {
+		t.Fatalf("manuscript FieldKind = %q, want file", manuscript.FieldKind)
+	}
+	if column := findTableColumn(t, rc.TableColumns, "cover"); column.Type != "image" {
+		t.Fatalf("cover column Type = %q, want image", column.Type)
+	}
+	if column := findTableColumn(t, rc.TableColumns, "manuscript"); column.Type != "file" {
+		t.Fatalf("manuscript column Type = %q, want file", column.Type)
+	}
+}
+
+func TestBuildProjectedRenderConfigUploadFieldsRequireString(t *testing.T) {
+	for _, annotation := range []VentSchemaAnnotation{
+		{ImageFields: []string{"published"}},
+		{FileFields: []string{"missing"}},
+		{FileFields: []string{"title"}, ImageFields: []string{"title"}},
+	} {
+		node := testInputNode()
+		node.Annotations = gen.Annotations{VentSchemaAnnotation{}.Name(): annotation}
+		if _, err := buildRenderConfig(node); err == nil {
+			t.Fatalf("buildRenderConfig(%+v) error = nil, want upload field error", annotation)
+		}
+	}
+}
+
+func TestBuildProjectedRenderConfigUUIDID(t *testing.T) {
+	node := testInputNode()
+	node.ID = &gen.Field{
+		Name: "id",
+		Type: &schemafield.TypeInfo{Type: schemafield.TypeUUID, Ident: "uuid.UUID", PkgPath: "github.com/google/uuid", PkgName: "uuid"},
+	}
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			TableColumns:      []string{"id", "title"},
+			FilterableColumns: []string{"id"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if rc.IDType != "uuid.UUID" {
+		t.Fatalf("IDType = %q, want uuid.UUID", rc.IDType)
+	}
+	if rc.IDPackage != (IDPackage{Name: "uuid", Path: "github.com/google/uuid"}) {
+		t.Fatalf("IDPackage = %#v, want github.com/google/uuid", rc.IDPackage)
+	}
+	if column := findTableColumn(t, rc.TableColumns, "id"); column.Type != "id" {
+		t.Fatalf("id column Type = %q, want id", column.Type)
+	}
+	if filter := findFilterableColumn(t, rc.FilterableColumns, "id"); filter.Type != "id" {
+		t.Fatalf("id filter Type = %q, want id", filter.Type)
+	}
+}
+
+func TestBuildProjectedRenderConfigDefaultIntID(t *testing.T) {
+	rc, err := buildRenderConfig(testInputNode())
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if rc.IDType != "int" || rc.IDPackage != (IDPackage{}) {
+		t.Fatalf("ID = %q/%#v, want int with no package", rc.IDType, rc.IDPackage)
+	}
+}
+
 func TestBuildProjectedRenderConfigPageSize(t *testing.T) {
 	node := testInputNode()
 	node.Annotations = gen.Annotations{
@@ -316,7 +812,7 @@ func TestBuildProjectedRenderConfigFilterableColumnUnsupported(t *testing.T) {
 	node := testInputNode()
 	node.Annotations = gen.Annotations{
 		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
-			FilterableColumns: []string{"author"},
+			FilterableColumns: []string{"settings"},
 		},
 	}
 
@@ -324,7 +820,7 @@ func TestBuildProjectedRenderConfigFilterableColumnUnsupported(t *testing.T) {
 	if err == nil {
 		t.Fatal("buildRenderConfig() error = nil, want unsupported filterable column")
 	}
-	if !strings.Contains(err.Error(), `filterable column "author" has unsupported type`) {
+	if !strings.Contains(err.Error(), `filterable column "settings" has unsupported type`) {
 		t.Fatalf("buildRenderConfig() error = %v", err)
 	}
 }
@@ -554,3 +1050,215 @@ func findFilterableColumn(t *testing.T, columns []FilterableColumnConfig, name s
 	t.Fatalf("filterable column %q not found in %#v", name, columns)
 	return FilterableColumnConfig{}
 }
+
+func TestBuildProjectedRenderConfigImportColumns(t *testing.T) {
+	node := testInputNode()
+	node.Fields[0].Unique = true
+	node.Edges[1].Type.Fields = []*gen.Field{
+		{Name: "slug", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}, Unique: true},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if rc.NaturalKey != "title" {
+		t.Fatalf("NaturalKey = %q, want title", rc.NaturalKey)
+	}
+	if want := []UpsertFieldConfig{{Name: "title", Label: "Title", Column: "FieldTitle", Type: "string"}}; !reflect.DeepEqual(rc.UpsertFields, want) {
+		t.Fatalf("UpsertFields = %+v, want %+v", rc.UpsertFields, want)
+	}
+	columns := map[string]ImportColumnConfig{}
+	for _, column := range rc.ImportColumns {
+		columns[column.Name] = column
+	}
+	if got, want := columns["author"], (ImportColumnConfig{Name: "author", Label: "Author", RefTypeName: "User"}); got != want {
+		t.Fatalf("author column = %+v, want %+v", got, want)
+	}
+	if got, want := columns["tags"], (ImportColumnConfig{Name: "tags", Label: "Tags", RefTypeName: "Tag", RefKey: "slug", RefMany: true}); got != want {
+		t.Fatalf("tags column = %+v, want %+v", got, want)
+	}
+	if _, ok := columns["settings"]; !ok {
+		t.Fatal("settings should be importable")
+	}
+}
+
+func TestBuildProjectedRenderConfigRevisionFields(t *testing.T) {
+	rc, err := buildRenderConfig(testInputNode())
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	want := []RevisionFieldConfig{
+		{Name: "title"},
+		{Name: "published"},
+		{Name: "nickname", Nillable: true},
+		{Name: "starts_at", Format: "vent.FormatFormValue"},
+		{Name: "ends_at", Nillable: true, Format: "vent.FormatFormValue"},
+		{Name: "settings", Format: "vent.FormatJSONFormValue"},
+		{Name: "author", Edge: true, EdgeUnique: true},
+		{Name: "tags", Edge: true},
+	}
+	if !reflect.DeepEqual(rc.RevisionFields, want) {
+		t.Fatalf("RevisionFields = %+v, want %+v", rc.RevisionFields, want)
+	}
+}
+
+func TestBuildRenderConfigHidesSoftDeleteField(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields, &gen.Field{Name: SoftDeleteField, Type: &schemafield.TypeInfo{Type: schemafield.TypeTime}, Optional: true, Nillable: true})
+	node.Annotations = gen.Annotations{VentSoftDeleteAnnotation{}.Name(): VentSoftDeleteAnnotation{}}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if !rc.SoftDelete {
+		t.Fatal("SoftDelete = false, want true")
+	}
+	for _, member := range rc.AdminSurface {
+		if member.Name == SoftDeleteField {
+			t.Fatalf("admin surface includes %q", SoftDeleteField)
+		}
+	}
+	for _, column := range rc.TableColumns {
+		if column.Name == SoftDeleteField {
+			t.Fatalf("table columns include %q", SoftDeleteField)
+		}
+	}
+}
+
+func TestBuildRenderConfigVersionField(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields, &gen.Field{Name: "version", Type: &schemafield.TypeInfo{Type: schemafield.TypeInt64}})
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{VersionField: "version"},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	want := VersionFieldConfig{Name: "version", GoType: "int64"}
+	if rc.Version == nil || *rc.Version != want {
+		t.Fatalf("Version = %+v, want %+v", rc.Version, want)
+	}
+	version := findSurfaceMember(t, rc.AdminSurface, "version")
+	if version.BindCreate || version.BindUpdate {
+		t.Fatalf("version bind flags = create %v update %v, want false/false", version.BindCreate, version.BindUpdate)
+	}
+	for _, field := range rc.RevisionFields {
+		if field.Name == "version" {
+			t.Fatal("RevisionFields includes version")
+		}
+	}
+}
+
+func TestBuildRenderConfigCopyOnDuplicate(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields,
+		&gen.Field{Name: "slug", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}, Unique: true},
+		&gen.Field{Name: "created_at", Type: &schemafield.TypeInfo{Type: schemafield.TypeTime}, Immutable: true},
+	)
+	node.Edges[0].Rel = gen.Relation{Type: gen.M2O}
+	node.Edges[1].Rel = gen.Relation{Type: gen.M2M}
+	node.Edges = append(node.Edges, &gen.Edge{Name: "comments", Type: &gen.Type{Name: "Comment"}, Rel: gen.Relation{Type: gen.O2M}})
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	for name, want := range map[string]bool{
+		"title":      true,
+		"nickname":   true,
+		"slug":       false,
+		"created_at": false,
+		"author":     true,
+		"tags":       true,
+		"comments":   false,
+	} {
+		if got := findSurfaceMember(t, rc.AdminSurface, name).CopyOnDuplicate; got != want {
+			t.Fatalf("%s CopyOnDuplicate = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestBuildRenderConfigInitialFromQuery(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields, &gen.Field{Name: "cover", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}})
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			ReadOnlyFields: []string{"nickname"},
+			ImageFields:    []string{"cover"},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	for name, want := range map[string]bool{
+		"id":       false,
+		"title":    true,
+		"nickname": false,
+		"cover":    false,
+		"settings": true,
+		"author":   true,
+		"tags":     true,
+	} {
+		if got := findSurfaceMember(t, rc.AdminSurface, name).InitialFromQuery; got != want {
+			t.Fatalf("%s InitialFromQuery = %v, want %v", name, got, want)
+		}
+	}
+	if got := findSurfaceMember(t, rc.AdminSurface, "author").EdgeIDType; got != "int" {
+		t.Fatalf("author EdgeIDType = %q, want int", got)
+	}
+}
+
+func TestBuildRenderConfigPrepopulatedFields(t *testing.T) {
+	node := testInputNode()
+	node.Fields = append(node.Fields, &gen.Field{Name: "slug", Type: &schemafield.TypeInfo{Type: schemafield.TypeString}})
+	node.Annotations = gen.Annotations{
+		VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+			PrepopulatedFields: map[string][]string{"slug": {"title", "nickname"}},
+		},
+	}
+
+	rc, err := buildRenderConfig(node)
+	if err != nil {
+		t.Fatalf("buildRenderConfig() error = %v", err)
+	}
+	if got := findSurfaceMember(t, rc.AdminSurface, "slug").PrepopulateFrom; !reflect.DeepEqual(got, []string{"title", "nickname"}) {
+		t.Fatalf("slug PrepopulateFrom = %v, want title and nickname", got)
+	}
+	if got := findSurfaceMember(t, rc.AdminSurface, "title").PrepopulateFrom; got != nil {
+		t.Fatalf("title PrepopulateFrom = %v, want none", got)
+	}
+}
+
+func TestBuildRenderConfigPrepopulatedFieldsErrors(t *testing.T) {
+	for _, tc := range []struct {
+		prepopulated map[string][]string
+		readOnly     []string
+		want         string
+	}{
+		{map[string][]string{"missing": {"title"}}, nil, `prepopulated field "missing" must be a string field`},
+		{map[string][]string{"starts_at": {"title"}}, nil, `prepopulated field "starts_at" must be a string field`},
+		{map[string][]string{"nickname": {"title"}}, []string{"nickname"}, `prepopulated field "nickname" must be a string field`},
+		{map[string][]string{"nickname": nil}, nil, `prepopulated field "nickname" has no source fields`},
+		{map[string][]string{"nickname": {"nickname"}}, nil, `source "nickname" must be another`},
+		{map[string][]string{"nickname": {"author"}}, nil, `source "author" must be another`},
+		{map[string][]string{"nickname": {"starts_at"}}, nil, `source "starts_at" must be another`},
+	} {
+		node := testInputNode()
+		node.Annotations = gen.Annotations{
+			VentSchemaAnnotation{}.Name(): VentSchemaAnnotation{
+				PrepopulatedFields: tc.prepopulated,
+				ReadOnlyFields:     tc.readOnly,
+			},
+		}
+		_, err := buildRenderConfig(node)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("PrepopulatedFields %v error = %v, want %q", tc.prepopulated, err, tc.want)
+		}
+	}
+}
@@ -111,7 +111,10 @@ func RotateCSRFToken(w http.ResponseWriter, r *http.Request, secureCookies bool)
 // CSRFMiddleware manages CSRF tokens for admin requests.
 // Safe methods issue a token when missing and reuse an existing cookie.
 // Mutating methods require a valid cookie and matching X-CSRF-Token header,
-// then load the token into context for templates and error re-renders.
+// then load the token into context for templates and error re-renders. A
+// multipart body parsed here or by the handler has its temporary files
+// removed once the handler returns; the server only cleans up the request it
+// created, not the copies middleware passes on.
 func CSRFMiddleware(secureCookies bool) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -130,16 +133,28 @@ func CSRFMiddleware(secureCookies bool) func(http.Handler) http.Handler {
 				return
 			}
 
+			// r is swapped for the handler's copy below; remove the form of
+			// whichever request ends up holding it.
+			defer func() { removeMultipartForm(r) }()
 			if !auth.ValidateCSRFToken(r) {
 				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 				return
 			}
 			token := csrfTokenFromCookie(r)
-			next.ServeHTTP(w, r.WithContext(WithCSRFToken(r.Context(), token)))
+			r = r.WithContext(WithCSRFToken(r.Context(), token))
+			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// removeMultipartForm deletes the temporary files of r's parsed multipart
+// form, if any.
+func removeMultipartForm(r *http.Request) {
+	if r.MultipartForm != nil {
+		_ = r.MultipartForm.RemoveAll()
+	}
+}
+
 func csrfTokenFromCookie(r *http.Request) string {
 	cookie, err := r.Cookie(auth.CSRFTokenCookieName)
 	if err != nil || cookie.Value == "" {
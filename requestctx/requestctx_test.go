@@ -1,9 +1,12 @@
 package requestctx
 
 import (
+	"bytes"
 	"context"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/troygilman/vent/auth"
@@ -347,3 +350,46 @@ func findThemeCookie(rec *httptest.ResponseRecorder) *http.Cookie {
 	}
 	return nil
 }
+
+func TestCSRFMiddlewareRemovesMultipartTempFiles(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	handler := AdminPathMiddleware("/admin/")(
+		CSRFMiddleware(false)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if entries, _ := os.ReadDir(tmp); len(entries) == 0 {
+					t.Fatal("upload was not spilled to a temporary file")
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if err := form.WriteField(auth.CSRFTokenFormField, "existing-token"); err != nil {
+		t.Fatal(err)
+	}
+	file, err := form.CreateFormFile("cover", "cover.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write(make([]byte, 9<<20)); err != nil {
+		t.Fatal(err)
+	}
+	if err := form.Close(); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/admin/books/add/", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.AddCookie(&http.Cookie{Name: auth.CSRFTokenCookieName, Value: "existing-token"})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if entries, _ := os.ReadDir(tmp); len(entries) != 0 {
+		t.Fatalf("temporary files left behind: %v", entries)
+	}
+}
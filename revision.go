@@ -0,0 +1,66 @@
+package vent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RevisionLimit is how many revisions an entity's Revisions tab lists,
+// newest first.
+const RevisionLimit = 100
+
+// RevisionSnapshot is an entity's form values keyed by field name, in the
+// shape the change form submits them. Restoring a revision decodes it into the
+// schema's update (or, for a deleted entity, create) input and replays it
+// through the fields' Apply methods.
+type RevisionSnapshot map[string]any
+
+// Decode sets the fields of the input struct dst points to from s, matching
+// keys to JSON tags.
+func (s RevisionSnapshot) Decode(dst any) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// RevisionDiff returns the fields whose values differ between two snapshots,
+// in the order of fields, formatted with FormatRevisionValue.
+func RevisionDiff(fields []string, from, to RevisionSnapshot) []AuditChange {
+	var changes []AuditChange
+	for _, field := range fields {
+		before := FormatRevisionValue(from[field])
+		after := FormatRevisionValue(to[field])
+		if before != after {
+			changes = append(changes, AuditChange{Field: field, Before: before, After: after})
+		}
+	}
+	return changes
+}
+
+// FormatRevisionValue formats a snapshot value for display. Stored snapshots
+// decode numbers as float64 and lists as []any, so values format the same
+// before and after a round trip through the database.
+func FormatRevisionValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		return strings.Join(v, ", ")
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = FormatRevisionValue(item)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,53 @@
+package vent
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRevisionDiffMatchesStoredSnapshots(t *testing.T) {
+	current := RevisionSnapshot{"title": "Dune", "pages": 412, "published": true, "tags": "sf", "author": "3", "reviewers": []string{"1", "2"}, "published_at": nil}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored RevisionSnapshot
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatal(err)
+	}
+	fields := []string{"title", "pages", "published", "tags", "author", "reviewers", "published_at"}
+	if changes := RevisionDiff(fields, stored, current); changes != nil {
+		t.Fatalf("RevisionDiff(stored, current) = %+v, want no changes", changes)
+	}
+
+	stored["title"] = "Dune Messiah"
+	stored["reviewers"] = []any{"1"}
+	want := []AuditChange{
+		{Field: "title", Before: "Dune Messiah", After: "Dune"},
+		{Field: "reviewers", Before: "1", After: "1, 2"},
+	}
+	if got := RevisionDiff(fields, stored, current); !reflect.DeepEqual(got, want) {
+		t.Fatalf("RevisionDiff() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRevisionSnapshotDecode(t *testing.T) {
+	var input struct {
+		Title     *string  `json:"title"`
+		Pages     *int     `json:"pages"`
+		Reviewers []string `json:"reviewers"`
+		Notes     *string  `json:"notes"`
+	}
+	snapshot := RevisionSnapshot{"title": "Dune", "pages": float64(412), "reviewers": []any{"1", "2"}}
+	if err := snapshot.Decode(&input); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if *input.Title != "Dune" || *input.Pages != 412 || !reflect.DeepEqual(input.Reviewers, []string{"1", "2"}) {
+		t.Fatalf("Decode() = %+v", input)
+	}
+	if input.Notes != nil {
+		t.Fatalf("Decode() set notes, which the snapshot leaves out")
+	}
+}
@@ -8,6 +8,14 @@ import (
 
 var segmentPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
 
+// pathParams are the path parameters a pattern may use: an entity id and the
+// revision or action nested under it.
+var pathParams = map[string]bool{
+	"id":       true,
+	"revision": true,
+	"action":   true,
+}
+
 // NormalizeSegment validates a relative group segment (no slashes).
 // An empty segment is allowed for middleware-only groups.
 func NormalizeSegment(segment string) (string, error) {
@@ -60,8 +68,8 @@ func validatePatternSegments(pattern string) error {
 		}
 		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
 			name := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
-			if name != "id" {
-				return fmt.Errorf("route: unsupported path parameter %q: only {id} is allowed", part)
+			if !pathParams[name] {
+				return fmt.Errorf("route: unsupported path parameter %q: only {id}, {revision} and {action} are allowed", part)
 			}
 			continue
 		}
@@ -48,6 +48,8 @@ func TestNormalizePattern(t *testing.T) {
 		{"/{id}", "/{id}/"},
 		{"/add/{$}", "/add/{$}"},
 		{"/{id}/password", "/{id}/password/"},
+		{"/{id}/revisions/{revision}/restore", "/{id}/revisions/{revision}/restore/"},
+		{"/{id}/actions/{action}/", "/{id}/actions/{action}/"},
 	}
 
 	for _, tt := range tests {
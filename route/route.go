@@ -1,6 +1,7 @@
 package route
 
 import (
+	"errors"
 	"net/http"
 	"slices"
 )
@@ -21,6 +22,9 @@ func Chain(mws ...Middleware) Middleware {
 type Router struct {
 	mux        *http.ServeMux
 	middleware []Middleware
+	// errs collects the registration errors of this router, so the Mount or
+	// Group that created it can report them.
+	errs []error
 }
 
 // New returns a root router backed by a new ServeMux.
@@ -33,11 +37,12 @@ func (r *Router) Use(mw ...Middleware) {
 	r.middleware = append(r.middleware, mw...)
 }
 
-// Mount registers a subtree at an absolute path prefix such as /admin/.
+// Mount registers a subtree at an absolute path prefix such as /admin/. It
+// returns the errors of every route fn failed to register.
 func (r *Router) Mount(absPrefix string, fn func(*Router), mws ...Middleware) error {
 	mount, strip, err := NormalizeMountPrefix(absPrefix)
 	if err != nil {
-		return err
+		return r.record(err)
 	}
 
 	child := &Router{
@@ -48,15 +53,16 @@ func (r *Router) Mount(absPrefix string, fn func(*Router), mws ...Middleware) er
 	fn(child)
 
 	r.mux.Handle(mount, http.StripPrefix(strip, child.mux))
-	return nil
+	return r.record(errors.Join(child.errs...))
 }
 
 // Group registers a relative path segment as a subtree. An empty segment applies
-// middleware without an additional mount (middleware-only group).
+// middleware without an additional mount (middleware-only group). Like Mount,
+// it returns the errors of every route fn failed to register.
 func (r *Router) Group(segment string, fn func(*Router), mws ...Middleware) error {
 	seg, err := NormalizeSegment(segment)
 	if err != nil {
-		return err
+		return r.record(err)
 	}
 
 	child := &Router{
@@ -66,7 +72,7 @@ func (r *Router) Group(segment string, fn func(*Router), mws ...Middleware) erro
 	if seg == "" {
 		child.mux = r.mux
 		fn(child)
-		return nil
+		return r.record(errors.Join(child.errs...))
 	}
 
 	child.mux = http.NewServeMux()
@@ -74,14 +80,14 @@ func (r *Router) Group(segment string, fn func(*Router), mws ...Middleware) erro
 
 	mount := "/" + seg + "/"
 	r.mux.Handle(mount, http.StripPrefix("/"+seg, child.mux))
-	return nil
+	return r.record(errors.Join(child.errs...))
 }
 
 // Handle registers a method-agnostic subtree handler.
 func (r *Router) Handle(pattern string, h http.Handler, mws ...Middleware) error {
 	pat, err := NormalizePattern(pattern)
 	if err != nil {
-		return err
+		return r.record(err)
 	}
 	handler := applyMiddleware(h, append(r.middleware, mws...))
 	r.mux.Handle(pat, handler)
@@ -116,13 +122,22 @@ func (r *Router) DELETE(pattern string, h http.Handler, mws ...Middleware) error
 func (r *Router) method(method, pattern string, h http.Handler, mws ...Middleware) error {
 	pat, err := NormalizePattern(pattern)
 	if err != nil {
-		return err
+		return r.record(err)
 	}
 	handler := applyMiddleware(h, append(r.middleware, mws...))
 	r.mux.Handle(method+" "+pat, handler)
 	return nil
 }
 
+// record keeps a non-nil registration error for the enclosing Mount or Group
+// and returns it.
+func (r *Router) record(err error) error {
+	if err != nil {
+		r.errs = append(r.errs, err)
+	}
+	return err
+}
+
 // Handler returns the router as an http.Handler.
 func (r *Router) Handler() http.Handler {
 	return r.mux
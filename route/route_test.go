@@ -3,6 +3,7 @@ package route
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -137,3 +138,16 @@ func TestNormalizePatternEnforcedOnGET(t *testing.T) {
 		t.Fatal("expected error for invalid segment casing")
 	}
 }
+
+func TestMountReturnsNestedRouteErrors(t *testing.T) {
+	root := New()
+	err := root.Mount("/admin/", func(admin *Router) {
+		admin.Group("books", func(books *Router) {
+			books.GET("/", http.NotFoundHandler())
+			books.POST("/{slug}/", http.NotFoundHandler())
+		})
+	})
+	if err == nil || !strings.Contains(err.Error(), "{slug}") {
+		t.Fatalf("Mount() error = %v, want the {slug} route's error", err)
+	}
+}
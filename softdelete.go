@@ -0,0 +1,44 @@
+package vent
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// SoftDeleteField is the field SoftDeleteMixin adds. It holds the time an
+// entity was moved to the trash and is nil for live entities.
+const SoftDeleteField = "deleted_at"
+
+// TrashLimit is how many entities a schema's Trash view shows, most recently
+// deleted first.
+const TrashLimit = 100
+
+type skipSoftDeleteKey struct{}
+
+// SkipSoftDelete returns a copy of ctx whose queries also return entities
+// that SoftDeleteMixin would leave out.
+func SkipSoftDelete(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipSoftDeleteKey{}, true)
+}
+
+func softDeleteSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipSoftDeleteKey{}).(bool)
+	return skip
+}
+
+// wherePredicate adds p to q through its generated Where method. Each schema's
+// predicate type is a named func(*sql.Selector), so p is converted to it. It
+// fails for queries without such a method, rather than letting them return
+// rows it should hide.
+func wherePredicate(q any, p func(*sql.Selector)) error {
+	where := reflect.ValueOf(q).MethodByName("Where")
+	if !where.IsValid() || !where.Type().IsVariadic() || where.Type().NumIn() != 1 ||
+		!reflect.TypeOf(p).ConvertibleTo(where.Type().In(0).Elem()) {
+		return fmt.Errorf("vent: soft delete cannot filter %T: it has no Where method taking SQL predicates", q)
+	}
+	where.Call([]reflect.Value{reflect.ValueOf(p).Convert(where.Type().In(0).Elem())})
+	return nil
+}
@@ -0,0 +1,56 @@
+package vent
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+type testPredicate func(*sql.Selector)
+
+type testSoftDeleteQuery struct {
+	predicates []testPredicate
+}
+
+func (q *testSoftDeleteQuery) Where(ps ...testPredicate) *testSoftDeleteQuery {
+	q.predicates = append(q.predicates, ps...)
+	return q
+}
+
+func TestSoftDeleteInterceptorHidesDeletedRows(t *testing.T) {
+	interceptor := SoftDeleteMixin{}.Interceptors()[0]
+	traverse := func(ctx context.Context) *testSoftDeleteQuery {
+		t.Helper()
+		q := &testSoftDeleteQuery{}
+		if err := interceptor.(ent.TraverseFunc).Traverse(ctx, q); err != nil {
+			t.Fatalf("Traverse() error = %v", err)
+		}
+		return q
+	}
+
+	q := traverse(context.Background())
+	if len(q.predicates) != 1 {
+		t.Fatalf("predicates = %d, want 1", len(q.predicates))
+	}
+	selector := sql.Select("*").From(sql.Table("books"))
+	q.predicates[0](selector)
+	if query, _ := selector.Query(); query != "SELECT * FROM `books` WHERE `books`.`deleted_at` IS NULL" {
+		t.Fatalf("query = %q, want deleted_at IS NULL", query)
+	}
+
+	if q := traverse(SkipSoftDelete(context.Background())); len(q.predicates) != 0 {
+		t.Fatalf("SkipSoftDelete predicates = %d, want 0", len(q.predicates))
+	}
+}
+
+func TestSoftDeleteInterceptorFailsOnQueriesItCannotFilter(t *testing.T) {
+	interceptor := SoftDeleteMixin{}.Interceptors()[0]
+	if err := interceptor.(ent.TraverseFunc).Traverse(context.Background(), struct{ ent.Query }{}); err == nil {
+		t.Fatal("Traverse() error = nil, want an error for a query without Where")
+	}
+	if err := interceptor.(ent.TraverseFunc).Traverse(SkipSoftDelete(context.Background()), struct{ ent.Query }{}); err != nil {
+		t.Fatalf("SkipSoftDelete Traverse() error = %v, want nil", err)
+	}
+}
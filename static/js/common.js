@@ -35,3 +35,93 @@ window.widgetDrawer = {
         state._open = true;
     },
 };
+
+window.jsonEditor = {
+    // validate marks the editor invalid and shows the parse error inline.
+    validate(el) {
+        let message = "";
+        if (el.value.trim() !== "") {
+            try {
+                JSON.parse(el.value);
+            } catch (err) {
+                message = err.message;
+            }
+        }
+        el.setCustomValidity(message);
+        const group = el.closest(".field-group");
+        group?.querySelector(".input")?.classList.toggle("error", message !== "");
+        const error = group?.querySelector(".field-error");
+        if (error) {
+            error.textContent = message;
+            error.hidden = message === "";
+        }
+    },
+};
+
+window.tagInput = {
+    // values reads a tag signal: a JSON array, or comma-separated text from
+    // older values.
+    values(value) {
+        const text = String(value ?? "").trim();
+        let tags = text.split(",");
+        if (text.startsWith("[")) {
+            try {
+                const parsed = JSON.parse(text);
+                if (Array.isArray(parsed)) {
+                    tags = parsed.map(String);
+                }
+            } catch {
+                // not JSON; split on commas
+            }
+        }
+        return tags.map((tag) => tag.trim()).filter((tag) => tag !== "");
+    },
+    // render redraws the tag chips, each with a button that removes it.
+    render(el, value) {
+        const tags = tagInput.values(value);
+        el.replaceChildren(
+            ...tags.map((tag, index) => {
+                const chip = document.createElement("span");
+                chip.className = "badge";
+                chip.textContent = tag;
+                const remove = document.createElement("button");
+                remove.type = "button";
+                remove.className = "tag-input-remove";
+                remove.textContent = "\u00d7";
+                remove.setAttribute("aria-label", `Remove ${tag}`);
+                remove.addEventListener("click", () => {
+                    tagInput.write(el, tags.filter((_, i) => i !== index));
+                });
+                chip.append(remove);
+                return chip;
+            }),
+        );
+    },
+    // keydown adds the entry's text as a tag on Enter instead of submitting.
+    keydown(evt, el) {
+        if (evt.key !== "Enter") {
+            return;
+        }
+        evt.preventDefault();
+        tagInput.commit(el);
+    },
+    // commit adds the entry's text as one tag, commas included.
+    commit(el) {
+        const tag = el.value.trim();
+        if (tag === "") {
+            return;
+        }
+        tagInput.write(el, [...tagInput.values(tagInput.field(el).value), tag]);
+        el.value = "";
+    },
+    // field is the hidden input bound to the tag signal.
+    field(el) {
+        return el.closest(".tag-input").querySelector('input[type="hidden"]');
+    },
+    // write stores tags as a JSON array and lets data-bind update the signal.
+    write(el, tags) {
+        const field = tagInput.field(el);
+        field.value = tags.length > 0 ? JSON.stringify(tags) : "";
+        field.dispatchEvent(new Event("input", { bubbles: true }));
+    },
+};
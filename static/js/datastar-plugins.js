@@ -1,4 +1,5 @@
 import {
+  action,
   attribute,
   effect,
   filtered,
@@ -32,6 +33,20 @@ attribute({
   },
 })
 
+/**
+ * Registers @confirm as an action so it can guard other actions in any
+ * expression, as in the usage above.
+ */
+action({
+  name: "confirm",
+  apply(_ctx, message) {
+    if (typeof message !== "string") {
+      throw new Error("confirm: argument must be a string")
+    }
+    return window.confirm(message)
+  },
+})
+
 /**
  * data-cookie plugin
  *
@@ -163,3 +178,51 @@ attribute({
     }
   },
 })
+
+/**
+ * data-prepopulate plugin
+ *
+ * Fills a bound input with a slug of other signals as they change, until the
+ * user edits the input. An input that already has a value is left alone.
+ *
+ * Usage:
+ *   <input data-bind="entity.slug" data-prepopulate="[$entity.title]">
+ */
+attribute({
+  name: "prepopulate",
+  requirement: {
+    key: "denied",
+    value: "must",
+  },
+  returnsValue: true,
+
+  apply({ el, rx }) {
+    const path = el.getAttribute("data-bind")
+    let edited = el.value !== ""
+    const onInput = () => {
+      edited = true
+    }
+    el.addEventListener("input", onInput)
+
+    const slugify = (text) =>
+      text
+        .normalize("NFKD")
+        .replace(/[\u0300-\u036f]/g, "")
+        .toLowerCase()
+        .replace(/[^a-z0-9]+/g, "-")
+        .replace(/^-+|-+$/g, "")
+
+    const stopEffect = effect(() => {
+      const values = [rx()].flat().filter((value) => value != null)
+      const slug = slugify(values.join(" "))
+      if (!edited && path) {
+        mergePaths([[path, slug]])
+      }
+    })
+
+    return () => {
+      stopEffect()
+      el.removeEventListener("input", onInput)
+    }
+  },
+})
@@ -1,6 +1,9 @@
 package gui
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
 
 type BreadcrumbItem struct {
 	Label string
@@ -35,12 +38,87 @@ func SchemaEntityBreadcrumbs(adminPath, routeName, pluralName, entityDisplay str
 	}
 }
 
-func SchemaPasswordBreadcrumbs(adminPath, routeName, pluralName, entityDisplay string, entityID int) []BreadcrumbItem {
+func SchemaPasswordBreadcrumbs(adminPath, routeName, pluralName, entityDisplay, entityID string) []BreadcrumbItem {
 	listPath := adminPath + routeName + "/"
-	entityPath := fmt.Sprintf("%s%s/%d/", adminPath, routeName, entityID)
+	entityPath := fmt.Sprintf("%s%s/%s/", adminPath, routeName, url.PathEscape(entityID))
 	return []BreadcrumbItem{
 		{Label: pluralName, Href: listPath},
 		{Label: entityDisplay, Href: entityPath},
 		{Label: "Manage Password"},
 	}
 }
+
+func SchemaImportBreadcrumbs(adminPath, routeName, pluralName string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: "Import"},
+	}
+}
+
+func SchemaDeleteBreadcrumbs(adminPath, routeName, pluralName, entityDisplay, entityID string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	entityPath := fmt.Sprintf("%s%s/%s/", adminPath, routeName, url.PathEscape(entityID))
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: entityDisplay, Href: entityPath},
+		{Label: "Delete"},
+	}
+}
+
+func SchemaHistoryBreadcrumbs(adminPath, routeName, pluralName, entityDisplay, entityID string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	entityPath := fmt.Sprintf("%s%s/%s/", adminPath, routeName, url.PathEscape(entityID))
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: entityDisplay, Href: entityPath},
+		{Label: "History"},
+	}
+}
+
+func SchemaRevisionsBreadcrumbs(adminPath, routeName, pluralName, entityDisplay, entityID string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	entityPath := fmt.Sprintf("%s%s/%s/", adminPath, routeName, url.PathEscape(entityID))
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: entityDisplay, Href: entityPath},
+		{Label: "Revisions"},
+	}
+}
+
+// SchemaDeletedRevisionsBreadcrumbs are the breadcrumbs of a deleted entity's
+// revisions, which sit under the schema's deleted list.
+func SchemaDeletedRevisionsBreadcrumbs(adminPath, routeName, pluralName, entityDisplay string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: "Deleted", Href: listPath + "deleted/"},
+		{Label: entityDisplay},
+	}
+}
+
+func SchemaDeletedBreadcrumbs(adminPath, routeName, pluralName string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: "Deleted"},
+	}
+}
+
+func SchemaTrashBreadcrumbs(adminPath, routeName, pluralName string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: "Trash"},
+	}
+}
+
+func SchemaPurgeBreadcrumbs(adminPath, routeName, pluralName, entityDisplay string) []BreadcrumbItem {
+	listPath := adminPath + routeName + "/"
+	return []BreadcrumbItem{
+		{Label: pluralName, Href: listPath},
+		{Label: "Trash", Href: listPath + "trash/"},
+		{Label: entityDisplay},
+		{Label: "Purge"},
+	}
+}
@@ -0,0 +1,101 @@
+package gui
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/troygilman/vent/requestctx"
+)
+
+func entityActionsTestContext() context.Context {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	return requestctx.WithTheme(ctx, "system")
+}
+
+func TestEntityActionExpr(t *testing.T) {
+	url := entityActionURL("/admin/books/7/", "mark read")
+	if url != "/admin/books/7/actions/mark%20read/" {
+		t.Fatalf("url = %q", url)
+	}
+	if got := entityActionExpr(url, EntityAction{Name: "mark read"}); got != `@post("/admin/books/7/actions/mark%20read/")` {
+		t.Fatalf("expr = %q", got)
+	}
+	want := `@confirm("Sure?") && @post("/admin/books/7/actions/mark%20read/")`
+	if got := entityActionExpr(url, EntityAction{Name: "mark read", Confirm: "Sure?"}); got != want {
+		t.Fatalf("expr = %q, want %q", got, want)
+	}
+}
+
+func TestSchemaEntityChangeActionButtons(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityChangeProps{
+		RouteName:     "books",
+		EntityID:      "7",
+		EntityDisplay: "Dune",
+		Actions:       []EntityAction{{Name: "publish", Label: "Mark published"}},
+	}
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`data-on:click__prevent="@post(&#34;/admin/books/7/actions/publish/&#34;)"`,
+		">Mark published</button>",
+		`<div id="toasts" class="toasts" aria-live="polite"></div>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("change page missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestSchemaTableRowActions(t *testing.T) {
+	props := SchemaTableProps{
+		RouteName:         "books",
+		PluralDisplayName: "Books",
+		Columns:           []SchemaTableColumn{{Name: "title", Label: "Title", Type: "string"}},
+		Search:            "dune",
+		Searchable:        true,
+		Rows: []SchemaTableRow{
+			{ID: "1", Cells: []SchemaTableCell{{Display: "Dune"}}, Actions: []EntityAction{{Name: "publish", Label: "Mark published"}}},
+			{ID: "2", Cells: []SchemaTableCell{{Display: "Emma"}}},
+		},
+		Pagination: SchemaTablePagination{Page: 2, PageSize: 1, Total: 2, TotalPages: 2},
+	}
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `@post(&#34;/admin/books/1/actions/publish/?from=list&amp;page=2&amp;q=dune&#34;)`) {
+		t.Fatalf("row menu should post with the list query:\n%s", html)
+	}
+	if got := strings.Count(html, `<td class="table-row-actions-cell">`); got != 2 {
+		t.Fatalf("action cells = %d, want one per row", got)
+	}
+
+	props.Rows[0].Actions = nil
+	buf.Reset()
+	if err := SchemaTablePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), "table-row-actions") {
+		t.Fatal("rows without actions should not get an actions column")
+	}
+}
+
+func TestToast(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Toast(ToastProps{Message: "forbidden", Error: true}).Render(context.Background(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{`class="alert toast alert-error"`, `role="alert"`, "<span>forbidden</span>"} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("toast missing %q:\n%s", want, html)
+		}
+	}
+}
@@ -8,6 +8,7 @@ type RenderContext struct {
 	CanCreate bool
 	CanUpdate bool
 	CanDelete bool
+	CanExport bool
 }
 
 type renderContextKey struct{}
@@ -10,6 +10,7 @@ import templruntime "github.com/a-h/templ/runtime"
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/troygilman/vent/requestctx"
 )
@@ -19,7 +20,26 @@ type SchemaEntityAddProps struct {
 	RouteName           string
 	SingularDisplayName string
 	ErrorMessage        string
-	Fields              []SchemaEntityFieldProps
+	FieldSets           []SchemaEntityFieldSetProps
+	Tabs                bool
+	Multipart           bool
+	// DuplicateOf names the entity the form was pre-filled from, and
+	// NotCopied labels the form fields left out of the copy.
+	DuplicateOf string
+	NotCopied   []string
+}
+
+// duplicateNotice tells the user what a duplicate's add form was filled
+// from and which fields still need values.
+func duplicateNotice(props SchemaEntityAddProps) string {
+	if props.DuplicateOf == "" {
+		return ""
+	}
+	notice := "Copied from " + props.DuplicateOf + "."
+	if len(props.NotCopied) > 0 {
+		notice += " Not copied: " + strings.Join(props.NotCopied, ", ") + "."
+	}
+	return notice
 }
 
 func SchemaEntityAddPage(props SchemaEntityAddProps) templ.Component {
@@ -71,10 +91,13 @@ func SchemaEntityAddPage(props SchemaEntityAddProps) templ.Component {
 				templ_7745c5c3_Err = SchemaEntityForm(SchemaEntityFormProps{
 					TitleText:    fmt.Sprintf("Add %s", props.SingularDisplayName),
 					ErrorMessage: props.ErrorMessage,
-					Fields:       props.Fields,
+					Notice:       duplicateNotice(props),
+					FieldSets:    props.FieldSets,
+					Tabs:         props.Tabs,
 					BackURL:      schemaEntityPath,
+					Multipart:    props.Multipart,
 					ActionButtons: []templ.Component{
-						SchemaEntityAddButton(schemaEntityPath),
+						SchemaEntityAddButton(schemaEntityPath, props.Multipart),
 					},
 				}).Render(ctx, templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
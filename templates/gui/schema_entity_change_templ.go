@@ -10,20 +10,89 @@ import templruntime "github.com/a-h/templ/runtime"
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 
+	"github.com/troygilman/vent"
 	"github.com/troygilman/vent/requestctx"
 )
 
+// EditConflictRegionID is the change form element an edit conflict is
+// patched into.
+const EditConflictRegionID = "edit-conflict"
+
 type SchemaEntityChangeProps struct {
 	LayoutProps   LayoutProps
 	RouteName     string
-	EntityID      int
+	EntityID      string
 	EntityDisplay string
 	ErrorMessage  string
-	Fields        []SchemaEntityFieldProps
+	FieldSets     []SchemaEntityFieldSetProps
+	Tabs          bool
+	Multipart     bool
+	// RelatedLists link to other lists filtered to this entity.
+	RelatedLists []SchemaEntityRelatedList
+	// Actions are the schema actions the user may run on this entity.
+	Actions []EntityAction
+	// History adds a History tab; it is set when the audit log is enabled.
+	History bool
+	// Revisions adds a Revisions tab; it is set when revisions are enabled.
+	Revisions bool
+	// Version is the entity's version field value when the schema has one.
+	Version string
+	// Original is the form's values at Version, from vent.EncodeEditOriginal.
+	Original string
+	// Duplicate offers a Duplicate button that opens the add form pre-filled
+	// from this entity.
+	Duplicate     bool
 	RenderContext RenderContext
 }
 
+// SchemaEntityConflictProps describes a change form save refused because
+// someone else changed the entity after the form loaded.
+type SchemaEntityConflictProps struct {
+	RouteName string
+	EntityID  string
+	Multipart bool
+	// Version is the entity's version now and Original its form values;
+	// overwriting resubmits the form with them.
+	Version  string
+	Original string
+	// Fields are the fields someone else changed and the ones the user
+	// changed on the form.
+	Fields []vent.EditConflictField
+}
+
+// SchemaEntityRelatedList is another schema's list with an edge filter that
+// targets this entity.
+type SchemaEntityRelatedList struct {
+	Label      string
+	RouteName  string
+	FilterName string
+	// AddLabel, when set, adds a link to the schema's add form with the
+	// filter's edge pre-filled to this entity.
+	AddLabel string
+}
+
+func relatedListLinks(adminPath, entityID string, lists []SchemaEntityRelatedList) []SchemaEntityRelatedLink {
+	links := make([]SchemaEntityRelatedLink, 0, len(lists))
+	for _, list := range lists {
+		query := url.Values{"filter." + list.FilterName: {entityID}}
+		links = append(links, SchemaEntityRelatedLink{
+			Label: list.Label,
+			URL:   fmt.Sprintf("%s%s/?%s", adminPath, list.RouteName, query.Encode()),
+		})
+		if list.AddLabel != "" {
+			query := url.Values{list.FilterName: {entityID}}
+			links = append(links, SchemaEntityRelatedLink{
+				Label: list.AddLabel,
+				URL:   fmt.Sprintf("%s%s/add/?%s", adminPath, list.RouteName, query.Encode()),
+			})
+		}
+	}
+	return links
+}
+
 func SchemaEntityChangePage(props SchemaEntityChangeProps) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
@@ -46,14 +115,22 @@ func SchemaEntityChangePage(props SchemaEntityChangeProps) templ.Component {
 		}
 		ctx = templ.ClearChildren(ctx)
 		schemaListPath := fmt.Sprintf("%s%s/", requestctx.MustAdminPath(ctx), props.RouteName)
-		schemaEntityPath := fmt.Sprintf("%s%s/%d/", requestctx.MustAdminPath(ctx), props.RouteName, props.EntityID)
-		actionButtons := make([]templ.Component, 0, 1)
+		schemaEntityPath := fmt.Sprintf("%s%s/%s/", requestctx.MustAdminPath(ctx), props.RouteName, url.PathEscape(props.EntityID))
+		actionButtons := make([]templ.Component, 0, 3+len(props.Actions))
 		trailingButtons := make([]templ.Component, 0, 1)
+		pageTabs := entityTabs(schemaEntityPath, "Change", schemaEntityPath, entityTabRecord, props.History, props.Revisions)
 		if props.RenderContext.CanUpdate {
-			actionButtons = append(actionButtons, SchemaEntitySaveButton(schemaEntityPath))
+			actionButtons = append(actionButtons, SchemaEntitySaveButton(schemaEntityPath, props.Multipart))
+		}
+		actionButtons = append(actionButtons, SchemaEntityViewButton(schemaEntityPath))
+		if props.Duplicate {
+			actionButtons = append(actionButtons, SchemaEntityDuplicateButton(schemaEntityPath))
+		}
+		for _, action := range props.Actions {
+			actionButtons = append(actionButtons, SchemaEntityActionButton(entityActionURL(schemaEntityPath, action.Name), action))
 		}
 		if props.RenderContext.CanDelete {
-			trailingButtons = append(trailingButtons, SchemaEntityDeleteButton(schemaEntityPath, props.EntityDisplay))
+			trailingButtons = append(trailingButtons, SchemaEntityDeleteButton(schemaEntityPath))
 		}
 		templ_7745c5c3_Var2 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
@@ -82,10 +159,16 @@ func SchemaEntityChangePage(props SchemaEntityChangeProps) templ.Component {
 				templ_7745c5c3_Err = SchemaEntityForm(SchemaEntityFormProps{
 					TitleText:       fmt.Sprintf("Change %s", props.EntityDisplay),
 					ErrorMessage:    props.ErrorMessage,
-					Fields:          props.Fields,
+					FieldSets:       props.FieldSets,
+					Tabs:            props.Tabs,
 					BackURL:         schemaListPath,
 					ActionButtons:   actionButtons,
 					TrailingButtons: trailingButtons,
+					Multipart:       props.Multipart,
+					RelatedLinks:    relatedListLinks(requestctx.MustAdminPath(ctx), props.EntityID, props.RelatedLists),
+					PageTabs:        pageTabs,
+					Version:         props.Version,
+					Original:        props.Original,
 				}).Render(ctx, templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
@@ -114,4 +197,172 @@ func SchemaEntityChangePage(props SchemaEntityChangeProps) templ.Component {
 	})
 }
 
+// editVersionSignals holds the version a change form was loaded at and its
+// values then. It only fills missing signals, so re-rendering the form after
+// a failed save keeps the version the user started editing from.
+func editVersionSignals(version, original string) string {
+	return fmt.Sprintf("{entityVersion: %s, entityOriginal: %s}", strconv.Quote(version), strconv.Quote(original))
+}
+
+// editConflictOverwriteExpr resubmits the change form at version, saving it
+// over the other edit.
+func editConflictOverwriteExpr(path string, version, original string, multipart bool) string {
+	return fmt.Sprintf("$entityVersion = %s; $entityOriginal = %s; %s", strconv.Quote(version), strconv.Quote(original), entityFormAction("patch", path, multipart))
+}
+
+// SchemaEntityConflict replaces the change form's conflict region when a save
+// loses to a concurrent edit, leaving the user's unsaved values in place.
+func SchemaEntityConflict(props SchemaEntityConflictProps) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var4 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var4 == nil {
+			templ_7745c5c3_Var4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		schemaEntityPath := fmt.Sprintf("%s%s/%s/", requestctx.MustAdminPath(ctx), props.RouteName, url.PathEscape(props.EntityID))
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "<div id=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var5 string
+		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.ResolveAttributeValue(EditConflictRegionID)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `templates/gui/schema_entity_change.templ`, Line: 145, Col: 31}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var5)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "\" class=\"alert alert-error edit-conflict\" role=\"alert\"><p>Someone else saved changes to this entity after you opened it. Overwrite saves your values over theirs; reload discards your changes.</p>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if len(props.Fields) == 0 {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "<p>Neither of you changed any of the form's fields.</p>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		} else {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "<table class=\"audit-changes\"><thead><tr><th scope=\"col\">Field</th><th scope=\"col\">When you opened it</th><th scope=\"col\">Saved since</th><th scope=\"col\">Your edit</th></tr></thead> <tbody>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			for _, field := range props.Fields {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "<tr><th scope=\"row\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var6 string
+				templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(field.Field)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `templates/gui/schema_entity_change.templ`, Line: 162, Col: 36}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "</th><td>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = auditValue(field.Loaded).Render(ctx, templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "</td><td>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				if field.Changed {
+					templ_7745c5c3_Err = auditValue(field.Saved).Render(ctx, templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				} else {
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<span class=\"detail-empty\">unchanged</span>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "</td>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				if field.Pending {
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<td class=\"edit-conflict-pending\">")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Err = auditValue(field.Submitted).Render(ctx, templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "</td>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				} else {
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<td><span class=\"detail-empty\">unchanged</span></td>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "</tr>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</tbody></table>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<div class=\"btn-group\"><button class=\"btn btn-error\" type=\"button\" data-on:click__prevent=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var7 string
+		templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.ResolveAttributeValue(editConflictOverwriteExpr(schemaEntityPath, props.Version, props.Original, props.Multipart))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `templates/gui/schema_entity_change.templ`, Line: 189, Col: 120}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var7)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "\" data-indicator=\"_indicator\">Overwrite</button> <a class=\"btn btn-neutral\" href=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var8 templ.SafeURL
+		templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinURLErrs(templ.SafeURL(schemaEntityPath))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `templates/gui/schema_entity_change.templ`, Line: 194, Col: 68}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "\">Reload</a></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
 var _ = templruntime.GeneratedTemplate
@@ -0,0 +1,89 @@
+package gui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/troygilman/vent"
+)
+
+func TestSchemaEntityChangeCarriesVersion(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityChangeProps{RouteName: "books", EntityID: "7", EntityDisplay: "Dune", Version: "3", Original: `{"title":"Dune"}`}
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `<div id="edit-conflict" data-signals__ifmissing="{entityVersion: &#34;3&#34;, entityOriginal: &#34;{\&#34;title\&#34;:\&#34;Dune\&#34;}&#34;}"></div>`) {
+		t.Fatalf("change page should carry its version and loaded values:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	props.Version = ""
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), EditConflictRegionID) {
+		t.Fatalf("change page without a version should have no conflict region:\n%s", buf.String())
+	}
+}
+
+func TestSchemaEntityConflict(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityConflictProps{
+		RouteName: "books",
+		EntityID:  "7",
+		Version:   "4",
+		Original:  `{"title":"Dune Messiah"}`,
+		Fields: []vent.EditConflictField{
+			{Field: "title", Loaded: "Dune", Saved: "Dune Messiah", Submitted: "Dune", Changed: true},
+			{Field: "pages", Loaded: "412", Saved: "412", Submitted: "500", Pending: true},
+		},
+	}
+	if err := SchemaEntityConflict(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`<div id="edit-conflict" class="alert alert-error edit-conflict" role="alert">`,
+		`<th scope="row">title</th><td>Dune</td><td>Dune Messiah</td><td><span class="detail-empty">unchanged</span></td>`,
+		`<th scope="row">pages</th><td>412</td><td><span class="detail-empty">unchanged</span></td><td class="edit-conflict-pending">500</td>`,
+		`data-on:click__prevent="$entityVersion = &#34;4&#34;; $entityOriginal = &#34;{\&#34;title\&#34;:\&#34;Dune Messiah\&#34;}&#34;; @patch(&#39;/admin/books/7/&#39;)"`,
+		`<a class="btn btn-neutral" href="/admin/books/7/">Reload</a>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("conflict missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestSchemaEntityChangeDuplicateButton(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityChangeProps{RouteName: "books", EntityID: "7", EntityDisplay: "Dune"}
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), "duplicate/") {
+		t.Fatalf("change page should not offer Duplicate without create permission:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	props.Duplicate = true
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `<a class="btn btn-outline" href="/admin/books/7/duplicate/">Duplicate</a>`) {
+		t.Fatalf("change page should link to the duplicate form:\n%s", buf.String())
+	}
+}
+
+func TestSchemaEntityAddDuplicateNotice(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityAddProps{RouteName: "books", SingularDisplayName: "Book", DuplicateOf: "Dune", NotCopied: []string{"Slug", "Cover"}}
+	if err := SchemaEntityAddPage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `<span>Copied from Dune. Not copied: Slug, Cover.</span>`) {
+		t.Fatalf("add page should say what the duplicate was copied from:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,76 @@
+package gui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/troygilman/vent"
+)
+
+func TestSchemaEntityDeletePage(t *testing.T) {
+	props := SchemaEntityDeleteProps{
+		RouteName:     "authors",
+		EntityID:      "7",
+		EntityDisplay: "Frank",
+		Relations: []DeleteRelation{
+			{Label: "Drafts", Effect: vent.DeleteCascade, Count: 12, Items: []SchemaEntityRelatedLink{{Label: "Dune", URL: "/admin/books/1/"}}},
+			{Label: "Tags", Effect: vent.DeleteUnlink, Count: 1, Items: []SchemaEntityRelatedLink{{Label: "scifi"}}},
+		},
+	}
+	render := func() string {
+		var buf bytes.Buffer
+		if err := SchemaEntityDeletePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return buf.String()
+	}
+
+	html := render()
+	for _, want := range []string{
+		`Are you sure you want to delete Frank?`,
+		`<h2 class="entity-form-section-title">Also deleted</h2>`,
+		`<h3 class="entity-form-section-title">Drafts (12)</h3>`,
+		`<a class="link" href="/admin/books/1/">Dune</a>`,
+		`and 11 more`,
+		`<h2 class="entity-form-section-title">Kept, but no longer linked</h2>`,
+		`<li>scifi</li>`,
+		`@delete(&#39;/admin/authors/7/&#39;)`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("delete page missing %q:\n%s", want, html)
+		}
+	}
+
+	props.Relations[0].Forbidden = true
+	html = render()
+	if !strings.Contains(html, "which you do not have permission to delete") || strings.Contains(html, "@delete(") {
+		t.Fatalf("delete page should refuse a forbidden cascade:\n%s", html)
+	}
+
+	props.Relations = []DeleteRelation{{Label: "Books", Effect: vent.DeleteBlock, Count: 1}}
+	html = render()
+	if !strings.Contains(html, "Frank cannot be deleted while these related objects refer to it.") || strings.Contains(html, "@delete(") {
+		t.Fatalf("delete page should refuse a blocked delete:\n%s", html)
+	}
+
+	props.Relations, props.Trash = nil, true
+	if html = render(); !strings.Contains(html, "Frank will be moved to the trash") {
+		t.Fatalf("soft delete page missing trash notice:\n%s", html)
+	}
+
+	props.Trash, props.Purge = false, true
+	props.Relations = []DeleteRelation{{Label: "Tags", Effect: vent.DeleteUnlink, Count: 1, Items: []SchemaEntityRelatedLink{{Label: "scifi"}}}}
+	html = render()
+	for _, want := range []string{
+		`<h1 class="page-title">Purge Frank</h1>`,
+		`Are you sure you want to permanently delete Frank from the trash?`,
+		`<h2 class="entity-form-section-title">Kept, but no longer linked</h2>`,
+		`@post(&#39;/admin/authors/7/purge/&#39;)`,
+		`<a class="btn btn-neutral" href="/admin/authors/trash/">Cancel</a>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("purge page missing %q:\n%s", want, html)
+		}
+	}
+}
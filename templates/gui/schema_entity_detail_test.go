@@ -0,0 +1,98 @@
+package gui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/troygilman/vent"
+)
+
+func renderDetailField(t *testing.T, props SchemaEntityDetailFieldProps) string {
+	t.Helper()
+	html, err := RenderDetailFieldHTML(entityActionsTestContext(), props)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	return html
+}
+
+func TestSchemaEntityDetailFieldFormatsByKind(t *testing.T) {
+	tests := []struct {
+		name  string
+		props SchemaEntityDetailFieldProps
+		want  string
+	}{
+		{"empty", SchemaEntityDetailFieldProps{Label: "Title"}, `<span class="detail-empty">—</span>`},
+		{"bool", SchemaEntityDetailFieldProps{Label: "Active", Kind: vent.FieldKindBool, Value: "true"}, `<dd class="detail-value">Yes</dd>`},
+		{"time", SchemaEntityDetailFieldProps{Label: "At", Kind: vent.FieldKindTime, Value: "2024-03-05T09:30"}, `<time datetime="2024-03-05T09:30">2024-03-05 09:30</time>`},
+		{"date", SchemaEntityDetailFieldProps{Label: "On", Kind: vent.FieldKindTime, Value: "2024-03-05T00:00", DateOnly: true}, `>2024-03-05</time>`},
+		{"enum", SchemaEntityDetailFieldProps{Label: "Status", Kind: vent.FieldKindEnum, Value: "draft"}, `<span class="badge">draft</span>`},
+		{"tags", SchemaEntityDetailFieldProps{Label: "Tags", Kind: vent.FieldKindStrings, Value: "a, b"}, `<span class="badge">a</span><span class="badge">b</span>`},
+		{"json", SchemaEntityDetailFieldProps{Label: "Meta", Kind: vent.FieldKindJSON, Value: `{"a":1}`}, "<pre class=\"detail-json\">{\n  &#34;a&#34;: 1\n}</pre>"},
+		{"links", SchemaEntityDetailFieldProps{Label: "Author", Links: []SchemaEntityRelatedLink{{Label: "Ann", URL: "/admin/authors/1/"}, {Label: "Bo"}}}, `<span class="detail-link"><a class="link" href="/admin/authors/1/">Ann</a></span><span class="detail-link">Bo</span>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := renderDetailField(t, tt.props)
+			if !strings.Contains(html, tt.want) {
+				t.Fatalf("missing %q:\n%s", tt.want, html)
+			}
+		})
+	}
+}
+
+func TestSchemaEntityDetailPage(t *testing.T) {
+	field := renderDetailField(t, SchemaEntityDetailFieldProps{Label: "Title", Value: "Dune"})
+	props := SchemaEntityDetailProps{
+		RouteName:     "authors",
+		EntityID:      "7",
+		EntityDisplay: "Frank",
+		FieldSets:     []SchemaEntityFieldSetProps{{Fields: []SchemaEntityFieldProps{{HTML: field}}}},
+		Relations: []SchemaEntityDetailRelation{
+			{Label: "Books", Items: []SchemaEntityRelatedLink{{Label: "Dune", URL: "/admin/books/1/"}}, More: true, AllURL: "/admin/books/?filter.author=7"},
+			{Label: "Reviews"},
+		},
+		Actions: []EntityAction{{Name: "publish", Label: "Publish"}},
+	}
+	render := func() string {
+		var buf bytes.Buffer
+		if err := SchemaEntityDetailPage(props).Render(entityActionsTestContext(), &buf); err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return buf.String()
+	}
+
+	html := render()
+	for _, want := range []string{
+		`<dt class="detail-label">Title</dt>`,
+		`<a class="link" href="/admin/books/1/">Dune</a>`,
+		`href="/admin/books/?filter.author=7">View all</a>`,
+		`<p class="detail-empty">None</p>`,
+		`@post(&#34;/admin/authors/7/actions/publish/?from=detail&#34;)`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("detail page missing %q:\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, ">Edit</a>") || strings.Contains(html, ">Delete<") {
+		t.Fatalf("detail page should hide edit and delete without permission:\n%s", html)
+	}
+
+	props.RenderContext = RenderContext{CanUpdate: true, CanDelete: true}
+	html = render()
+	if !strings.Contains(html, `<a class="btn btn-primary" href="/admin/authors/7/">Edit</a>`) {
+		t.Fatalf("detail page should link to the change form:\n%s", html)
+	}
+}
+
+func TestSchemaEntityChangeViewButton(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityChangeProps{RouteName: "books", EntityID: "7", EntityDisplay: "Dune"}
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `<a class="btn btn-outline" href="/admin/books/7/view/">View</a>`) {
+		t.Fatalf("change page should link to the detail page:\n%s", buf.String())
+	}
+}
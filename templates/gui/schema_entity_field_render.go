@@ -38,10 +38,30 @@ func RenderTimeFieldHTML(ctx context.Context, props SchemaEntityTimeFieldProps)
 	return renderComponentHTML(ctx, SchemaEntityTimeField(props))
 }
 
+func RenderEnumFieldHTML(ctx context.Context, props SchemaEntityEnumFieldProps) (string, error) {
+	return renderComponentHTML(ctx, SchemaEntityEnumField(props))
+}
+
+func RenderJSONFieldHTML(ctx context.Context, props SchemaEntityJSONFieldProps) (string, error) {
+	return renderComponentHTML(ctx, SchemaEntityJSONField(props))
+}
+
+func RenderTagsFieldHTML(ctx context.Context, props SchemaEntityTagsFieldProps) (string, error) {
+	return renderComponentHTML(ctx, SchemaEntityTagsField(props))
+}
+
+func RenderFileFieldHTML(ctx context.Context, props SchemaEntityFileFieldProps) (string, error) {
+	return renderComponentHTML(ctx, SchemaEntityFileField(props))
+}
+
 func RenderForeignKeyUniqueFieldHTML(ctx context.Context, props SchemaEntityForeignKeyUniqueFieldProps) (string, error) {
 	return renderComponentHTML(ctx, SchemaEntityForeignKeyUniqueField(props))
 }
 
+func RenderDetailFieldHTML(ctx context.Context, props SchemaEntityDetailFieldProps) (string, error) {
+	return renderComponentHTML(ctx, SchemaEntityDetailField(props))
+}
+
 func RenderForeignKeyFieldHTML(ctx context.Context, props SchemaEntityForeignKeyFieldProps) (string, error) {
 	return renderComponentHTML(ctx, SchemaEntityForeignKeyField(props))
 }
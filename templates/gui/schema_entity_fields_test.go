@@ -0,0 +1,101 @@
+package gui
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSchemaEntityTextFieldWidgets(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		props SchemaEntityTextFieldProps
+		want  []string
+	}{
+		{
+			name:  "text with placeholder and help",
+			props: SchemaEntityTextFieldProps{Name: "title", Label: "Headline", Editable: true, Placeholder: "Untitled", Desc: "Shown publicly."},
+			want:  []string{`type="text"`, `placeholder="Untitled"`, `<span class="field-label">Headline</span>`, `<p class="field-desc">Shown publicly.</p>`},
+		},
+		{
+			name:  "email",
+			props: SchemaEntityTextFieldProps{Name: "email", Label: "Email", Editable: true, Widget: "email"},
+			want:  []string{`type="email"`},
+		},
+		{
+			name:  "textarea",
+			props: SchemaEntityTextFieldProps{Name: "body", Label: "Body", Value: "Great read", Editable: true, Widget: "textarea"},
+			want:  []string{`<textarea rows="4" data-bind="entity.body"`, `>Great read</textarea>`},
+		},
+		{
+			name:  "prepopulated",
+			props: SchemaEntityTextFieldProps{Name: "slug", Label: "Slug", Editable: true, Prepopulate: []string{"title", "edition"}},
+			want:  []string{`data-bind="entity.slug"`, `data-prepopulate="[$entity.title, $entity.edition]"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := RenderTextFieldHTML(ctx, tt.props)
+			if err != nil {
+				t.Fatalf("RenderTextFieldHTML() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(html, want) {
+					t.Fatalf("text field missing %q:\n%s", want, html)
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaEntityTagsFieldKeepsCommasInValues(t *testing.T) {
+	html, err := RenderTagsFieldHTML(context.Background(), SchemaEntityTagsFieldProps{
+		Name:     "authors",
+		Label:    "Authors",
+		Value:    `["Smith, John","Le Guin"]`,
+		Editable: true,
+	})
+	if err != nil {
+		t.Fatalf("RenderTagsFieldHTML() error = %v", err)
+	}
+	for _, want := range []string{
+		`<span class="badge">Smith, John</span>`,
+		`<input type="hidden" data-bind="entity.authors" value="[&#34;Smith, John&#34;,&#34;Le Guin&#34;]">`,
+		`data-on:keydown="tagInput.keydown(evt, el)"`,
+		`<p id="field-error-authors" class="field-error" hidden></p>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("tags field missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestSchemaEntityFieldError(t *testing.T) {
+	var buf strings.Builder
+	if err := SchemaEntityFieldError("metadata", "invalid metadata: unexpected end of JSON input").Render(context.Background(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	want := `<p id="field-error-metadata" class="field-error">invalid metadata: unexpected end of JSON input</p>`
+	if buf.String() != want {
+		t.Fatalf("field error = %s, want %s", buf.String(), want)
+	}
+}
+
+func TestSchemaEntityTimeFieldDateOnly(t *testing.T) {
+	html, err := RenderTimeFieldHTML(context.Background(), SchemaEntityTimeFieldProps{
+		Name:     "published_at",
+		Label:    "Publication date",
+		Value:    "2024-03-01T09:30",
+		Editable: true,
+		DateOnly: true,
+	})
+	if err != nil {
+		t.Fatalf("RenderTimeFieldHTML() error = %v", err)
+	}
+	for _, want := range []string{`type="date"`, `value="2024-03-01"`} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("date field missing %q:\n%s", want, html)
+		}
+	}
+}
@@ -1,5 +1,107 @@
 package gui
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/troygilman/vent"
+	"github.com/troygilman/vent/requestctx"
+)
+
 func entitySignal(name string) string {
 	return "entity." + name
 }
+
+// tagValues splits a tag editor value into the chips shown before JS runs.
+func tagValues(value string) []string {
+	return vent.ParseStringList(value)
+}
+
+// fieldErrorID is the element ID of a form field's inline error.
+func fieldErrorID(name string) string {
+	return "field-error-" + name
+}
+
+// fileURL is the admin download URL for a stored file key.
+func fileURL(ctx context.Context, key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return requestctx.MustAdminPath(ctx) + "files/" + strings.Join(segments, "/")
+}
+
+// entityFormAction is the Datastar action that submits an entity form. Multipart
+// forms post the form element so file inputs travel with the signals.
+func entityFormAction(method, path string, multipart bool) string {
+	if multipart {
+		return fmt.Sprintf("@%s('%s', {contentType: 'form'})", method, path)
+	}
+	return fmt.Sprintf("@%s('%s')", method, path)
+}
+
+// prepopulateExpression is the data-prepopulate expression listing the signals
+// of the fields a text input is filled from.
+func prepopulateExpression(names []string) string {
+	signals := make([]string, len(names))
+	for i, name := range names {
+		signals[i] = "$" + entitySignal(name)
+	}
+	return "[" + strings.Join(signals, ", ") + "]"
+}
+
+// SelectOptionValues marks the options whose values are in values as
+// selected, e.g. to pre-fill an edge select.
+func SelectOptionValues(options []SelectOption, values []string) {
+	for i := range options {
+		options[i].Selected = slices.Contains(values, options[i].Value)
+	}
+}
+
+// textInputType maps a text field widget to its input type.
+func textInputType(widget string) string {
+	switch widget {
+	case "email", "url", "color":
+		return widget
+	default:
+		return "text"
+	}
+}
+
+// timeInputValue trims a datetime-local value to its date for date-only inputs.
+func timeInputValue(value string, dateOnly bool) string {
+	if dateOnly && len(value) > len("2006-01-02") {
+		return value[:len("2006-01-02")]
+	}
+	return value
+}
+
+func tagsPlaceholder(placeholder string) string {
+	if placeholder != "" {
+		return placeholder
+	}
+	return "Type a value and press Enter"
+}
+
+// visibleFieldSets drops field sets with nothing to render (e.g. an "id"-only
+// set on the add form).
+func visibleFieldSets(fieldSets []SchemaEntityFieldSetProps) []SchemaEntityFieldSetProps {
+	visible := make([]SchemaEntityFieldSetProps, 0, len(fieldSets))
+	for _, fieldSet := range fieldSets {
+		if len(fieldSet.Fields) > 0 {
+			visible = append(visible, fieldSet)
+		}
+	}
+	return visible
+}
+
+// fieldSetTitle labels a tab or disclosure, falling back to its position.
+func fieldSetTitle(fieldSet SchemaEntityFieldSetProps, index int) string {
+	if fieldSet.Label != "" {
+		return fieldSet.Label
+	}
+	return fmt.Sprintf("Section %d", index+1)
+}
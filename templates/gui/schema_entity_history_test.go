@@ -0,0 +1,74 @@
+package gui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/troygilman/vent"
+)
+
+func TestSchemaEntityHistoryPage(t *testing.T) {
+	props := SchemaEntityHistoryProps{
+		RouteName:     "books",
+		EntityID:      "7",
+		EntityDisplay: "Dune",
+		Entries: []AuditEntry{
+			{
+				At:      time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC),
+				Actor:   "ann@example.com",
+				Action:  vent.AuditActionUpdate,
+				Changes: []vent.AuditChange{{Field: "title", Before: "Dun", After: "Dune"}},
+			},
+			{Action: vent.AuditActionCreate},
+		},
+		Truncated: true,
+	}
+	render := func() string {
+		var buf bytes.Buffer
+		if err := SchemaEntityHistoryPage(props).Render(entityActionsTestContext(), &buf); err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return buf.String()
+	}
+
+	html := render()
+	for _, want := range []string{
+		`<h1 class="page-title">History of Dune</h1>`,
+		`<a class="entity-form-tab" href="/admin/books/7/">Details</a>`,
+		`<a class="entity-form-tab is-active" href="/admin/books/7/history/" aria-current="page">History</a>`,
+		`<h2 class="entity-form-section-title">Changed</h2>`,
+		`ann@example.com`,
+		`<th scope="row">title</th><td class="audit-before">Dun</td><td class="audit-after">Dune</td>`,
+		`Unknown user`,
+		`<p class="detail-empty">No fields changed.</p>`,
+		`Showing the latest 2 changes.`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("history page missing %q:\n%s", want, html)
+		}
+	}
+
+	props.RenderContext = RenderContext{CanUpdate: true}
+	props.Entries = nil
+	props.Truncated = false
+	html = render()
+	if !strings.Contains(html, `href="/admin/books/7/">Change</a>`) {
+		t.Fatalf("history page should link back to the change form:\n%s", html)
+	}
+	if !strings.Contains(html, "No changes have been recorded.") {
+		t.Fatalf("history page should say when there are no entries:\n%s", html)
+	}
+}
+
+func TestSchemaEntityChangeHistoryTab(t *testing.T) {
+	var buf bytes.Buffer
+	props := SchemaEntityChangeProps{RouteName: "books", EntityID: "7", EntityDisplay: "Dune", History: true}
+	if err := SchemaEntityChangePage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `<a class="entity-form-tab" href="/admin/books/7/history/">History</a>`) {
+		t.Fatalf("change page should link to the history page:\n%s", buf.String())
+	}
+}
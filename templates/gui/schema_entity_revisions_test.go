@@ -0,0 +1,95 @@
+package gui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/troygilman/vent"
+)
+
+func TestSchemaEntityRevisionsPage(t *testing.T) {
+	props := SchemaEntityRevisionsProps{
+		RouteName:     "books",
+		EntityID:      "7",
+		EntityDisplay: "Dune",
+		Revisions: []RevisionEntry{
+			{ID: "3", At: time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC), Actor: "ann@example.com", Action: vent.AuditActionUpdate},
+		},
+		From:          "3",
+		To:            CurrentRevision,
+		Changes:       []vent.AuditChange{{Field: "title", Before: "Dun", After: "Dune"}},
+		CanRestore:    true,
+		History:       true,
+		RenderContext: RenderContext{CanUpdate: true},
+	}
+	render := func() string {
+		var buf bytes.Buffer
+		if err := SchemaEntityRevisionsPage(props).Render(entityActionsTestContext(), &buf); err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return buf.String()
+	}
+
+	html := render()
+	for _, want := range []string{
+		`<h1 class="page-title">Revisions of Dune</h1>`,
+		`href="/admin/books/7/">Change</a>`,
+		`href="/admin/books/7/history/">History</a>`,
+		`<a class="entity-form-tab is-active" href="/admin/books/7/revisions/" aria-current="page">Revisions</a>`,
+		`<input type="radio" name="from" value="3" aria-label="Compare from this version" checked>`,
+		`<input type="radio" name="to" value="current" aria-label="Compare to the current version" checked>`,
+		`Changed by ann@example.com`,
+		`@post(&#34;/admin/books/7/revisions/3/restore/&#34;)`,
+		`Changes from the version of 2024-03-05 09:30 to the current version`,
+		`<th scope="row">title</th><td class="audit-before">Dun</td><td class="audit-after">Dune</td>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("revisions page missing %q:\n%s", want, html)
+		}
+	}
+
+	props.Deleted = true
+	props.CanRestore = false
+	props.From, props.To, props.Changes = "", "", nil
+	html = render()
+	if strings.Contains(html, "entity-form-tab") || strings.Contains(html, "Current version") {
+		t.Fatalf("deleted entity should have no tabs or current version:\n%s", html)
+	}
+	if strings.Contains(html, "/restore/") {
+		t.Fatalf("revisions page should hide restore without permission:\n%s", html)
+	}
+	if !strings.Contains(html, "Restoring a revision recreates it with a new ID.") {
+		t.Fatalf("revisions page should explain deleted entities:\n%s", html)
+	}
+
+	props.RestoredURL = "/admin/books/9/"
+	if html = render(); !strings.Contains(html, `<a class="link" href="/admin/books/9/">View the restored entity</a>`) {
+		t.Fatalf("revisions page should link to the restored entity:\n%s", html)
+	}
+}
+
+func TestSchemaDeletedPage(t *testing.T) {
+	props := SchemaDeletedProps{
+		RouteName:         "books",
+		PluralDisplayName: "Books",
+		Entries: []DeletedEntry{
+			{EntityID: "7", EntityDisplay: "Dune", At: time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC), Actor: "ann@example.com"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := SchemaDeletedPage(props).Render(entityActionsTestContext(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`<h1 class="page-title">Deleted Books</h1>`,
+		`<a class="link" href="/admin/books/7/revisions/">Dune</a>`,
+		`ann@example.com`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("deleted page missing %q:\n%s", want, html)
+		}
+	}
+}
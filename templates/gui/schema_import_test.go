@@ -0,0 +1,72 @@
+package gui
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/troygilman/vent"
+	"github.com/troygilman/vent/requestctx"
+)
+
+func renderImportResult(t *testing.T, result vent.ImportResult) string {
+	t.Helper()
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	var buf bytes.Buffer
+	props := SchemaImportResultProps{RouteName: "books", PluralDisplayName: "Books", Result: &result}
+	if err := SchemaImportResult(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	return buf.String()
+}
+
+func TestSchemaImportResultPreviewShowsRowErrors(t *testing.T) {
+	html := renderImportResult(t, vent.ImportResult{
+		DryRun:  true,
+		Columns: []string{"Title"},
+		Ignored: []string{"Notes"},
+		Rows: []vent.ImportRowResult{
+			{Line: 2, Values: []string{"Dune"}, Action: vent.ImportActionUpdate},
+			{Line: 3, Values: []string{""}, Action: vent.ImportActionCreate, Error: "title is required"},
+		},
+		Total:   2,
+		Updated: 1,
+		Failed:  1,
+	})
+	for _, want := range []string{
+		`class="alert alert-error"`,
+		"Preview: 1 of 2 rows have errors.",
+		"Ignored columns: Notes",
+		"<th>Title</th>",
+		`<span class="badge">Will update</span>`,
+		`<tr class="is-error"><td>3</td><td><span class="text-error">title is required</span>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("preview missing %q:\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, "View Books") {
+		t.Fatal("uncommitted imports should not link to the list")
+	}
+}
+
+func TestSchemaImportResultCommitted(t *testing.T) {
+	html := renderImportResult(t, vent.ImportResult{
+		Committed: true,
+		Columns:   []string{"Title"},
+		Rows:      []vent.ImportRowResult{{Line: 2, Values: []string{"Dune"}, Action: vent.ImportActionCreate}},
+		Total:     1,
+		Created:   1,
+	})
+	for _, want := range []string{
+		`class="alert alert-success"`,
+		"Imported 1 rows: 1 created, 0 updated.",
+		`<a class="link" href="/admin/books/">View Books</a>`,
+		`<span class="badge">Created</span>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("result missing %q:\n%s", want, html)
+		}
+	}
+}
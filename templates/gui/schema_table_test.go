@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/a-h/templ"
 	"github.com/troygilman/vent"
 	"github.com/troygilman/vent/requestctx"
 )
@@ -76,13 +77,47 @@ func TestTableFilterChipValue(t *testing.T) {
 	}
 }
 
+func TestTableFilterRangeAndNull(t *testing.T) {
+	column := SchemaTableFilterableColumn{
+		Name: "pages", Label: "Pages", Type: "int",
+		Range: true, Min: "100", Max: "300",
+		Nullable: true, Null: vent.BoolFilterFalse.String(),
+	}
+	if !tableFilterActive(column) {
+		t.Fatal("range and null bounds should be active")
+	}
+	if got := tableFilterChipValue(column); got != "100 – 300 · not empty" {
+		t.Fatalf("chip = %q, want range and null", got)
+	}
+	if got := tableFilterChipValue(SchemaTableFilterableColumn{Type: "int", Max: "10"}); got != "≤ 10" {
+		t.Fatalf("max-only chip = %q", got)
+	}
+	if got := tableFilterChipValue(SchemaTableFilterableColumn{Type: "enum", Value: "ebook,hardcover"}); got != "ebook, hardcover" {
+		t.Fatalf("enum In chip = %q", got)
+	}
+	if got := tableFilterChipValue(SchemaTableFilterableColumn{Type: "time", Value: string(vent.TimeFilterLast7Days)}); got != "Last 7 days" {
+		t.Fatalf("preset chip = %q", got)
+	}
+
+	state := tableListState{Filters: []SchemaTableFilterableColumn{column}}
+	got := tableListURL("/admin/books/", state, 1)
+	for _, want := range []string{"filter.pages.min=100", "filter.pages.max=300", "filter.pages.null=false"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("url = %q, want %s", got, want)
+		}
+	}
+	if cleared := tableListURLWithoutFilter("/admin/books/", state, "pages"); cleared != "/admin/books/" {
+		t.Fatalf("removing the chip = %q, want every bound cleared", cleared)
+	}
+}
+
 func TestTableListURL(t *testing.T) {
 	columns := []SchemaTableFilterableColumn{
 		{Name: "email", Type: "string", Value: "admin"},
 		{Name: "is_staff", Type: "bool", Value: vent.BoolFilterFalse.String()},
 		{Name: "is_active", Type: "bool", Value: ""},
 	}
-	got := tableListURL("/admin/users/", columns, 1)
+	got := tableListURL("/admin/users/", tableListState{Filters: columns}, 1)
 	if !strings.Contains(got, "/admin/users/?") {
 		t.Fatalf("page 1 url = %q, want path with query", got)
 	}
@@ -96,12 +131,12 @@ func TestTableListURL(t *testing.T) {
 		t.Fatalf("page 1 url = %q, must omit empty filters", got)
 	}
 
-	paged := tableListURL("/admin/users/", columns, 2)
+	paged := tableListURL("/admin/users/", tableListState{Filters: columns}, 2)
 	if !strings.Contains(paged, "page=2") {
 		t.Fatalf("page 2 url = %q, want page=2", paged)
 	}
 
-	plain := tableListURL("/admin/users/", nil, 1)
+	plain := tableListURL("/admin/users/", tableListState{}, 1)
 	if plain != "/admin/users/" {
 		t.Fatalf("empty url = %q, want path only", plain)
 	}
@@ -112,7 +147,7 @@ func TestTableListURLWithoutFilter(t *testing.T) {
 		{Name: "email", Type: "string", Value: "admin"},
 		{Name: "is_staff", Type: "bool", Value: vent.BoolFilterFalse.String()},
 	}
-	got := tableListURLWithoutFilter("/admin/users/", columns, "is_staff")
+	got := tableListURLWithoutFilter("/admin/users/", tableListState{Filters: columns}, "is_staff")
 	if !strings.Contains(got, "filter.email=admin") {
 		t.Fatalf("url = %q, want remaining filter", got)
 	}
@@ -121,6 +156,127 @@ func TestTableListURLWithoutFilter(t *testing.T) {
 	}
 }
 
+func TestTableListURLCarriesSort(t *testing.T) {
+	sort := []vent.ListSort{{Name: "title"}, {Name: "pages", Desc: true}}
+	got := tableListURL("/admin/books/", tableListState{Sort: sort}, 2)
+	if !strings.Contains(got, "sort=title%2Cpages") || !strings.Contains(got, "dir=asc%2Cdesc") {
+		t.Fatalf("url = %q, want sort and dir params", got)
+	}
+	if tableExplicitSort(sort, sort) != nil {
+		t.Fatal("default ordering should be omitted from URLs")
+	}
+}
+
+func TestTableListURLCarriesSearch(t *testing.T) {
+	state := tableListState{
+		Filters: []SchemaTableFilterableColumn{{Name: "is_staff", Type: "bool", Value: vent.BoolFilterTrue.String()}},
+		Search:  "ada@example.com",
+	}
+	got := tableListURL("/admin/users/", state, 2)
+	if !strings.Contains(got, "q=ada%40example.com") || !strings.Contains(got, "filter.is_staff=true") {
+		t.Fatalf("url = %q, want search and filter", got)
+	}
+	cleared := tableListURLWithoutSearch("/admin/users/", state)
+	if strings.Contains(cleared, "q=") || strings.Contains(cleared, "page=") || !strings.Contains(cleared, "filter.is_staff=true") {
+		t.Fatalf("url = %q, want filters without search or page", cleared)
+	}
+}
+
+func TestTableSortIndicator(t *testing.T) {
+	single := []vent.ListSort{{Name: "title", Desc: true}}
+	if got := tableSortIndicator(single, "title"); got != "▼" {
+		t.Fatalf("single indicator = %q, want ▼", got)
+	}
+	multi := []vent.ListSort{{Name: "title"}, {Name: "pages", Desc: true}}
+	if got := tableSortIndicator(multi, "pages"); got != "▼2" {
+		t.Fatalf("secondary indicator = %q, want ▼2", got)
+	}
+	if got := tableSortAria(multi, "pages"); got != "" {
+		t.Fatalf("secondary aria-sort = %q, want empty", got)
+	}
+	if got := tableSortAria(multi, "title"); got != "ascending" {
+		t.Fatalf("primary aria-sort = %q, want ascending", got)
+	}
+}
+
+func TestSchemaTableSortableHeaders(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		PluralDisplayName:   "Books",
+		Columns: []SchemaTableColumn{
+			{Name: "title", Label: "Title", Type: "string", Sortable: true},
+			{Name: "tags", Label: "Tags", Type: "[]string"},
+		},
+		Rows: []SchemaTableRow{{
+			Cells: []SchemaTableCell{{Display: "Dune"}, {Display: "scifi"}},
+		}},
+		Sort:        []vent.ListSort{{Name: "title", Desc: true}},
+		DefaultSort: []vent.ListSort{{Name: "title"}},
+		Pagination:  NewSchemaTablePagination(vent.ParseListPage("1", 10).WithTotal(1)),
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `aria-sort="descending"`) {
+		t.Fatal("sorted header should expose aria-sort")
+	}
+	if !strings.Contains(html, `href="/admin/books/?dir=asc&amp;sort=title"`) {
+		t.Fatal("clicking the primary column should flip its direction")
+	}
+	if strings.Count(html, `class="table-sort"`) != 1 {
+		t.Fatal("only sortable columns should render sort links")
+	}
+	if !strings.Contains(html, `name="sort" value="title"`) || !strings.Contains(html, `name="dir" value="desc"`) {
+		t.Fatal("filter form should keep a non-default sort")
+	}
+}
+
+func TestSchemaTableSearchBox(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:           "users",
+		SingularDisplayName: "User",
+		PluralDisplayName:   "Users",
+		Columns:             []SchemaTableColumn{{Name: "email", Label: "Email", Type: "string"}},
+		Searchable:          true,
+		Search:              "ada",
+		Pagination:          NewSchemaTablePagination(vent.ParseListPage("1", 10).WithTotal(0)),
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `type="search" name="q" value="ada"`) {
+		t.Fatal("search box should render the current query")
+	}
+	if !strings.Contains(html, `aria-label="Clear search"`) {
+		t.Fatal("active search should render a removable chip")
+	}
+
+	props.Searchable = false
+	props.Search = ""
+	buf.Reset()
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), `name="q"`) {
+		t.Fatal("schemas without SearchFields should not render a search box")
+	}
+}
+
 func TestTableWidgetsCookieExpr(t *testing.T) {
 	got := tableWidgetsCookieExpr("/admin/")
 	want := `{include: /^widgets\./, path: "/admin/"}`
@@ -138,6 +294,9 @@ func TestTableColumnKind(t *testing.T) {
 		"float64":   "num",
 		"time":      "time",
 		"time.Time": "time",
+		"enum":      "enum",
+		"file":      "file",
+		"image":     "image",
 		"string":    "text",
 		"edge":      "text",
 		"custom":    "text",
@@ -206,6 +365,9 @@ func TestSchemaTableAddButtonIsALink(t *testing.T) {
 	if strings.Contains(html, `<a href="/admin/books/add/"><button`) {
 		t.Fatal("add control must not nest a button inside a link (submits the filter form)")
 	}
+	if !strings.Contains(html, `<a class="btn btn-outline" href="/admin/books/import/">Import</a>`) {
+		t.Fatal("users who can create should see the import link")
+	}
 }
 
 func TestSchemaTableFilterChipDelimiter(t *testing.T) {
@@ -253,7 +415,7 @@ func TestSchemaTableFilterChipDelimiter(t *testing.T) {
 	if !strings.Contains(html, `method="get"`) || !strings.Contains(html, `action="/admin/users/"`) {
 		t.Fatal("filter form should be a GET to the list path")
 	}
-	if !strings.Contains(html, `data-on:change="el.requestSubmit()"`) {
+	if !strings.Contains(html, `data-on:change="evt.target.name && el.requestSubmit()"`) {
 		t.Fatal("filter changes should submit the GET form")
 	}
 	if !strings.Contains(html, `data-cookie:vent-widgets="`) {
@@ -278,6 +440,129 @@ func TestSchemaTableFilterChipDelimiter(t *testing.T) {
 	}
 }
 
+func TestSchemaTableEnumFilterAndBadge(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		PluralDisplayName:   "Books",
+		Columns: []SchemaTableColumn{
+			{Name: "title", Label: "Title", Type: "string"},
+			{Name: "format", Label: "Format", Type: "enum"},
+		},
+		Rows: []SchemaTableRow{
+			{Cells: []SchemaTableCell{{Display: "Dune", LinkURL: "/admin/books/1/"}, {Display: "ebook"}}},
+		},
+		FilterableColumns: []SchemaTableFilterableColumn{
+			{Name: "format", Label: "Format", Type: "enum", Value: "ebook", Options: []string{"hardcover", "ebook"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `<span class="badge">ebook</span>`) {
+		t.Fatal("enum cells should render as a badge")
+	}
+	if strings.Contains(html, `<span class="badge">Dune</span>`) {
+		t.Fatal("non-enum cells must not render as a badge")
+	}
+	if !strings.Contains(html, `<option value="ebook" selected>ebook</option>`) {
+		t.Fatal("enum filter should select the active value")
+	}
+	if !strings.Contains(html, `<option value="hardcover">hardcover</option>`) {
+		t.Fatal("enum filter should list every option")
+	}
+	if !strings.Contains(html, "Format: <b>ebook</b>") {
+		t.Fatal("active enum filter should render a chip")
+	}
+}
+
+func TestSchemaTableUploadCells(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		PluralDisplayName:   "Books",
+		Columns: []SchemaTableColumn{
+			{Name: "cover", Label: "Cover", Type: "image"},
+			{Name: "sample", Label: "Sample", Type: "file"},
+		},
+		Rows: []SchemaTableRow{
+			{Cells: []SchemaTableCell{{Display: "book/cover/abc.png"}, {Display: "book/sample/def.pdf"}}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `<img class="table-thumb" src="/admin/files/book/cover/abc.png" alt="abc.png">`) {
+		t.Fatal("image cells should render a thumbnail")
+	}
+	if !strings.Contains(html, `href="/admin/files/book/sample/def.pdf"`) || !strings.Contains(html, "def.pdf</a>") {
+		t.Fatal("file cells should render a download link named after the file")
+	}
+}
+
+func TestSchemaEntityFormMultipart(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+
+	field, err := RenderFileFieldHTML(ctx, SchemaEntityFileFieldProps{
+		Name:      "cover",
+		Label:     "Cover",
+		Value:     "book/cover/abc.png",
+		Editable:  true,
+		Image:     true,
+		Clearable: true,
+	})
+	if err != nil {
+		t.Fatalf("RenderFileFieldHTML() error = %v", err)
+	}
+	for _, want := range []string{
+		`<input type="file" name="cover" accept="image/png,image/jpeg,image/gif,image/webp">`,
+		`data-bind="entity.cover" value="book/cover/abc.png"`,
+		`<img class="file-preview" src="/admin/files/book/cover/abc.png"`,
+		`Remove`,
+	} {
+		if !strings.Contains(field, want) {
+			t.Fatalf("file field missing %q:\n%s", want, field)
+		}
+	}
+
+	var buf bytes.Buffer
+	form := SchemaEntityForm(SchemaEntityFormProps{
+		TitleText:     "Add Book",
+		FieldSets:     []SchemaEntityFieldSetProps{{Fields: []SchemaEntityFieldProps{{HTML: field}}}},
+		ActionButtons: []templ.Component{SchemaEntityAddButton("/admin/books/", true)},
+		Multipart:     true,
+	})
+	if err := form.Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`enctype="multipart/form-data"`,
+		`name="csrf_token" value="test-csrf-token"`,
+		`<textarea name="datastar" hidden`,
+		`@post(&#39;/admin/books/&#39;, {contentType: &#39;form&#39;})`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("multipart form missing %q:\n%s", want, html)
+		}
+	}
+}
+
 func TestSchemaTableDrawerRendersOpenFromCookie(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/admin/users/", nil)
 	req.AddCookie(&http.Cookie{
@@ -448,7 +733,7 @@ func TestSchemaTableLoadingWithoutFiltersFetchesRows(t *testing.T) {
 	if strings.Contains(html, `filterSignals: {include: /^(filter\.|page$)/}`) {
 		t.Fatal("list fetch should not send filter or page signals")
 	}
-	if !strings.Contains(html, `data-on:change="el.requestSubmit()"`) {
+	if !strings.Contains(html, `data-on:change="evt.target.name && el.requestSubmit()"`) {
 		t.Fatal("filter form should submit on change")
 	}
 	if !strings.Contains(html, `data-indicator="_indicator"`) {
@@ -607,3 +892,223 @@ func TestSchemaTablePaginationRendersFooter(t *testing.T) {
 		t.Fatal("pagination should not use Datastar page signals")
 	}
 }
+
+func TestSchemaEntityFormFieldSets(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	fieldSets := []SchemaEntityFieldSetProps{
+		{Label: "Book", Fields: []SchemaEntityFieldProps{{HTML: `<div id="title"></div>`}}},
+		{Label: "Empty"},
+		{Label: "Advanced", Description: "Rarely edited.", Collapsible: true, Collapsed: true, Fields: []SchemaEntityFieldProps{{HTML: `<div id="metadata"></div>`}}},
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaEntityForm(SchemaEntityFormProps{TitleText: "Add Book", FieldSets: fieldSets}).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`<h2 class="entity-form-section-title">Book</h2>`,
+		`<details class="entity-form-section"><summary class="entity-form-section-title">Advanced</summary>`,
+		`<p class="entity-form-section-desc">Rarely edited.</p>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("sections form missing %q:\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, "Empty") {
+		t.Fatalf("sections form should skip empty field sets:\n%s", html)
+	}
+
+	buf.Reset()
+	if err := SchemaEntityForm(SchemaEntityFormProps{TitleText: "Add Book", FieldSets: fieldSets, Tabs: true}).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html = buf.String()
+	for _, want := range []string{
+		`data-signals="{_fieldsetTab: 0}"`,
+		`data-on:click="$_fieldsetTab = 1"`,
+		`data-show="$_fieldsetTab === 1"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("tabs form missing %q:\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, "<details") {
+		t.Fatalf("tabs form should not render collapsible sections:\n%s", html)
+	}
+}
+
+func TestSchemaTableEdgeFilterPicker(t *testing.T) {
+	column := SchemaTableFilterableColumn{
+		Name:    "author",
+		Label:   "Author",
+		Type:    "edge",
+		Value:   "2,9",
+		Choices: []SelectOption{{Value: "1", Label: "Le Guin"}, {Value: "2", Label: "Herbert"}},
+	}
+	if got := tableFilterChipValue(column); got != "Herbert, 9" {
+		t.Fatalf("edge chip = %q, want selected labels", got)
+	}
+
+	var buf bytes.Buffer
+	if err := schemaTableFilterField(column).Render(context.Background(), &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `name="filter.author" value="2" checked`) {
+		t.Fatal("selected related entities should be checked")
+	}
+	if strings.Contains(html, `value="1" checked`) {
+		t.Fatal("unselected related entities should not be checked")
+	}
+	if !strings.Contains(html, `data-bind="_picker.author"`) {
+		t.Fatal("picker search should bind a local signal")
+	}
+}
+
+func TestSchemaEntityChangeRelatedLinks(t *testing.T) {
+	links := relatedListLinks("/admin/", "7", []SchemaEntityRelatedList{
+		{Label: "Books by Author", RouteName: "books", FilterName: "author", AddLabel: "Add Book"},
+	})
+	if len(links) != 2 || links[0].URL != "/admin/books/?filter.author=7" {
+		t.Fatalf("links = %+v, want filtered books list", links)
+	}
+	if links[1].Label != "Add Book" || links[1].URL != "/admin/books/add/?author=7" {
+		t.Fatalf("links[1] = %+v, want book add form with the author", links[1])
+	}
+
+	var buf bytes.Buffer
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	if err := SchemaEntityForm(SchemaEntityFormProps{TitleText: "Change Herbert", RelatedLinks: links}).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(buf.String(), `href="/admin/books/?filter.author=7">Books by Author</a>`) {
+		t.Fatalf("form header should link to related lists:\n%s", buf.String())
+	}
+}
+
+func TestSchemaTableExportMenu(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		PluralDisplayName:   "Books",
+		FilterableColumns: []SchemaTableFilterableColumn{
+			{Name: "title", Type: "string", Value: "dune"},
+		},
+		Sort: []vent.ListSort{{Name: "title", Desc: true}},
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), "table-export") {
+		t.Fatal("export menu should be hidden without the export permission")
+	}
+
+	props.RenderContext.CanExport = true
+	buf.Reset()
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	want := `href="/admin/books/export/?dir=desc&amp;filter.title=dune&amp;format=xlsx&amp;sort=title"`
+	if !strings.Contains(html, want) {
+		t.Fatalf("export link should carry the list query:\n%s", html)
+	}
+	for _, format := range vent.ExportFormats {
+		if !strings.Contains(html, ">"+format.Label()+"</a>") {
+			t.Fatalf("export menu missing %s", format.Label())
+		}
+	}
+}
+
+func TestSchemaTableBulkSelection(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:           "books",
+		SingularDisplayName: "Book",
+		PluralDisplayName:   "Books",
+		Columns:             []SchemaTableColumn{{Name: "title", Label: "Title", Type: "string"}},
+		FilterableColumns: []SchemaTableFilterableColumn{
+			{Name: "title", Type: "string", Value: "dune"},
+		},
+		Rows: []SchemaTableRow{
+			{ID: "1", Cells: []SchemaTableCell{{Display: "Dune"}}},
+			{ID: "2", Cells: []SchemaTableCell{{Display: "Dune Messiah"}}},
+		},
+		Pagination: SchemaTablePagination{Page: 2, PageSize: 2, Total: 5, TotalPages: 3},
+	}
+
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), "table-select") {
+		t.Fatal("rows should not be selectable without bulk actions")
+	}
+
+	props.BulkActions = []SchemaTableBulkAction{
+		{Name: "delete", Label: "Delete", Confirm: "Delete the selected Books?", Danger: true},
+		{Name: "publish", Label: "Mark published"},
+	}
+	buf.Reset()
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`data-signals:bulk__ifmissing="{action: '', ids: [], all: false}"`,
+		`data-on:change="$bulk.all = false; $bulk.ids = el.checked ? [&#34;1&#34;,&#34;2&#34;] : []"`,
+		`data-effect="el.checked = $bulk.all || $bulk.ids.includes(&#34;2&#34;)"`,
+		`data-show="!$bulk.all &amp;&amp; $bulk.ids.length === 2"`,
+		"Select all 5 matching",
+		`$bulk.action = &#34;delete&#34;; @confirm(&#34;Delete the selected Books?&#34;) &amp;&amp; @post(&#34;/admin/books/bulk/?filter.title=dune&amp;page=2&#34;)`,
+		`$bulk.action = &#34;publish&#34;; @post(`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("bulk selection missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestSchemaTableBulkResult(t *testing.T) {
+	ctx := requestctx.WithAdminPath(context.Background(), "/admin/")
+	ctx = requestctx.WithCSRFToken(ctx, "test-csrf-token")
+	ctx = requestctx.WithTheme(ctx, "system")
+
+	props := SchemaTableProps{
+		RouteName:         "books",
+		PluralDisplayName: "Books",
+		BulkResult: &vent.BulkResult{
+			Label:     "Delete",
+			Succeeded: 2,
+			Failures:  []vent.BulkFailure{{ID: "3", Name: "Emma", Message: "forbidden"}},
+		},
+	}
+	var buf bytes.Buffer
+	if err := SchemaTablePage(props).Render(ctx, &buf); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`class="alert alert-error table-bulk-result"`,
+		"Delete: done for 2 of 3 Books; 1 failed.",
+		"<li><b>Emma</b>: forbidden</li>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("bulk result missing %q:\n%s", want, html)
+		}
+	}
+
+	if got := tableBulkResultSummary(vent.BulkResult{Label: "Delete", Succeeded: 3}, "Books"); got != "Delete: done for 3 Books." {
+		t.Fatalf("summary = %q", got)
+	}
+}
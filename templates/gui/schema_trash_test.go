@@ -0,0 +1,53 @@
+package gui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSchemaTrashPage(t *testing.T) {
+	props := SchemaTrashProps{
+		RouteName:         "publishers",
+		PluralDisplayName: "Publishers",
+		Columns:           []string{"Name", "ID"},
+		Rows: []SchemaTrashRow{
+			{ID: "7", Display: "Acme", Cells: []string{"Acme", "7"}, DeletedAt: time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC)},
+		},
+		CanRestore: true,
+	}
+	render := func() string {
+		var buf bytes.Buffer
+		if err := SchemaTrashPage(props).Render(entityActionsTestContext(), &buf); err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return buf.String()
+	}
+
+	html := render()
+	for _, want := range []string{
+		`<th scope="col">Name</th><th scope="col">ID</th><th scope="col">Deleted</th>`,
+		`<th scope="row">Acme</th><td>7</td>`,
+		`<time datetime="2024-03-05T09:30:00Z">2024-03-05 09:30</time>`,
+		`@post(&#34;/admin/publishers/7/restore/&#34;)`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("trash page missing %q:\n%s", want, html)
+		}
+	}
+	if strings.Contains(html, "/purge/") {
+		t.Fatalf("trash page should hide purge without permission:\n%s", html)
+	}
+
+	props.CanRestore, props.CanPurge = false, true
+	html = render()
+	if strings.Contains(html, "/restore/") || !strings.Contains(html, `<a class="btn btn-outline" href="/admin/publishers/7/purge/">Purge</a>`) {
+		t.Fatalf("trash page should offer only purge:\n%s", html)
+	}
+
+	props.Rows = nil
+	if html = render(); !strings.Contains(html, "The trash is empty.") {
+		t.Fatalf("empty trash page missing notice:\n%s", html)
+	}
+}
@@ -0,0 +1,259 @@
+package vent
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+	"github.com/troygilman/vent/auth"
+)
+
+// signalsFormField carries the JSON-encoded Datastar signals in multipart forms.
+const signalsFormField = "datastar"
+
+// FileStorage persists uploaded files under opaque keys. Keys are slash-separated
+// relative paths generated by Vent (e.g. "book/cover/3f2a….png").
+// Delete removes a stored file; deleting a missing key is not an error.
+type FileStorage interface {
+	Save(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// LocalFileStorage stores uploads beneath a directory on the local filesystem.
+type LocalFileStorage struct {
+	root string
+}
+
+// NewLocalFileStorage returns a FileStorage rooted at dir.
+func NewLocalFileStorage(dir string) *LocalFileStorage {
+	return &LocalFileStorage{root: dir}
+}
+
+func (s *LocalFileStorage) Save(_ context.Context, key string, r io.Reader) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(name)
+		return err
+	}
+	return f.Close()
+}
+
+func (s *LocalFileStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(name)
+}
+
+func (s *LocalFileStorage) Delete(_ context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalFileStorage) path(key string) (string, error) {
+	name := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(name) {
+		return "", fmt.Errorf("invalid file key %q", key)
+	}
+	return filepath.Join(s.root, name), nil
+}
+
+// ReadSignals decodes Datastar signals from a request. JSON bodies are read as
+// usual; multipart form submissions carry the signals in the "datastar" form
+// value alongside any uploaded files, which remain available on r.MultipartForm.
+func ReadSignals(r *http.Request, signals any) error {
+	if !auth.IsMultipartRequest(r) {
+		return datastar.ReadSignals(r, signals)
+	}
+	if err := auth.ParseMultipartForm(r); err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(r.FormValue(signalsFormField)), signals)
+}
+
+type fileStorageKey struct{}
+type uploadFormKey struct{}
+
+// uploadForm is the multipart form upload fields save from, with the keys
+// saved from it so far.
+type uploadForm struct {
+	form  *multipart.Form
+	saved []string
+}
+
+// WithFileStorage attaches the storage used by generated upload fields.
+func WithFileStorage(ctx context.Context, storage FileStorage) context.Context {
+	return context.WithValue(ctx, fileStorageKey{}, storage)
+}
+
+// WithUploadForm attaches the parsed multipart form whose files upload fields save.
+func WithUploadForm(ctx context.Context, form *multipart.Form) context.Context {
+	return context.WithValue(ctx, uploadFormKey{}, &uploadForm{form: form})
+}
+
+// DiscardUploads deletes the files saved from ctx's upload form, for a save
+// that rolled back after storing them.
+func DiscardUploads(ctx context.Context) error {
+	upload, _ := ctx.Value(uploadFormKey{}).(*uploadForm)
+	storage, _ := ctx.Value(fileStorageKey{}).(FileStorage)
+	if upload == nil || storage == nil {
+		return nil
+	}
+	var errs []error
+	for _, key := range upload.saved {
+		if err := storage.Delete(ctx, key); err != nil {
+			errs = append(errs, fmt.Errorf("deleting upload %q: %w", key, err))
+		}
+	}
+	upload.saved = nil
+	return errors.Join(errs...)
+}
+
+// SaveUpload stores the file submitted for field under dir and returns its key.
+// ok is false when the request carried no file for field.
+func SaveUpload(ctx context.Context, field, dir string) (key string, ok bool, err error) {
+	return saveUpload(ctx, field, dir, false)
+}
+
+// SaveImageUpload is SaveUpload restricted to raster images (PNG, JPEG, GIF, WebP).
+func SaveImageUpload(ctx context.Context, field, dir string) (key string, ok bool, err error) {
+	return saveUpload(ctx, field, dir, true)
+}
+
+func saveUpload(ctx context.Context, field, dir string, image bool) (string, bool, error) {
+	upload, _ := ctx.Value(uploadFormKey{}).(*uploadForm)
+	if upload == nil || upload.form == nil || len(upload.form.File[field]) == 0 {
+		return "", false, nil
+	}
+	header := upload.form.File[field][0]
+	if header.Size == 0 && header.Filename == "" {
+		return "", false, nil
+	}
+	storage, _ := ctx.Value(fileStorageKey{}).(FileStorage)
+	if storage == nil {
+		return "", false, Internal(errors.New("file storage is not configured"))
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return "", false, BadRequest(fmt.Sprintf("invalid %s upload", field)).WithCause(err)
+	}
+	defer file.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", false, BadRequest(fmt.Sprintf("invalid %s upload", field)).WithCause(err)
+	}
+	head = head[:n]
+	ext := uploadExtension(header.Filename)
+	if image {
+		imageExt, ok := imageExtensions[http.DetectContentType(head)]
+		if !ok {
+			return "", false, BadRequest(fmt.Sprintf("%s must be a PNG, JPEG, GIF, or WebP image", field))
+		}
+		ext = imageExt
+	}
+
+	key := path.Join(dir, rand.Text()+ext)
+	if err := storage.Save(ctx, key, io.MultiReader(bytes.NewReader(head), file)); err != nil {
+		return "", false, err
+	}
+	upload.saved = append(upload.saved, key)
+	return key, true, nil
+}
+
+var imageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// uploadExtension keeps a short alphanumeric extension from the client filename.
+func uploadExtension(filename string) string {
+	ext := strings.ToLower(path.Ext(filename))
+	if len(ext) < 2 || len(ext) > 10 {
+		return ""
+	}
+	for _, r := range ext[1:] {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return ""
+		}
+	}
+	return ext
+}
+
+// FileName returns the display name of a stored file key.
+func FileName(key string) string {
+	if key == "" {
+		return ""
+	}
+	return path.Base(key)
+}
+
+// FileHandler serves stored files by key from the request path once authorize
+// allows the key. Raster images are served inline; everything else downloads
+// as an attachment.
+func FileHandler(storage FileStorage, authorize func(ctx context.Context, key string) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/")
+		if err := authorize(r.Context(), key); err != nil {
+			HandleError(w, r, err)
+			return
+		}
+		file, err := storage.Open(r.Context(), key)
+		if err != nil {
+			HandleError(w, r, NotFound("file not found").WithCause(err))
+			return
+		}
+		defer file.Close()
+
+		ext := path.Ext(key)
+		contentType := "application/octet-stream"
+		disposition := "attachment"
+		for imageType, imageExt := range imageExtensions {
+			if ext == imageExt {
+				contentType = imageType
+				disposition = "inline"
+			}
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": path.Base(key)}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		w.Header().Set("Cache-Control", "private, max-age=3600")
+		_, _ = io.Copy(w, file)
+	})
+}
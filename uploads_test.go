@@ -0,0 +1,165 @@
+package vent
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// pngHeader is enough of a PNG for http.DetectContentType.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newMultipartRequest(t *testing.T, signals string, files map[string][]byte) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField(signalsFormField, signals); err != nil {
+		t.Fatalf("WriteField() error = %v", err)
+	}
+	for name, content := range files {
+		part, err := writer.CreateFormFile(name, name+".upload")
+		if err != nil {
+			t.Fatalf("CreateFormFile() error = %v", err)
+		}
+		part.Write(content)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/admin/books/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return r
+}
+
+func TestReadSignalsMultipart(t *testing.T) {
+	r := newMultipartRequest(t, `{"entity":{"title":"Dune"}}`, map[string][]byte{"cover": pngHeader})
+
+	var signals struct {
+		Entity struct {
+			Title string `json:"title"`
+		} `json:"entity"`
+	}
+	if err := ReadSignals(r, &signals); err != nil {
+		t.Fatalf("ReadSignals() error = %v", err)
+	}
+	if signals.Entity.Title != "Dune" {
+		t.Fatalf("title = %q, want Dune", signals.Entity.Title)
+	}
+	if r.MultipartForm == nil || len(r.MultipartForm.File["cover"]) != 1 {
+		t.Fatal("uploaded files should remain on r.MultipartForm")
+	}
+}
+
+func TestSaveUploadAndServe(t *testing.T) {
+	storage := NewLocalFileStorage(t.TempDir())
+	r := newMultipartRequest(t, `{}`, map[string][]byte{
+		"cover":  pngHeader,
+		"sample": []byte("plain text"),
+	})
+	if err := ReadSignals(r, &struct{}{}); err != nil {
+		t.Fatalf("ReadSignals() error = %v", err)
+	}
+	ctx := WithUploadForm(WithFileStorage(context.Background(), storage), r.MultipartForm)
+
+	key, ok, err := SaveImageUpload(ctx, "cover", "book/cover")
+	if err != nil || !ok {
+		t.Fatalf("SaveImageUpload() = %q, %v, %v", key, ok, err)
+	}
+	if !strings.HasPrefix(key, "book/cover/") || !strings.HasSuffix(key, ".png") {
+		t.Fatalf("key = %q, want book/cover/*.png", key)
+	}
+	if _, _, err := SaveImageUpload(ctx, "sample", "book/sample"); err == nil {
+		t.Fatal("SaveImageUpload() should reject non-image uploads")
+	}
+	if _, ok, err := SaveUpload(ctx, "missing", "book/missing"); ok || err != nil {
+		t.Fatalf("SaveUpload(missing) = %v, %v, want no file", ok, err)
+	}
+
+	var authorized string
+	recorder := httptest.NewRecorder()
+	FileHandler(storage, func(_ context.Context, key string) error {
+		authorized = key
+		return nil
+	}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/"+key, nil))
+	if authorized != key {
+		t.Fatalf("authorized key = %q, want %q", authorized, key)
+	}
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+	if got := recorder.Header().Get("Content-Type"); got != "image/png" {
+		t.Fatalf("Content-Type = %q, want image/png", got)
+	}
+	if body, _ := io.ReadAll(recorder.Body); !bytes.Equal(body, pngHeader) {
+		t.Fatalf("body = %q, want stored upload", body)
+	}
+}
+
+func TestFileHandlerDeniesUnauthorizedKeys(t *testing.T) {
+	storage := NewLocalFileStorage(t.TempDir())
+	if err := storage.Save(context.Background(), "book/cover/a.png", bytes.NewReader(pngHeader)); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	FileHandler(storage, func(context.Context, string) error {
+		return Forbidden("forbidden")
+	}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/book/cover/a.png", nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", recorder.Code)
+	}
+	if bytes.Contains(recorder.Body.Bytes(), pngHeader) {
+		t.Fatal("denied file was served")
+	}
+}
+
+func TestDiscardUploads(t *testing.T) {
+	storage := NewLocalFileStorage(t.TempDir())
+	r := newMultipartRequest(t, `{}`, map[string][]byte{"cover": pngHeader})
+	if err := ReadSignals(r, &struct{}{}); err != nil {
+		t.Fatalf("ReadSignals() error = %v", err)
+	}
+	ctx := WithUploadForm(WithFileStorage(context.Background(), storage), r.MultipartForm)
+
+	key, ok, err := SaveImageUpload(ctx, "cover", "book/cover")
+	if err != nil || !ok {
+		t.Fatalf("SaveImageUpload() = %q, %v, %v", key, ok, err)
+	}
+	if err := DiscardUploads(ctx); err != nil {
+		t.Fatalf("DiscardUploads() error = %v", err)
+	}
+	if _, err := storage.Open(ctx, key); err == nil {
+		t.Fatal("discarded upload should be deleted")
+	}
+	if err := DiscardUploads(ctx); err != nil {
+		t.Fatalf("DiscardUploads() again error = %v", err)
+	}
+	if err := DiscardUploads(context.Background()); err != nil {
+		t.Fatalf("DiscardUploads() without a form error = %v", err)
+	}
+}
+
+func TestLocalFileStorageRejectsEscapingKeys(t *testing.T) {
+	storage := NewLocalFileStorage(t.TempDir())
+	for _, key := range []string{"", "../secret", "/etc/passwd"} {
+		if err := storage.Save(context.Background(), key, strings.NewReader("x")); err == nil {
+			t.Fatalf("Save(%q) error = nil, want invalid key", key)
+		}
+	}
+}
+
+func TestSaveUploadRequiresStorage(t *testing.T) {
+	r := newMultipartRequest(t, `{}`, map[string][]byte{"sample": []byte("x")})
+	if err := ReadSignals(r, &struct{}{}); err != nil {
+		t.Fatalf("ReadSignals() error = %v", err)
+	}
+	ctx := WithUploadForm(context.Background(), r.MultipartForm)
+	if _, _, err := SaveUpload(ctx, "sample", "book/sample"); err == nil {
+		t.Fatal("SaveUpload() without storage should fail")
+	}
+}